@@ -87,19 +87,23 @@ export function GenerationPanel({ semesterId }: GenerationPanelProps) {
             <span className={`text-sm font-medium ${statusCfg.color}`}>{statusCfg.label}</span>
           </div>
 
-          {/* Real-time progress bar from SSE */}
+          {/* Real-time progress bar from SSE; the best depth reached never moves back */}
           {progress && status === 'generating' && (
             <div className="space-y-1">
               <div className="flex justify-between text-xs text-muted-foreground">
                 <span>Assigning teachers…</span>
-                <span>{progress.assigned}/{progress.total} assigned</span>
+                <span>{progress.best_assigned}/{progress.total} assigned</span>
               </div>
               <div className="h-1.5 w-full rounded-full bg-muted overflow-hidden">
                 <div
                   className="h-full rounded-full bg-blue-500 transition-all"
-                  style={{ width: `${progress.total > 0 ? (progress.assigned / progress.total) * 100 : 0}%` }}
+                  style={{ width: `${progress.total > 0 ? (progress.best_assigned / progress.total) * 100 : 0}%` }}
                 />
               </div>
+              <div className="flex justify-between text-xs text-muted-foreground">
+                <span>{progress.backtracks} backtracks · penalty {progress.soft_penalty.toFixed(2)}</span>
+                <span>{(progress.elapsed_ms / 1000).toFixed(1)}s</span>
+              </div>
             </div>
           )}
 
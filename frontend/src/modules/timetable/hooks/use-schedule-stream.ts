@@ -5,9 +5,15 @@ import type { Schedule } from '../types'
 // SSE event types emitted by the schedule generation stream
 type StreamStatus = 'idle' | 'started' | 'completed' | 'failed'
 
+// Search progress, published at most twice a second while the solver runs
 interface ProgressPayload {
   assigned: number
   total: number
+  best_assigned: number
+  backtracks: number
+  nodes: number
+  soft_penalty: number
+  elapsed_ms: number
 }
 
 interface UseScheduleStreamReturn {
@@ -83,6 +83,8 @@ export function useTeacherSuggestions(scheduleId: string, entry: ScheduleEntry |
     queryFn: async () => {
       const { data } = await apiClient.get<TeacherSuggestion[]>(ENDPOINTS.timetable.suggestTeachers, {
         params: {
+          schedule_id: scheduleId,
+          entry_id: entry!.id,
           subject_id: entry!.subject_id,
           day_of_week: entry!.day_of_week,
           start_period: entry!.start_period,
@@ -65,6 +65,14 @@ export interface ScheduleEntry {
   end_period: number
   is_manual_override: boolean
   department_id: string
+  section?: number          // 1-based section of the subject
+  teachers?: EntryTeacher[] // lead first, then assistants
+}
+
+export interface EntryTeacher {
+  teacher_id: string
+  teacher_name: string
+  role: 'lead' | 'assistant'
 }
 
 export interface Schedule {
@@ -156,10 +156,12 @@ func (x *Teacher) GetPhone() string {
 }
 
 type TimeSlot struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DayOfWeek     int32                  `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
-	StartPeriod   int32                  `protobuf:"varint,2,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
-	EndPeriod     int32                  `protobuf:"varint,3,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	DayOfWeek   int32                  `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	StartPeriod int32                  `protobuf:"varint,2,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	EndPeriod   int32                  `protobuf:"varint,3,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+	// "neutral", "preferred" or "disliked"; empty keeps the stored level on update.
+	Preference    string `protobuf:"bytes,4,opt,name=preference,proto3" json:"preference,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -215,6 +217,13 @@ func (x *TimeSlot) GetEndPeriod() int32 {
 	return 0
 }
 
+func (x *TimeSlot) GetPreference() string {
+	if x != nil {
+		return x.Preference
+	}
+	return ""
+}
+
 type CreateTeacherRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	FullName        string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
@@ -959,6 +968,209 @@ func (x *UpdateTeacherAvailabilityResponse) GetAvailableSlots() []*TimeSlot {
 	return nil
 }
 
+type ListTeacherPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeacherPreferencesRequest) Reset() {
+	*x = ListTeacherPreferencesRequest{}
+	mi := &file_hr_v1_teacher_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeacherPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeacherPreferencesRequest) ProtoMessage() {}
+
+func (x *ListTeacherPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_v1_teacher_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeacherPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*ListTeacherPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_hr_v1_teacher_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListTeacherPreferencesRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+// Only slots marked preferred or disliked are returned.
+type ListTeacherPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Preferences   []*TimeSlot            `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeacherPreferencesResponse) Reset() {
+	*x = ListTeacherPreferencesResponse{}
+	mi := &file_hr_v1_teacher_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeacherPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeacherPreferencesResponse) ProtoMessage() {}
+
+func (x *ListTeacherPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_v1_teacher_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeacherPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*ListTeacherPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_hr_v1_teacher_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTeacherPreferencesResponse) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ListTeacherPreferencesResponse) GetPreferences() []*TimeSlot {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// Replaces all preference levels; slots not listed become neutral. Each entry
+// must match an existing availability slot by day_of_week and start_period.
+type UpdateTeacherPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Preferences   []*TimeSlot            `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeacherPreferencesRequest) Reset() {
+	*x = UpdateTeacherPreferencesRequest{}
+	mi := &file_hr_v1_teacher_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeacherPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeacherPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateTeacherPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_v1_teacher_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeacherPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeacherPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_hr_v1_teacher_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateTeacherPreferencesRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *UpdateTeacherPreferencesRequest) GetPreferences() []*TimeSlot {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateTeacherPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Preferences   []*TimeSlot            `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeacherPreferencesResponse) Reset() {
+	*x = UpdateTeacherPreferencesResponse{}
+	mi := &file_hr_v1_teacher_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeacherPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeacherPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateTeacherPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_v1_teacher_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeacherPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTeacherPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_hr_v1_teacher_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateTeacherPreferencesResponse) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *UpdateTeacherPreferencesResponse) GetPreferences() []*TimeSlot {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
 var File_hr_v1_teacher_proto protoreflect.FileDescriptor
 
 const file_hr_v1_teacher_proto_rawDesc = "" +
@@ -979,12 +1191,15 @@ const file_hr_v1_teacher_proto_rawDesc = "" +
 	"\x12max_hours_per_week\x18\n" +
 	" \x01(\x05R\x0fmaxHoursPerWeek\x12(\n" +
 	"\x0fspecializations\x18\v \x03(\tR\x0fspecializations\x12\x14\n" +
-	"\x05phone\x18\f \x01(\tR\x05phone\"l\n" +
+	"\x05phone\x18\f \x01(\tR\x05phone\"\x8c\x01\n" +
 	"\bTimeSlot\x12\x1e\n" +
 	"\vday_of_week\x18\x01 \x01(\x05R\tdayOfWeek\x12!\n" +
 	"\fstart_period\x18\x02 \x01(\x05R\vstartPeriod\x12\x1d\n" +
 	"\n" +
-	"end_period\x18\x03 \x01(\x05R\tendPeriod\"\x96\x02\n" +
+	"end_period\x18\x03 \x01(\x05R\tendPeriod\x12\x1e\n" +
+	"\n" +
+	"preference\x18\x04 \x01(\tR\n" +
+	"preference\"\x96\x02\n" +
 	"\x14CreateTeacherRequest\x12\x1b\n" +
 	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12#\n" +
@@ -1044,7 +1259,22 @@ const file_hr_v1_teacher_proto_rawDesc = "" +
 	"!UpdateTeacherAvailabilityResponse\x12\x1d\n" +
 	"\n" +
 	"teacher_id\x18\x01 \x01(\tR\tteacherId\x128\n" +
-	"\x0favailable_slots\x18\x02 \x03(\v2\x0f.hr.v1.TimeSlotR\x0eavailableSlots2\xda\x04\n" +
+	"\x0favailable_slots\x18\x02 \x03(\v2\x0f.hr.v1.TimeSlotR\x0eavailableSlots\">\n" +
+	"\x1dListTeacherPreferencesRequest\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\"r\n" +
+	"\x1eListTeacherPreferencesResponse\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x121\n" +
+	"\vpreferences\x18\x02 \x03(\v2\x0f.hr.v1.TimeSlotR\vpreferences\"s\n" +
+	"\x1fUpdateTeacherPreferencesRequest\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x121\n" +
+	"\vpreferences\x18\x02 \x03(\v2\x0f.hr.v1.TimeSlotR\vpreferences\"t\n" +
+	" UpdateTeacherPreferencesResponse\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x121\n" +
+	"\vpreferences\x18\x02 \x03(\v2\x0f.hr.v1.TimeSlotR\vpreferences2\xae\x06\n" +
 	"\x0eTeacherService\x12J\n" +
 	"\rCreateTeacher\x12\x1b.hr.v1.CreateTeacherRequest\x1a\x1c.hr.v1.CreateTeacherResponse\x12A\n" +
 	"\n" +
@@ -1053,7 +1283,9 @@ const file_hr_v1_teacher_proto_rawDesc = "" +
 	"\rUpdateTeacher\x12\x1b.hr.v1.UpdateTeacherRequest\x1a\x1c.hr.v1.UpdateTeacherResponse\x12J\n" +
 	"\rDeleteTeacher\x12\x1b.hr.v1.DeleteTeacherRequest\x1a\x1c.hr.v1.DeleteTeacherResponse\x12h\n" +
 	"\x17ListTeacherAvailability\x12%.hr.v1.ListTeacherAvailabilityRequest\x1a&.hr.v1.ListTeacherAvailabilityResponse\x12n\n" +
-	"\x19UpdateTeacherAvailability\x12'.hr.v1.UpdateTeacherAvailabilityRequest\x1a(.hr.v1.UpdateTeacherAvailabilityResponseB4Z2github.com/HuynhHoangPhuc/myrmex/gen/go/hr/v1;hrv1b\x06proto3"
+	"\x19UpdateTeacherAvailability\x12'.hr.v1.UpdateTeacherAvailabilityRequest\x1a(.hr.v1.UpdateTeacherAvailabilityResponse\x12e\n" +
+	"\x16ListTeacherPreferences\x12$.hr.v1.ListTeacherPreferencesRequest\x1a%.hr.v1.ListTeacherPreferencesResponse\x12k\n" +
+	"\x18UpdateTeacherPreferences\x12&.hr.v1.UpdateTeacherPreferencesRequest\x1a'.hr.v1.UpdateTeacherPreferencesResponseB4Z2github.com/HuynhHoangPhuc/myrmex/gen/go/hr/v1;hrv1b\x06proto3"
 
 var (
 	file_hr_v1_teacher_proto_rawDescOnce sync.Once
@@ -1067,7 +1299,7 @@ func file_hr_v1_teacher_proto_rawDescGZIP() []byte {
 	return file_hr_v1_teacher_proto_rawDescData
 }
 
-var file_hr_v1_teacher_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_hr_v1_teacher_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_hr_v1_teacher_proto_goTypes = []any{
 	(*Teacher)(nil),                           // 0: hr.v1.Teacher
 	(*TimeSlot)(nil),                          // 1: hr.v1.TimeSlot
@@ -1085,41 +1317,52 @@ var file_hr_v1_teacher_proto_goTypes = []any{
 	(*ListTeacherAvailabilityResponse)(nil),   // 13: hr.v1.ListTeacherAvailabilityResponse
 	(*UpdateTeacherAvailabilityRequest)(nil),  // 14: hr.v1.UpdateTeacherAvailabilityRequest
 	(*UpdateTeacherAvailabilityResponse)(nil), // 15: hr.v1.UpdateTeacherAvailabilityResponse
-	(*timestamppb.Timestamp)(nil),             // 16: google.protobuf.Timestamp
-	(*v1.PaginationRequest)(nil),              // 17: core.v1.PaginationRequest
-	(*v1.PaginationResponse)(nil),             // 18: core.v1.PaginationResponse
+	(*ListTeacherPreferencesRequest)(nil),     // 16: hr.v1.ListTeacherPreferencesRequest
+	(*ListTeacherPreferencesResponse)(nil),    // 17: hr.v1.ListTeacherPreferencesResponse
+	(*UpdateTeacherPreferencesRequest)(nil),   // 18: hr.v1.UpdateTeacherPreferencesRequest
+	(*UpdateTeacherPreferencesResponse)(nil),  // 19: hr.v1.UpdateTeacherPreferencesResponse
+	(*timestamppb.Timestamp)(nil),             // 20: google.protobuf.Timestamp
+	(*v1.PaginationRequest)(nil),              // 21: core.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),             // 22: core.v1.PaginationResponse
 }
 var file_hr_v1_teacher_proto_depIdxs = []int32{
-	16, // 0: hr.v1.Teacher.created_at:type_name -> google.protobuf.Timestamp
-	16, // 1: hr.v1.Teacher.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 0: hr.v1.Teacher.created_at:type_name -> google.protobuf.Timestamp
+	20, // 1: hr.v1.Teacher.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: hr.v1.CreateTeacherResponse.teacher:type_name -> hr.v1.Teacher
 	0,  // 3: hr.v1.GetTeacherResponse.teacher:type_name -> hr.v1.Teacher
-	17, // 4: hr.v1.ListTeachersRequest.pagination:type_name -> core.v1.PaginationRequest
+	21, // 4: hr.v1.ListTeachersRequest.pagination:type_name -> core.v1.PaginationRequest
 	0,  // 5: hr.v1.ListTeachersResponse.teachers:type_name -> hr.v1.Teacher
-	18, // 6: hr.v1.ListTeachersResponse.pagination:type_name -> core.v1.PaginationResponse
+	22, // 6: hr.v1.ListTeachersResponse.pagination:type_name -> core.v1.PaginationResponse
 	0,  // 7: hr.v1.UpdateTeacherResponse.teacher:type_name -> hr.v1.Teacher
 	1,  // 8: hr.v1.ListTeacherAvailabilityResponse.available_slots:type_name -> hr.v1.TimeSlot
 	1,  // 9: hr.v1.UpdateTeacherAvailabilityRequest.available_slots:type_name -> hr.v1.TimeSlot
 	1,  // 10: hr.v1.UpdateTeacherAvailabilityResponse.available_slots:type_name -> hr.v1.TimeSlot
-	2,  // 11: hr.v1.TeacherService.CreateTeacher:input_type -> hr.v1.CreateTeacherRequest
-	4,  // 12: hr.v1.TeacherService.GetTeacher:input_type -> hr.v1.GetTeacherRequest
-	6,  // 13: hr.v1.TeacherService.ListTeachers:input_type -> hr.v1.ListTeachersRequest
-	8,  // 14: hr.v1.TeacherService.UpdateTeacher:input_type -> hr.v1.UpdateTeacherRequest
-	10, // 15: hr.v1.TeacherService.DeleteTeacher:input_type -> hr.v1.DeleteTeacherRequest
-	12, // 16: hr.v1.TeacherService.ListTeacherAvailability:input_type -> hr.v1.ListTeacherAvailabilityRequest
-	14, // 17: hr.v1.TeacherService.UpdateTeacherAvailability:input_type -> hr.v1.UpdateTeacherAvailabilityRequest
-	3,  // 18: hr.v1.TeacherService.CreateTeacher:output_type -> hr.v1.CreateTeacherResponse
-	5,  // 19: hr.v1.TeacherService.GetTeacher:output_type -> hr.v1.GetTeacherResponse
-	7,  // 20: hr.v1.TeacherService.ListTeachers:output_type -> hr.v1.ListTeachersResponse
-	9,  // 21: hr.v1.TeacherService.UpdateTeacher:output_type -> hr.v1.UpdateTeacherResponse
-	11, // 22: hr.v1.TeacherService.DeleteTeacher:output_type -> hr.v1.DeleteTeacherResponse
-	13, // 23: hr.v1.TeacherService.ListTeacherAvailability:output_type -> hr.v1.ListTeacherAvailabilityResponse
-	15, // 24: hr.v1.TeacherService.UpdateTeacherAvailability:output_type -> hr.v1.UpdateTeacherAvailabilityResponse
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	1,  // 11: hr.v1.ListTeacherPreferencesResponse.preferences:type_name -> hr.v1.TimeSlot
+	1,  // 12: hr.v1.UpdateTeacherPreferencesRequest.preferences:type_name -> hr.v1.TimeSlot
+	1,  // 13: hr.v1.UpdateTeacherPreferencesResponse.preferences:type_name -> hr.v1.TimeSlot
+	2,  // 14: hr.v1.TeacherService.CreateTeacher:input_type -> hr.v1.CreateTeacherRequest
+	4,  // 15: hr.v1.TeacherService.GetTeacher:input_type -> hr.v1.GetTeacherRequest
+	6,  // 16: hr.v1.TeacherService.ListTeachers:input_type -> hr.v1.ListTeachersRequest
+	8,  // 17: hr.v1.TeacherService.UpdateTeacher:input_type -> hr.v1.UpdateTeacherRequest
+	10, // 18: hr.v1.TeacherService.DeleteTeacher:input_type -> hr.v1.DeleteTeacherRequest
+	12, // 19: hr.v1.TeacherService.ListTeacherAvailability:input_type -> hr.v1.ListTeacherAvailabilityRequest
+	14, // 20: hr.v1.TeacherService.UpdateTeacherAvailability:input_type -> hr.v1.UpdateTeacherAvailabilityRequest
+	16, // 21: hr.v1.TeacherService.ListTeacherPreferences:input_type -> hr.v1.ListTeacherPreferencesRequest
+	18, // 22: hr.v1.TeacherService.UpdateTeacherPreferences:input_type -> hr.v1.UpdateTeacherPreferencesRequest
+	3,  // 23: hr.v1.TeacherService.CreateTeacher:output_type -> hr.v1.CreateTeacherResponse
+	5,  // 24: hr.v1.TeacherService.GetTeacher:output_type -> hr.v1.GetTeacherResponse
+	7,  // 25: hr.v1.TeacherService.ListTeachers:output_type -> hr.v1.ListTeachersResponse
+	9,  // 26: hr.v1.TeacherService.UpdateTeacher:output_type -> hr.v1.UpdateTeacherResponse
+	11, // 27: hr.v1.TeacherService.DeleteTeacher:output_type -> hr.v1.DeleteTeacherResponse
+	13, // 28: hr.v1.TeacherService.ListTeacherAvailability:output_type -> hr.v1.ListTeacherAvailabilityResponse
+	15, // 29: hr.v1.TeacherService.UpdateTeacherAvailability:output_type -> hr.v1.UpdateTeacherAvailabilityResponse
+	17, // 30: hr.v1.TeacherService.ListTeacherPreferences:output_type -> hr.v1.ListTeacherPreferencesResponse
+	19, // 31: hr.v1.TeacherService.UpdateTeacherPreferences:output_type -> hr.v1.UpdateTeacherPreferencesResponse
+	23, // [23:32] is the sub-list for method output_type
+	14, // [14:23] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_hr_v1_teacher_proto_init() }
@@ -1135,7 +1378,7 @@ func file_hr_v1_teacher_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hr_v1_teacher_proto_rawDesc), len(file_hr_v1_teacher_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,8 @@ const (
 	TeacherService_DeleteTeacher_FullMethodName             = "/hr.v1.TeacherService/DeleteTeacher"
 	TeacherService_ListTeacherAvailability_FullMethodName   = "/hr.v1.TeacherService/ListTeacherAvailability"
 	TeacherService_UpdateTeacherAvailability_FullMethodName = "/hr.v1.TeacherService/UpdateTeacherAvailability"
+	TeacherService_ListTeacherPreferences_FullMethodName    = "/hr.v1.TeacherService/ListTeacherPreferences"
+	TeacherService_UpdateTeacherPreferences_FullMethodName  = "/hr.v1.TeacherService/UpdateTeacherPreferences"
 )
 
 // TeacherServiceClient is the client API for TeacherService service.
@@ -39,6 +41,8 @@ type TeacherServiceClient interface {
 	DeleteTeacher(ctx context.Context, in *DeleteTeacherRequest, opts ...grpc.CallOption) (*DeleteTeacherResponse, error)
 	ListTeacherAvailability(ctx context.Context, in *ListTeacherAvailabilityRequest, opts ...grpc.CallOption) (*ListTeacherAvailabilityResponse, error)
 	UpdateTeacherAvailability(ctx context.Context, in *UpdateTeacherAvailabilityRequest, opts ...grpc.CallOption) (*UpdateTeacherAvailabilityResponse, error)
+	ListTeacherPreferences(ctx context.Context, in *ListTeacherPreferencesRequest, opts ...grpc.CallOption) (*ListTeacherPreferencesResponse, error)
+	UpdateTeacherPreferences(ctx context.Context, in *UpdateTeacherPreferencesRequest, opts ...grpc.CallOption) (*UpdateTeacherPreferencesResponse, error)
 }
 
 type teacherServiceClient struct {
@@ -119,6 +123,26 @@ func (c *teacherServiceClient) UpdateTeacherAvailability(ctx context.Context, in
 	return out, nil
 }
 
+func (c *teacherServiceClient) ListTeacherPreferences(ctx context.Context, in *ListTeacherPreferencesRequest, opts ...grpc.CallOption) (*ListTeacherPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeacherPreferencesResponse)
+	err := c.cc.Invoke(ctx, TeacherService_ListTeacherPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teacherServiceClient) UpdateTeacherPreferences(ctx context.Context, in *UpdateTeacherPreferencesRequest, opts ...grpc.CallOption) (*UpdateTeacherPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTeacherPreferencesResponse)
+	err := c.cc.Invoke(ctx, TeacherService_UpdateTeacherPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TeacherServiceServer is the server API for TeacherService service.
 // All implementations must embed UnimplementedTeacherServiceServer
 // for forward compatibility.
@@ -130,6 +154,8 @@ type TeacherServiceServer interface {
 	DeleteTeacher(context.Context, *DeleteTeacherRequest) (*DeleteTeacherResponse, error)
 	ListTeacherAvailability(context.Context, *ListTeacherAvailabilityRequest) (*ListTeacherAvailabilityResponse, error)
 	UpdateTeacherAvailability(context.Context, *UpdateTeacherAvailabilityRequest) (*UpdateTeacherAvailabilityResponse, error)
+	ListTeacherPreferences(context.Context, *ListTeacherPreferencesRequest) (*ListTeacherPreferencesResponse, error)
+	UpdateTeacherPreferences(context.Context, *UpdateTeacherPreferencesRequest) (*UpdateTeacherPreferencesResponse, error)
 	mustEmbedUnimplementedTeacherServiceServer()
 }
 
@@ -161,6 +187,12 @@ func (UnimplementedTeacherServiceServer) ListTeacherAvailability(context.Context
 func (UnimplementedTeacherServiceServer) UpdateTeacherAvailability(context.Context, *UpdateTeacherAvailabilityRequest) (*UpdateTeacherAvailabilityResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateTeacherAvailability not implemented")
 }
+func (UnimplementedTeacherServiceServer) ListTeacherPreferences(context.Context, *ListTeacherPreferencesRequest) (*ListTeacherPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTeacherPreferences not implemented")
+}
+func (UnimplementedTeacherServiceServer) UpdateTeacherPreferences(context.Context, *UpdateTeacherPreferencesRequest) (*UpdateTeacherPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTeacherPreferences not implemented")
+}
 func (UnimplementedTeacherServiceServer) mustEmbedUnimplementedTeacherServiceServer() {}
 func (UnimplementedTeacherServiceServer) testEmbeddedByValue()                        {}
 
@@ -308,6 +340,42 @@ func _TeacherService_UpdateTeacherAvailability_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TeacherService_ListTeacherPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeacherPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherServiceServer).ListTeacherPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherService_ListTeacherPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherServiceServer).ListTeacherPreferences(ctx, req.(*ListTeacherPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeacherService_UpdateTeacherPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeacherPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherServiceServer).UpdateTeacherPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherService_UpdateTeacherPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherServiceServer).UpdateTeacherPreferences(ctx, req.(*UpdateTeacherPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TeacherService_ServiceDesc is the grpc.ServiceDesc for TeacherService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +411,14 @@ var TeacherService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTeacherAvailability",
 			Handler:    _TeacherService_UpdateTeacherAvailability_Handler,
 		},
+		{
+			MethodName: "ListTeacherPreferences",
+			Handler:    _TeacherService_ListTeacherPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateTeacherPreferences",
+			Handler:    _TeacherService_UpdateTeacherPreferences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hr/v1/teacher.proto",
@@ -24,19 +24,20 @@ const (
 )
 
 type Subject struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Credits       int32                  `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`
-	DepartmentId  string                 `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	WeeklyHours   int32                  `protobuf:"varint,9,opt,name=weekly_hours,json=weeklyHours,proto3" json:"weekly_hours,omitempty"`
-	IsActive      bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code             string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Credits          int32                  `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`
+	DepartmentId     string                 `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	Description      string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	WeeklyHours      int32                  `protobuf:"varint,9,opt,name=weekly_hours,json=weeklyHours,proto3" json:"weekly_hours,omitempty"`
+	IsActive         bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	RoomRequirements *RoomRequirements      `protobuf:"bytes,11,opt,name=room_requirements,json=roomRequirements,proto3" json:"room_requirements,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Subject) Reset() {
@@ -139,21 +140,91 @@ func (x *Subject) GetIsActive() bool {
 	return false
 }
 
-type CreateSubjectRequest struct {
+func (x *Subject) GetRoomRequirements() *RoomRequirements {
+	if x != nil {
+		return x.RoomRequirements
+	}
+	return nil
+}
+
+// RoomRequirements describes the rooms a subject can be taught in.
+// Zero values mean "no requirement".
+type RoomRequirements struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Credits       int32                  `protobuf:"varint,3,opt,name=credits,proto3" json:"credits,omitempty"`
-	DepartmentId  string                 `protobuf:"bytes,4,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	WeeklyHours   int32                  `protobuf:"varint,6,opt,name=weekly_hours,json=weeklyHours,proto3" json:"weekly_hours,omitempty"`
+	MinCapacity   int32                  `protobuf:"varint,1,opt,name=min_capacity,json=minCapacity,proto3" json:"min_capacity,omitempty"`
+	RoomType      string                 `protobuf:"bytes,2,opt,name=room_type,json=roomType,proto3" json:"room_type,omitempty"` // e.g. "lab"; empty accepts any type
+	Features      []string               `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`                 // every listed feature must be present
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *RoomRequirements) Reset() {
+	*x = RoomRequirements{}
+	mi := &file_subject_v1_subject_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomRequirements) ProtoMessage() {}
+
+func (x *RoomRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_subject_v1_subject_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomRequirements.ProtoReflect.Descriptor instead.
+func (*RoomRequirements) Descriptor() ([]byte, []int) {
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RoomRequirements) GetMinCapacity() int32 {
+	if x != nil {
+		return x.MinCapacity
+	}
+	return 0
+}
+
+func (x *RoomRequirements) GetRoomType() string {
+	if x != nil {
+		return x.RoomType
+	}
+	return ""
+}
+
+func (x *RoomRequirements) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type CreateSubjectRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Code             string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Credits          int32                  `protobuf:"varint,3,opt,name=credits,proto3" json:"credits,omitempty"`
+	DepartmentId     string                 `protobuf:"bytes,4,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	WeeklyHours      int32                  `protobuf:"varint,6,opt,name=weekly_hours,json=weeklyHours,proto3" json:"weekly_hours,omitempty"`
+	RoomRequirements *RoomRequirements      `protobuf:"bytes,7,opt,name=room_requirements,json=roomRequirements,proto3" json:"room_requirements,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *CreateSubjectRequest) Reset() {
 	*x = CreateSubjectRequest{}
-	mi := &file_subject_v1_subject_proto_msgTypes[1]
+	mi := &file_subject_v1_subject_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -165,7 +236,7 @@ func (x *CreateSubjectRequest) String() string {
 func (*CreateSubjectRequest) ProtoMessage() {}
 
 func (x *CreateSubjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subject_v1_subject_proto_msgTypes[1]
+	mi := &file_subject_v1_subject_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,7 +249,7 @@ func (x *CreateSubjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSubjectRequest.ProtoReflect.Descriptor instead.
 func (*CreateSubjectRequest) Descriptor() ([]byte, []int) {
-	return file_subject_v1_subject_proto_rawDescGZIP(), []int{1}
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateSubjectRequest) GetCode() string {
@@ -223,6 +294,13 @@ func (x *CreateSubjectRequest) GetWeeklyHours() int32 {
 	return 0
 }
 
+func (x *CreateSubjectRequest) GetRoomRequirements() *RoomRequirements {
+	if x != nil {
+		return x.RoomRequirements
+	}
+	return nil
+}
+
 type CreateSubjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Subject       *Subject               `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
@@ -232,7 +310,7 @@ type CreateSubjectResponse struct {
 
 func (x *CreateSubjectResponse) Reset() {
 	*x = CreateSubjectResponse{}
-	mi := &file_subject_v1_subject_proto_msgTypes[2]
+	mi := &file_subject_v1_subject_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -244,7 +322,7 @@ func (x *CreateSubjectResponse) String() string {
 func (*CreateSubjectResponse) ProtoMessage() {}
 
 func (x *CreateSubjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subject_v1_subject_proto_msgTypes[2]
+	mi := &file_subject_v1_subject_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,7 +335,7 @@ func (x *CreateSubjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSubjectResponse.ProtoReflect.Descriptor instead.
 func (*CreateSubjectResponse) Descriptor() ([]byte, []int) {
-	return file_subject_v1_subject_proto_rawDescGZIP(), []int{2}
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateSubjectResponse) GetSubject() *Subject {
@@ -276,7 +354,7 @@ type GetSubjectRequest struct {
 
 func (x *GetSubjectRequest) Reset() {
 	*x = GetSubjectRequest{}
-	mi := &file_subject_v1_subject_proto_msgTypes[3]
+	mi := &file_subject_v1_subject_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +366,7 @@ func (x *GetSubjectRequest) String() string {
 func (*GetSubjectRequest) ProtoMessage() {}
 
 func (x *GetSubjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subject_v1_subject_proto_msgTypes[3]
+	mi := &file_subject_v1_subject_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +379,7 @@ func (x *GetSubjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubjectRequest.ProtoReflect.Descriptor instead.
 func (*GetSubjectRequest) Descriptor() ([]byte, []int) {
-	return file_subject_v1_subject_proto_rawDescGZIP(), []int{3}
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetSubjectRequest) GetId() string {
@@ -320,7 +398,7 @@ type GetSubjectResponse struct {
 
 func (x *GetSubjectResponse) Reset() {
 	*x = GetSubjectResponse{}
-	mi := &file_subject_v1_subject_proto_msgTypes[4]
+	mi := &file_subject_v1_subject_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -332,7 +410,7 @@ func (x *GetSubjectResponse) String() string {
 func (*GetSubjectResponse) ProtoMessage() {}
 
 func (x *GetSubjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subject_v1_subject_proto_msgTypes[4]
+	mi := &file_subject_v1_subject_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,7 +423,7 @@ func (x *GetSubjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubjectResponse.ProtoReflect.Descriptor instead.
 func (*GetSubjectResponse) Descriptor() ([]byte, []int) {
-	return file_subject_v1_subject_proto_rawDescGZIP(), []int{4}
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetSubjectResponse) GetSubject() *Subject {
@@ -365,7 +443,7 @@ type ListSubjectsRequest struct {
 
 func (x *ListSubjectsRequest) Reset() {
 	*x = ListSubjectsRequest{}
-	mi := &file_subject_v1_subject_proto_msgTypes[5]
+	mi := &file_subject_v1_subject_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -377,7 +455,7 @@ func (x *ListSubjectsRequest) String() string {
 func (*ListSubjectsRequest) ProtoMessage() {}
 
 func (x *ListSubjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subject_v1_subject_proto_msgTypes[5]
+	mi := &file_subject_v1_subject_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +468,7 @@ func (x *ListSubjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubjectsRequest.ProtoReflect.Descriptor instead.
 func (*ListSubjectsRequest) Descriptor() ([]byte, []int) {
-	return file_subject_v1_subject_proto_rawDescGZIP(), []int{5}
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListSubjectsRequest) GetPagination() *v1.PaginationRequest {
@@ -417,7 +495,7 @@ type ListSubjectsResponse struct {
 
 func (x *ListSubjectsResponse) Reset() {
 	*x = ListSubjectsResponse{}
-	mi := &file_subject_v1_subject_proto_msgTypes[6]
+	mi := &file_subject_v1_subject_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -429,7 +507,7 @@ func (x *ListSubjectsResponse) String() string {
 func (*ListSubjectsResponse) ProtoMessage() {}
 
 func (x *ListSubjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subject_v1_subject_proto_msgTypes[6]
+	mi := &file_subject_v1_subject_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +520,7 @@ func (x *ListSubjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListSubjectsResponse) Descriptor() ([]byte, []int) {
-	return file_subject_v1_subject_proto_rawDescGZIP(), []int{6}
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListSubjectsResponse) GetSubjects() []*Subject {
@@ -460,21 +538,23 @@ func (x *ListSubjectsResponse) GetPagination() *v1.PaginationResponse {
 }
 
 type UpdateSubjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          *string                `protobuf:"bytes,2,opt,name=code,proto3,oneof" json:"code,omitempty"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Credits       *int32                 `protobuf:"varint,4,opt,name=credits,proto3,oneof" json:"credits,omitempty"`
-	DepartmentId  *string                `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
-	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	WeeklyHours   *int32                 `protobuf:"varint,7,opt,name=weekly_hours,json=weeklyHours,proto3,oneof" json:"weekly_hours,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code         *string                `protobuf:"bytes,2,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	Name         *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Credits      *int32                 `protobuf:"varint,4,opt,name=credits,proto3,oneof" json:"credits,omitempty"`
+	DepartmentId *string                `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	Description  *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	WeeklyHours  *int32                 `protobuf:"varint,7,opt,name=weekly_hours,json=weeklyHours,proto3,oneof" json:"weekly_hours,omitempty"`
+	// When set, replaces the subject's room requirements as a whole.
+	RoomRequirements *RoomRequirements `protobuf:"bytes,8,opt,name=room_requirements,json=roomRequirements,proto3" json:"room_requirements,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *UpdateSubjectRequest) Reset() {
 	*x = UpdateSubjectRequest{}
-	mi := &file_subject_v1_subject_proto_msgTypes[7]
+	mi := &file_subject_v1_subject_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -486,7 +566,7 @@ func (x *UpdateSubjectRequest) String() string {
 func (*UpdateSubjectRequest) ProtoMessage() {}
 
 func (x *UpdateSubjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subject_v1_subject_proto_msgTypes[7]
+	mi := &file_subject_v1_subject_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +579,7 @@ func (x *UpdateSubjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSubjectRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSubjectRequest) Descriptor() ([]byte, []int) {
-	return file_subject_v1_subject_proto_rawDescGZIP(), []int{7}
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateSubjectRequest) GetId() string {
@@ -551,6 +631,13 @@ func (x *UpdateSubjectRequest) GetWeeklyHours() int32 {
 	return 0
 }
 
+func (x *UpdateSubjectRequest) GetRoomRequirements() *RoomRequirements {
+	if x != nil {
+		return x.RoomRequirements
+	}
+	return nil
+}
+
 type UpdateSubjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Subject       *Subject               `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
@@ -560,7 +647,7 @@ type UpdateSubjectResponse struct {
 
 func (x *UpdateSubjectResponse) Reset() {
 	*x = UpdateSubjectResponse{}
-	mi := &file_subject_v1_subject_proto_msgTypes[8]
+	mi := &file_subject_v1_subject_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +659,7 @@ func (x *UpdateSubjectResponse) String() string {
 func (*UpdateSubjectResponse) ProtoMessage() {}
 
 func (x *UpdateSubjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subject_v1_subject_proto_msgTypes[8]
+	mi := &file_subject_v1_subject_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +672,7 @@ func (x *UpdateSubjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSubjectResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSubjectResponse) Descriptor() ([]byte, []int) {
-	return file_subject_v1_subject_proto_rawDescGZIP(), []int{8}
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateSubjectResponse) GetSubject() *Subject {
@@ -604,7 +691,7 @@ type DeleteSubjectRequest struct {
 
 func (x *DeleteSubjectRequest) Reset() {
 	*x = DeleteSubjectRequest{}
-	mi := &file_subject_v1_subject_proto_msgTypes[9]
+	mi := &file_subject_v1_subject_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -616,7 +703,7 @@ func (x *DeleteSubjectRequest) String() string {
 func (*DeleteSubjectRequest) ProtoMessage() {}
 
 func (x *DeleteSubjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subject_v1_subject_proto_msgTypes[9]
+	mi := &file_subject_v1_subject_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +716,7 @@ func (x *DeleteSubjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSubjectRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSubjectRequest) Descriptor() ([]byte, []int) {
-	return file_subject_v1_subject_proto_rawDescGZIP(), []int{9}
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteSubjectRequest) GetId() string {
@@ -647,7 +734,7 @@ type DeleteSubjectResponse struct {
 
 func (x *DeleteSubjectResponse) Reset() {
 	*x = DeleteSubjectResponse{}
-	mi := &file_subject_v1_subject_proto_msgTypes[10]
+	mi := &file_subject_v1_subject_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -659,7 +746,7 @@ func (x *DeleteSubjectResponse) String() string {
 func (*DeleteSubjectResponse) ProtoMessage() {}
 
 func (x *DeleteSubjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subject_v1_subject_proto_msgTypes[10]
+	mi := &file_subject_v1_subject_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,7 +759,164 @@ func (x *DeleteSubjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSubjectResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSubjectResponse) Descriptor() ([]byte, []int) {
-	return file_subject_v1_subject_proto_rawDescGZIP(), []int{10}
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{11}
+}
+
+// SemesterOffering is a subject offered in a semester with its enrollment cap.
+type SemesterOffering struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	SemesterId    string                 `protobuf:"bytes,3,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	MaxEnrollment int32                  `protobuf:"varint,4,opt,name=max_enrollment,json=maxEnrollment,proto3" json:"max_enrollment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SemesterOffering) Reset() {
+	*x = SemesterOffering{}
+	mi := &file_subject_v1_subject_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SemesterOffering) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemesterOffering) ProtoMessage() {}
+
+func (x *SemesterOffering) ProtoReflect() protoreflect.Message {
+	mi := &file_subject_v1_subject_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemesterOffering.ProtoReflect.Descriptor instead.
+func (*SemesterOffering) Descriptor() ([]byte, []int) {
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SemesterOffering) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SemesterOffering) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *SemesterOffering) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *SemesterOffering) GetMaxEnrollment() int32 {
+	if x != nil {
+		return x.MaxEnrollment
+	}
+	return 0
+}
+
+type ListSemesterOfferingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSemesterOfferingsRequest) Reset() {
+	*x = ListSemesterOfferingsRequest{}
+	mi := &file_subject_v1_subject_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSemesterOfferingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSemesterOfferingsRequest) ProtoMessage() {}
+
+func (x *ListSemesterOfferingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subject_v1_subject_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSemesterOfferingsRequest.ProtoReflect.Descriptor instead.
+func (*ListSemesterOfferingsRequest) Descriptor() ([]byte, []int) {
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSemesterOfferingsRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+type ListSemesterOfferingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offerings     []*SemesterOffering    `protobuf:"bytes,1,rep,name=offerings,proto3" json:"offerings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSemesterOfferingsResponse) Reset() {
+	*x = ListSemesterOfferingsResponse{}
+	mi := &file_subject_v1_subject_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSemesterOfferingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSemesterOfferingsResponse) ProtoMessage() {}
+
+func (x *ListSemesterOfferingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subject_v1_subject_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSemesterOfferingsResponse.ProtoReflect.Descriptor instead.
+func (*ListSemesterOfferingsResponse) Descriptor() ([]byte, []int) {
+	return file_subject_v1_subject_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSemesterOfferingsResponse) GetOfferings() []*SemesterOffering {
+	if x != nil {
+		return x.Offerings
+	}
+	return nil
 }
 
 var File_subject_v1_subject_proto protoreflect.FileDescriptor
@@ -680,7 +924,7 @@ var File_subject_v1_subject_proto protoreflect.FileDescriptor
 const file_subject_v1_subject_proto_rawDesc = "" +
 	"\n" +
 	"\x18subject/v1/subject.proto\x12\n" +
-	"subject.v1\x1a\x14core/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd8\x02\n" +
+	"subject.v1\x1a\x14core/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x03\n" +
 	"\aSubject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
@@ -694,14 +938,20 @@ const file_subject_v1_subject_proto_rawDesc = "" +
 	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
 	"\fweekly_hours\x18\t \x01(\x05R\vweeklyHours\x12\x1b\n" +
 	"\tis_active\x18\n" +
-	" \x01(\bR\bisActive\"\xc2\x01\n" +
+	" \x01(\bR\bisActive\x12I\n" +
+	"\x11room_requirements\x18\v \x01(\v2\x1c.subject.v1.RoomRequirementsR\x10roomRequirements\"n\n" +
+	"\x10RoomRequirements\x12!\n" +
+	"\fmin_capacity\x18\x01 \x01(\x05R\vminCapacity\x12\x1b\n" +
+	"\troom_type\x18\x02 \x01(\tR\broomType\x12\x1a\n" +
+	"\bfeatures\x18\x03 \x03(\tR\bfeatures\"\x8d\x02\n" +
 	"\x14CreateSubjectRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
 	"\acredits\x18\x03 \x01(\x05R\acredits\x12#\n" +
 	"\rdepartment_id\x18\x04 \x01(\tR\fdepartmentId\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12!\n" +
-	"\fweekly_hours\x18\x06 \x01(\x05R\vweeklyHours\"F\n" +
+	"\fweekly_hours\x18\x06 \x01(\x05R\vweeklyHours\x12I\n" +
+	"\x11room_requirements\x18\a \x01(\v2\x1c.subject.v1.RoomRequirementsR\x10roomRequirements\"F\n" +
 	"\x15CreateSubjectResponse\x12-\n" +
 	"\asubject\x18\x01 \x01(\v2\x13.subject.v1.SubjectR\asubject\"#\n" +
 	"\x11GetSubjectRequest\x12\x0e\n" +
@@ -718,7 +968,7 @@ const file_subject_v1_subject_proto_rawDesc = "" +
 	"\bsubjects\x18\x01 \x03(\v2\x13.subject.v1.SubjectR\bsubjects\x12;\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1b.core.v1.PaginationResponseR\n" +
-	"pagination\"\xc1\x02\n" +
+	"pagination\"\x8c\x03\n" +
 	"\x14UpdateSubjectRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04code\x18\x02 \x01(\tH\x00R\x04code\x88\x01\x01\x12\x17\n" +
@@ -726,7 +976,8 @@ const file_subject_v1_subject_proto_rawDesc = "" +
 	"\acredits\x18\x04 \x01(\x05H\x02R\acredits\x88\x01\x01\x12(\n" +
 	"\rdepartment_id\x18\x05 \x01(\tH\x03R\fdepartmentId\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x06 \x01(\tH\x04R\vdescription\x88\x01\x01\x12&\n" +
-	"\fweekly_hours\x18\a \x01(\x05H\x05R\vweeklyHours\x88\x01\x01B\a\n" +
+	"\fweekly_hours\x18\a \x01(\x05H\x05R\vweeklyHours\x88\x01\x01\x12I\n" +
+	"\x11room_requirements\x18\b \x01(\v2\x1c.subject.v1.RoomRequirementsR\x10roomRequirementsB\a\n" +
 	"\x05_codeB\a\n" +
 	"\x05_nameB\n" +
 	"\n" +
@@ -738,14 +989,27 @@ const file_subject_v1_subject_proto_rawDesc = "" +
 	"\asubject\x18\x01 \x01(\v2\x13.subject.v1.SubjectR\asubject\"&\n" +
 	"\x14DeleteSubjectRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
-	"\x15DeleteSubjectResponse2\xb2\x03\n" +
+	"\x15DeleteSubjectResponse\"\x89\x01\n" +
+	"\x10SemesterOffering\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tR\tsubjectId\x12\x1f\n" +
+	"\vsemester_id\x18\x03 \x01(\tR\n" +
+	"semesterId\x12%\n" +
+	"\x0emax_enrollment\x18\x04 \x01(\x05R\rmaxEnrollment\"?\n" +
+	"\x1cListSemesterOfferingsRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\"[\n" +
+	"\x1dListSemesterOfferingsResponse\x12:\n" +
+	"\tofferings\x18\x01 \x03(\v2\x1c.subject.v1.SemesterOfferingR\tofferings2\xa0\x04\n" +
 	"\x0eSubjectService\x12T\n" +
 	"\rCreateSubject\x12 .subject.v1.CreateSubjectRequest\x1a!.subject.v1.CreateSubjectResponse\x12K\n" +
 	"\n" +
 	"GetSubject\x12\x1d.subject.v1.GetSubjectRequest\x1a\x1e.subject.v1.GetSubjectResponse\x12Q\n" +
 	"\fListSubjects\x12\x1f.subject.v1.ListSubjectsRequest\x1a .subject.v1.ListSubjectsResponse\x12T\n" +
 	"\rUpdateSubject\x12 .subject.v1.UpdateSubjectRequest\x1a!.subject.v1.UpdateSubjectResponse\x12T\n" +
-	"\rDeleteSubject\x12 .subject.v1.DeleteSubjectRequest\x1a!.subject.v1.DeleteSubjectResponseB>Z<github.com/HuynhHoangPhuc/myrmex/gen/go/subject/v1;subjectv1b\x06proto3"
+	"\rDeleteSubject\x12 .subject.v1.DeleteSubjectRequest\x1a!.subject.v1.DeleteSubjectResponse\x12l\n" +
+	"\x15ListSemesterOfferings\x12(.subject.v1.ListSemesterOfferingsRequest\x1a).subject.v1.ListSemesterOfferingsResponseB>Z<github.com/HuynhHoangPhuc/myrmex/gen/go/subject/v1;subjectv1b\x06proto3"
 
 var (
 	file_subject_v1_subject_proto_rawDescOnce sync.Once
@@ -759,47 +1023,57 @@ func file_subject_v1_subject_proto_rawDescGZIP() []byte {
 	return file_subject_v1_subject_proto_rawDescData
 }
 
-var file_subject_v1_subject_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_subject_v1_subject_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_subject_v1_subject_proto_goTypes = []any{
-	(*Subject)(nil),               // 0: subject.v1.Subject
-	(*CreateSubjectRequest)(nil),  // 1: subject.v1.CreateSubjectRequest
-	(*CreateSubjectResponse)(nil), // 2: subject.v1.CreateSubjectResponse
-	(*GetSubjectRequest)(nil),     // 3: subject.v1.GetSubjectRequest
-	(*GetSubjectResponse)(nil),    // 4: subject.v1.GetSubjectResponse
-	(*ListSubjectsRequest)(nil),   // 5: subject.v1.ListSubjectsRequest
-	(*ListSubjectsResponse)(nil),  // 6: subject.v1.ListSubjectsResponse
-	(*UpdateSubjectRequest)(nil),  // 7: subject.v1.UpdateSubjectRequest
-	(*UpdateSubjectResponse)(nil), // 8: subject.v1.UpdateSubjectResponse
-	(*DeleteSubjectRequest)(nil),  // 9: subject.v1.DeleteSubjectRequest
-	(*DeleteSubjectResponse)(nil), // 10: subject.v1.DeleteSubjectResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
-	(*v1.PaginationRequest)(nil),  // 12: core.v1.PaginationRequest
-	(*v1.PaginationResponse)(nil), // 13: core.v1.PaginationResponse
+	(*Subject)(nil),                       // 0: subject.v1.Subject
+	(*RoomRequirements)(nil),              // 1: subject.v1.RoomRequirements
+	(*CreateSubjectRequest)(nil),          // 2: subject.v1.CreateSubjectRequest
+	(*CreateSubjectResponse)(nil),         // 3: subject.v1.CreateSubjectResponse
+	(*GetSubjectRequest)(nil),             // 4: subject.v1.GetSubjectRequest
+	(*GetSubjectResponse)(nil),            // 5: subject.v1.GetSubjectResponse
+	(*ListSubjectsRequest)(nil),           // 6: subject.v1.ListSubjectsRequest
+	(*ListSubjectsResponse)(nil),          // 7: subject.v1.ListSubjectsResponse
+	(*UpdateSubjectRequest)(nil),          // 8: subject.v1.UpdateSubjectRequest
+	(*UpdateSubjectResponse)(nil),         // 9: subject.v1.UpdateSubjectResponse
+	(*DeleteSubjectRequest)(nil),          // 10: subject.v1.DeleteSubjectRequest
+	(*DeleteSubjectResponse)(nil),         // 11: subject.v1.DeleteSubjectResponse
+	(*SemesterOffering)(nil),              // 12: subject.v1.SemesterOffering
+	(*ListSemesterOfferingsRequest)(nil),  // 13: subject.v1.ListSemesterOfferingsRequest
+	(*ListSemesterOfferingsResponse)(nil), // 14: subject.v1.ListSemesterOfferingsResponse
+	(*timestamppb.Timestamp)(nil),         // 15: google.protobuf.Timestamp
+	(*v1.PaginationRequest)(nil),          // 16: core.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),         // 17: core.v1.PaginationResponse
 }
 var file_subject_v1_subject_proto_depIdxs = []int32{
-	11, // 0: subject.v1.Subject.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: subject.v1.Subject.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: subject.v1.CreateSubjectResponse.subject:type_name -> subject.v1.Subject
-	0,  // 3: subject.v1.GetSubjectResponse.subject:type_name -> subject.v1.Subject
-	12, // 4: subject.v1.ListSubjectsRequest.pagination:type_name -> core.v1.PaginationRequest
-	0,  // 5: subject.v1.ListSubjectsResponse.subjects:type_name -> subject.v1.Subject
-	13, // 6: subject.v1.ListSubjectsResponse.pagination:type_name -> core.v1.PaginationResponse
-	0,  // 7: subject.v1.UpdateSubjectResponse.subject:type_name -> subject.v1.Subject
-	1,  // 8: subject.v1.SubjectService.CreateSubject:input_type -> subject.v1.CreateSubjectRequest
-	3,  // 9: subject.v1.SubjectService.GetSubject:input_type -> subject.v1.GetSubjectRequest
-	5,  // 10: subject.v1.SubjectService.ListSubjects:input_type -> subject.v1.ListSubjectsRequest
-	7,  // 11: subject.v1.SubjectService.UpdateSubject:input_type -> subject.v1.UpdateSubjectRequest
-	9,  // 12: subject.v1.SubjectService.DeleteSubject:input_type -> subject.v1.DeleteSubjectRequest
-	2,  // 13: subject.v1.SubjectService.CreateSubject:output_type -> subject.v1.CreateSubjectResponse
-	4,  // 14: subject.v1.SubjectService.GetSubject:output_type -> subject.v1.GetSubjectResponse
-	6,  // 15: subject.v1.SubjectService.ListSubjects:output_type -> subject.v1.ListSubjectsResponse
-	8,  // 16: subject.v1.SubjectService.UpdateSubject:output_type -> subject.v1.UpdateSubjectResponse
-	10, // 17: subject.v1.SubjectService.DeleteSubject:output_type -> subject.v1.DeleteSubjectResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	15, // 0: subject.v1.Subject.created_at:type_name -> google.protobuf.Timestamp
+	15, // 1: subject.v1.Subject.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: subject.v1.Subject.room_requirements:type_name -> subject.v1.RoomRequirements
+	1,  // 3: subject.v1.CreateSubjectRequest.room_requirements:type_name -> subject.v1.RoomRequirements
+	0,  // 4: subject.v1.CreateSubjectResponse.subject:type_name -> subject.v1.Subject
+	0,  // 5: subject.v1.GetSubjectResponse.subject:type_name -> subject.v1.Subject
+	16, // 6: subject.v1.ListSubjectsRequest.pagination:type_name -> core.v1.PaginationRequest
+	0,  // 7: subject.v1.ListSubjectsResponse.subjects:type_name -> subject.v1.Subject
+	17, // 8: subject.v1.ListSubjectsResponse.pagination:type_name -> core.v1.PaginationResponse
+	1,  // 9: subject.v1.UpdateSubjectRequest.room_requirements:type_name -> subject.v1.RoomRequirements
+	0,  // 10: subject.v1.UpdateSubjectResponse.subject:type_name -> subject.v1.Subject
+	12, // 11: subject.v1.ListSemesterOfferingsResponse.offerings:type_name -> subject.v1.SemesterOffering
+	2,  // 12: subject.v1.SubjectService.CreateSubject:input_type -> subject.v1.CreateSubjectRequest
+	4,  // 13: subject.v1.SubjectService.GetSubject:input_type -> subject.v1.GetSubjectRequest
+	6,  // 14: subject.v1.SubjectService.ListSubjects:input_type -> subject.v1.ListSubjectsRequest
+	8,  // 15: subject.v1.SubjectService.UpdateSubject:input_type -> subject.v1.UpdateSubjectRequest
+	10, // 16: subject.v1.SubjectService.DeleteSubject:input_type -> subject.v1.DeleteSubjectRequest
+	13, // 17: subject.v1.SubjectService.ListSemesterOfferings:input_type -> subject.v1.ListSemesterOfferingsRequest
+	3,  // 18: subject.v1.SubjectService.CreateSubject:output_type -> subject.v1.CreateSubjectResponse
+	5,  // 19: subject.v1.SubjectService.GetSubject:output_type -> subject.v1.GetSubjectResponse
+	7,  // 20: subject.v1.SubjectService.ListSubjects:output_type -> subject.v1.ListSubjectsResponse
+	9,  // 21: subject.v1.SubjectService.UpdateSubject:output_type -> subject.v1.UpdateSubjectResponse
+	11, // 22: subject.v1.SubjectService.DeleteSubject:output_type -> subject.v1.DeleteSubjectResponse
+	14, // 23: subject.v1.SubjectService.ListSemesterOfferings:output_type -> subject.v1.ListSemesterOfferingsResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_subject_v1_subject_proto_init() }
@@ -807,15 +1081,15 @@ func file_subject_v1_subject_proto_init() {
 	if File_subject_v1_subject_proto != nil {
 		return
 	}
-	file_subject_v1_subject_proto_msgTypes[5].OneofWrappers = []any{}
-	file_subject_v1_subject_proto_msgTypes[7].OneofWrappers = []any{}
+	file_subject_v1_subject_proto_msgTypes[6].OneofWrappers = []any{}
+	file_subject_v1_subject_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subject_v1_subject_proto_rawDesc), len(file_subject_v1_subject_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
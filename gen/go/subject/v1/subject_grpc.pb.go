@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SubjectService_CreateSubject_FullMethodName = "/subject.v1.SubjectService/CreateSubject"
-	SubjectService_GetSubject_FullMethodName    = "/subject.v1.SubjectService/GetSubject"
-	SubjectService_ListSubjects_FullMethodName  = "/subject.v1.SubjectService/ListSubjects"
-	SubjectService_UpdateSubject_FullMethodName = "/subject.v1.SubjectService/UpdateSubject"
-	SubjectService_DeleteSubject_FullMethodName = "/subject.v1.SubjectService/DeleteSubject"
+	SubjectService_CreateSubject_FullMethodName         = "/subject.v1.SubjectService/CreateSubject"
+	SubjectService_GetSubject_FullMethodName            = "/subject.v1.SubjectService/GetSubject"
+	SubjectService_ListSubjects_FullMethodName          = "/subject.v1.SubjectService/ListSubjects"
+	SubjectService_UpdateSubject_FullMethodName         = "/subject.v1.SubjectService/UpdateSubject"
+	SubjectService_DeleteSubject_FullMethodName         = "/subject.v1.SubjectService/DeleteSubject"
+	SubjectService_ListSemesterOfferings_FullMethodName = "/subject.v1.SubjectService/ListSemesterOfferings"
 )
 
 // SubjectServiceClient is the client API for SubjectService service.
@@ -35,6 +36,7 @@ type SubjectServiceClient interface {
 	ListSubjects(ctx context.Context, in *ListSubjectsRequest, opts ...grpc.CallOption) (*ListSubjectsResponse, error)
 	UpdateSubject(ctx context.Context, in *UpdateSubjectRequest, opts ...grpc.CallOption) (*UpdateSubjectResponse, error)
 	DeleteSubject(ctx context.Context, in *DeleteSubjectRequest, opts ...grpc.CallOption) (*DeleteSubjectResponse, error)
+	ListSemesterOfferings(ctx context.Context, in *ListSemesterOfferingsRequest, opts ...grpc.CallOption) (*ListSemesterOfferingsResponse, error)
 }
 
 type subjectServiceClient struct {
@@ -95,6 +97,16 @@ func (c *subjectServiceClient) DeleteSubject(ctx context.Context, in *DeleteSubj
 	return out, nil
 }
 
+func (c *subjectServiceClient) ListSemesterOfferings(ctx context.Context, in *ListSemesterOfferingsRequest, opts ...grpc.CallOption) (*ListSemesterOfferingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSemesterOfferingsResponse)
+	err := c.cc.Invoke(ctx, SubjectService_ListSemesterOfferings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubjectServiceServer is the server API for SubjectService service.
 // All implementations must embed UnimplementedSubjectServiceServer
 // for forward compatibility.
@@ -104,6 +116,7 @@ type SubjectServiceServer interface {
 	ListSubjects(context.Context, *ListSubjectsRequest) (*ListSubjectsResponse, error)
 	UpdateSubject(context.Context, *UpdateSubjectRequest) (*UpdateSubjectResponse, error)
 	DeleteSubject(context.Context, *DeleteSubjectRequest) (*DeleteSubjectResponse, error)
+	ListSemesterOfferings(context.Context, *ListSemesterOfferingsRequest) (*ListSemesterOfferingsResponse, error)
 	mustEmbedUnimplementedSubjectServiceServer()
 }
 
@@ -129,6 +142,9 @@ func (UnimplementedSubjectServiceServer) UpdateSubject(context.Context, *UpdateS
 func (UnimplementedSubjectServiceServer) DeleteSubject(context.Context, *DeleteSubjectRequest) (*DeleteSubjectResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteSubject not implemented")
 }
+func (UnimplementedSubjectServiceServer) ListSemesterOfferings(context.Context, *ListSemesterOfferingsRequest) (*ListSemesterOfferingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSemesterOfferings not implemented")
+}
 func (UnimplementedSubjectServiceServer) mustEmbedUnimplementedSubjectServiceServer() {}
 func (UnimplementedSubjectServiceServer) testEmbeddedByValue()                        {}
 
@@ -240,6 +256,24 @@ func _SubjectService_DeleteSubject_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubjectService_ListSemesterOfferings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSemesterOfferingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectServiceServer).ListSemesterOfferings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectService_ListSemesterOfferings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectServiceServer).ListSemesterOfferings(ctx, req.(*ListSemesterOfferingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SubjectService_ServiceDesc is the grpc.ServiceDesc for SubjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +301,10 @@ var SubjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSubject",
 			Handler:    _SubjectService_DeleteSubject_Handler,
 		},
+		{
+			MethodName: "ListSemesterOfferings",
+			Handler:    _SubjectService_ListSemesterOfferings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "subject/v1/subject.proto",
@@ -0,0 +1,1066 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: timetable/v1/room_booking.proto
+
+package timetablev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RoomBooking struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName      string                 `protobuf:"bytes,3,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Purpose       string                 `protobuf:"bytes,5,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	StartPeriod   int32                  `protobuf:"varint,9,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	EndPeriod     int32                  `protobuf:"varint,10,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // pending, approved, rejected, cancelled
+	ReviewedBy    string                 `protobuf:"bytes,12,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewNote    string                 `protobuf:"bytes,13,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomBooking) Reset() {
+	*x = RoomBooking{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomBooking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomBooking) ProtoMessage() {}
+
+func (x *RoomBooking) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomBooking.ProtoReflect.Descriptor instead.
+func (*RoomBooking) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoomBooking) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RoomBooking) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *RoomBooking) GetStartPeriod() int32 {
+	if x != nil {
+		return x.StartPeriod
+	}
+	return 0
+}
+
+func (x *RoomBooking) GetEndPeriod() int32 {
+	if x != nil {
+		return x.EndPeriod
+	}
+	return 0
+}
+
+func (x *RoomBooking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *RoomBooking) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateRoomBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Purpose       string                 `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"` // user ID
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"` // optional; defaults to start_date
+	StartPeriod   int32                  `protobuf:"varint,7,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	EndPeriod     int32                  `protobuf:"varint,8,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomBookingRequest) Reset() {
+	*x = CreateRoomBookingRequest{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomBookingRequest) ProtoMessage() {}
+
+func (x *CreateRoomBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomBookingRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRoomBookingRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateRoomBookingRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateRoomBookingRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *CreateRoomBookingRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CreateRoomBookingRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateRoomBookingRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateRoomBookingRequest) GetStartPeriod() int32 {
+	if x != nil {
+		return x.StartPeriod
+	}
+	return 0
+}
+
+func (x *CreateRoomBookingRequest) GetEndPeriod() int32 {
+	if x != nil {
+		return x.EndPeriod
+	}
+	return 0
+}
+
+type CreateRoomBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *RoomBooking           `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomBookingResponse) Reset() {
+	*x = CreateRoomBookingResponse{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomBookingResponse) ProtoMessage() {}
+
+func (x *CreateRoomBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomBookingResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateRoomBookingResponse) GetBooking() *RoomBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type GetRoomBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomBookingRequest) Reset() {
+	*x = GetRoomBookingRequest{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomBookingRequest) ProtoMessage() {}
+
+func (x *GetRoomBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomBookingRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRoomBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRoomBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *RoomBooking           `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomBookingResponse) Reset() {
+	*x = GetRoomBookingResponse{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomBookingResponse) ProtoMessage() {}
+
+func (x *GetRoomBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomBookingResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRoomBookingResponse) GetBooking() *RoomBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// All filters are optional; from/to select bookings overlapping the range.
+type ListRoomBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Statuses      []string               `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomBookingsRequest) Reset() {
+	*x = ListRoomBookingsRequest{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomBookingsRequest) ProtoMessage() {}
+
+func (x *ListRoomBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListRoomBookingsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ListRoomBookingsRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ListRoomBookingsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListRoomBookingsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListRoomBookingsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListRoomBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*RoomBooking         `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomBookingsResponse) Reset() {
+	*x = ListRoomBookingsResponse{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomBookingsResponse) ProtoMessage() {}
+
+func (x *ListRoomBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListRoomBookingsResponse) GetBookings() []*RoomBooking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+type ReviewRoomBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewRoomBookingRequest) Reset() {
+	*x = ReviewRoomBookingRequest{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewRoomBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRoomBookingRequest) ProtoMessage() {}
+
+func (x *ReviewRoomBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRoomBookingRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRoomBookingRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReviewRoomBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewRoomBookingRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewRoomBookingRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewRoomBookingRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReviewRoomBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *RoomBooking           `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewRoomBookingResponse) Reset() {
+	*x = ReviewRoomBookingResponse{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewRoomBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRoomBookingResponse) ProtoMessage() {}
+
+func (x *ReviewRoomBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRoomBookingResponse.ProtoReflect.Descriptor instead.
+func (*ReviewRoomBookingResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReviewRoomBookingResponse) GetBooking() *RoomBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type CancelRoomBookingRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Lets administrators cancel bookings requested by someone else.
+	Override      bool `protobuf:"varint,3,opt,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRoomBookingRequest) Reset() {
+	*x = CancelRoomBookingRequest{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRoomBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRoomBookingRequest) ProtoMessage() {}
+
+func (x *CancelRoomBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRoomBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelRoomBookingRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelRoomBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelRoomBookingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelRoomBookingRequest) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+type CancelRoomBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *RoomBooking           `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRoomBookingResponse) Reset() {
+	*x = CancelRoomBookingResponse{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRoomBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRoomBookingResponse) ProtoMessage() {}
+
+func (x *CancelRoomBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRoomBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelRoomBookingResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelRoomBookingResponse) GetBooking() *RoomBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type FindFreeRoomsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"` // optional; defaults to start_date
+	// Only this weekday of the range (0=Monday); unset = every day.
+	DayOfWeek     *int32 `protobuf:"varint,3,opt,name=day_of_week,json=dayOfWeek,proto3,oneof" json:"day_of_week,omitempty"`
+	StartPeriod   int32  `protobuf:"varint,4,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	EndPeriod     int32  `protobuf:"varint,5,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+	MinCapacity   int32  `protobuf:"varint,6,opt,name=min_capacity,json=minCapacity,proto3" json:"min_capacity,omitempty"`
+	RoomType      string `protobuf:"bytes,7,opt,name=room_type,json=roomType,proto3" json:"room_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindFreeRoomsRequest) Reset() {
+	*x = FindFreeRoomsRequest{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindFreeRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindFreeRoomsRequest) ProtoMessage() {}
+
+func (x *FindFreeRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindFreeRoomsRequest.ProtoReflect.Descriptor instead.
+func (*FindFreeRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FindFreeRoomsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *FindFreeRoomsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *FindFreeRoomsRequest) GetDayOfWeek() int32 {
+	if x != nil && x.DayOfWeek != nil {
+		return *x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *FindFreeRoomsRequest) GetStartPeriod() int32 {
+	if x != nil {
+		return x.StartPeriod
+	}
+	return 0
+}
+
+func (x *FindFreeRoomsRequest) GetEndPeriod() int32 {
+	if x != nil {
+		return x.EndPeriod
+	}
+	return 0
+}
+
+func (x *FindFreeRoomsRequest) GetMinCapacity() int32 {
+	if x != nil {
+		return x.MinCapacity
+	}
+	return 0
+}
+
+func (x *FindFreeRoomsRequest) GetRoomType() string {
+	if x != nil {
+		return x.RoomType
+	}
+	return ""
+}
+
+type FindFreeRoomsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*Room                `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindFreeRoomsResponse) Reset() {
+	*x = FindFreeRoomsResponse{}
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindFreeRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindFreeRoomsResponse) ProtoMessage() {}
+
+func (x *FindFreeRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_room_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindFreeRoomsResponse.ProtoReflect.Descriptor instead.
+func (*FindFreeRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_room_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FindFreeRoomsResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+var File_timetable_v1_room_booking_proto protoreflect.FileDescriptor
+
+const file_timetable_v1_room_booking_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftimetable/v1/room_booking.proto\x12\ftimetable.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ctimetable/v1/timetable.proto\"\xac\x04\n" +
+	"\vRoomBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\troom_name\x18\x03 \x01(\tR\broomName\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\apurpose\x18\x05 \x01(\tR\apurpose\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\x129\n" +
+	"\n" +
+	"start_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12!\n" +
+	"\fstart_period\x18\t \x01(\x05R\vstartPeriod\x12\x1d\n" +
+	"\n" +
+	"end_period\x18\n" +
+	" \x01(\x05R\tendPeriod\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\f \x01(\tR\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreview_note\x18\r \x01(\tR\n" +
+	"reviewNote\x12;\n" +
+	"\vreviewed_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xba\x02\n" +
+	"\x18CreateRoomBookingRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12!\n" +
+	"\fstart_period\x18\a \x01(\x05R\vstartPeriod\x12\x1d\n" +
+	"\n" +
+	"end_period\x18\b \x01(\x05R\tendPeriod\"P\n" +
+	"\x19CreateRoomBookingResponse\x123\n" +
+	"\abooking\x18\x01 \x01(\v2\x19.timetable.v1.RoomBookingR\abooking\"'\n" +
+	"\x15GetRoomBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"M\n" +
+	"\x16GetRoomBookingResponse\x123\n" +
+	"\abooking\x18\x01 \x01(\v2\x19.timetable.v1.RoomBookingR\abooking\"\xcd\x01\n" +
+	"\x17ListRoomBookingsRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\x12\x1a\n" +
+	"\bstatuses\x18\x03 \x03(\tR\bstatuses\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"Q\n" +
+	"\x18ListRoomBookingsResponse\x125\n" +
+	"\bbookings\x18\x01 \x03(\v2\x19.timetable.v1.RoomBookingR\bbookings\"y\n" +
+	"\x18ReviewRoomBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"P\n" +
+	"\x19ReviewRoomBookingResponse\x123\n" +
+	"\abooking\x18\x01 \x01(\v2\x19.timetable.v1.RoomBookingR\abooking\"_\n" +
+	"\x18CancelRoomBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\boverride\x18\x03 \x01(\bR\boverride\"P\n" +
+	"\x19CancelRoomBookingResponse\x123\n" +
+	"\abooking\x18\x01 \x01(\v2\x19.timetable.v1.RoomBookingR\abooking\"\xbf\x02\n" +
+	"\x14FindFreeRoomsRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12#\n" +
+	"\vday_of_week\x18\x03 \x01(\x05H\x00R\tdayOfWeek\x88\x01\x01\x12!\n" +
+	"\fstart_period\x18\x04 \x01(\x05R\vstartPeriod\x12\x1d\n" +
+	"\n" +
+	"end_period\x18\x05 \x01(\x05R\tendPeriod\x12!\n" +
+	"\fmin_capacity\x18\x06 \x01(\x05R\vminCapacity\x12\x1b\n" +
+	"\troom_type\x18\a \x01(\tR\broomTypeB\x0e\n" +
+	"\f_day_of_week\"A\n" +
+	"\x15FindFreeRoomsResponse\x12(\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x12.timetable.v1.RoomR\x05rooms2\xe0\x04\n" +
+	"\x12RoomBookingService\x12d\n" +
+	"\x11CreateRoomBooking\x12&.timetable.v1.CreateRoomBookingRequest\x1a'.timetable.v1.CreateRoomBookingResponse\x12[\n" +
+	"\x0eGetRoomBooking\x12#.timetable.v1.GetRoomBookingRequest\x1a$.timetable.v1.GetRoomBookingResponse\x12a\n" +
+	"\x10ListRoomBookings\x12%.timetable.v1.ListRoomBookingsRequest\x1a&.timetable.v1.ListRoomBookingsResponse\x12d\n" +
+	"\x11ReviewRoomBooking\x12&.timetable.v1.ReviewRoomBookingRequest\x1a'.timetable.v1.ReviewRoomBookingResponse\x12d\n" +
+	"\x11CancelRoomBooking\x12&.timetable.v1.CancelRoomBookingRequest\x1a'.timetable.v1.CancelRoomBookingResponse\x12X\n" +
+	"\rFindFreeRooms\x12\".timetable.v1.FindFreeRoomsRequest\x1a#.timetable.v1.FindFreeRoomsResponseBBZ@github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1;timetablev1b\x06proto3"
+
+var (
+	file_timetable_v1_room_booking_proto_rawDescOnce sync.Once
+	file_timetable_v1_room_booking_proto_rawDescData []byte
+)
+
+func file_timetable_v1_room_booking_proto_rawDescGZIP() []byte {
+	file_timetable_v1_room_booking_proto_rawDescOnce.Do(func() {
+		file_timetable_v1_room_booking_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_timetable_v1_room_booking_proto_rawDesc), len(file_timetable_v1_room_booking_proto_rawDesc)))
+	})
+	return file_timetable_v1_room_booking_proto_rawDescData
+}
+
+var file_timetable_v1_room_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_timetable_v1_room_booking_proto_goTypes = []any{
+	(*RoomBooking)(nil),               // 0: timetable.v1.RoomBooking
+	(*CreateRoomBookingRequest)(nil),  // 1: timetable.v1.CreateRoomBookingRequest
+	(*CreateRoomBookingResponse)(nil), // 2: timetable.v1.CreateRoomBookingResponse
+	(*GetRoomBookingRequest)(nil),     // 3: timetable.v1.GetRoomBookingRequest
+	(*GetRoomBookingResponse)(nil),    // 4: timetable.v1.GetRoomBookingResponse
+	(*ListRoomBookingsRequest)(nil),   // 5: timetable.v1.ListRoomBookingsRequest
+	(*ListRoomBookingsResponse)(nil),  // 6: timetable.v1.ListRoomBookingsResponse
+	(*ReviewRoomBookingRequest)(nil),  // 7: timetable.v1.ReviewRoomBookingRequest
+	(*ReviewRoomBookingResponse)(nil), // 8: timetable.v1.ReviewRoomBookingResponse
+	(*CancelRoomBookingRequest)(nil),  // 9: timetable.v1.CancelRoomBookingRequest
+	(*CancelRoomBookingResponse)(nil), // 10: timetable.v1.CancelRoomBookingResponse
+	(*FindFreeRoomsRequest)(nil),      // 11: timetable.v1.FindFreeRoomsRequest
+	(*FindFreeRoomsResponse)(nil),     // 12: timetable.v1.FindFreeRoomsResponse
+	(*timestamppb.Timestamp)(nil),     // 13: google.protobuf.Timestamp
+	(*Room)(nil),                      // 14: timetable.v1.Room
+}
+var file_timetable_v1_room_booking_proto_depIdxs = []int32{
+	13, // 0: timetable.v1.RoomBooking.start_date:type_name -> google.protobuf.Timestamp
+	13, // 1: timetable.v1.RoomBooking.end_date:type_name -> google.protobuf.Timestamp
+	13, // 2: timetable.v1.RoomBooking.reviewed_at:type_name -> google.protobuf.Timestamp
+	13, // 3: timetable.v1.RoomBooking.created_at:type_name -> google.protobuf.Timestamp
+	13, // 4: timetable.v1.CreateRoomBookingRequest.start_date:type_name -> google.protobuf.Timestamp
+	13, // 5: timetable.v1.CreateRoomBookingRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 6: timetable.v1.CreateRoomBookingResponse.booking:type_name -> timetable.v1.RoomBooking
+	0,  // 7: timetable.v1.GetRoomBookingResponse.booking:type_name -> timetable.v1.RoomBooking
+	13, // 8: timetable.v1.ListRoomBookingsRequest.from:type_name -> google.protobuf.Timestamp
+	13, // 9: timetable.v1.ListRoomBookingsRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 10: timetable.v1.ListRoomBookingsResponse.bookings:type_name -> timetable.v1.RoomBooking
+	0,  // 11: timetable.v1.ReviewRoomBookingResponse.booking:type_name -> timetable.v1.RoomBooking
+	0,  // 12: timetable.v1.CancelRoomBookingResponse.booking:type_name -> timetable.v1.RoomBooking
+	13, // 13: timetable.v1.FindFreeRoomsRequest.start_date:type_name -> google.protobuf.Timestamp
+	13, // 14: timetable.v1.FindFreeRoomsRequest.end_date:type_name -> google.protobuf.Timestamp
+	14, // 15: timetable.v1.FindFreeRoomsResponse.rooms:type_name -> timetable.v1.Room
+	1,  // 16: timetable.v1.RoomBookingService.CreateRoomBooking:input_type -> timetable.v1.CreateRoomBookingRequest
+	3,  // 17: timetable.v1.RoomBookingService.GetRoomBooking:input_type -> timetable.v1.GetRoomBookingRequest
+	5,  // 18: timetable.v1.RoomBookingService.ListRoomBookings:input_type -> timetable.v1.ListRoomBookingsRequest
+	7,  // 19: timetable.v1.RoomBookingService.ReviewRoomBooking:input_type -> timetable.v1.ReviewRoomBookingRequest
+	9,  // 20: timetable.v1.RoomBookingService.CancelRoomBooking:input_type -> timetable.v1.CancelRoomBookingRequest
+	11, // 21: timetable.v1.RoomBookingService.FindFreeRooms:input_type -> timetable.v1.FindFreeRoomsRequest
+	2,  // 22: timetable.v1.RoomBookingService.CreateRoomBooking:output_type -> timetable.v1.CreateRoomBookingResponse
+	4,  // 23: timetable.v1.RoomBookingService.GetRoomBooking:output_type -> timetable.v1.GetRoomBookingResponse
+	6,  // 24: timetable.v1.RoomBookingService.ListRoomBookings:output_type -> timetable.v1.ListRoomBookingsResponse
+	8,  // 25: timetable.v1.RoomBookingService.ReviewRoomBooking:output_type -> timetable.v1.ReviewRoomBookingResponse
+	10, // 26: timetable.v1.RoomBookingService.CancelRoomBooking:output_type -> timetable.v1.CancelRoomBookingResponse
+	12, // 27: timetable.v1.RoomBookingService.FindFreeRooms:output_type -> timetable.v1.FindFreeRoomsResponse
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_timetable_v1_room_booking_proto_init() }
+func file_timetable_v1_room_booking_proto_init() {
+	if File_timetable_v1_room_booking_proto != nil {
+		return
+	}
+	file_timetable_v1_timetable_proto_init()
+	file_timetable_v1_room_booking_proto_msgTypes[11].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_timetable_v1_room_booking_proto_rawDesc), len(file_timetable_v1_room_booking_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_timetable_v1_room_booking_proto_goTypes,
+		DependencyIndexes: file_timetable_v1_room_booking_proto_depIdxs,
+		MessageInfos:      file_timetable_v1_room_booking_proto_msgTypes,
+	}.Build()
+	File_timetable_v1_room_booking_proto = out.File
+	file_timetable_v1_room_booking_proto_goTypes = nil
+	file_timetable_v1_room_booking_proto_depIdxs = nil
+}
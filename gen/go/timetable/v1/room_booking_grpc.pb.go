@@ -0,0 +1,325 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: timetable/v1/room_booking.proto
+
+package timetablev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RoomBookingService_CreateRoomBooking_FullMethodName = "/timetable.v1.RoomBookingService/CreateRoomBooking"
+	RoomBookingService_GetRoomBooking_FullMethodName    = "/timetable.v1.RoomBookingService/GetRoomBooking"
+	RoomBookingService_ListRoomBookings_FullMethodName  = "/timetable.v1.RoomBookingService/ListRoomBookings"
+	RoomBookingService_ReviewRoomBooking_FullMethodName = "/timetable.v1.RoomBookingService/ReviewRoomBooking"
+	RoomBookingService_CancelRoomBooking_FullMethodName = "/timetable.v1.RoomBookingService/CancelRoomBooking"
+	RoomBookingService_FindFreeRooms_FullMethodName     = "/timetable.v1.RoomBookingService/FindFreeRooms"
+)
+
+// RoomBookingServiceClient is the client API for RoomBookingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RoomBookingService books rooms outside the generated timetable (thesis
+// defences, meetings). A booking holds periods start_period..end_period on
+// every day from start_date to end_date and is refused while a class of a
+// published schedule or another pending/approved booking holds the room.
+type RoomBookingServiceClient interface {
+	CreateRoomBooking(ctx context.Context, in *CreateRoomBookingRequest, opts ...grpc.CallOption) (*CreateRoomBookingResponse, error)
+	GetRoomBooking(ctx context.Context, in *GetRoomBookingRequest, opts ...grpc.CallOption) (*GetRoomBookingResponse, error)
+	ListRoomBookings(ctx context.Context, in *ListRoomBookingsRequest, opts ...grpc.CallOption) (*ListRoomBookingsResponse, error)
+	// ReviewRoomBooking approves or rejects a pending booking of a restricted room.
+	ReviewRoomBooking(ctx context.Context, in *ReviewRoomBookingRequest, opts ...grpc.CallOption) (*ReviewRoomBookingResponse, error)
+	CancelRoomBooking(ctx context.Context, in *CancelRoomBookingRequest, opts ...grpc.CallOption) (*CancelRoomBookingResponse, error)
+	// FindFreeRooms lists active rooms that are free at the requested times.
+	FindFreeRooms(ctx context.Context, in *FindFreeRoomsRequest, opts ...grpc.CallOption) (*FindFreeRoomsResponse, error)
+}
+
+type roomBookingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoomBookingServiceClient(cc grpc.ClientConnInterface) RoomBookingServiceClient {
+	return &roomBookingServiceClient{cc}
+}
+
+func (c *roomBookingServiceClient) CreateRoomBooking(ctx context.Context, in *CreateRoomBookingRequest, opts ...grpc.CallOption) (*CreateRoomBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoomBookingResponse)
+	err := c.cc.Invoke(ctx, RoomBookingService_CreateRoomBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomBookingServiceClient) GetRoomBooking(ctx context.Context, in *GetRoomBookingRequest, opts ...grpc.CallOption) (*GetRoomBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoomBookingResponse)
+	err := c.cc.Invoke(ctx, RoomBookingService_GetRoomBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomBookingServiceClient) ListRoomBookings(ctx context.Context, in *ListRoomBookingsRequest, opts ...grpc.CallOption) (*ListRoomBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoomBookingsResponse)
+	err := c.cc.Invoke(ctx, RoomBookingService_ListRoomBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomBookingServiceClient) ReviewRoomBooking(ctx context.Context, in *ReviewRoomBookingRequest, opts ...grpc.CallOption) (*ReviewRoomBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewRoomBookingResponse)
+	err := c.cc.Invoke(ctx, RoomBookingService_ReviewRoomBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomBookingServiceClient) CancelRoomBooking(ctx context.Context, in *CancelRoomBookingRequest, opts ...grpc.CallOption) (*CancelRoomBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelRoomBookingResponse)
+	err := c.cc.Invoke(ctx, RoomBookingService_CancelRoomBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomBookingServiceClient) FindFreeRooms(ctx context.Context, in *FindFreeRoomsRequest, opts ...grpc.CallOption) (*FindFreeRoomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindFreeRoomsResponse)
+	err := c.cc.Invoke(ctx, RoomBookingService_FindFreeRooms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoomBookingServiceServer is the server API for RoomBookingService service.
+// All implementations must embed UnimplementedRoomBookingServiceServer
+// for forward compatibility.
+//
+// RoomBookingService books rooms outside the generated timetable (thesis
+// defences, meetings). A booking holds periods start_period..end_period on
+// every day from start_date to end_date and is refused while a class of a
+// published schedule or another pending/approved booking holds the room.
+type RoomBookingServiceServer interface {
+	CreateRoomBooking(context.Context, *CreateRoomBookingRequest) (*CreateRoomBookingResponse, error)
+	GetRoomBooking(context.Context, *GetRoomBookingRequest) (*GetRoomBookingResponse, error)
+	ListRoomBookings(context.Context, *ListRoomBookingsRequest) (*ListRoomBookingsResponse, error)
+	// ReviewRoomBooking approves or rejects a pending booking of a restricted room.
+	ReviewRoomBooking(context.Context, *ReviewRoomBookingRequest) (*ReviewRoomBookingResponse, error)
+	CancelRoomBooking(context.Context, *CancelRoomBookingRequest) (*CancelRoomBookingResponse, error)
+	// FindFreeRooms lists active rooms that are free at the requested times.
+	FindFreeRooms(context.Context, *FindFreeRoomsRequest) (*FindFreeRoomsResponse, error)
+	mustEmbedUnimplementedRoomBookingServiceServer()
+}
+
+// UnimplementedRoomBookingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRoomBookingServiceServer struct{}
+
+func (UnimplementedRoomBookingServiceServer) CreateRoomBooking(context.Context, *CreateRoomBookingRequest) (*CreateRoomBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRoomBooking not implemented")
+}
+func (UnimplementedRoomBookingServiceServer) GetRoomBooking(context.Context, *GetRoomBookingRequest) (*GetRoomBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRoomBooking not implemented")
+}
+func (UnimplementedRoomBookingServiceServer) ListRoomBookings(context.Context, *ListRoomBookingsRequest) (*ListRoomBookingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRoomBookings not implemented")
+}
+func (UnimplementedRoomBookingServiceServer) ReviewRoomBooking(context.Context, *ReviewRoomBookingRequest) (*ReviewRoomBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewRoomBooking not implemented")
+}
+func (UnimplementedRoomBookingServiceServer) CancelRoomBooking(context.Context, *CancelRoomBookingRequest) (*CancelRoomBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelRoomBooking not implemented")
+}
+func (UnimplementedRoomBookingServiceServer) FindFreeRooms(context.Context, *FindFreeRoomsRequest) (*FindFreeRoomsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindFreeRooms not implemented")
+}
+func (UnimplementedRoomBookingServiceServer) mustEmbedUnimplementedRoomBookingServiceServer() {}
+func (UnimplementedRoomBookingServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeRoomBookingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoomBookingServiceServer will
+// result in compilation errors.
+type UnsafeRoomBookingServiceServer interface {
+	mustEmbedUnimplementedRoomBookingServiceServer()
+}
+
+func RegisterRoomBookingServiceServer(s grpc.ServiceRegistrar, srv RoomBookingServiceServer) {
+	// If the following call panics, it indicates UnimplementedRoomBookingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RoomBookingService_ServiceDesc, srv)
+}
+
+func _RoomBookingService_CreateRoomBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomBookingServiceServer).CreateRoomBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomBookingService_CreateRoomBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomBookingServiceServer).CreateRoomBooking(ctx, req.(*CreateRoomBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomBookingService_GetRoomBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomBookingServiceServer).GetRoomBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomBookingService_GetRoomBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomBookingServiceServer).GetRoomBooking(ctx, req.(*GetRoomBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomBookingService_ListRoomBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomBookingServiceServer).ListRoomBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomBookingService_ListRoomBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomBookingServiceServer).ListRoomBookings(ctx, req.(*ListRoomBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomBookingService_ReviewRoomBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRoomBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomBookingServiceServer).ReviewRoomBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomBookingService_ReviewRoomBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomBookingServiceServer).ReviewRoomBooking(ctx, req.(*ReviewRoomBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomBookingService_CancelRoomBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRoomBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomBookingServiceServer).CancelRoomBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomBookingService_CancelRoomBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomBookingServiceServer).CancelRoomBooking(ctx, req.(*CancelRoomBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomBookingService_FindFreeRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFreeRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomBookingServiceServer).FindFreeRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomBookingService_FindFreeRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomBookingServiceServer).FindFreeRooms(ctx, req.(*FindFreeRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoomBookingService_ServiceDesc is the grpc.ServiceDesc for RoomBookingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomBookingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "timetable.v1.RoomBookingService",
+	HandlerType: (*RoomBookingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoomBooking",
+			Handler:    _RoomBookingService_CreateRoomBooking_Handler,
+		},
+		{
+			MethodName: "GetRoomBooking",
+			Handler:    _RoomBookingService_GetRoomBooking_Handler,
+		},
+		{
+			MethodName: "ListRoomBookings",
+			Handler:    _RoomBookingService_ListRoomBookings_Handler,
+		},
+		{
+			MethodName: "ReviewRoomBooking",
+			Handler:    _RoomBookingService_ReviewRoomBooking_Handler,
+		},
+		{
+			MethodName: "CancelRoomBooking",
+			Handler:    _RoomBookingService_CancelRoomBooking_Handler,
+		},
+		{
+			MethodName: "FindFreeRooms",
+			Handler:    _RoomBookingService_FindFreeRooms_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "timetable/v1/room_booking.proto",
+}
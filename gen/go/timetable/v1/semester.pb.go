@@ -34,8 +34,21 @@ type Semester struct {
 	OfferedSubjectIds []string               `protobuf:"bytes,7,rep,name=offered_subject_ids,json=offeredSubjectIds,proto3" json:"offered_subject_ids,omitempty"`
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	RoomIds           []string               `protobuf:"bytes,9,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Empty means the solver's defaults (teacher_gap x2.0, load_imbalance x1.5,
+	// preference_break x1.0, cohort_clash x10.0).
+	SoftConstraints []*SoftConstraintSetting `protobuf:"bytes,10,rep,name=soft_constraints,json=softConstraints,proto3" json:"soft_constraints,omitempty"`
+	// The period table; semesters without their own get the default one
+	// (period 1 = 08:00-09:30 … 8 = 20:15-21:45).
+	Periods  []*PeriodDefinition `protobuf:"bytes,11,rep,name=periods,proto3" json:"periods,omitempty"`
+	Holidays []*Holiday          `protobuf:"bytes,12,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	// Offered subjects that do not meet every week: subject id -> pattern
+	// (odd_weeks, even_weeks, first_half, second_half).
+	WeekPatterns map[string]string `protobuf:"bytes,13,rep,name=week_patterns,json=weekPatterns,proto3" json:"week_patterns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Offered subjects taught as several sections: subject id -> sections.
+	// Subjects missing here are one section for everyone.
+	Sections      map[string]*SubjectSections `protobuf:"bytes,14,rep,name=sections,proto3" json:"sections,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Semester) Reset() {
@@ -131,31 +144,69 @@ func (x *Semester) GetRoomIds() []string {
 	return nil
 }
 
-type CreateSemesterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
-	Term          int32                  `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+func (x *Semester) GetSoftConstraints() []*SoftConstraintSetting {
+	if x != nil {
+		return x.SoftConstraints
+	}
+	return nil
+}
+
+func (x *Semester) GetPeriods() []*PeriodDefinition {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *Semester) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *Semester) GetWeekPatterns() map[string]string {
+	if x != nil {
+		return x.WeekPatterns
+	}
+	return nil
+}
+
+func (x *Semester) GetSections() map[string]*SubjectSections {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// CourseSection is one parallel group of a subject, scheduled on its own.
+type CourseSection struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Number int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"` // 1-based, unique within the subject
+	// Expected enrolment; 0 keeps the subject's room minimum.
+	Capacity int32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// Student group the section is reserved for; empty when open to all.
+	StudentGroup string `protobuf:"bytes,3,opt,name=student_group,json=studentGroup,proto3" json:"student_group,omitempty"`
+	// Assistant teachers needed alongside the lead in every session (0-4).
+	Assistants    int32 `protobuf:"varint,4,opt,name=assistants,proto3" json:"assistants,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSemesterRequest) Reset() {
-	*x = CreateSemesterRequest{}
+func (x *CourseSection) Reset() {
+	*x = CourseSection{}
 	mi := &file_timetable_v1_semester_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSemesterRequest) String() string {
+func (x *CourseSection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSemesterRequest) ProtoMessage() {}
+func (*CourseSection) ProtoMessage() {}
 
-func (x *CreateSemesterRequest) ProtoReflect() protoreflect.Message {
+func (x *CourseSection) ProtoReflect() protoreflect.Message {
 	mi := &file_timetable_v1_semester_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -167,67 +218,60 @@ func (x *CreateSemesterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSemesterRequest.ProtoReflect.Descriptor instead.
-func (*CreateSemesterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CourseSection.ProtoReflect.Descriptor instead.
+func (*CourseSection) Descriptor() ([]byte, []int) {
 	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateSemesterRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateSemesterRequest) GetYear() int32 {
+func (x *CourseSection) GetNumber() int32 {
 	if x != nil {
-		return x.Year
+		return x.Number
 	}
 	return 0
 }
 
-func (x *CreateSemesterRequest) GetTerm() int32 {
+func (x *CourseSection) GetCapacity() int32 {
 	if x != nil {
-		return x.Term
+		return x.Capacity
 	}
 	return 0
 }
 
-func (x *CreateSemesterRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *CourseSection) GetStudentGroup() string {
 	if x != nil {
-		return x.StartDate
+		return x.StudentGroup
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateSemesterRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *CourseSection) GetAssistants() int32 {
 	if x != nil {
-		return x.EndDate
+		return x.Assistants
 	}
-	return nil
+	return 0
 }
 
-type CreateSemesterResponse struct {
+type SubjectSections struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	Sections      []*CourseSection       `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSemesterResponse) Reset() {
-	*x = CreateSemesterResponse{}
+func (x *SubjectSections) Reset() {
+	*x = SubjectSections{}
 	mi := &file_timetable_v1_semester_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSemesterResponse) String() string {
+func (x *SubjectSections) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSemesterResponse) ProtoMessage() {}
+func (*SubjectSections) ProtoMessage() {}
 
-func (x *CreateSemesterResponse) ProtoReflect() protoreflect.Message {
+func (x *SubjectSections) ProtoReflect() protoreflect.Message {
 	mi := &file_timetable_v1_semester_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -239,39 +283,44 @@ func (x *CreateSemesterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSemesterResponse.ProtoReflect.Descriptor instead.
-func (*CreateSemesterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubjectSections.ProtoReflect.Descriptor instead.
+func (*SubjectSections) Descriptor() ([]byte, []int) {
 	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateSemesterResponse) GetSemester() *Semester {
+func (x *SubjectSections) GetSections() []*CourseSection {
 	if x != nil {
-		return x.Semester
+		return x.Sections
 	}
 	return nil
 }
 
-type GetSemesterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// PeriodDefinition gives the clock times of one period as "HH:MM".
+type PeriodDefinition struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Period int32                  `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Start  string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End    string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// Output only: minutes until the next period starts (0 for the last one).
+	BreakMinutes  int32 `protobuf:"varint,4,opt,name=break_minutes,json=breakMinutes,proto3" json:"break_minutes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSemesterRequest) Reset() {
-	*x = GetSemesterRequest{}
+func (x *PeriodDefinition) Reset() {
+	*x = PeriodDefinition{}
 	mi := &file_timetable_v1_semester_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSemesterRequest) String() string {
+func (x *PeriodDefinition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSemesterRequest) ProtoMessage() {}
+func (*PeriodDefinition) ProtoMessage() {}
 
-func (x *GetSemesterRequest) ProtoReflect() protoreflect.Message {
+func (x *PeriodDefinition) ProtoReflect() protoreflect.Message {
 	mi := &file_timetable_v1_semester_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -283,39 +332,63 @@ func (x *GetSemesterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSemesterRequest.ProtoReflect.Descriptor instead.
-func (*GetSemesterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PeriodDefinition.ProtoReflect.Descriptor instead.
+func (*PeriodDefinition) Descriptor() ([]byte, []int) {
 	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetSemesterRequest) GetId() string {
+func (x *PeriodDefinition) GetPeriod() int32 {
 	if x != nil {
-		return x.Id
+		return x.Period
+	}
+	return 0
+}
+
+func (x *PeriodDefinition) GetStart() string {
+	if x != nil {
+		return x.Start
 	}
 	return ""
 }
 
-type GetSemesterResponse struct {
+func (x *PeriodDefinition) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *PeriodDefinition) GetBreakMinutes() int32 {
+	if x != nil {
+		return x.BreakMinutes
+	}
+	return 0
+}
+
+// Holiday is a non-teaching day, or an inclusive range of days.
+type Holiday struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSemesterResponse) Reset() {
-	*x = GetSemesterResponse{}
+func (x *Holiday) Reset() {
+	*x = Holiday{}
 	mi := &file_timetable_v1_semester_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSemesterResponse) String() string {
+func (x *Holiday) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSemesterResponse) ProtoMessage() {}
+func (*Holiday) ProtoMessage() {}
 
-func (x *GetSemesterResponse) ProtoReflect() protoreflect.Message {
+func (x *Holiday) ProtoReflect() protoreflect.Message {
 	mi := &file_timetable_v1_semester_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -327,39 +400,58 @@ func (x *GetSemesterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSemesterResponse.ProtoReflect.Descriptor instead.
-func (*GetSemesterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
 	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetSemesterResponse) GetSemester() *Semester {
+func (x *Holiday) GetName() string {
 	if x != nil {
-		return x.Semester
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Holiday) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
 	}
 	return nil
 }
 
-type ListSemestersRequest struct {
+func (x *Holiday) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+// SoftConstraintSetting enables one soft constraint with its penalty weight.
+// Known types: teacher_gap, load_imbalance, preference_break, late_period,
+// max_consecutive, building_travel, lunch_break, cohort_clash.
+type SoftConstraintSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *v1.PaginationRequest  `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Params        map[string]int32       `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSemestersRequest) Reset() {
-	*x = ListSemestersRequest{}
+func (x *SoftConstraintSetting) Reset() {
+	*x = SoftConstraintSetting{}
 	mi := &file_timetable_v1_semester_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSemestersRequest) String() string {
+func (x *SoftConstraintSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSemestersRequest) ProtoMessage() {}
+func (*SoftConstraintSetting) ProtoMessage() {}
 
-func (x *ListSemestersRequest) ProtoReflect() protoreflect.Message {
+func (x *SoftConstraintSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_timetable_v1_semester_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -371,40 +463,57 @@ func (x *ListSemestersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSemestersRequest.ProtoReflect.Descriptor instead.
-func (*ListSemestersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SoftConstraintSetting.ProtoReflect.Descriptor instead.
+func (*SoftConstraintSetting) Descriptor() ([]byte, []int) {
 	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListSemestersRequest) GetPagination() *v1.PaginationRequest {
+func (x *SoftConstraintSetting) GetType() string {
 	if x != nil {
-		return x.Pagination
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SoftConstraintSetting) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SoftConstraintSetting) GetParams() map[string]int32 {
+	if x != nil {
+		return x.Params
 	}
 	return nil
 }
 
-type ListSemestersResponse struct {
+type CreateSemesterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Semesters     []*Semester            `protobuf:"bytes,1,rep,name=semesters,proto3" json:"semesters,omitempty"`
-	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Term          int32                  `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSemestersResponse) Reset() {
-	*x = ListSemestersResponse{}
+func (x *CreateSemesterRequest) Reset() {
+	*x = CreateSemesterRequest{}
 	mi := &file_timetable_v1_semester_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSemestersResponse) String() string {
+func (x *CreateSemesterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSemestersResponse) ProtoMessage() {}
+func (*CreateSemesterRequest) ProtoMessage() {}
 
-func (x *ListSemestersResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSemesterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_timetable_v1_semester_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -416,47 +525,67 @@ func (x *ListSemestersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSemestersResponse.ProtoReflect.Descriptor instead.
-func (*ListSemestersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSemesterRequest.ProtoReflect.Descriptor instead.
+func (*CreateSemesterRequest) Descriptor() ([]byte, []int) {
 	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListSemestersResponse) GetSemesters() []*Semester {
+func (x *CreateSemesterRequest) GetName() string {
 	if x != nil {
-		return x.Semesters
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSemesterRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CreateSemesterRequest) GetTerm() int32 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *CreateSemesterRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
 	}
 	return nil
 }
 
-func (x *ListSemestersResponse) GetPagination() *v1.PaginationResponse {
+func (x *CreateSemesterRequest) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Pagination
+		return x.EndDate
 	}
 	return nil
 }
 
-type AddOfferedSubjectRequest struct {
+type CreateSemesterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
-	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddOfferedSubjectRequest) Reset() {
-	*x = AddOfferedSubjectRequest{}
+func (x *CreateSemesterResponse) Reset() {
+	*x = CreateSemesterResponse{}
 	mi := &file_timetable_v1_semester_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddOfferedSubjectRequest) String() string {
+func (x *CreateSemesterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOfferedSubjectRequest) ProtoMessage() {}
+func (*CreateSemesterResponse) ProtoMessage() {}
 
-func (x *AddOfferedSubjectRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSemesterResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_timetable_v1_semester_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -468,46 +597,39 @@ func (x *AddOfferedSubjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOfferedSubjectRequest.ProtoReflect.Descriptor instead.
-func (*AddOfferedSubjectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSemesterResponse.ProtoReflect.Descriptor instead.
+func (*CreateSemesterResponse) Descriptor() ([]byte, []int) {
 	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AddOfferedSubjectRequest) GetSemesterId() string {
-	if x != nil {
-		return x.SemesterId
-	}
-	return ""
-}
-
-func (x *AddOfferedSubjectRequest) GetSubjectId() string {
+func (x *CreateSemesterResponse) GetSemester() *Semester {
 	if x != nil {
-		return x.SubjectId
+		return x.Semester
 	}
-	return ""
+	return nil
 }
 
-type AddOfferedSubjectResponse struct {
+type GetSemesterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddOfferedSubjectResponse) Reset() {
-	*x = AddOfferedSubjectResponse{}
+func (x *GetSemesterRequest) Reset() {
+	*x = GetSemesterRequest{}
 	mi := &file_timetable_v1_semester_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddOfferedSubjectResponse) String() string {
+func (x *GetSemesterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOfferedSubjectResponse) ProtoMessage() {}
+func (*GetSemesterRequest) ProtoMessage() {}
 
-func (x *AddOfferedSubjectResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSemesterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_timetable_v1_semester_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -519,29 +641,265 @@ func (x *AddOfferedSubjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOfferedSubjectResponse.ProtoReflect.Descriptor instead.
-func (*AddOfferedSubjectResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSemesterRequest.ProtoReflect.Descriptor instead.
+func (*GetSemesterRequest) Descriptor() ([]byte, []int) {
 	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AddOfferedSubjectResponse) GetSemester() *Semester {
+func (x *GetSemesterRequest) GetId() string {
 	if x != nil {
-		return x.Semester
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type RemoveOfferedSubjectRequest struct {
+type GetSemesterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
-	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemesterResponse) Reset() {
+	*x = GetSemesterResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterResponse) ProtoMessage() {}
+
+func (x *GetSemesterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemesterResponse.ProtoReflect.Descriptor instead.
+func (*GetSemesterResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSemesterResponse) GetSemester() *Semester {
+	if x != nil {
+		return x.Semester
+	}
+	return nil
+}
+
+type ListSemestersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *v1.PaginationRequest  `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSemestersRequest) Reset() {
+	*x = ListSemestersRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSemestersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSemestersRequest) ProtoMessage() {}
+
+func (x *ListSemestersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSemestersRequest.ProtoReflect.Descriptor instead.
+func (*ListSemestersRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSemestersRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListSemestersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semesters     []*Semester            `protobuf:"bytes,1,rep,name=semesters,proto3" json:"semesters,omitempty"`
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSemestersResponse) Reset() {
+	*x = ListSemestersResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSemestersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSemestersResponse) ProtoMessage() {}
+
+func (x *ListSemestersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSemestersResponse.ProtoReflect.Descriptor instead.
+func (*ListSemestersResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSemestersResponse) GetSemesters() []*Semester {
+	if x != nil {
+		return x.Semesters
+	}
+	return nil
+}
+
+func (x *ListSemestersResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type AddOfferedSubjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOfferedSubjectRequest) Reset() {
+	*x = AddOfferedSubjectRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOfferedSubjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOfferedSubjectRequest) ProtoMessage() {}
+
+func (x *AddOfferedSubjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOfferedSubjectRequest.ProtoReflect.Descriptor instead.
+func (*AddOfferedSubjectRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddOfferedSubjectRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *AddOfferedSubjectRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+type AddOfferedSubjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOfferedSubjectResponse) Reset() {
+	*x = AddOfferedSubjectResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOfferedSubjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOfferedSubjectResponse) ProtoMessage() {}
+
+func (x *AddOfferedSubjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOfferedSubjectResponse.ProtoReflect.Descriptor instead.
+func (*AddOfferedSubjectResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddOfferedSubjectResponse) GetSemester() *Semester {
+	if x != nil {
+		return x.Semester
+	}
+	return nil
+}
+
+type RemoveOfferedSubjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RemoveOfferedSubjectRequest) Reset() {
 	*x = RemoveOfferedSubjectRequest{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[9]
+	mi := &file_timetable_v1_semester_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -553,7 +911,7 @@ func (x *RemoveOfferedSubjectRequest) String() string {
 func (*RemoveOfferedSubjectRequest) ProtoMessage() {}
 
 func (x *RemoveOfferedSubjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[9]
+	mi := &file_timetable_v1_semester_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,7 +924,7 @@ func (x *RemoveOfferedSubjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveOfferedSubjectRequest.ProtoReflect.Descriptor instead.
 func (*RemoveOfferedSubjectRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{9}
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RemoveOfferedSubjectRequest) GetSemesterId() string {
@@ -592,7 +950,7 @@ type RemoveOfferedSubjectResponse struct {
 
 func (x *RemoveOfferedSubjectResponse) Reset() {
 	*x = RemoveOfferedSubjectResponse{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[10]
+	mi := &file_timetable_v1_semester_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -604,7 +962,7 @@ func (x *RemoveOfferedSubjectResponse) String() string {
 func (*RemoveOfferedSubjectResponse) ProtoMessage() {}
 
 func (x *RemoveOfferedSubjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[10]
+	mi := &file_timetable_v1_semester_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +975,7 @@ func (x *RemoveOfferedSubjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveOfferedSubjectResponse.ProtoReflect.Descriptor instead.
 func (*RemoveOfferedSubjectResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{10}
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RemoveOfferedSubjectResponse) GetSemester() *Semester {
@@ -638,21 +996,567 @@ type TimeSlot struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeSlot) Reset() {
-	*x = TimeSlot{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[11]
+func (x *TimeSlot) Reset() {
+	*x = TimeSlot{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSlot) ProtoMessage() {}
+
+func (x *TimeSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSlot.ProtoReflect.Descriptor instead.
+func (*TimeSlot) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TimeSlot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TimeSlot) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *TimeSlot) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *TimeSlot) GetStartPeriod() int32 {
+	if x != nil {
+		return x.StartPeriod
+	}
+	return 0
+}
+
+func (x *TimeSlot) GetEndPeriod() int32 {
+	if x != nil {
+		return x.EndPeriod
+	}
+	return 0
+}
+
+type ListTimeSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeSlotsRequest) Reset() {
+	*x = ListTimeSlotsRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeSlotsRequest) ProtoMessage() {}
+
+func (x *ListTimeSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimeSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListTimeSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTimeSlotsRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+type ListTimeSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeSlots     []*TimeSlot            `protobuf:"bytes,1,rep,name=time_slots,json=timeSlots,proto3" json:"time_slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeSlotsResponse) Reset() {
+	*x = ListTimeSlotsResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeSlotsResponse) ProtoMessage() {}
+
+func (x *ListTimeSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimeSlotsResponse.ProtoReflect.Descriptor instead.
+func (*ListTimeSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTimeSlotsResponse) GetTimeSlots() []*TimeSlot {
+	if x != nil {
+		return x.TimeSlots
+	}
+	return nil
+}
+
+type CreateTimeSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	DayOfWeek     int32                  `protobuf:"varint,2,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	StartPeriod   int32                  `protobuf:"varint,3,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	EndPeriod     int32                  `protobuf:"varint,4,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTimeSlotRequest) Reset() {
+	*x = CreateTimeSlotRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTimeSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimeSlotRequest) ProtoMessage() {}
+
+func (x *CreateTimeSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimeSlotRequest.ProtoReflect.Descriptor instead.
+func (*CreateTimeSlotRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateTimeSlotRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *CreateTimeSlotRequest) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *CreateTimeSlotRequest) GetStartPeriod() int32 {
+	if x != nil {
+		return x.StartPeriod
+	}
+	return 0
+}
+
+func (x *CreateTimeSlotRequest) GetEndPeriod() int32 {
+	if x != nil {
+		return x.EndPeriod
+	}
+	return 0
+}
+
+type CreateTimeSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeSlot      *TimeSlot              `protobuf:"bytes,1,opt,name=time_slot,json=timeSlot,proto3" json:"time_slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTimeSlotResponse) Reset() {
+	*x = CreateTimeSlotResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTimeSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimeSlotResponse) ProtoMessage() {}
+
+func (x *CreateTimeSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimeSlotResponse.ProtoReflect.Descriptor instead.
+func (*CreateTimeSlotResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateTimeSlotResponse) GetTimeSlot() *TimeSlot {
+	if x != nil {
+		return x.TimeSlot
+	}
+	return nil
+}
+
+type DeleteTimeSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTimeSlotRequest) Reset() {
+	*x = DeleteTimeSlotRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTimeSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTimeSlotRequest) ProtoMessage() {}
+
+func (x *DeleteTimeSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTimeSlotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTimeSlotRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteTimeSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTimeSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTimeSlotResponse) Reset() {
+	*x = DeleteTimeSlotResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTimeSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTimeSlotResponse) ProtoMessage() {}
+
+func (x *DeleteTimeSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTimeSlotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTimeSlotResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{22}
+}
+
+type ApplyTimeSlotPresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	Preset        string                 `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"` // "standard" | "mwf" | "tuth"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyTimeSlotPresetRequest) Reset() {
+	*x = ApplyTimeSlotPresetRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyTimeSlotPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyTimeSlotPresetRequest) ProtoMessage() {}
+
+func (x *ApplyTimeSlotPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyTimeSlotPresetRequest.ProtoReflect.Descriptor instead.
+func (*ApplyTimeSlotPresetRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ApplyTimeSlotPresetRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *ApplyTimeSlotPresetRequest) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+type ApplyTimeSlotPresetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeSlots     []*TimeSlot            `protobuf:"bytes,1,rep,name=time_slots,json=timeSlots,proto3" json:"time_slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyTimeSlotPresetResponse) Reset() {
+	*x = ApplyTimeSlotPresetResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyTimeSlotPresetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyTimeSlotPresetResponse) ProtoMessage() {}
+
+func (x *ApplyTimeSlotPresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyTimeSlotPresetResponse.ProtoReflect.Descriptor instead.
+func (*ApplyTimeSlotPresetResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ApplyTimeSlotPresetResponse) GetTimeSlots() []*TimeSlot {
+	if x != nil {
+		return x.TimeSlots
+	}
+	return nil
+}
+
+type SetSemesterRoomsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	RoomIds       []string               `protobuf:"bytes,2,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSemesterRoomsRequest) Reset() {
+	*x = SetSemesterRoomsRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSemesterRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSemesterRoomsRequest) ProtoMessage() {}
+
+func (x *SetSemesterRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSemesterRoomsRequest.ProtoReflect.Descriptor instead.
+func (*SetSemesterRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetSemesterRoomsRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *SetSemesterRoomsRequest) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+type SetSemesterRoomsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomIds       []string               `protobuf:"bytes,1,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSemesterRoomsResponse) Reset() {
+	*x = SetSemesterRoomsResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSemesterRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSemesterRoomsResponse) ProtoMessage() {}
+
+func (x *SetSemesterRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSemesterRoomsResponse.ProtoReflect.Descriptor instead.
+func (*SetSemesterRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetSemesterRoomsResponse) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+type SetSoftConstraintsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	// Replaces all settings; an empty list restores the defaults.
+	SoftConstraints []*SoftConstraintSetting `protobuf:"bytes,2,rep,name=soft_constraints,json=softConstraints,proto3" json:"soft_constraints,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetSoftConstraintsRequest) Reset() {
+	*x = SetSoftConstraintsRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeSlot) String() string {
+func (x *SetSoftConstraintsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeSlot) ProtoMessage() {}
+func (*SetSoftConstraintsRequest) ProtoMessage() {}
 
-func (x *TimeSlot) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[11]
+func (x *SetSoftConstraintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,68 +1567,47 @@ func (x *TimeSlot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeSlot.ProtoReflect.Descriptor instead.
-func (*TimeSlot) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *TimeSlot) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use SetSoftConstraintsRequest.ProtoReflect.Descriptor instead.
+func (*SetSoftConstraintsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *TimeSlot) GetSemesterId() string {
+func (x *SetSoftConstraintsRequest) GetSemesterId() string {
 	if x != nil {
 		return x.SemesterId
 	}
 	return ""
 }
 
-func (x *TimeSlot) GetDayOfWeek() int32 {
-	if x != nil {
-		return x.DayOfWeek
-	}
-	return 0
-}
-
-func (x *TimeSlot) GetStartPeriod() int32 {
-	if x != nil {
-		return x.StartPeriod
-	}
-	return 0
-}
-
-func (x *TimeSlot) GetEndPeriod() int32 {
+func (x *SetSoftConstraintsRequest) GetSoftConstraints() []*SoftConstraintSetting {
 	if x != nil {
-		return x.EndPeriod
+		return x.SoftConstraints
 	}
-	return 0
+	return nil
 }
 
-type ListTimeSlotsRequest struct {
+type SetSoftConstraintsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTimeSlotsRequest) Reset() {
-	*x = ListTimeSlotsRequest{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[12]
+func (x *SetSoftConstraintsResponse) Reset() {
+	*x = SetSoftConstraintsResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTimeSlotsRequest) String() string {
+func (x *SetSoftConstraintsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTimeSlotsRequest) ProtoMessage() {}
+func (*SetSoftConstraintsResponse) ProtoMessage() {}
 
-func (x *ListTimeSlotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[12]
+func (x *SetSoftConstraintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,40 +1618,45 @@ func (x *ListTimeSlotsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTimeSlotsRequest.ProtoReflect.Descriptor instead.
-func (*ListTimeSlotsRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SetSoftConstraintsResponse.ProtoReflect.Descriptor instead.
+func (*SetSoftConstraintsResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ListTimeSlotsRequest) GetSemesterId() string {
+func (x *SetSoftConstraintsResponse) GetSemester() *Semester {
 	if x != nil {
-		return x.SemesterId
+		return x.Semester
 	}
-	return ""
+	return nil
 }
 
-type ListTimeSlotsResponse struct {
+// SetPeriodDefinitionsRequest replaces the period table, either explicitly
+// or generated from equal-length periods; an empty request restores the
+// defaults.
+type SetPeriodDefinitionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TimeSlots     []*TimeSlot            `protobuf:"bytes,1,rep,name=time_slots,json=timeSlots,proto3" json:"time_slots,omitempty"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	Periods       []*PeriodDefinition    `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	Generate      *PeriodGenerator       `protobuf:"bytes,3,opt,name=generate,proto3" json:"generate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTimeSlotsResponse) Reset() {
-	*x = ListTimeSlotsResponse{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[13]
+func (x *SetPeriodDefinitionsRequest) Reset() {
+	*x = SetPeriodDefinitionsRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTimeSlotsResponse) String() string {
+func (x *SetPeriodDefinitionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTimeSlotsResponse) ProtoMessage() {}
+func (*SetPeriodDefinitionsRequest) ProtoMessage() {}
 
-func (x *ListTimeSlotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[13]
+func (x *SetPeriodDefinitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,43 +1667,61 @@ func (x *ListTimeSlotsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTimeSlotsResponse.ProtoReflect.Descriptor instead.
-func (*ListTimeSlotsResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SetPeriodDefinitionsRequest.ProtoReflect.Descriptor instead.
+func (*SetPeriodDefinitionsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListTimeSlotsResponse) GetTimeSlots() []*TimeSlot {
+func (x *SetPeriodDefinitionsRequest) GetSemesterId() string {
 	if x != nil {
-		return x.TimeSlots
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *SetPeriodDefinitionsRequest) GetPeriods() []*PeriodDefinition {
+	if x != nil {
+		return x.Periods
 	}
 	return nil
 }
 
-type CreateTimeSlotRequest struct {
+func (x *SetPeriodDefinitionsRequest) GetGenerate() *PeriodGenerator {
+	if x != nil {
+		return x.Generate
+	}
+	return nil
+}
+
+// PeriodGenerator builds count periods of period_minutes from first_start
+// ("HH:MM"), separated by break_minutes; long_breaks overrides the break
+// after a period, e.g. {3: 60} for lunch after period 3.
+type PeriodGenerator struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
-	DayOfWeek     int32                  `protobuf:"varint,2,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
-	StartPeriod   int32                  `protobuf:"varint,3,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
-	EndPeriod     int32                  `protobuf:"varint,4,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+	FirstStart    string                 `protobuf:"bytes,1,opt,name=first_start,json=firstStart,proto3" json:"first_start,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	PeriodMinutes int32                  `protobuf:"varint,3,opt,name=period_minutes,json=periodMinutes,proto3" json:"period_minutes,omitempty"`
+	BreakMinutes  int32                  `protobuf:"varint,4,opt,name=break_minutes,json=breakMinutes,proto3" json:"break_minutes,omitempty"`
+	LongBreaks    map[int32]int32        `protobuf:"bytes,5,rep,name=long_breaks,json=longBreaks,proto3" json:"long_breaks,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTimeSlotRequest) Reset() {
-	*x = CreateTimeSlotRequest{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[14]
+func (x *PeriodGenerator) Reset() {
+	*x = PeriodGenerator{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTimeSlotRequest) String() string {
+func (x *PeriodGenerator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTimeSlotRequest) ProtoMessage() {}
+func (*PeriodGenerator) ProtoMessage() {}
 
-func (x *CreateTimeSlotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[14]
+func (x *PeriodGenerator) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,61 +1732,68 @@ func (x *CreateTimeSlotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTimeSlotRequest.ProtoReflect.Descriptor instead.
-func (*CreateTimeSlotRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use PeriodGenerator.ProtoReflect.Descriptor instead.
+func (*PeriodGenerator) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CreateTimeSlotRequest) GetSemesterId() string {
+func (x *PeriodGenerator) GetFirstStart() string {
 	if x != nil {
-		return x.SemesterId
+		return x.FirstStart
 	}
 	return ""
 }
 
-func (x *CreateTimeSlotRequest) GetDayOfWeek() int32 {
+func (x *PeriodGenerator) GetCount() int32 {
 	if x != nil {
-		return x.DayOfWeek
+		return x.Count
 	}
 	return 0
 }
 
-func (x *CreateTimeSlotRequest) GetStartPeriod() int32 {
+func (x *PeriodGenerator) GetPeriodMinutes() int32 {
 	if x != nil {
-		return x.StartPeriod
+		return x.PeriodMinutes
 	}
 	return 0
 }
 
-func (x *CreateTimeSlotRequest) GetEndPeriod() int32 {
+func (x *PeriodGenerator) GetBreakMinutes() int32 {
 	if x != nil {
-		return x.EndPeriod
+		return x.BreakMinutes
 	}
 	return 0
 }
 
-type CreateTimeSlotResponse struct {
+func (x *PeriodGenerator) GetLongBreaks() map[int32]int32 {
+	if x != nil {
+		return x.LongBreaks
+	}
+	return nil
+}
+
+type SetPeriodDefinitionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TimeSlot      *TimeSlot              `protobuf:"bytes,1,opt,name=time_slot,json=timeSlot,proto3" json:"time_slot,omitempty"`
+	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTimeSlotResponse) Reset() {
-	*x = CreateTimeSlotResponse{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[15]
+func (x *SetPeriodDefinitionsResponse) Reset() {
+	*x = SetPeriodDefinitionsResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTimeSlotResponse) String() string {
+func (x *SetPeriodDefinitionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTimeSlotResponse) ProtoMessage() {}
+func (*SetPeriodDefinitionsResponse) ProtoMessage() {}
 
-func (x *CreateTimeSlotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[15]
+func (x *SetPeriodDefinitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,40 +1804,42 @@ func (x *CreateTimeSlotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTimeSlotResponse.ProtoReflect.Descriptor instead.
-func (*CreateTimeSlotResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SetPeriodDefinitionsResponse.ProtoReflect.Descriptor instead.
+func (*SetPeriodDefinitionsResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CreateTimeSlotResponse) GetTimeSlot() *TimeSlot {
+func (x *SetPeriodDefinitionsResponse) GetSemester() *Semester {
 	if x != nil {
-		return x.TimeSlot
+		return x.Semester
 	}
 	return nil
 }
 
-type DeleteTimeSlotRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type SetHolidaysRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	// Replaces the whole calendar; holidays must lie within the semester.
+	Holidays      []*Holiday `protobuf:"bytes,2,rep,name=holidays,proto3" json:"holidays,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTimeSlotRequest) Reset() {
-	*x = DeleteTimeSlotRequest{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[16]
+func (x *SetHolidaysRequest) Reset() {
+	*x = SetHolidaysRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTimeSlotRequest) String() string {
+func (x *SetHolidaysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTimeSlotRequest) ProtoMessage() {}
+func (*SetHolidaysRequest) ProtoMessage() {}
 
-func (x *DeleteTimeSlotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[16]
+func (x *SetHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,39 +1850,47 @@ func (x *DeleteTimeSlotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTimeSlotRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTimeSlotRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SetHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*SetHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeleteTimeSlotRequest) GetId() string {
+func (x *SetHolidaysRequest) GetSemesterId() string {
 	if x != nil {
-		return x.Id
+		return x.SemesterId
 	}
 	return ""
 }
 
-type DeleteTimeSlotResponse struct {
+func (x *SetHolidaysRequest) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type SetHolidaysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTimeSlotResponse) Reset() {
-	*x = DeleteTimeSlotResponse{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[17]
+func (x *SetHolidaysResponse) Reset() {
+	*x = SetHolidaysResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTimeSlotResponse) String() string {
+func (x *SetHolidaysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTimeSlotResponse) ProtoMessage() {}
+func (*SetHolidaysResponse) ProtoMessage() {}
 
-func (x *DeleteTimeSlotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[17]
+func (x *SetHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,34 +1901,44 @@ func (x *DeleteTimeSlotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTimeSlotResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTimeSlotResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SetHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*SetHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{33}
 }
 
-type ApplyTimeSlotPresetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
-	Preset        string                 `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"` // "standard" | "mwf" | "tuth"
+func (x *SetHolidaysResponse) GetSemester() *Semester {
+	if x != nil {
+		return x.Semester
+	}
+	return nil
+}
+
+type SetWeekPatternRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	SubjectId  string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// weekly, odd_weeks, even_weeks, first_half or second_half. Weeks count
+	// from 1 with the week of the start date; the halves split them evenly.
+	Pattern       string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyTimeSlotPresetRequest) Reset() {
-	*x = ApplyTimeSlotPresetRequest{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[18]
+func (x *SetWeekPatternRequest) Reset() {
+	*x = SetWeekPatternRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyTimeSlotPresetRequest) String() string {
+func (x *SetWeekPatternRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyTimeSlotPresetRequest) ProtoMessage() {}
+func (*SetWeekPatternRequest) ProtoMessage() {}
 
-func (x *ApplyTimeSlotPresetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[18]
+func (x *SetWeekPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,47 +1949,54 @@ func (x *ApplyTimeSlotPresetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyTimeSlotPresetRequest.ProtoReflect.Descriptor instead.
-func (*ApplyTimeSlotPresetRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetWeekPatternRequest.ProtoReflect.Descriptor instead.
+func (*SetWeekPatternRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ApplyTimeSlotPresetRequest) GetSemesterId() string {
+func (x *SetWeekPatternRequest) GetSemesterId() string {
 	if x != nil {
 		return x.SemesterId
 	}
 	return ""
 }
 
-func (x *ApplyTimeSlotPresetRequest) GetPreset() string {
+func (x *SetWeekPatternRequest) GetSubjectId() string {
 	if x != nil {
-		return x.Preset
+		return x.SubjectId
 	}
 	return ""
 }
 
-type ApplyTimeSlotPresetResponse struct {
+func (x *SetWeekPatternRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type SetWeekPatternResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TimeSlots     []*TimeSlot            `protobuf:"bytes,1,rep,name=time_slots,json=timeSlots,proto3" json:"time_slots,omitempty"`
+	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyTimeSlotPresetResponse) Reset() {
-	*x = ApplyTimeSlotPresetResponse{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[19]
+func (x *SetWeekPatternResponse) Reset() {
+	*x = SetWeekPatternResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyTimeSlotPresetResponse) String() string {
+func (x *SetWeekPatternResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyTimeSlotPresetResponse) ProtoMessage() {}
+func (*SetWeekPatternResponse) ProtoMessage() {}
 
-func (x *ApplyTimeSlotPresetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[19]
+func (x *SetWeekPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,41 +2007,43 @@ func (x *ApplyTimeSlotPresetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyTimeSlotPresetResponse.ProtoReflect.Descriptor instead.
-func (*ApplyTimeSlotPresetResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SetWeekPatternResponse.ProtoReflect.Descriptor instead.
+func (*SetWeekPatternResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ApplyTimeSlotPresetResponse) GetTimeSlots() []*TimeSlot {
+func (x *SetWeekPatternResponse) GetSemester() *Semester {
 	if x != nil {
-		return x.TimeSlots
+		return x.Semester
 	}
 	return nil
 }
 
-type SetSemesterRoomsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
-	RoomIds       []string               `protobuf:"bytes,2,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+type SetSubjectSectionsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	SubjectId  string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// Replaces the subject's sections; a single plain section clears them.
+	Sections      []*CourseSection `protobuf:"bytes,3,rep,name=sections,proto3" json:"sections,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetSemesterRoomsRequest) Reset() {
-	*x = SetSemesterRoomsRequest{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[20]
+func (x *SetSubjectSectionsRequest) Reset() {
+	*x = SetSubjectSectionsRequest{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSemesterRoomsRequest) String() string {
+func (x *SetSubjectSectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSemesterRoomsRequest) ProtoMessage() {}
+func (*SetSubjectSectionsRequest) ProtoMessage() {}
 
-func (x *SetSemesterRoomsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[20]
+func (x *SetSubjectSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,47 +2054,54 @@ func (x *SetSemesterRoomsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSemesterRoomsRequest.ProtoReflect.Descriptor instead.
-func (*SetSemesterRoomsRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetSubjectSectionsRequest.ProtoReflect.Descriptor instead.
+func (*SetSubjectSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SetSemesterRoomsRequest) GetSemesterId() string {
+func (x *SetSubjectSectionsRequest) GetSemesterId() string {
 	if x != nil {
 		return x.SemesterId
 	}
 	return ""
 }
 
-func (x *SetSemesterRoomsRequest) GetRoomIds() []string {
+func (x *SetSubjectSectionsRequest) GetSubjectId() string {
 	if x != nil {
-		return x.RoomIds
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *SetSubjectSectionsRequest) GetSections() []*CourseSection {
+	if x != nil {
+		return x.Sections
 	}
 	return nil
 }
 
-type SetSemesterRoomsResponse struct {
+type SetSubjectSectionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomIds       []string               `protobuf:"bytes,1,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetSemesterRoomsResponse) Reset() {
-	*x = SetSemesterRoomsResponse{}
-	mi := &file_timetable_v1_semester_proto_msgTypes[21]
+func (x *SetSubjectSectionsResponse) Reset() {
+	*x = SetSubjectSectionsResponse{}
+	mi := &file_timetable_v1_semester_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSemesterRoomsResponse) String() string {
+func (x *SetSubjectSectionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSemesterRoomsResponse) ProtoMessage() {}
+func (*SetSubjectSectionsResponse) ProtoMessage() {}
 
-func (x *SetSemesterRoomsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_semester_proto_msgTypes[21]
+func (x *SetSubjectSectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_semester_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,14 +2112,14 @@ func (x *SetSemesterRoomsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSemesterRoomsResponse.ProtoReflect.Descriptor instead.
-func (*SetSemesterRoomsResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SetSubjectSectionsResponse.ProtoReflect.Descriptor instead.
+func (*SetSubjectSectionsResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_semester_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SetSemesterRoomsResponse) GetRoomIds() []string {
+func (x *SetSubjectSectionsResponse) GetSemester() *Semester {
 	if x != nil {
-		return x.RoomIds
+		return x.Semester
 	}
 	return nil
 }
@@ -1179,7 +2128,7 @@ var File_timetable_v1_semester_proto protoreflect.FileDescriptor
 
 const file_timetable_v1_semester_proto_rawDesc = "" +
 	"\n" +
-	"\x1btimetable/v1/semester.proto\x12\ftimetable.v1\x1a\x14core/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x02\n" +
+	"\x1btimetable/v1/semester.proto\x12\ftimetable.v1\x1a\x14core/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb9\x06\n" +
 	"\bSemester\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -1191,7 +2140,45 @@ const file_timetable_v1_semester_proto_rawDesc = "" +
 	"\x13offered_subject_ids\x18\a \x03(\tR\x11offeredSubjectIds\x129\n" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x19\n" +
-	"\broom_ids\x18\t \x03(\tR\aroomIds\"\xc5\x01\n" +
+	"\broom_ids\x18\t \x03(\tR\aroomIds\x12N\n" +
+	"\x10soft_constraints\x18\n" +
+	" \x03(\v2#.timetable.v1.SoftConstraintSettingR\x0fsoftConstraints\x128\n" +
+	"\aperiods\x18\v \x03(\v2\x1e.timetable.v1.PeriodDefinitionR\aperiods\x121\n" +
+	"\bholidays\x18\f \x03(\v2\x15.timetable.v1.HolidayR\bholidays\x12M\n" +
+	"\rweek_patterns\x18\r \x03(\v2(.timetable.v1.Semester.WeekPatternsEntryR\fweekPatterns\x12@\n" +
+	"\bsections\x18\x0e \x03(\v2$.timetable.v1.Semester.SectionsEntryR\bsections\x1a?\n" +
+	"\x11WeekPatternsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aZ\n" +
+	"\rSectionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.timetable.v1.SubjectSectionsR\x05value:\x028\x01\"\x88\x01\n" +
+	"\rCourseSection\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12#\n" +
+	"\rstudent_group\x18\x03 \x01(\tR\fstudentGroup\x12\x1e\n" +
+	"\n" +
+	"assistants\x18\x04 \x01(\x05R\n" +
+	"assistants\"J\n" +
+	"\x0fSubjectSections\x127\n" +
+	"\bsections\x18\x01 \x03(\v2\x1b.timetable.v1.CourseSectionR\bsections\"w\n" +
+	"\x10PeriodDefinition\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\x05R\x06period\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12#\n" +
+	"\rbreak_minutes\x18\x04 \x01(\x05R\fbreakMinutes\"\x8f\x01\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\xc7\x01\n" +
+	"\x15SoftConstraintSetting\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\x12G\n" +
+	"\x06params\x18\x03 \x03(\v2/.timetable.v1.SoftConstraintSetting.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xc5\x01\n" +
 	"\x15CreateSemesterRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x12\n" +
@@ -1266,7 +2253,53 @@ const file_timetable_v1_semester_proto_rawDesc = "" +
 	"semesterId\x12\x19\n" +
 	"\broom_ids\x18\x02 \x03(\tR\aroomIds\"5\n" +
 	"\x18SetSemesterRoomsResponse\x12\x19\n" +
-	"\broom_ids\x18\x01 \x03(\tR\aroomIds2\xd4\a\n" +
+	"\broom_ids\x18\x01 \x03(\tR\aroomIds\"\x8c\x01\n" +
+	"\x19SetSoftConstraintsRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\x12N\n" +
+	"\x10soft_constraints\x18\x02 \x03(\v2#.timetable.v1.SoftConstraintSettingR\x0fsoftConstraints\"P\n" +
+	"\x1aSetSoftConstraintsResponse\x122\n" +
+	"\bsemester\x18\x01 \x01(\v2\x16.timetable.v1.SemesterR\bsemester\"\xb3\x01\n" +
+	"\x1bSetPeriodDefinitionsRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\x128\n" +
+	"\aperiods\x18\x02 \x03(\v2\x1e.timetable.v1.PeriodDefinitionR\aperiods\x129\n" +
+	"\bgenerate\x18\x03 \x01(\v2\x1d.timetable.v1.PeriodGeneratorR\bgenerate\"\xa3\x02\n" +
+	"\x0fPeriodGenerator\x12\x1f\n" +
+	"\vfirst_start\x18\x01 \x01(\tR\n" +
+	"firstStart\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12%\n" +
+	"\x0eperiod_minutes\x18\x03 \x01(\x05R\rperiodMinutes\x12#\n" +
+	"\rbreak_minutes\x18\x04 \x01(\x05R\fbreakMinutes\x12N\n" +
+	"\vlong_breaks\x18\x05 \x03(\v2-.timetable.v1.PeriodGenerator.LongBreaksEntryR\n" +
+	"longBreaks\x1a=\n" +
+	"\x0fLongBreaksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"R\n" +
+	"\x1cSetPeriodDefinitionsResponse\x122\n" +
+	"\bsemester\x18\x01 \x01(\v2\x16.timetable.v1.SemesterR\bsemester\"h\n" +
+	"\x12SetHolidaysRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\x121\n" +
+	"\bholidays\x18\x02 \x03(\v2\x15.timetable.v1.HolidayR\bholidays\"I\n" +
+	"\x13SetHolidaysResponse\x122\n" +
+	"\bsemester\x18\x01 \x01(\v2\x16.timetable.v1.SemesterR\bsemester\"q\n" +
+	"\x15SetWeekPatternRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tR\tsubjectId\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\"L\n" +
+	"\x16SetWeekPatternResponse\x122\n" +
+	"\bsemester\x18\x01 \x01(\v2\x16.timetable.v1.SemesterR\bsemester\"\x94\x01\n" +
+	"\x19SetSubjectSectionsRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tR\tsubjectId\x127\n" +
+	"\bsections\x18\x03 \x03(\v2\x1b.timetable.v1.CourseSectionR\bsections\"P\n" +
+	"\x1aSetSubjectSectionsResponse\x122\n" +
+	"\bsemester\x18\x01 \x01(\v2\x16.timetable.v1.SemesterR\bsemester2\xc6\v\n" +
 	"\x0fSemesterService\x12[\n" +
 	"\x0eCreateSemester\x12#.timetable.v1.CreateSemesterRequest\x1a$.timetable.v1.CreateSemesterResponse\x12R\n" +
 	"\vGetSemester\x12 .timetable.v1.GetSemesterRequest\x1a!.timetable.v1.GetSemesterResponse\x12X\n" +
@@ -1277,7 +2310,12 @@ const file_timetable_v1_semester_proto_rawDesc = "" +
 	"\x0eCreateTimeSlot\x12#.timetable.v1.CreateTimeSlotRequest\x1a$.timetable.v1.CreateTimeSlotResponse\x12[\n" +
 	"\x0eDeleteTimeSlot\x12#.timetable.v1.DeleteTimeSlotRequest\x1a$.timetable.v1.DeleteTimeSlotResponse\x12j\n" +
 	"\x13ApplyTimeSlotPreset\x12(.timetable.v1.ApplyTimeSlotPresetRequest\x1a).timetable.v1.ApplyTimeSlotPresetResponse\x12a\n" +
-	"\x10SetSemesterRooms\x12%.timetable.v1.SetSemesterRoomsRequest\x1a&.timetable.v1.SetSemesterRoomsResponseBBZ@github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1;timetablev1b\x06proto3"
+	"\x10SetSemesterRooms\x12%.timetable.v1.SetSemesterRoomsRequest\x1a&.timetable.v1.SetSemesterRoomsResponse\x12g\n" +
+	"\x12SetSoftConstraints\x12'.timetable.v1.SetSoftConstraintsRequest\x1a(.timetable.v1.SetSoftConstraintsResponse\x12m\n" +
+	"\x14SetPeriodDefinitions\x12).timetable.v1.SetPeriodDefinitionsRequest\x1a*.timetable.v1.SetPeriodDefinitionsResponse\x12R\n" +
+	"\vSetHolidays\x12 .timetable.v1.SetHolidaysRequest\x1a!.timetable.v1.SetHolidaysResponse\x12[\n" +
+	"\x0eSetWeekPattern\x12#.timetable.v1.SetWeekPatternRequest\x1a$.timetable.v1.SetWeekPatternResponse\x12g\n" +
+	"\x12SetSubjectSections\x12'.timetable.v1.SetSubjectSectionsRequest\x1a(.timetable.v1.SetSubjectSectionsResponseBBZ@github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1;timetablev1b\x06proto3"
 
 var (
 	file_timetable_v1_semester_proto_rawDescOnce sync.Once
@@ -1291,75 +2329,126 @@ func file_timetable_v1_semester_proto_rawDescGZIP() []byte {
 	return file_timetable_v1_semester_proto_rawDescData
 }
 
-var file_timetable_v1_semester_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_timetable_v1_semester_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_timetable_v1_semester_proto_goTypes = []any{
 	(*Semester)(nil),                     // 0: timetable.v1.Semester
-	(*CreateSemesterRequest)(nil),        // 1: timetable.v1.CreateSemesterRequest
-	(*CreateSemesterResponse)(nil),       // 2: timetable.v1.CreateSemesterResponse
-	(*GetSemesterRequest)(nil),           // 3: timetable.v1.GetSemesterRequest
-	(*GetSemesterResponse)(nil),          // 4: timetable.v1.GetSemesterResponse
-	(*ListSemestersRequest)(nil),         // 5: timetable.v1.ListSemestersRequest
-	(*ListSemestersResponse)(nil),        // 6: timetable.v1.ListSemestersResponse
-	(*AddOfferedSubjectRequest)(nil),     // 7: timetable.v1.AddOfferedSubjectRequest
-	(*AddOfferedSubjectResponse)(nil),    // 8: timetable.v1.AddOfferedSubjectResponse
-	(*RemoveOfferedSubjectRequest)(nil),  // 9: timetable.v1.RemoveOfferedSubjectRequest
-	(*RemoveOfferedSubjectResponse)(nil), // 10: timetable.v1.RemoveOfferedSubjectResponse
-	(*TimeSlot)(nil),                     // 11: timetable.v1.TimeSlot
-	(*ListTimeSlotsRequest)(nil),         // 12: timetable.v1.ListTimeSlotsRequest
-	(*ListTimeSlotsResponse)(nil),        // 13: timetable.v1.ListTimeSlotsResponse
-	(*CreateTimeSlotRequest)(nil),        // 14: timetable.v1.CreateTimeSlotRequest
-	(*CreateTimeSlotResponse)(nil),       // 15: timetable.v1.CreateTimeSlotResponse
-	(*DeleteTimeSlotRequest)(nil),        // 16: timetable.v1.DeleteTimeSlotRequest
-	(*DeleteTimeSlotResponse)(nil),       // 17: timetable.v1.DeleteTimeSlotResponse
-	(*ApplyTimeSlotPresetRequest)(nil),   // 18: timetable.v1.ApplyTimeSlotPresetRequest
-	(*ApplyTimeSlotPresetResponse)(nil),  // 19: timetable.v1.ApplyTimeSlotPresetResponse
-	(*SetSemesterRoomsRequest)(nil),      // 20: timetable.v1.SetSemesterRoomsRequest
-	(*SetSemesterRoomsResponse)(nil),     // 21: timetable.v1.SetSemesterRoomsResponse
-	(*timestamppb.Timestamp)(nil),        // 22: google.protobuf.Timestamp
-	(*v1.PaginationRequest)(nil),         // 23: core.v1.PaginationRequest
-	(*v1.PaginationResponse)(nil),        // 24: core.v1.PaginationResponse
+	(*CourseSection)(nil),                // 1: timetable.v1.CourseSection
+	(*SubjectSections)(nil),              // 2: timetable.v1.SubjectSections
+	(*PeriodDefinition)(nil),             // 3: timetable.v1.PeriodDefinition
+	(*Holiday)(nil),                      // 4: timetable.v1.Holiday
+	(*SoftConstraintSetting)(nil),        // 5: timetable.v1.SoftConstraintSetting
+	(*CreateSemesterRequest)(nil),        // 6: timetable.v1.CreateSemesterRequest
+	(*CreateSemesterResponse)(nil),       // 7: timetable.v1.CreateSemesterResponse
+	(*GetSemesterRequest)(nil),           // 8: timetable.v1.GetSemesterRequest
+	(*GetSemesterResponse)(nil),          // 9: timetable.v1.GetSemesterResponse
+	(*ListSemestersRequest)(nil),         // 10: timetable.v1.ListSemestersRequest
+	(*ListSemestersResponse)(nil),        // 11: timetable.v1.ListSemestersResponse
+	(*AddOfferedSubjectRequest)(nil),     // 12: timetable.v1.AddOfferedSubjectRequest
+	(*AddOfferedSubjectResponse)(nil),    // 13: timetable.v1.AddOfferedSubjectResponse
+	(*RemoveOfferedSubjectRequest)(nil),  // 14: timetable.v1.RemoveOfferedSubjectRequest
+	(*RemoveOfferedSubjectResponse)(nil), // 15: timetable.v1.RemoveOfferedSubjectResponse
+	(*TimeSlot)(nil),                     // 16: timetable.v1.TimeSlot
+	(*ListTimeSlotsRequest)(nil),         // 17: timetable.v1.ListTimeSlotsRequest
+	(*ListTimeSlotsResponse)(nil),        // 18: timetable.v1.ListTimeSlotsResponse
+	(*CreateTimeSlotRequest)(nil),        // 19: timetable.v1.CreateTimeSlotRequest
+	(*CreateTimeSlotResponse)(nil),       // 20: timetable.v1.CreateTimeSlotResponse
+	(*DeleteTimeSlotRequest)(nil),        // 21: timetable.v1.DeleteTimeSlotRequest
+	(*DeleteTimeSlotResponse)(nil),       // 22: timetable.v1.DeleteTimeSlotResponse
+	(*ApplyTimeSlotPresetRequest)(nil),   // 23: timetable.v1.ApplyTimeSlotPresetRequest
+	(*ApplyTimeSlotPresetResponse)(nil),  // 24: timetable.v1.ApplyTimeSlotPresetResponse
+	(*SetSemesterRoomsRequest)(nil),      // 25: timetable.v1.SetSemesterRoomsRequest
+	(*SetSemesterRoomsResponse)(nil),     // 26: timetable.v1.SetSemesterRoomsResponse
+	(*SetSoftConstraintsRequest)(nil),    // 27: timetable.v1.SetSoftConstraintsRequest
+	(*SetSoftConstraintsResponse)(nil),   // 28: timetable.v1.SetSoftConstraintsResponse
+	(*SetPeriodDefinitionsRequest)(nil),  // 29: timetable.v1.SetPeriodDefinitionsRequest
+	(*PeriodGenerator)(nil),              // 30: timetable.v1.PeriodGenerator
+	(*SetPeriodDefinitionsResponse)(nil), // 31: timetable.v1.SetPeriodDefinitionsResponse
+	(*SetHolidaysRequest)(nil),           // 32: timetable.v1.SetHolidaysRequest
+	(*SetHolidaysResponse)(nil),          // 33: timetable.v1.SetHolidaysResponse
+	(*SetWeekPatternRequest)(nil),        // 34: timetable.v1.SetWeekPatternRequest
+	(*SetWeekPatternResponse)(nil),       // 35: timetable.v1.SetWeekPatternResponse
+	(*SetSubjectSectionsRequest)(nil),    // 36: timetable.v1.SetSubjectSectionsRequest
+	(*SetSubjectSectionsResponse)(nil),   // 37: timetable.v1.SetSubjectSectionsResponse
+	nil,                                  // 38: timetable.v1.Semester.WeekPatternsEntry
+	nil,                                  // 39: timetable.v1.Semester.SectionsEntry
+	nil,                                  // 40: timetable.v1.SoftConstraintSetting.ParamsEntry
+	nil,                                  // 41: timetable.v1.PeriodGenerator.LongBreaksEntry
+	(*timestamppb.Timestamp)(nil),        // 42: google.protobuf.Timestamp
+	(*v1.PaginationRequest)(nil),         // 43: core.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),        // 44: core.v1.PaginationResponse
 }
 var file_timetable_v1_semester_proto_depIdxs = []int32{
-	22, // 0: timetable.v1.Semester.start_date:type_name -> google.protobuf.Timestamp
-	22, // 1: timetable.v1.Semester.end_date:type_name -> google.protobuf.Timestamp
-	22, // 2: timetable.v1.Semester.created_at:type_name -> google.protobuf.Timestamp
-	22, // 3: timetable.v1.CreateSemesterRequest.start_date:type_name -> google.protobuf.Timestamp
-	22, // 4: timetable.v1.CreateSemesterRequest.end_date:type_name -> google.protobuf.Timestamp
-	0,  // 5: timetable.v1.CreateSemesterResponse.semester:type_name -> timetable.v1.Semester
-	0,  // 6: timetable.v1.GetSemesterResponse.semester:type_name -> timetable.v1.Semester
-	23, // 7: timetable.v1.ListSemestersRequest.pagination:type_name -> core.v1.PaginationRequest
-	0,  // 8: timetable.v1.ListSemestersResponse.semesters:type_name -> timetable.v1.Semester
-	24, // 9: timetable.v1.ListSemestersResponse.pagination:type_name -> core.v1.PaginationResponse
-	0,  // 10: timetable.v1.AddOfferedSubjectResponse.semester:type_name -> timetable.v1.Semester
-	0,  // 11: timetable.v1.RemoveOfferedSubjectResponse.semester:type_name -> timetable.v1.Semester
-	11, // 12: timetable.v1.ListTimeSlotsResponse.time_slots:type_name -> timetable.v1.TimeSlot
-	11, // 13: timetable.v1.CreateTimeSlotResponse.time_slot:type_name -> timetable.v1.TimeSlot
-	11, // 14: timetable.v1.ApplyTimeSlotPresetResponse.time_slots:type_name -> timetable.v1.TimeSlot
-	1,  // 15: timetable.v1.SemesterService.CreateSemester:input_type -> timetable.v1.CreateSemesterRequest
-	3,  // 16: timetable.v1.SemesterService.GetSemester:input_type -> timetable.v1.GetSemesterRequest
-	5,  // 17: timetable.v1.SemesterService.ListSemesters:input_type -> timetable.v1.ListSemestersRequest
-	7,  // 18: timetable.v1.SemesterService.AddOfferedSubject:input_type -> timetable.v1.AddOfferedSubjectRequest
-	9,  // 19: timetable.v1.SemesterService.RemoveOfferedSubject:input_type -> timetable.v1.RemoveOfferedSubjectRequest
-	12, // 20: timetable.v1.SemesterService.ListTimeSlots:input_type -> timetable.v1.ListTimeSlotsRequest
-	14, // 21: timetable.v1.SemesterService.CreateTimeSlot:input_type -> timetable.v1.CreateTimeSlotRequest
-	16, // 22: timetable.v1.SemesterService.DeleteTimeSlot:input_type -> timetable.v1.DeleteTimeSlotRequest
-	18, // 23: timetable.v1.SemesterService.ApplyTimeSlotPreset:input_type -> timetable.v1.ApplyTimeSlotPresetRequest
-	20, // 24: timetable.v1.SemesterService.SetSemesterRooms:input_type -> timetable.v1.SetSemesterRoomsRequest
-	2,  // 25: timetable.v1.SemesterService.CreateSemester:output_type -> timetable.v1.CreateSemesterResponse
-	4,  // 26: timetable.v1.SemesterService.GetSemester:output_type -> timetable.v1.GetSemesterResponse
-	6,  // 27: timetable.v1.SemesterService.ListSemesters:output_type -> timetable.v1.ListSemestersResponse
-	8,  // 28: timetable.v1.SemesterService.AddOfferedSubject:output_type -> timetable.v1.AddOfferedSubjectResponse
-	10, // 29: timetable.v1.SemesterService.RemoveOfferedSubject:output_type -> timetable.v1.RemoveOfferedSubjectResponse
-	13, // 30: timetable.v1.SemesterService.ListTimeSlots:output_type -> timetable.v1.ListTimeSlotsResponse
-	15, // 31: timetable.v1.SemesterService.CreateTimeSlot:output_type -> timetable.v1.CreateTimeSlotResponse
-	17, // 32: timetable.v1.SemesterService.DeleteTimeSlot:output_type -> timetable.v1.DeleteTimeSlotResponse
-	19, // 33: timetable.v1.SemesterService.ApplyTimeSlotPreset:output_type -> timetable.v1.ApplyTimeSlotPresetResponse
-	21, // 34: timetable.v1.SemesterService.SetSemesterRooms:output_type -> timetable.v1.SetSemesterRoomsResponse
-	25, // [25:35] is the sub-list for method output_type
-	15, // [15:25] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	42, // 0: timetable.v1.Semester.start_date:type_name -> google.protobuf.Timestamp
+	42, // 1: timetable.v1.Semester.end_date:type_name -> google.protobuf.Timestamp
+	42, // 2: timetable.v1.Semester.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 3: timetable.v1.Semester.soft_constraints:type_name -> timetable.v1.SoftConstraintSetting
+	3,  // 4: timetable.v1.Semester.periods:type_name -> timetable.v1.PeriodDefinition
+	4,  // 5: timetable.v1.Semester.holidays:type_name -> timetable.v1.Holiday
+	38, // 6: timetable.v1.Semester.week_patterns:type_name -> timetable.v1.Semester.WeekPatternsEntry
+	39, // 7: timetable.v1.Semester.sections:type_name -> timetable.v1.Semester.SectionsEntry
+	1,  // 8: timetable.v1.SubjectSections.sections:type_name -> timetable.v1.CourseSection
+	42, // 9: timetable.v1.Holiday.start_date:type_name -> google.protobuf.Timestamp
+	42, // 10: timetable.v1.Holiday.end_date:type_name -> google.protobuf.Timestamp
+	40, // 11: timetable.v1.SoftConstraintSetting.params:type_name -> timetable.v1.SoftConstraintSetting.ParamsEntry
+	42, // 12: timetable.v1.CreateSemesterRequest.start_date:type_name -> google.protobuf.Timestamp
+	42, // 13: timetable.v1.CreateSemesterRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 14: timetable.v1.CreateSemesterResponse.semester:type_name -> timetable.v1.Semester
+	0,  // 15: timetable.v1.GetSemesterResponse.semester:type_name -> timetable.v1.Semester
+	43, // 16: timetable.v1.ListSemestersRequest.pagination:type_name -> core.v1.PaginationRequest
+	0,  // 17: timetable.v1.ListSemestersResponse.semesters:type_name -> timetable.v1.Semester
+	44, // 18: timetable.v1.ListSemestersResponse.pagination:type_name -> core.v1.PaginationResponse
+	0,  // 19: timetable.v1.AddOfferedSubjectResponse.semester:type_name -> timetable.v1.Semester
+	0,  // 20: timetable.v1.RemoveOfferedSubjectResponse.semester:type_name -> timetable.v1.Semester
+	16, // 21: timetable.v1.ListTimeSlotsResponse.time_slots:type_name -> timetable.v1.TimeSlot
+	16, // 22: timetable.v1.CreateTimeSlotResponse.time_slot:type_name -> timetable.v1.TimeSlot
+	16, // 23: timetable.v1.ApplyTimeSlotPresetResponse.time_slots:type_name -> timetable.v1.TimeSlot
+	5,  // 24: timetable.v1.SetSoftConstraintsRequest.soft_constraints:type_name -> timetable.v1.SoftConstraintSetting
+	0,  // 25: timetable.v1.SetSoftConstraintsResponse.semester:type_name -> timetable.v1.Semester
+	3,  // 26: timetable.v1.SetPeriodDefinitionsRequest.periods:type_name -> timetable.v1.PeriodDefinition
+	30, // 27: timetable.v1.SetPeriodDefinitionsRequest.generate:type_name -> timetable.v1.PeriodGenerator
+	41, // 28: timetable.v1.PeriodGenerator.long_breaks:type_name -> timetable.v1.PeriodGenerator.LongBreaksEntry
+	0,  // 29: timetable.v1.SetPeriodDefinitionsResponse.semester:type_name -> timetable.v1.Semester
+	4,  // 30: timetable.v1.SetHolidaysRequest.holidays:type_name -> timetable.v1.Holiday
+	0,  // 31: timetable.v1.SetHolidaysResponse.semester:type_name -> timetable.v1.Semester
+	0,  // 32: timetable.v1.SetWeekPatternResponse.semester:type_name -> timetable.v1.Semester
+	1,  // 33: timetable.v1.SetSubjectSectionsRequest.sections:type_name -> timetable.v1.CourseSection
+	0,  // 34: timetable.v1.SetSubjectSectionsResponse.semester:type_name -> timetable.v1.Semester
+	2,  // 35: timetable.v1.Semester.SectionsEntry.value:type_name -> timetable.v1.SubjectSections
+	6,  // 36: timetable.v1.SemesterService.CreateSemester:input_type -> timetable.v1.CreateSemesterRequest
+	8,  // 37: timetable.v1.SemesterService.GetSemester:input_type -> timetable.v1.GetSemesterRequest
+	10, // 38: timetable.v1.SemesterService.ListSemesters:input_type -> timetable.v1.ListSemestersRequest
+	12, // 39: timetable.v1.SemesterService.AddOfferedSubject:input_type -> timetable.v1.AddOfferedSubjectRequest
+	14, // 40: timetable.v1.SemesterService.RemoveOfferedSubject:input_type -> timetable.v1.RemoveOfferedSubjectRequest
+	17, // 41: timetable.v1.SemesterService.ListTimeSlots:input_type -> timetable.v1.ListTimeSlotsRequest
+	19, // 42: timetable.v1.SemesterService.CreateTimeSlot:input_type -> timetable.v1.CreateTimeSlotRequest
+	21, // 43: timetable.v1.SemesterService.DeleteTimeSlot:input_type -> timetable.v1.DeleteTimeSlotRequest
+	23, // 44: timetable.v1.SemesterService.ApplyTimeSlotPreset:input_type -> timetable.v1.ApplyTimeSlotPresetRequest
+	25, // 45: timetable.v1.SemesterService.SetSemesterRooms:input_type -> timetable.v1.SetSemesterRoomsRequest
+	27, // 46: timetable.v1.SemesterService.SetSoftConstraints:input_type -> timetable.v1.SetSoftConstraintsRequest
+	29, // 47: timetable.v1.SemesterService.SetPeriodDefinitions:input_type -> timetable.v1.SetPeriodDefinitionsRequest
+	32, // 48: timetable.v1.SemesterService.SetHolidays:input_type -> timetable.v1.SetHolidaysRequest
+	34, // 49: timetable.v1.SemesterService.SetWeekPattern:input_type -> timetable.v1.SetWeekPatternRequest
+	36, // 50: timetable.v1.SemesterService.SetSubjectSections:input_type -> timetable.v1.SetSubjectSectionsRequest
+	7,  // 51: timetable.v1.SemesterService.CreateSemester:output_type -> timetable.v1.CreateSemesterResponse
+	9,  // 52: timetable.v1.SemesterService.GetSemester:output_type -> timetable.v1.GetSemesterResponse
+	11, // 53: timetable.v1.SemesterService.ListSemesters:output_type -> timetable.v1.ListSemestersResponse
+	13, // 54: timetable.v1.SemesterService.AddOfferedSubject:output_type -> timetable.v1.AddOfferedSubjectResponse
+	15, // 55: timetable.v1.SemesterService.RemoveOfferedSubject:output_type -> timetable.v1.RemoveOfferedSubjectResponse
+	18, // 56: timetable.v1.SemesterService.ListTimeSlots:output_type -> timetable.v1.ListTimeSlotsResponse
+	20, // 57: timetable.v1.SemesterService.CreateTimeSlot:output_type -> timetable.v1.CreateTimeSlotResponse
+	22, // 58: timetable.v1.SemesterService.DeleteTimeSlot:output_type -> timetable.v1.DeleteTimeSlotResponse
+	24, // 59: timetable.v1.SemesterService.ApplyTimeSlotPreset:output_type -> timetable.v1.ApplyTimeSlotPresetResponse
+	26, // 60: timetable.v1.SemesterService.SetSemesterRooms:output_type -> timetable.v1.SetSemesterRoomsResponse
+	28, // 61: timetable.v1.SemesterService.SetSoftConstraints:output_type -> timetable.v1.SetSoftConstraintsResponse
+	31, // 62: timetable.v1.SemesterService.SetPeriodDefinitions:output_type -> timetable.v1.SetPeriodDefinitionsResponse
+	33, // 63: timetable.v1.SemesterService.SetHolidays:output_type -> timetable.v1.SetHolidaysResponse
+	35, // 64: timetable.v1.SemesterService.SetWeekPattern:output_type -> timetable.v1.SetWeekPatternResponse
+	37, // 65: timetable.v1.SemesterService.SetSubjectSections:output_type -> timetable.v1.SetSubjectSectionsResponse
+	51, // [51:66] is the sub-list for method output_type
+	36, // [36:51] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_timetable_v1_semester_proto_init() }
@@ -1373,7 +2462,7 @@ func file_timetable_v1_semester_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_timetable_v1_semester_proto_rawDesc), len(file_timetable_v1_semester_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,11 @@ const (
 	SemesterService_DeleteTimeSlot_FullMethodName       = "/timetable.v1.SemesterService/DeleteTimeSlot"
 	SemesterService_ApplyTimeSlotPreset_FullMethodName  = "/timetable.v1.SemesterService/ApplyTimeSlotPreset"
 	SemesterService_SetSemesterRooms_FullMethodName     = "/timetable.v1.SemesterService/SetSemesterRooms"
+	SemesterService_SetSoftConstraints_FullMethodName   = "/timetable.v1.SemesterService/SetSoftConstraints"
+	SemesterService_SetPeriodDefinitions_FullMethodName = "/timetable.v1.SemesterService/SetPeriodDefinitions"
+	SemesterService_SetHolidays_FullMethodName          = "/timetable.v1.SemesterService/SetHolidays"
+	SemesterService_SetWeekPattern_FullMethodName       = "/timetable.v1.SemesterService/SetWeekPattern"
+	SemesterService_SetSubjectSections_FullMethodName   = "/timetable.v1.SemesterService/SetSubjectSections"
 )
 
 // SemesterServiceClient is the client API for SemesterService service.
@@ -45,6 +50,11 @@ type SemesterServiceClient interface {
 	DeleteTimeSlot(ctx context.Context, in *DeleteTimeSlotRequest, opts ...grpc.CallOption) (*DeleteTimeSlotResponse, error)
 	ApplyTimeSlotPreset(ctx context.Context, in *ApplyTimeSlotPresetRequest, opts ...grpc.CallOption) (*ApplyTimeSlotPresetResponse, error)
 	SetSemesterRooms(ctx context.Context, in *SetSemesterRoomsRequest, opts ...grpc.CallOption) (*SetSemesterRoomsResponse, error)
+	SetSoftConstraints(ctx context.Context, in *SetSoftConstraintsRequest, opts ...grpc.CallOption) (*SetSoftConstraintsResponse, error)
+	SetPeriodDefinitions(ctx context.Context, in *SetPeriodDefinitionsRequest, opts ...grpc.CallOption) (*SetPeriodDefinitionsResponse, error)
+	SetHolidays(ctx context.Context, in *SetHolidaysRequest, opts ...grpc.CallOption) (*SetHolidaysResponse, error)
+	SetWeekPattern(ctx context.Context, in *SetWeekPatternRequest, opts ...grpc.CallOption) (*SetWeekPatternResponse, error)
+	SetSubjectSections(ctx context.Context, in *SetSubjectSectionsRequest, opts ...grpc.CallOption) (*SetSubjectSectionsResponse, error)
 }
 
 type semesterServiceClient struct {
@@ -155,6 +165,56 @@ func (c *semesterServiceClient) SetSemesterRooms(ctx context.Context, in *SetSem
 	return out, nil
 }
 
+func (c *semesterServiceClient) SetSoftConstraints(ctx context.Context, in *SetSoftConstraintsRequest, opts ...grpc.CallOption) (*SetSoftConstraintsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSoftConstraintsResponse)
+	err := c.cc.Invoke(ctx, SemesterService_SetSoftConstraints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *semesterServiceClient) SetPeriodDefinitions(ctx context.Context, in *SetPeriodDefinitionsRequest, opts ...grpc.CallOption) (*SetPeriodDefinitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPeriodDefinitionsResponse)
+	err := c.cc.Invoke(ctx, SemesterService_SetPeriodDefinitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *semesterServiceClient) SetHolidays(ctx context.Context, in *SetHolidaysRequest, opts ...grpc.CallOption) (*SetHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetHolidaysResponse)
+	err := c.cc.Invoke(ctx, SemesterService_SetHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *semesterServiceClient) SetWeekPattern(ctx context.Context, in *SetWeekPatternRequest, opts ...grpc.CallOption) (*SetWeekPatternResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetWeekPatternResponse)
+	err := c.cc.Invoke(ctx, SemesterService_SetWeekPattern_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *semesterServiceClient) SetSubjectSections(ctx context.Context, in *SetSubjectSectionsRequest, opts ...grpc.CallOption) (*SetSubjectSectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSubjectSectionsResponse)
+	err := c.cc.Invoke(ctx, SemesterService_SetSubjectSections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SemesterServiceServer is the server API for SemesterService service.
 // All implementations must embed UnimplementedSemesterServiceServer
 // for forward compatibility.
@@ -169,6 +229,11 @@ type SemesterServiceServer interface {
 	DeleteTimeSlot(context.Context, *DeleteTimeSlotRequest) (*DeleteTimeSlotResponse, error)
 	ApplyTimeSlotPreset(context.Context, *ApplyTimeSlotPresetRequest) (*ApplyTimeSlotPresetResponse, error)
 	SetSemesterRooms(context.Context, *SetSemesterRoomsRequest) (*SetSemesterRoomsResponse, error)
+	SetSoftConstraints(context.Context, *SetSoftConstraintsRequest) (*SetSoftConstraintsResponse, error)
+	SetPeriodDefinitions(context.Context, *SetPeriodDefinitionsRequest) (*SetPeriodDefinitionsResponse, error)
+	SetHolidays(context.Context, *SetHolidaysRequest) (*SetHolidaysResponse, error)
+	SetWeekPattern(context.Context, *SetWeekPatternRequest) (*SetWeekPatternResponse, error)
+	SetSubjectSections(context.Context, *SetSubjectSectionsRequest) (*SetSubjectSectionsResponse, error)
 	mustEmbedUnimplementedSemesterServiceServer()
 }
 
@@ -209,6 +274,21 @@ func (UnimplementedSemesterServiceServer) ApplyTimeSlotPreset(context.Context, *
 func (UnimplementedSemesterServiceServer) SetSemesterRooms(context.Context, *SetSemesterRoomsRequest) (*SetSemesterRoomsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetSemesterRooms not implemented")
 }
+func (UnimplementedSemesterServiceServer) SetSoftConstraints(context.Context, *SetSoftConstraintsRequest) (*SetSoftConstraintsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSoftConstraints not implemented")
+}
+func (UnimplementedSemesterServiceServer) SetPeriodDefinitions(context.Context, *SetPeriodDefinitionsRequest) (*SetPeriodDefinitionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPeriodDefinitions not implemented")
+}
+func (UnimplementedSemesterServiceServer) SetHolidays(context.Context, *SetHolidaysRequest) (*SetHolidaysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHolidays not implemented")
+}
+func (UnimplementedSemesterServiceServer) SetWeekPattern(context.Context, *SetWeekPatternRequest) (*SetWeekPatternResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetWeekPattern not implemented")
+}
+func (UnimplementedSemesterServiceServer) SetSubjectSections(context.Context, *SetSubjectSectionsRequest) (*SetSubjectSectionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSubjectSections not implemented")
+}
 func (UnimplementedSemesterServiceServer) mustEmbedUnimplementedSemesterServiceServer() {}
 func (UnimplementedSemesterServiceServer) testEmbeddedByValue()                         {}
 
@@ -410,6 +490,96 @@ func _SemesterService_SetSemesterRooms_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SemesterService_SetSoftConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSoftConstraintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SemesterServiceServer).SetSoftConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SemesterService_SetSoftConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SemesterServiceServer).SetSoftConstraints(ctx, req.(*SetSoftConstraintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SemesterService_SetPeriodDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeriodDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SemesterServiceServer).SetPeriodDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SemesterService_SetPeriodDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SemesterServiceServer).SetPeriodDefinitions(ctx, req.(*SetPeriodDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SemesterService_SetHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SemesterServiceServer).SetHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SemesterService_SetHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SemesterServiceServer).SetHolidays(ctx, req.(*SetHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SemesterService_SetWeekPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWeekPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SemesterServiceServer).SetWeekPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SemesterService_SetWeekPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SemesterServiceServer).SetWeekPattern(ctx, req.(*SetWeekPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SemesterService_SetSubjectSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubjectSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SemesterServiceServer).SetSubjectSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SemesterService_SetSubjectSections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SemesterServiceServer).SetSubjectSections(ctx, req.(*SetSubjectSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SemesterService_ServiceDesc is the grpc.ServiceDesc for SemesterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,6 +627,26 @@ var SemesterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSemesterRooms",
 			Handler:    _SemesterService_SetSemesterRooms_Handler,
 		},
+		{
+			MethodName: "SetSoftConstraints",
+			Handler:    _SemesterService_SetSoftConstraints_Handler,
+		},
+		{
+			MethodName: "SetPeriodDefinitions",
+			Handler:    _SemesterService_SetPeriodDefinitions_Handler,
+		},
+		{
+			MethodName: "SetHolidays",
+			Handler:    _SemesterService_SetHolidays_Handler,
+		},
+		{
+			MethodName: "SetWeekPattern",
+			Handler:    _SemesterService_SetWeekPattern_Handler,
+		},
+		{
+			MethodName: "SetSubjectSections",
+			Handler:    _SemesterService_SetSubjectSections_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "timetable/v1/semester.proto",
@@ -38,8 +38,11 @@ type ScheduleEntry struct {
 	RoomName         string `protobuf:"bytes,11,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
 	IsManualOverride bool   `protobuf:"varint,12,opt,name=is_manual_override,json=isManualOverride,proto3" json:"is_manual_override,omitempty"`
 	DepartmentId     string `protobuf:"bytes,13,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	Section          int32  `protobuf:"varint,14,opt,name=section,proto3" json:"section,omitempty"` // 1-based section of the subject
+	// Everyone teaching the session, the lead (teacher_id) first.
+	Teachers      []*EntryTeacher `protobuf:"bytes,15,rep,name=teachers,proto3" json:"teachers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScheduleEntry) Reset() {
@@ -163,6 +166,80 @@ func (x *ScheduleEntry) GetDepartmentId() string {
 	return ""
 }
 
+func (x *ScheduleEntry) GetSection() int32 {
+	if x != nil {
+		return x.Section
+	}
+	return 0
+}
+
+func (x *ScheduleEntry) GetTeachers() []*EntryTeacher {
+	if x != nil {
+		return x.Teachers
+	}
+	return nil
+}
+
+type EntryTeacher struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	TeacherName   string                 `protobuf:"bytes,2,opt,name=teacher_name,json=teacherName,proto3" json:"teacher_name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // lead or assistant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntryTeacher) Reset() {
+	*x = EntryTeacher{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntryTeacher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryTeacher) ProtoMessage() {}
+
+func (x *EntryTeacher) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryTeacher.ProtoReflect.Descriptor instead.
+func (*EntryTeacher) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EntryTeacher) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *EntryTeacher) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *EntryTeacher) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 type Schedule struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -173,13 +250,16 @@ type Schedule struct {
 	Score          float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
 	HardViolations int32                  `protobuf:"varint,7,opt,name=hard_violations,json=hardViolations,proto3" json:"hard_violations,omitempty"`
 	SoftViolations float64                `protobuf:"fixed64,8,opt,name=soft_violations,json=softViolations,proto3" json:"soft_violations,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Students booked into two overlapping sessions; non-zero only when the
+	// run relaxed the cohort clash rule to a soft constraint.
+	CohortClashes int32 `protobuf:"varint,9,opt,name=cohort_clashes,json=cohortClashes,proto3" json:"cohort_clashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Schedule) Reset() {
 	*x = Schedule{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[1]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +271,7 @@ func (x *Schedule) String() string {
 func (*Schedule) ProtoMessage() {}
 
 func (x *Schedule) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[1]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +284,7 @@ func (x *Schedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
 func (*Schedule) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{1}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Schedule) GetId() string {
@@ -263,6 +343,13 @@ func (x *Schedule) GetSoftViolations() float64 {
 	return 0
 }
 
+func (x *Schedule) GetCohortClashes() int32 {
+	if x != nil {
+		return x.CohortClashes
+	}
+	return 0
+}
+
 type ListSchedulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"` // optional; empty = list all
@@ -274,7 +361,7 @@ type ListSchedulesRequest struct {
 
 func (x *ListSchedulesRequest) Reset() {
 	*x = ListSchedulesRequest{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[2]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +373,7 @@ func (x *ListSchedulesRequest) String() string {
 func (*ListSchedulesRequest) ProtoMessage() {}
 
 func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[2]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +386,7 @@ func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
 func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{2}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListSchedulesRequest) GetSemesterId() string {
@@ -335,7 +422,7 @@ type ListSchedulesResponse struct {
 
 func (x *ListSchedulesResponse) Reset() {
 	*x = ListSchedulesResponse{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[3]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +434,7 @@ func (x *ListSchedulesResponse) String() string {
 func (*ListSchedulesResponse) ProtoMessage() {}
 
 func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[3]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +447,7 @@ func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
 func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{3}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
@@ -395,13 +482,30 @@ type GenerateScheduleRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	SemesterId     string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
 	TimeoutSeconds int32                  `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// When true, every weekly session of a subject is taught by the same teacher.
+	BindSessionTeacher bool `protobuf:"varint,3,opt,name=bind_session_teacher,json=bindSessionTeacher,proto3" json:"bind_session_teacher,omitempty"`
+	// When true, subjects sharing enrolled students may overlap at a penalty
+	// instead of being forbidden from overlapping.
+	SoftCohortClash bool `protobuf:"varint,4,opt,name=soft_cohort_clash,json=softCohortClash,proto3" json:"soft_cohort_clash,omitempty"`
+	// Sessions pre-placed before search; the solver keeps them where pinned.
+	Pins []*PinnedAssignment `protobuf:"bytes,5,rep,name=pins,proto3" json:"pins,omitempty"`
+	// Carries over the manual-override entries of a previous schedule of the
+	// same semester. Explicit pins win over carried-over locks.
+	LockFromScheduleId string `protobuf:"bytes,6,opt,name=lock_from_schedule_id,json=lockFromScheduleId,proto3" json:"lock_from_schedule_id,omitempty"`
+	// Seeds every random choice of the solver; the same seed on the same data
+	// gives the same schedule unless the timeout cuts the search short.
+	// 0 picks a random seed, returned in the response.
+	Seed uint64 `protobuf:"varint,7,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Number of solver strategies run concurrently, keeping the best result
+	// (0 or 1 = a single run; capped by the server's CPUs).
+	PortfolioSize int32 `protobuf:"varint,8,opt,name=portfolio_size,json=portfolioSize,proto3" json:"portfolio_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GenerateScheduleRequest) Reset() {
 	*x = GenerateScheduleRequest{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[4]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +517,7 @@ func (x *GenerateScheduleRequest) String() string {
 func (*GenerateScheduleRequest) ProtoMessage() {}
 
 func (x *GenerateScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[4]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +530,7 @@ func (x *GenerateScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateScheduleRequest.ProtoReflect.Descriptor instead.
 func (*GenerateScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{4}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GenerateScheduleRequest) GetSemesterId() string {
@@ -443,18 +547,148 @@ func (x *GenerateScheduleRequest) GetTimeoutSeconds() int32 {
 	return 0
 }
 
+func (x *GenerateScheduleRequest) GetBindSessionTeacher() bool {
+	if x != nil {
+		return x.BindSessionTeacher
+	}
+	return false
+}
+
+func (x *GenerateScheduleRequest) GetSoftCohortClash() bool {
+	if x != nil {
+		return x.SoftCohortClash
+	}
+	return false
+}
+
+func (x *GenerateScheduleRequest) GetPins() []*PinnedAssignment {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+func (x *GenerateScheduleRequest) GetLockFromScheduleId() string {
+	if x != nil {
+		return x.LockFromScheduleId
+	}
+	return ""
+}
+
+func (x *GenerateScheduleRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GenerateScheduleRequest) GetPortfolioSize() int32 {
+	if x != nil {
+		return x.PortfolioSize
+	}
+	return 0
+}
+
+// PinnedAssignment pre-places one weekly session of a subject. Empty ids are
+// left to the solver.
+type PinnedAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubjectId     string                 `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Session       int32                  `protobuf:"varint,2,opt,name=session,proto3" json:"session,omitempty"` // 0-based weekly session index
+	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	TimeSlotId    string                 `protobuf:"bytes,5,opt,name=time_slot_id,json=timeSlotId,proto3" json:"time_slot_id,omitempty"`
+	Section       int32                  `protobuf:"varint,6,opt,name=section,proto3" json:"section,omitempty"` // 1-based; 0 means the first section
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinnedAssignment) Reset() {
+	*x = PinnedAssignment{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinnedAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedAssignment) ProtoMessage() {}
+
+func (x *PinnedAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedAssignment.ProtoReflect.Descriptor instead.
+func (*PinnedAssignment) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PinnedAssignment) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *PinnedAssignment) GetSession() int32 {
+	if x != nil {
+		return x.Session
+	}
+	return 0
+}
+
+func (x *PinnedAssignment) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *PinnedAssignment) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *PinnedAssignment) GetTimeSlotId() string {
+	if x != nil {
+		return x.TimeSlotId
+	}
+	return ""
+}
+
+func (x *PinnedAssignment) GetSection() int32 {
+	if x != nil {
+		return x.Section
+	}
+	return 0
+}
+
 type GenerateScheduleResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Schedule        *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	IsPartial       bool                   `protobuf:"varint,2,opt,name=is_partial,json=isPartial,proto3" json:"is_partial,omitempty"`
 	UnassignedCount int32                  `protobuf:"varint,3,opt,name=unassigned_count,json=unassignedCount,proto3" json:"unassigned_count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Seed of the run; pass it back to reproduce the schedule.
+	Seed          uint64 `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GenerateScheduleResponse) Reset() {
 	*x = GenerateScheduleResponse{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[5]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -466,7 +700,7 @@ func (x *GenerateScheduleResponse) String() string {
 func (*GenerateScheduleResponse) ProtoMessage() {}
 
 func (x *GenerateScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[5]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,7 +713,7 @@ func (x *GenerateScheduleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateScheduleResponse.ProtoReflect.Descriptor instead.
 func (*GenerateScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{5}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GenerateScheduleResponse) GetSchedule() *Schedule {
@@ -503,6 +737,13 @@ func (x *GenerateScheduleResponse) GetUnassignedCount() int32 {
 	return 0
 }
 
+func (x *GenerateScheduleResponse) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
 type GetScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -512,7 +753,7 @@ type GetScheduleRequest struct {
 
 func (x *GetScheduleRequest) Reset() {
 	*x = GetScheduleRequest{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[6]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -524,7 +765,7 @@ func (x *GetScheduleRequest) String() string {
 func (*GetScheduleRequest) ProtoMessage() {}
 
 func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[6]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +778,7 @@ func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
 func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{6}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetScheduleRequest) GetId() string {
@@ -556,7 +797,7 @@ type GetScheduleResponse struct {
 
 func (x *GetScheduleResponse) Reset() {
 	*x = GetScheduleResponse{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[7]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +809,7 @@ func (x *GetScheduleResponse) String() string {
 func (*GetScheduleResponse) ProtoMessage() {}
 
 func (x *GetScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[7]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +822,7 @@ func (x *GetScheduleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleResponse.ProtoReflect.Descriptor instead.
 func (*GetScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{7}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetScheduleResponse) GetSchedule() *Schedule {
@@ -592,21 +833,23 @@ func (x *GetScheduleResponse) GetSchedule() *Schedule {
 }
 
 type UpdateScheduleEntryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	EntryId       string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
-	TeacherId     *string                `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3,oneof" json:"teacher_id,omitempty"`
-	DayOfWeek     *int32                 `protobuf:"varint,4,opt,name=day_of_week,json=dayOfWeek,proto3,oneof" json:"day_of_week,omitempty"`
-	StartPeriod   *int32                 `protobuf:"varint,5,opt,name=start_period,json=startPeriod,proto3,oneof" json:"start_period,omitempty"`
-	EndPeriod     *int32                 `protobuf:"varint,6,opt,name=end_period,json=endPeriod,proto3,oneof" json:"end_period,omitempty"`
-	Room          *string                `protobuf:"bytes,7,opt,name=room,proto3,oneof" json:"room,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId  string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	EntryId     string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	TeacherId   *string                `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3,oneof" json:"teacher_id,omitempty"`
+	DayOfWeek   *int32                 `protobuf:"varint,4,opt,name=day_of_week,json=dayOfWeek,proto3,oneof" json:"day_of_week,omitempty"`
+	StartPeriod *int32                 `protobuf:"varint,5,opt,name=start_period,json=startPeriod,proto3,oneof" json:"start_period,omitempty"`
+	EndPeriod   *int32                 `protobuf:"varint,6,opt,name=end_period,json=endPeriod,proto3,oneof" json:"end_period,omitempty"`
+	Room        *string                `protobuf:"bytes,7,opt,name=room,proto3,oneof" json:"room,omitempty"`
+	// Validate and score the edit without saving it.
+	DryRun        bool `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateScheduleEntryRequest) Reset() {
 	*x = UpdateScheduleEntryRequest{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[8]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +861,7 @@ func (x *UpdateScheduleEntryRequest) String() string {
 func (*UpdateScheduleEntryRequest) ProtoMessage() {}
 
 func (x *UpdateScheduleEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[8]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +874,7 @@ func (x *UpdateScheduleEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateScheduleEntryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateScheduleEntryRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{8}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateScheduleEntryRequest) GetScheduleId() string {
@@ -683,16 +926,29 @@ func (x *UpdateScheduleEntryRequest) GetRoom() string {
 	return ""
 }
 
+func (x *UpdateScheduleEntryRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type UpdateScheduleEntryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *ScheduleEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Entry *ScheduleEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Hard constraints the edit breaks that the schedule did not break before.
+	Violations []*ScheduleViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	// Schedule totals after the edit (not saved on a dry run).
+	Score          float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	HardViolations int32   `protobuf:"varint,4,opt,name=hard_violations,json=hardViolations,proto3" json:"hard_violations,omitempty"`
+	SoftPenalty    float64 `protobuf:"fixed64,5,opt,name=soft_penalty,json=softPenalty,proto3" json:"soft_penalty,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateScheduleEntryResponse) Reset() {
 	*x = UpdateScheduleEntryResponse{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[9]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +960,7 @@ func (x *UpdateScheduleEntryResponse) String() string {
 func (*UpdateScheduleEntryResponse) ProtoMessage() {}
 
 func (x *UpdateScheduleEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[9]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +973,7 @@ func (x *UpdateScheduleEntryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateScheduleEntryResponse.ProtoReflect.Descriptor instead.
 func (*UpdateScheduleEntryResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{9}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateScheduleEntryResponse) GetEntry() *ScheduleEntry {
@@ -727,21 +983,56 @@ func (x *UpdateScheduleEntryResponse) GetEntry() *ScheduleEntry {
 	return nil
 }
 
-type SuggestTeachersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SubjectId     string                 `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
-	DayOfWeek     int32                  `protobuf:"varint,2,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
-	StartPeriod   int32                  `protobuf:"varint,3,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
-	EndPeriod     int32                  `protobuf:"varint,4,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateScheduleEntryResponse) GetViolations() []*ScheduleViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
 }
 
-func (x *SuggestTeachersRequest) Reset() {
-	*x = SuggestTeachersRequest{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateScheduleEntryResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *UpdateScheduleEntryResponse) GetHardViolations() int32 {
+	if x != nil {
+		return x.HardViolations
+	}
+	return 0
+}
+
+func (x *UpdateScheduleEntryResponse) GetSoftPenalty() float64 {
+	if x != nil {
+		return x.SoftPenalty
+	}
+	return 0
+}
+
+type SuggestTeachersRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SubjectId   string                 `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"` // optional with entry_id
+	DayOfWeek   int32                  `protobuf:"varint,2,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	StartPeriod int32                  `protobuf:"varint,3,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	EndPeriod   int32                  `protobuf:"varint,4,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+	// Optional: rank against a schedule, ruling out teachers busy at the time
+	// or out of weekly hours. entry_id takes the subject and slot from the
+	// entry being reassigned; time_slot_id names a slot of the semester in
+	// place of the periods above. Both need schedule_id.
+	ScheduleId    string `protobuf:"bytes,5,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	EntryId       string `protobuf:"bytes,6,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	TimeSlotId    string `protobuf:"bytes,7,opt,name=time_slot_id,json=timeSlotId,proto3" json:"time_slot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTeachersRequest) Reset() {
+	*x = SuggestTeachersRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SuggestTeachersRequest) String() string {
@@ -751,7 +1042,7 @@ func (x *SuggestTeachersRequest) String() string {
 func (*SuggestTeachersRequest) ProtoMessage() {}
 
 func (x *SuggestTeachersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[10]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,7 +1055,7 @@ func (x *SuggestTeachersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestTeachersRequest.ProtoReflect.Descriptor instead.
 func (*SuggestTeachersRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{10}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SuggestTeachersRequest) GetSubjectId() string {
@@ -795,6 +1086,27 @@ func (x *SuggestTeachersRequest) GetEndPeriod() int32 {
 	return 0
 }
 
+func (x *SuggestTeachersRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *SuggestTeachersRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *SuggestTeachersRequest) GetTimeSlotId() string {
+	if x != nil {
+		return x.TimeSlotId
+	}
+	return ""
+}
+
 type SuggestTeachersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Suggestions   []*TeacherSuggestion   `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
@@ -804,7 +1116,7 @@ type SuggestTeachersResponse struct {
 
 func (x *SuggestTeachersResponse) Reset() {
 	*x = SuggestTeachersResponse{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[11]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -816,7 +1128,7 @@ func (x *SuggestTeachersResponse) String() string {
 func (*SuggestTeachersResponse) ProtoMessage() {}
 
 func (x *SuggestTeachersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[11]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +1141,7 @@ func (x *SuggestTeachersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestTeachersResponse.ProtoReflect.Descriptor instead.
 func (*SuggestTeachersResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{11}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SuggestTeachersResponse) GetSuggestions() []*TeacherSuggestion {
@@ -840,17 +1152,23 @@ func (x *SuggestTeachersResponse) GetSuggestions() []*TeacherSuggestion {
 }
 
 type TeacherSuggestion struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
-	TeacherName   string                 `protobuf:"bytes,2,opt,name=teacher_name,json=teacherName,proto3" json:"teacher_name,omitempty"`
-	Score         float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId   string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	TeacherName string                 `protobuf:"bytes,2,opt,name=teacher_name,json=teacherName,proto3" json:"teacher_name,omitempty"`
+	Score       float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Why the teacher ranks where they do; for unavailable teachers the
+	// disqualifying reasons come first, e.g. "teaching MATH101 at the same time".
+	Reasons []string `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// False when the teacher cannot take the session; such teachers are listed
+	// after every available one.
+	Available     bool `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TeacherSuggestion) Reset() {
 	*x = TeacherSuggestion{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[12]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +1180,7 @@ func (x *TeacherSuggestion) String() string {
 func (*TeacherSuggestion) ProtoMessage() {}
 
 func (x *TeacherSuggestion) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[12]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1193,7 @@ func (x *TeacherSuggestion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeacherSuggestion.ProtoReflect.Descriptor instead.
 func (*TeacherSuggestion) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{12}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TeacherSuggestion) GetTeacherId() string {
@@ -899,18 +1217,37 @@ func (x *TeacherSuggestion) GetScore() float32 {
 	return 0
 }
 
+func (x *TeacherSuggestion) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *TeacherSuggestion) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
 type ManualAssignRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	EntryId       string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
-	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	EntryId    string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	TeacherId  string                 `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// Optional moves; empty keeps the entry's current room and slot.
+	RoomId     string `protobuf:"bytes,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	TimeSlotId string `protobuf:"bytes,5,opt,name=time_slot_id,json=timeSlotId,proto3" json:"time_slot_id,omitempty"`
+	// Validate and score the edit without saving it.
+	DryRun        bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ManualAssignRequest) Reset() {
 	*x = ManualAssignRequest{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[13]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -922,7 +1259,7 @@ func (x *ManualAssignRequest) String() string {
 func (*ManualAssignRequest) ProtoMessage() {}
 
 func (x *ManualAssignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[13]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,7 +1272,7 @@ func (x *ManualAssignRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManualAssignRequest.ProtoReflect.Descriptor instead.
 func (*ManualAssignRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{13}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ManualAssignRequest) GetScheduleId() string {
@@ -959,16 +1296,43 @@ func (x *ManualAssignRequest) GetTeacherId() string {
 	return ""
 }
 
+func (x *ManualAssignRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ManualAssignRequest) GetTimeSlotId() string {
+	if x != nil {
+		return x.TimeSlotId
+	}
+	return ""
+}
+
+func (x *ManualAssignRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type ManualAssignResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *ScheduleEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Entry *ScheduleEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Hard constraints the edit breaks that the schedule did not break before.
+	Violations []*ScheduleViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	// Schedule totals after the edit (not saved on a dry run).
+	Score          float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	HardViolations int32   `protobuf:"varint,4,opt,name=hard_violations,json=hardViolations,proto3" json:"hard_violations,omitempty"`
+	SoftPenalty    float64 `protobuf:"fixed64,5,opt,name=soft_penalty,json=softPenalty,proto3" json:"soft_penalty,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ManualAssignResponse) Reset() {
 	*x = ManualAssignResponse{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[14]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -980,7 +1344,7 @@ func (x *ManualAssignResponse) String() string {
 func (*ManualAssignResponse) ProtoMessage() {}
 
 func (x *ManualAssignResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[14]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,7 +1357,7 @@ func (x *ManualAssignResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManualAssignResponse.ProtoReflect.Descriptor instead.
 func (*ManualAssignResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{14}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ManualAssignResponse) GetEntry() *ScheduleEntry {
@@ -1003,19 +1367,125 @@ func (x *ManualAssignResponse) GetEntry() *ScheduleEntry {
 	return nil
 }
 
-type Room struct {
+func (x *ManualAssignResponse) GetViolations() []*ScheduleViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *ManualAssignResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ManualAssignResponse) GetHardViolations() int32 {
+	if x != nil {
+		return x.HardViolations
+	}
+	return 0
+}
+
+func (x *ManualAssignResponse) GetSoftPenalty() float64 {
+	if x != nil {
+		return x.SoftPenalty
+	}
+	return 0
+}
+
+// A hard constraint broken by a schedule. other_entry_id is set for conflicts
+// between two entries; teacher_id (without entry_id) for teacher overloads.
+type ScheduleViolation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	RoomType      string                 `protobuf:"bytes,4,opt,name=room_type,json=roomType,proto3" json:"room_type,omitempty"`
+	Constraint    string                 `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	EntryId       string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	OtherEntryId  string                 `protobuf:"bytes,3,opt,name=other_entry_id,json=otherEntryId,proto3" json:"other_entry_id,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,4,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleViolation) Reset() {
+	*x = ScheduleViolation{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleViolation) ProtoMessage() {}
+
+func (x *ScheduleViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleViolation.ProtoReflect.Descriptor instead.
+func (*ScheduleViolation) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ScheduleViolation) GetConstraint() string {
+	if x != nil {
+		return x.Constraint
+	}
+	return ""
+}
+
+func (x *ScheduleViolation) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *ScheduleViolation) GetOtherEntryId() string {
+	if x != nil {
+		return x.OtherEntryId
+	}
+	return ""
+}
+
+func (x *ScheduleViolation) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+type Room struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	RoomType string                 `protobuf:"bytes,4,opt,name=room_type,json=roomType,proto3" json:"room_type,omitempty"`
+	// Bookings of a restricted room wait for approval (RoomBookingService).
+	RequiresApproval bool     `protobuf:"varint,5,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	Features         []string `protobuf:"bytes,6,rep,name=features,proto3" json:"features,omitempty"`
+	IsActive         bool     `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Location; empty when the room has no building.
+	BuildingId    string `protobuf:"bytes,8,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	BuildingCode  string `protobuf:"bytes,9,opt,name=building_code,json=buildingCode,proto3" json:"building_code,omitempty"`
+	CampusId      string `protobuf:"bytes,10,opt,name=campus_id,json=campusId,proto3" json:"campus_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Room) Reset() {
 	*x = Room{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[15]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1027,7 +1497,7 @@ func (x *Room) String() string {
 func (*Room) ProtoMessage() {}
 
 func (x *Room) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[15]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,7 +1510,7 @@ func (x *Room) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Room.ProtoReflect.Descriptor instead.
 func (*Room) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{15}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Room) GetId() string {
@@ -1071,15 +1541,58 @@ func (x *Room) GetRoomType() string {
 	return ""
 }
 
+func (x *Room) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *Room) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *Room) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Room) GetBuildingId() string {
+	if x != nil {
+		return x.BuildingId
+	}
+	return ""
+}
+
+func (x *Room) GetBuildingCode() string {
+	if x != nil {
+		return x.BuildingCode
+	}
+	return ""
+}
+
+func (x *Room) GetCampusId() string {
+	if x != nil {
+		return x.CampusId
+	}
+	return ""
+}
+
 type ListRoomsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListRoomsRequest) Reset() {
 	*x = ListRoomsRequest{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[16]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1091,7 +1604,7 @@ func (x *ListRoomsRequest) String() string {
 func (*ListRoomsRequest) ProtoMessage() {}
 
 func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[16]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,7 +1617,14 @@ func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
 func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{16}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListRoomsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
 }
 
 type ListRoomsResponse struct {
@@ -1116,7 +1636,7 @@ type ListRoomsResponse struct {
 
 func (x *ListRoomsResponse) Reset() {
 	*x = ListRoomsResponse{}
-	mi := &file_timetable_v1_timetable_proto_msgTypes[17]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1128,7 +1648,7 @@ func (x *ListRoomsResponse) String() string {
 func (*ListRoomsResponse) ProtoMessage() {}
 
 func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timetable_v1_timetable_proto_msgTypes[17]
+	mi := &file_timetable_v1_timetable_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,7 +1661,7 @@ func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
 func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
-	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{17}
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListRoomsResponse) GetRooms() []*Room {
@@ -1151,29 +1671,3769 @@ func (x *ListRoomsResponse) GetRooms() []*Room {
 	return nil
 }
 
-var File_timetable_v1_timetable_proto protoreflect.FileDescriptor
+type GetGenerationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_timetable_v1_timetable_proto_rawDesc = "" +
-	"\n" +
-	"\x1ctimetable/v1/timetable.proto\x12\ftimetable.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x03\n" +
-	"\rScheduleEntry\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
-	"\n" +
-	"subject_id\x18\x02 \x01(\tR\tsubjectId\x12\x1d\n" +
-	"\n" +
-	"teacher_id\x18\x03 \x01(\tR\tteacherId\x12\x1e\n" +
-	"\vday_of_week\x18\x04 \x01(\x05R\tdayOfWeek\x12!\n" +
-	"\fstart_period\x18\x05 \x01(\x05R\vstartPeriod\x12\x1d\n" +
-	"\n" +
-	"end_period\x18\x06 \x01(\x05R\tendPeriod\x12\x12\n" +
-	"\x04room\x18\a \x01(\tR\x04room\x12!\n" +
+func (x *GetGenerationStatusRequest) Reset() {
+	*x = GetGenerationStatusRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGenerationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenerationStatusRequest) ProtoMessage() {}
+
+func (x *GetGenerationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGenerationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetGenerationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetGenerationStatusRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type RepairScheduleRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Teachers, rooms and slots that can no longer be used. Entries using an
+	// inactive teacher, unavailable room or foreign slot are repaired as well.
+	RemovedTeacherIds []string `protobuf:"bytes,2,rep,name=removed_teacher_ids,json=removedTeacherIds,proto3" json:"removed_teacher_ids,omitempty"`
+	ClosedRoomIds     []string `protobuf:"bytes,3,rep,name=closed_room_ids,json=closedRoomIds,proto3" json:"closed_room_ids,omitempty"`
+	RemovedSlotIds    []string `protobuf:"bytes,4,rep,name=removed_slot_ids,json=removedSlotIds,proto3" json:"removed_slot_ids,omitempty"`
+	TimeoutSeconds    int32    `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RepairScheduleRequest) Reset() {
+	*x = RepairScheduleRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairScheduleRequest) ProtoMessage() {}
+
+func (x *RepairScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairScheduleRequest.ProtoReflect.Descriptor instead.
+func (*RepairScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RepairScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *RepairScheduleRequest) GetRemovedTeacherIds() []string {
+	if x != nil {
+		return x.RemovedTeacherIds
+	}
+	return nil
+}
+
+func (x *RepairScheduleRequest) GetClosedRoomIds() []string {
+	if x != nil {
+		return x.ClosedRoomIds
+	}
+	return nil
+}
+
+func (x *RepairScheduleRequest) GetRemovedSlotIds() []string {
+	if x != nil {
+		return x.RemovedSlotIds
+	}
+	return nil
+}
+
+func (x *RepairScheduleRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type RepairScheduleResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Schedule          *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	AffectedEntries   int32                  `protobuf:"varint,2,opt,name=affected_entries,json=affectedEntries,proto3" json:"affected_entries,omitempty"`       // entries invalidated by the change
+	ReassignedEntries int32                  `protobuf:"varint,3,opt,name=reassigned_entries,json=reassignedEntries,proto3" json:"reassigned_entries,omitempty"` // affected entries given a new value
+	MovedEntries      int32                  `protobuf:"varint,4,opt,name=moved_entries,json=movedEntries,proto3" json:"moved_entries,omitempty"`                // reassigned entries whose room or slot changed
+	UnresolvedEntries int32                  `protobuf:"varint,5,opt,name=unresolved_entries,json=unresolvedEntries,proto3" json:"unresolved_entries,omitempty"` // affected entries left unchanged
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RepairScheduleResponse) Reset() {
+	*x = RepairScheduleResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairScheduleResponse) ProtoMessage() {}
+
+func (x *RepairScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairScheduleResponse.ProtoReflect.Descriptor instead.
+func (*RepairScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RepairScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *RepairScheduleResponse) GetAffectedEntries() int32 {
+	if x != nil {
+		return x.AffectedEntries
+	}
+	return 0
+}
+
+func (x *RepairScheduleResponse) GetReassignedEntries() int32 {
+	if x != nil {
+		return x.ReassignedEntries
+	}
+	return 0
+}
+
+func (x *RepairScheduleResponse) GetMovedEntries() int32 {
+	if x != nil {
+		return x.MovedEntries
+	}
+	return 0
+}
+
+func (x *RepairScheduleResponse) GetUnresolvedEntries() int32 {
+	if x != nil {
+		return x.UnresolvedEntries
+	}
+	return 0
+}
+
+type GetGenerationStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	IsComplete    bool                   `protobuf:"varint,3,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty"`
+	IsPartial     bool                   `protobuf:"varint,4,opt,name=is_partial,json=isPartial,proto3" json:"is_partial,omitempty"`
+	IsFailed      bool                   `protobuf:"varint,5,opt,name=is_failed,json=isFailed,proto3" json:"is_failed,omitempty"`
+	FailureReason string                 `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// Set only when the solver proved the semester infeasible.
+	Diagnosis *InfeasibilityDiagnosis `protobuf:"bytes,7,opt,name=diagnosis,proto3" json:"diagnosis,omitempty"`
+	// Generation job state: queued | running | succeeded | failed | cancelled.
+	// Empty for schedules created before generation jobs existed.
+	JobStatus     string `protobuf:"bytes,8,opt,name=job_status,json=jobStatus,proto3" json:"job_status,omitempty"`
+	JobAttempts   int32  `protobuf:"varint,9,opt,name=job_attempts,json=jobAttempts,proto3" json:"job_attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGenerationStatusResponse) Reset() {
+	*x = GetGenerationStatusResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGenerationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenerationStatusResponse) ProtoMessage() {}
+
+func (x *GetGenerationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGenerationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetGenerationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetGenerationStatusResponse) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *GetGenerationStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetGenerationStatusResponse) GetIsComplete() bool {
+	if x != nil {
+		return x.IsComplete
+	}
+	return false
+}
+
+func (x *GetGenerationStatusResponse) GetIsPartial() bool {
+	if x != nil {
+		return x.IsPartial
+	}
+	return false
+}
+
+func (x *GetGenerationStatusResponse) GetIsFailed() bool {
+	if x != nil {
+		return x.IsFailed
+	}
+	return false
+}
+
+func (x *GetGenerationStatusResponse) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *GetGenerationStatusResponse) GetDiagnosis() *InfeasibilityDiagnosis {
+	if x != nil {
+		return x.Diagnosis
+	}
+	return nil
+}
+
+func (x *GetGenerationStatusResponse) GetJobStatus() string {
+	if x != nil {
+		return x.JobStatus
+	}
+	return ""
+}
+
+func (x *GetGenerationStatusResponse) GetJobAttempts() int32 {
+	if x != nil {
+		return x.JobAttempts
+	}
+	return 0
+}
+
+type CancelGenerationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelGenerationRequest) Reset() {
+	*x = CancelGenerationRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGenerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGenerationRequest) ProtoMessage() {}
+
+func (x *CancelGenerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGenerationRequest.ProtoReflect.Descriptor instead.
+func (*CancelGenerationRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CancelGenerationRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type CancelGenerationResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// "cancelled" when the job had not started; "running" until its worker stops it.
+	JobStatus     string `protobuf:"bytes,2,opt,name=job_status,json=jobStatus,proto3" json:"job_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelGenerationResponse) Reset() {
+	*x = CancelGenerationResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGenerationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGenerationResponse) ProtoMessage() {}
+
+func (x *CancelGenerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGenerationResponse.ProtoReflect.Descriptor instead.
+func (*CancelGenerationResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelGenerationResponse) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *CancelGenerationResponse) GetJobStatus() string {
+	if x != nil {
+		return x.JobStatus
+	}
+	return ""
+}
+
+// InfeasibilityDiagnosis explains which subjects could not be placed and why.
+type InfeasibilityDiagnosis struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	EmptyDomains []*EmptyDomain         `protobuf:"bytes,1,rep,name=empty_domains,json=emptyDomains,proto3" json:"empty_domains,omitempty"`
+	// Minimal set of subjects that cannot be scheduled together.
+	ConflictSubjects []*DiagnosisRef `protobuf:"bytes,2,rep,name=conflict_subjects,json=conflictSubjects,proto3" json:"conflict_subjects,omitempty"`
+	// Teachers still eligible for the conflicting subjects.
+	ConflictTeachers []*DiagnosisRef `protobuf:"bytes,3,rep,name=conflict_teachers,json=conflictTeachers,proto3" json:"conflict_teachers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InfeasibilityDiagnosis) Reset() {
+	*x = InfeasibilityDiagnosis{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InfeasibilityDiagnosis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfeasibilityDiagnosis) ProtoMessage() {}
+
+func (x *InfeasibilityDiagnosis) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfeasibilityDiagnosis.ProtoReflect.Descriptor instead.
+func (*InfeasibilityDiagnosis) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *InfeasibilityDiagnosis) GetEmptyDomains() []*EmptyDomain {
+	if x != nil {
+		return x.EmptyDomains
+	}
+	return nil
+}
+
+func (x *InfeasibilityDiagnosis) GetConflictSubjects() []*DiagnosisRef {
+	if x != nil {
+		return x.ConflictSubjects
+	}
+	return nil
+}
+
+func (x *InfeasibilityDiagnosis) GetConflictTeachers() []*DiagnosisRef {
+	if x != nil {
+		return x.ConflictTeachers
+	}
+	return nil
+}
+
+// EmptyDomain is a subject session left without any (teacher, room, slot) candidate.
+type EmptyDomain struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SubjectId   string                 `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	SubjectCode string                 `protobuf:"bytes,2,opt,name=subject_code,json=subjectCode,proto3" json:"subject_code,omitempty"`
+	SubjectName string                 `protobuf:"bytes,3,opt,name=subject_name,json=subjectName,proto3" json:"subject_name,omitempty"`
+	Session     int32                  `protobuf:"varint,4,opt,name=session,proto3" json:"session,omitempty"`
+	Candidates  int32                  `protobuf:"varint,5,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	// Constraint that eliminated the most candidates, e.g. "specialization_missing".
+	Cause string `protobuf:"bytes,6,opt,name=cause,proto3" json:"cause,omitempty"`
+	// Candidates eliminated per constraint type.
+	Eliminated    map[string]int32 `protobuf:"bytes,7,rep,name=eliminated,proto3" json:"eliminated,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyDomain) Reset() {
+	*x = EmptyDomain{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyDomain) ProtoMessage() {}
+
+func (x *EmptyDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyDomain.ProtoReflect.Descriptor instead.
+func (*EmptyDomain) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EmptyDomain) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *EmptyDomain) GetSubjectCode() string {
+	if x != nil {
+		return x.SubjectCode
+	}
+	return ""
+}
+
+func (x *EmptyDomain) GetSubjectName() string {
+	if x != nil {
+		return x.SubjectName
+	}
+	return ""
+}
+
+func (x *EmptyDomain) GetSession() int32 {
+	if x != nil {
+		return x.Session
+	}
+	return 0
+}
+
+func (x *EmptyDomain) GetCandidates() int32 {
+	if x != nil {
+		return x.Candidates
+	}
+	return 0
+}
+
+func (x *EmptyDomain) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+func (x *EmptyDomain) GetEliminated() map[string]int32 {
+	if x != nil {
+		return x.Eliminated
+	}
+	return nil
+}
+
+type DiagnosisRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosisRef) Reset() {
+	*x = DiagnosisRef{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosisRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosisRef) ProtoMessage() {}
+
+func (x *DiagnosisRef) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosisRef.ProtoReflect.Descriptor instead.
+func (*DiagnosisRef) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DiagnosisRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiagnosisRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// An immutable snapshot of a schedule taken when it was published.
+type ScheduleVersion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SemesterId     string                 `protobuf:"bytes,2,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	ScheduleId     string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Version        int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"` // numbered per semester from 1
+	Score          float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	HardViolations int32                  `protobuf:"varint,6,opt,name=hard_violations,json=hardViolations,proto3" json:"hard_violations,omitempty"`
+	SoftPenalty    float64                `protobuf:"fixed64,7,opt,name=soft_penalty,json=softPenalty,proto3" json:"soft_penalty,omitempty"`
+	PublishedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	Entries        []*ScheduleEntry       `protobuf:"bytes,9,rep,name=entries,proto3" json:"entries,omitempty"` // empty when listed
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScheduleVersion) Reset() {
+	*x = ScheduleVersion{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleVersion) ProtoMessage() {}
+
+func (x *ScheduleVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleVersion.ProtoReflect.Descriptor instead.
+func (*ScheduleVersion) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ScheduleVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleVersion) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *ScheduleVersion) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduleVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ScheduleVersion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScheduleVersion) GetHardViolations() int32 {
+	if x != nil {
+		return x.HardViolations
+	}
+	return 0
+}
+
+func (x *ScheduleVersion) GetSoftPenalty() float64 {
+	if x != nil {
+		return x.SoftPenalty
+	}
+	return 0
+}
+
+func (x *ScheduleVersion) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *ScheduleVersion) GetEntries() []*ScheduleEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PublishScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishScheduleRequest) Reset() {
+	*x = PublishScheduleRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishScheduleRequest) ProtoMessage() {}
+
+func (x *PublishScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PublishScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PublishScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type PublishScheduleResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Schedule            *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Version             *ScheduleVersion       `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ArchivedScheduleIds []string               `protobuf:"bytes,3,rep,name=archived_schedule_ids,json=archivedScheduleIds,proto3" json:"archived_schedule_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PublishScheduleResponse) Reset() {
+	*x = PublishScheduleResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishScheduleResponse) ProtoMessage() {}
+
+func (x *PublishScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishScheduleResponse.ProtoReflect.Descriptor instead.
+func (*PublishScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PublishScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *PublishScheduleResponse) GetVersion() *ScheduleVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *PublishScheduleResponse) GetArchivedScheduleIds() []string {
+	if x != nil {
+		return x.ArchivedScheduleIds
+	}
+	return nil
+}
+
+type UnpublishScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishScheduleRequest) Reset() {
+	*x = UnpublishScheduleRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishScheduleRequest) ProtoMessage() {}
+
+func (x *UnpublishScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UnpublishScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type UnpublishScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishScheduleResponse) Reset() {
+	*x = UnpublishScheduleResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishScheduleResponse) ProtoMessage() {}
+
+func (x *UnpublishScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UnpublishScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ArchiveScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveScheduleRequest) Reset() {
+	*x = ArchiveScheduleRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveScheduleRequest) ProtoMessage() {}
+
+func (x *ArchiveScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ArchiveScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type ArchiveScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveScheduleResponse) Reset() {
+	*x = ArchiveScheduleResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveScheduleResponse) ProtoMessage() {}
+
+func (x *ArchiveScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ArchiveScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ListScheduleVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleVersionsRequest) Reset() {
+	*x = ListScheduleVersionsRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleVersionsRequest) ProtoMessage() {}
+
+func (x *ListScheduleVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduleVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListScheduleVersionsRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+type ListScheduleVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*ScheduleVersion     `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleVersionsResponse) Reset() {
+	*x = ListScheduleVersionsResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleVersionsResponse) ProtoMessage() {}
+
+func (x *ListScheduleVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduleVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListScheduleVersionsResponse) GetVersions() []*ScheduleVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type GetScheduleVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleVersionRequest) Reset() {
+	*x = GetScheduleVersionRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleVersionRequest) ProtoMessage() {}
+
+func (x *GetScheduleVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleVersionRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetScheduleVersionRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *GetScheduleVersionRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetScheduleVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *ScheduleVersion       `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleVersionResponse) Reset() {
+	*x = GetScheduleVersionResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleVersionResponse) ProtoMessage() {}
+
+func (x *GetScheduleVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleVersionResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetScheduleVersionResponse) GetVersion() *ScheduleVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type RollbackScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackScheduleRequest) Reset() {
+	*x = RollbackScheduleRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackScheduleRequest) ProtoMessage() {}
+
+func (x *RollbackScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackScheduleRequest.ProtoReflect.Descriptor instead.
+func (*RollbackScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RollbackScheduleRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *RollbackScheduleRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RollbackScheduleResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Schedule            *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Version             *ScheduleVersion       `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ArchivedScheduleIds []string               `protobuf:"bytes,3,rep,name=archived_schedule_ids,json=archivedScheduleIds,proto3" json:"archived_schedule_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RollbackScheduleResponse) Reset() {
+	*x = RollbackScheduleResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackScheduleResponse) ProtoMessage() {}
+
+func (x *RollbackScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackScheduleResponse.ProtoReflect.Descriptor instead.
+func (*RollbackScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RollbackScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *RollbackScheduleResponse) GetVersion() *ScheduleVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *RollbackScheduleResponse) GetArchivedScheduleIds() []string {
+	if x != nil {
+		return x.ArchivedScheduleIds
+	}
+	return nil
+}
+
+// Each side is a schedule ID or, when that is empty, a published version of
+// semester_id.
+type DiffSchedulesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BaseScheduleId   string                 `protobuf:"bytes,1,opt,name=base_schedule_id,json=baseScheduleId,proto3" json:"base_schedule_id,omitempty"`
+	TargetScheduleId string                 `protobuf:"bytes,2,opt,name=target_schedule_id,json=targetScheduleId,proto3" json:"target_schedule_id,omitempty"`
+	SemesterId       string                 `protobuf:"bytes,3,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	BaseVersion      int32                  `protobuf:"varint,4,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"`
+	TargetVersion    int32                  `protobuf:"varint,5,opt,name=target_version,json=targetVersion,proto3" json:"target_version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DiffSchedulesRequest) Reset() {
+	*x = DiffSchedulesRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSchedulesRequest) ProtoMessage() {}
+
+func (x *DiffSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*DiffSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DiffSchedulesRequest) GetBaseScheduleId() string {
+	if x != nil {
+		return x.BaseScheduleId
+	}
+	return ""
+}
+
+func (x *DiffSchedulesRequest) GetTargetScheduleId() string {
+	if x != nil {
+		return x.TargetScheduleId
+	}
+	return ""
+}
+
+func (x *DiffSchedulesRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *DiffSchedulesRequest) GetBaseVersion() int32 {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return 0
+}
+
+func (x *DiffSchedulesRequest) GetTargetVersion() int32 {
+	if x != nil {
+		return x.TargetVersion
+	}
+	return 0
+}
+
+type ScheduleChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Kind           string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // moved, reassigned, added or removed
+	SubjectId      string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Before         *ScheduleEntry         `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"` // unset for added sessions
+	After          *ScheduleEntry         `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`   // unset for removed sessions
+	TeacherChanged bool                   `protobuf:"varint,5,opt,name=teacher_changed,json=teacherChanged,proto3" json:"teacher_changed,omitempty"`
+	RoomChanged    bool                   `protobuf:"varint,6,opt,name=room_changed,json=roomChanged,proto3" json:"room_changed,omitempty"`
+	SlotChanged    bool                   `protobuf:"varint,7,opt,name=slot_changed,json=slotChanged,proto3" json:"slot_changed,omitempty"`
+	Description    string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"` // e.g. "CS101 moved: Mon P1-3, R101, Alice -> Tue P4-6, R101, Alice"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScheduleChange) Reset() {
+	*x = ScheduleChange{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleChange) ProtoMessage() {}
+
+func (x *ScheduleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleChange.ProtoReflect.Descriptor instead.
+func (*ScheduleChange) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ScheduleChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ScheduleChange) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *ScheduleChange) GetBefore() *ScheduleEntry {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ScheduleChange) GetAfter() *ScheduleEntry {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ScheduleChange) GetTeacherChanged() bool {
+	if x != nil {
+		return x.TeacherChanged
+	}
+	return false
+}
+
+func (x *ScheduleChange) GetRoomChanged() bool {
+	if x != nil {
+		return x.RoomChanged
+	}
+	return false
+}
+
+func (x *ScheduleChange) GetSlotChanged() bool {
+	if x != nil {
+		return x.SlotChanged
+	}
+	return false
+}
+
+func (x *ScheduleChange) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Per-teacher or per-room tally of a diff, for targeted notifications.
+type ResourceChangeSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Gained        int32                  `protobuf:"varint,3,opt,name=gained,proto3" json:"gained,omitempty"` // sessions newly assigned to it
+	Lost          int32                  `protobuf:"varint,4,opt,name=lost,proto3" json:"lost,omitempty"`     // sessions taken away from it
+	Moved         int32                  `protobuf:"varint,5,opt,name=moved,proto3" json:"moved,omitempty"`   // sessions it keeps, at another slot or room
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceChangeSummary) Reset() {
+	*x = ResourceChangeSummary{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceChangeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceChangeSummary) ProtoMessage() {}
+
+func (x *ResourceChangeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceChangeSummary.ProtoReflect.Descriptor instead.
+func (*ResourceChangeSummary) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ResourceChangeSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResourceChangeSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceChangeSummary) GetGained() int32 {
+	if x != nil {
+		return x.Gained
+	}
+	return 0
+}
+
+func (x *ResourceChangeSummary) GetLost() int32 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *ResourceChangeSummary) GetMoved() int32 {
+	if x != nil {
+		return x.Moved
+	}
+	return 0
+}
+
+// Exports schedule_id, or the published schedule of semester_id when that is
+// empty. At most one of the filters may be set; none exports every entry.
+type ExportICalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	SemesterId    string                 `protobuf:"bytes,2,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,5,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,6,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportICalendarRequest) Reset() {
+	*x = ExportICalendarRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportICalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportICalendarRequest) ProtoMessage() {}
+
+func (x *ExportICalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportICalendarRequest.ProtoReflect.Descriptor instead.
+func (*ExportICalendarRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportICalendarRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ExportICalendarRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *ExportICalendarRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ExportICalendarRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ExportICalendarRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ExportICalendarRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+type ExportICalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      []byte                 `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"` // text/calendar content
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportICalendarResponse) Reset() {
+	*x = ExportICalendarResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportICalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportICalendarResponse) ProtoMessage() {}
+
+func (x *ExportICalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportICalendarResponse.ProtoReflect.Descriptor instead.
+func (*ExportICalendarResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportICalendarResponse) GetCalendar() []byte {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+func (x *ExportICalendarResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type DiffSchedulesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Changes       []*ScheduleChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Teachers      []*ResourceChangeSummary `protobuf:"bytes,2,rep,name=teachers,proto3" json:"teachers,omitempty"`
+	Rooms         []*ResourceChangeSummary `protobuf:"bytes,3,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	Unchanged     int32                    `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffSchedulesResponse) Reset() {
+	*x = DiffSchedulesResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSchedulesResponse) ProtoMessage() {}
+
+func (x *DiffSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*DiffSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DiffSchedulesResponse) GetChanges() []*ScheduleChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *DiffSchedulesResponse) GetTeachers() []*ResourceChangeSummary {
+	if x != nil {
+		return x.Teachers
+	}
+	return nil
+}
+
+func (x *DiffSchedulesResponse) GetRooms() []*ResourceChangeSummary {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *DiffSchedulesResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+// One exam sitting offered on every exam day, periods start..end inclusive.
+type ExamSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartPeriod   int32                  `protobuf:"varint,1,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	EndPeriod     int32                  `protobuf:"varint,2,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExamSession) Reset() {
+	*x = ExamSession{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamSession) ProtoMessage() {}
+
+func (x *ExamSession) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamSession.ProtoReflect.Descriptor instead.
+func (*ExamSession) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExamSession) GetStartPeriod() int32 {
+	if x != nil {
+		return x.StartPeriod
+	}
+	return 0
+}
+
+func (x *ExamSession) GetEndPeriod() int32 {
+	if x != nil {
+		return x.EndPeriod
+	}
+	return 0
+}
+
+// Exam period rules; zero values take the defaults noted.
+type ExamSettings struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Sessions         []*ExamSession         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`                                          // default periods 1-3 and 4-6
+	MaxPerDay        int32                  `protobuf:"varint,2,opt,name=max_per_day,json=maxPerDay,proto3" json:"max_per_day,omitempty"`                    // exams one student may sit a day, default 2
+	SeatSpacing      int32                  `protobuf:"varint,3,opt,name=seat_spacing,json=seatSpacing,proto3" json:"seat_spacing,omitempty"`                // empty seats between candidates, default 0
+	MinGapDays       int32                  `protobuf:"varint,4,opt,name=min_gap_days,json=minGapDays,proto3" json:"min_gap_days,omitempty"`                 // days aimed for between exams sharing students, default 2
+	MaxInvigilations int32                  `protobuf:"varint,5,opt,name=max_invigilations,json=maxInvigilations,proto3" json:"max_invigilations,omitempty"` // per teacher, 0 = uncapped
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExamSettings) Reset() {
+	*x = ExamSettings{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamSettings) ProtoMessage() {}
+
+func (x *ExamSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamSettings.ProtoReflect.Descriptor instead.
+func (*ExamSettings) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExamSettings) GetSessions() []*ExamSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ExamSettings) GetMaxPerDay() int32 {
+	if x != nil {
+		return x.MaxPerDay
+	}
+	return 0
+}
+
+func (x *ExamSettings) GetSeatSpacing() int32 {
+	if x != nil {
+		return x.SeatSpacing
+	}
+	return 0
+}
+
+func (x *ExamSettings) GetMinGapDays() int32 {
+	if x != nil {
+		return x.MinGapDays
+	}
+	return 0
+}
+
+func (x *ExamSettings) GetMaxInvigilations() int32 {
+	if x != nil {
+		return x.MaxInvigilations
+	}
+	return 0
+}
+
+type ExamEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SubjectId       string                 `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	SubjectCode     string                 `protobuf:"bytes,2,opt,name=subject_code,json=subjectCode,proto3" json:"subject_code,omitempty"`
+	SubjectName     string                 `protobuf:"bytes,3,opt,name=subject_name,json=subjectName,proto3" json:"subject_name,omitempty"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	StartPeriod     int32                  `protobuf:"varint,5,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	EndPeriod       int32                  `protobuf:"varint,6,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+	RoomId          string                 `protobuf:"bytes,7,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName        string                 `protobuf:"bytes,8,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	InvigilatorId   string                 `protobuf:"bytes,9,opt,name=invigilator_id,json=invigilatorId,proto3" json:"invigilator_id,omitempty"`
+	InvigilatorName string                 `protobuf:"bytes,10,opt,name=invigilator_name,json=invigilatorName,proto3" json:"invigilator_name,omitempty"`
+	StudentCount    int32                  `protobuf:"varint,11,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExamEntry) Reset() {
+	*x = ExamEntry{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamEntry) ProtoMessage() {}
+
+func (x *ExamEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamEntry.ProtoReflect.Descriptor instead.
+func (*ExamEntry) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExamEntry) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *ExamEntry) GetSubjectCode() string {
+	if x != nil {
+		return x.SubjectCode
+	}
+	return ""
+}
+
+func (x *ExamEntry) GetSubjectName() string {
+	if x != nil {
+		return x.SubjectName
+	}
+	return ""
+}
+
+func (x *ExamEntry) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ExamEntry) GetStartPeriod() int32 {
+	if x != nil {
+		return x.StartPeriod
+	}
+	return 0
+}
+
+func (x *ExamEntry) GetEndPeriod() int32 {
+	if x != nil {
+		return x.EndPeriod
+	}
+	return 0
+}
+
+func (x *ExamEntry) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ExamEntry) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *ExamEntry) GetInvigilatorId() string {
+	if x != nil {
+		return x.InvigilatorId
+	}
+	return ""
+}
+
+func (x *ExamEntry) GetInvigilatorName() string {
+	if x != nil {
+		return x.InvigilatorName
+	}
+	return ""
+}
+
+func (x *ExamEntry) GetStudentCount() int32 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+type ExamSchedule struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SemesterId  string                 `protobuf:"bytes,2,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Settings    *ExamSettings          `protobuf:"bytes,6,opt,name=settings,proto3" json:"settings,omitempty"`
+	Score       float64                `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	SoftPenalty float64                `protobuf:"fixed64,8,opt,name=soft_penalty,json=softPenalty,proto3" json:"soft_penalty,omitempty"`
+	Entries     []*ExamEntry           `protobuf:"bytes,9,rep,name=entries,proto3" json:"entries,omitempty"` // empty when listed
+	// Subjects the solver could not place before the timeout.
+	UnscheduledSubjectIds []string               `protobuf:"bytes,10,rep,name=unscheduled_subject_ids,json=unscheduledSubjectIds,proto3" json:"unscheduled_subject_ids,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ExamSchedule) Reset() {
+	*x = ExamSchedule{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamSchedule) ProtoMessage() {}
+
+func (x *ExamSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamSchedule.ProtoReflect.Descriptor instead.
+func (*ExamSchedule) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ExamSchedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExamSchedule) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *ExamSchedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExamSchedule) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ExamSchedule) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ExamSchedule) GetSettings() *ExamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ExamSchedule) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ExamSchedule) GetSoftPenalty() float64 {
+	if x != nil {
+		return x.SoftPenalty
+	}
+	return 0
+}
+
+func (x *ExamSchedule) GetEntries() []*ExamEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ExamSchedule) GetUnscheduledSubjectIds() []string {
+	if x != nil {
+		return x.UnscheduledSubjectIds
+	}
+	return nil
+}
+
+func (x *ExamSchedule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GenerateExamScheduleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId     string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Settings       *ExamSettings          `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
+	TimeoutSeconds int32                  `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // default 20
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenerateExamScheduleRequest) Reset() {
+	*x = GenerateExamScheduleRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateExamScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateExamScheduleRequest) ProtoMessage() {}
+
+func (x *GenerateExamScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateExamScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GenerateExamScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GenerateExamScheduleRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+func (x *GenerateExamScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GenerateExamScheduleRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GenerateExamScheduleRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GenerateExamScheduleRequest) GetSettings() *ExamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *GenerateExamScheduleRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type GenerateExamScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamSchedule  *ExamSchedule          `protobuf:"bytes,1,opt,name=exam_schedule,json=examSchedule,proto3" json:"exam_schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateExamScheduleResponse) Reset() {
+	*x = GenerateExamScheduleResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateExamScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateExamScheduleResponse) ProtoMessage() {}
+
+func (x *GenerateExamScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateExamScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GenerateExamScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GenerateExamScheduleResponse) GetExamSchedule() *ExamSchedule {
+	if x != nil {
+		return x.ExamSchedule
+	}
+	return nil
+}
+
+type GetExamScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExamScheduleRequest) Reset() {
+	*x = GetExamScheduleRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExamScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamScheduleRequest) ProtoMessage() {}
+
+func (x *GetExamScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetExamScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetExamScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetExamScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamSchedule  *ExamSchedule          `protobuf:"bytes,1,opt,name=exam_schedule,json=examSchedule,proto3" json:"exam_schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExamScheduleResponse) Reset() {
+	*x = GetExamScheduleResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExamScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamScheduleResponse) ProtoMessage() {}
+
+func (x *GetExamScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetExamScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetExamScheduleResponse) GetExamSchedule() *ExamSchedule {
+	if x != nil {
+		return x.ExamSchedule
+	}
+	return nil
+}
+
+type ListExamSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterId    string                 `protobuf:"bytes,1,opt,name=semester_id,json=semesterId,proto3" json:"semester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExamSchedulesRequest) Reset() {
+	*x = ListExamSchedulesRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExamSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExamSchedulesRequest) ProtoMessage() {}
+
+func (x *ListExamSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExamSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListExamSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListExamSchedulesRequest) GetSemesterId() string {
+	if x != nil {
+		return x.SemesterId
+	}
+	return ""
+}
+
+type ListExamSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamSchedules []*ExamSchedule        `protobuf:"bytes,1,rep,name=exam_schedules,json=examSchedules,proto3" json:"exam_schedules,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExamSchedulesResponse) Reset() {
+	*x = ListExamSchedulesResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExamSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExamSchedulesResponse) ProtoMessage() {}
+
+func (x *ListExamSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExamSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListExamSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListExamSchedulesResponse) GetExamSchedules() []*ExamSchedule {
+	if x != nil {
+		return x.ExamSchedules
+	}
+	return nil
+}
+
+type CreateRoomRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity         int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	RoomType         string                 `protobuf:"bytes,3,opt,name=room_type,json=roomType,proto3" json:"room_type,omitempty"` // default classroom
+	Features         []string               `protobuf:"bytes,4,rep,name=features,proto3" json:"features,omitempty"`
+	RequiresApproval bool                   `protobuf:"varint,5,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	BuildingId       string                 `protobuf:"bytes,6,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"` // optional
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetRoomType() string {
+	if x != nil {
+		return x.RoomType
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *CreateRoomRequest) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetBuildingId() string {
+	if x != nil {
+		return x.BuildingId
+	}
+	return ""
+}
+
+type CreateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomResponse) Reset() {
+	*x = CreateRoomResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomResponse) ProtoMessage() {}
+
+func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type GetRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomRequest) Reset() {
+	*x = GetRoomRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomRequest) ProtoMessage() {}
+
+func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomResponse) Reset() {
+	*x = GetRoomResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomResponse) ProtoMessage() {}
+
+func (x *GetRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+// UpdateRoomRequest changes only the fields that are set. Features are
+// replaced when replace_features is true; an empty building_id detaches the
+// room from its building.
+type UpdateRoomRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Capacity         *int32                 `protobuf:"varint,3,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	RoomType         *string                `protobuf:"bytes,4,opt,name=room_type,json=roomType,proto3,oneof" json:"room_type,omitempty"`
+	Features         []string               `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`
+	ReplaceFeatures  bool                   `protobuf:"varint,6,opt,name=replace_features,json=replaceFeatures,proto3" json:"replace_features,omitempty"`
+	RequiresApproval *bool                  `protobuf:"varint,7,opt,name=requires_approval,json=requiresApproval,proto3,oneof" json:"requires_approval,omitempty"`
+	BuildingId       *string                `protobuf:"bytes,8,opt,name=building_id,json=buildingId,proto3,oneof" json:"building_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateRoomRequest) Reset() {
+	*x = UpdateRoomRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomRequest) ProtoMessage() {}
+
+func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *UpdateRoomRequest) GetRoomType() string {
+	if x != nil && x.RoomType != nil {
+		return *x.RoomType
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *UpdateRoomRequest) GetReplaceFeatures() bool {
+	if x != nil {
+		return x.ReplaceFeatures
+	}
+	return false
+}
+
+func (x *UpdateRoomRequest) GetRequiresApproval() bool {
+	if x != nil && x.RequiresApproval != nil {
+		return *x.RequiresApproval
+	}
+	return false
+}
+
+func (x *UpdateRoomRequest) GetBuildingId() string {
+	if x != nil && x.BuildingId != nil {
+		return *x.BuildingId
+	}
+	return ""
+}
+
+type UpdateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomResponse) Reset() {
+	*x = UpdateRoomResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomResponse) ProtoMessage() {}
+
+func (x *UpdateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type DeactivateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateRoomRequest) Reset() {
+	*x = DeactivateRoomRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateRoomRequest) ProtoMessage() {}
+
+func (x *DeactivateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateRoomRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeactivateRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeactivateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateRoomResponse) Reset() {
+	*x = DeactivateRoomResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateRoomResponse) ProtoMessage() {}
+
+func (x *DeactivateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateRoomResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeactivateRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type ActivateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateRoomRequest) Reset() {
+	*x = ActivateRoomRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateRoomRequest) ProtoMessage() {}
+
+func (x *ActivateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateRoomRequest.ProtoReflect.Descriptor instead.
+func (*ActivateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ActivateRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ActivateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateRoomResponse) Reset() {
+	*x = ActivateRoomResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateRoomResponse) ProtoMessage() {}
+
+func (x *ActivateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateRoomResponse.ProtoReflect.Descriptor instead.
+func (*ActivateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ActivateRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type DeleteRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomRequest) Reset() {
+	*x = DeleteRoomRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomRequest) ProtoMessage() {}
+
+func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomResponse) Reset() {
+	*x = DeleteRoomResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomResponse) ProtoMessage() {}
+
+func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoomResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{70}
+}
+
+type Campus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Campus) Reset() {
+	*x = Campus{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Campus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Campus) ProtoMessage() {}
+
+func (x *Campus) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Campus.ProtoReflect.Descriptor instead.
+func (*Campus) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Campus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Campus) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Campus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Building struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CampusId      string                 `protobuf:"bytes,2,opt,name=campus_id,json=campusId,proto3" json:"campus_id,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Building) Reset() {
+	*x = Building{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Building) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Building) ProtoMessage() {}
+
+func (x *Building) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Building.ProtoReflect.Descriptor instead.
+func (*Building) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Building) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Building) GetCampusId() string {
+	if x != nil {
+		return x.CampusId
+	}
+	return ""
+}
+
+func (x *Building) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Building) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CampusTravelTime is one entry of the symmetric travel-time matrix.
+type CampusTravelTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromCampusId  string                 `protobuf:"bytes,1,opt,name=from_campus_id,json=fromCampusId,proto3" json:"from_campus_id,omitempty"`
+	ToCampusId    string                 `protobuf:"bytes,2,opt,name=to_campus_id,json=toCampusId,proto3" json:"to_campus_id,omitempty"`
+	Minutes       int32                  `protobuf:"varint,3,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampusTravelTime) Reset() {
+	*x = CampusTravelTime{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampusTravelTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampusTravelTime) ProtoMessage() {}
+
+func (x *CampusTravelTime) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampusTravelTime.ProtoReflect.Descriptor instead.
+func (*CampusTravelTime) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CampusTravelTime) GetFromCampusId() string {
+	if x != nil {
+		return x.FromCampusId
+	}
+	return ""
+}
+
+func (x *CampusTravelTime) GetToCampusId() string {
+	if x != nil {
+		return x.ToCampusId
+	}
+	return ""
+}
+
+func (x *CampusTravelTime) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+type CreateCampusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampusRequest) Reset() {
+	*x = CreateCampusRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampusRequest) ProtoMessage() {}
+
+func (x *CreateCampusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampusRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampusRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateCampusRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCampusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateCampusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campus        *Campus                `protobuf:"bytes,1,opt,name=campus,proto3" json:"campus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampusResponse) Reset() {
+	*x = CreateCampusResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampusResponse) ProtoMessage() {}
+
+func (x *CreateCampusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampusResponse.ProtoReflect.Descriptor instead.
+func (*CreateCampusResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateCampusResponse) GetCampus() *Campus {
+	if x != nil {
+		return x.Campus
+	}
+	return nil
+}
+
+type ListCampusesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampusesRequest) Reset() {
+	*x = ListCampusesRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampusesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampusesRequest) ProtoMessage() {}
+
+func (x *ListCampusesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampusesRequest.ProtoReflect.Descriptor instead.
+func (*ListCampusesRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{76}
+}
+
+type ListCampusesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campuses      []*Campus              `protobuf:"bytes,1,rep,name=campuses,proto3" json:"campuses,omitempty"`
+	TravelTimes   []*CampusTravelTime    `protobuf:"bytes,2,rep,name=travel_times,json=travelTimes,proto3" json:"travel_times,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampusesResponse) Reset() {
+	*x = ListCampusesResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampusesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampusesResponse) ProtoMessage() {}
+
+func (x *ListCampusesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampusesResponse.ProtoReflect.Descriptor instead.
+func (*ListCampusesResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListCampusesResponse) GetCampuses() []*Campus {
+	if x != nil {
+		return x.Campuses
+	}
+	return nil
+}
+
+func (x *ListCampusesResponse) GetTravelTimes() []*CampusTravelTime {
+	if x != nil {
+		return x.TravelTimes
+	}
+	return nil
+}
+
+type CreateBuildingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampusId      string                 `protobuf:"bytes,1,opt,name=campus_id,json=campusId,proto3" json:"campus_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBuildingRequest) Reset() {
+	*x = CreateBuildingRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBuildingRequest) ProtoMessage() {}
+
+func (x *CreateBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBuildingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateBuildingRequest) GetCampusId() string {
+	if x != nil {
+		return x.CampusId
+	}
+	return ""
+}
+
+func (x *CreateBuildingRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateBuildingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateBuildingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Building      *Building              `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBuildingResponse) Reset() {
+	*x = CreateBuildingResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBuildingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBuildingResponse) ProtoMessage() {}
+
+func (x *CreateBuildingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBuildingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBuildingResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateBuildingResponse) GetBuilding() *Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
+}
+
+type ListBuildingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampusId      string                 `protobuf:"bytes,1,opt,name=campus_id,json=campusId,proto3" json:"campus_id,omitempty"` // optional; empty = every campus
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBuildingsRequest) Reset() {
+	*x = ListBuildingsRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBuildingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuildingsRequest) ProtoMessage() {}
+
+func (x *ListBuildingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuildingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBuildingsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListBuildingsRequest) GetCampusId() string {
+	if x != nil {
+		return x.CampusId
+	}
+	return ""
+}
+
+type ListBuildingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buildings     []*Building            `protobuf:"bytes,1,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBuildingsResponse) Reset() {
+	*x = ListBuildingsResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBuildingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuildingsResponse) ProtoMessage() {}
+
+func (x *ListBuildingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuildingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBuildingsResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListBuildingsResponse) GetBuildings() []*Building {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+type SetCampusTravelTimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromCampusId  string                 `protobuf:"bytes,1,opt,name=from_campus_id,json=fromCampusId,proto3" json:"from_campus_id,omitempty"`
+	ToCampusId    string                 `protobuf:"bytes,2,opt,name=to_campus_id,json=toCampusId,proto3" json:"to_campus_id,omitempty"`
+	Minutes       int32                  `protobuf:"varint,3,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCampusTravelTimeRequest) Reset() {
+	*x = SetCampusTravelTimeRequest{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCampusTravelTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCampusTravelTimeRequest) ProtoMessage() {}
+
+func (x *SetCampusTravelTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCampusTravelTimeRequest.ProtoReflect.Descriptor instead.
+func (*SetCampusTravelTimeRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetCampusTravelTimeRequest) GetFromCampusId() string {
+	if x != nil {
+		return x.FromCampusId
+	}
+	return ""
+}
+
+func (x *SetCampusTravelTimeRequest) GetToCampusId() string {
+	if x != nil {
+		return x.ToCampusId
+	}
+	return ""
+}
+
+func (x *SetCampusTravelTimeRequest) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+type SetCampusTravelTimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TravelTime    *CampusTravelTime      `protobuf:"bytes,1,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCampusTravelTimeResponse) Reset() {
+	*x = SetCampusTravelTimeResponse{}
+	mi := &file_timetable_v1_timetable_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCampusTravelTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCampusTravelTimeResponse) ProtoMessage() {}
+
+func (x *SetCampusTravelTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_v1_timetable_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCampusTravelTimeResponse.ProtoReflect.Descriptor instead.
+func (*SetCampusTravelTimeResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_v1_timetable_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SetCampusTravelTimeResponse) GetTravelTime() *CampusTravelTime {
+	if x != nil {
+		return x.TravelTime
+	}
+	return nil
+}
+
+var File_timetable_v1_timetable_proto protoreflect.FileDescriptor
+
+const file_timetable_v1_timetable_proto_rawDesc = "" +
+	"\n" +
+	"\x1ctimetable/v1/timetable.proto\x12\ftimetable.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfe\x03\n" +
+	"\rScheduleEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tR\tsubjectId\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x03 \x01(\tR\tteacherId\x12\x1e\n" +
+	"\vday_of_week\x18\x04 \x01(\x05R\tdayOfWeek\x12!\n" +
+	"\fstart_period\x18\x05 \x01(\x05R\vstartPeriod\x12\x1d\n" +
+	"\n" +
+	"end_period\x18\x06 \x01(\x05R\tendPeriod\x12\x12\n" +
+	"\x04room\x18\a \x01(\tR\x04room\x12!\n" +
 	"\fsubject_name\x18\b \x01(\tR\vsubjectName\x12!\n" +
 	"\fsubject_code\x18\t \x01(\tR\vsubjectCode\x12!\n" +
 	"\fteacher_name\x18\n" +
 	" \x01(\tR\vteacherName\x12\x1b\n" +
 	"\troom_name\x18\v \x01(\tR\broomName\x12,\n" +
 	"\x12is_manual_override\x18\f \x01(\bR\x10isManualOverride\x12#\n" +
-	"\rdepartment_id\x18\r \x01(\tR\fdepartmentId\"\xad\x02\n" +
+	"\rdepartment_id\x18\r \x01(\tR\fdepartmentId\x12\x18\n" +
+	"\asection\x18\x0e \x01(\x05R\asection\x126\n" +
+	"\bteachers\x18\x0f \x03(\v2\x1a.timetable.v1.EntryTeacherR\bteachers\"d\n" +
+	"\fEntryTeacher\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x12!\n" +
+	"\fteacher_name\x18\x02 \x01(\tR\vteacherName\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"\xd4\x02\n" +
 	"\bSchedule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vsemester_id\x18\x02 \x01(\tR\n" +
@@ -1184,7 +5444,8 @@ const file_timetable_v1_timetable_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
 	"\x05score\x18\x06 \x01(\x01R\x05score\x12'\n" +
 	"\x0fhard_violations\x18\a \x01(\x05R\x0ehardViolations\x12'\n" +
-	"\x0fsoft_violations\x18\b \x01(\x01R\x0esoftViolations\"h\n" +
+	"\x0fsoft_violations\x18\b \x01(\x01R\x0esoftViolations\x12%\n" +
+	"\x0ecohort_clashes\x18\t \x01(\x05R\rcohortClashes\"h\n" +
 	"\x14ListSchedulesRequest\x12\x1f\n" +
 	"\vsemester_id\x18\x01 \x01(\tR\n" +
 	"semesterId\x12\x12\n" +
@@ -1194,20 +5455,37 @@ const file_timetable_v1_timetable_proto_rawDesc = "" +
 	"\tschedules\x18\x01 \x03(\v2\x16.timetable.v1.ScheduleR\tschedules\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"c\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe3\x02\n" +
 	"\x17GenerateScheduleRequest\x12\x1f\n" +
 	"\vsemester_id\x18\x01 \x01(\tR\n" +
 	"semesterId\x12'\n" +
-	"\x0ftimeout_seconds\x18\x02 \x01(\x05R\x0etimeoutSeconds\"\x98\x01\n" +
+	"\x0ftimeout_seconds\x18\x02 \x01(\x05R\x0etimeoutSeconds\x120\n" +
+	"\x14bind_session_teacher\x18\x03 \x01(\bR\x12bindSessionTeacher\x12*\n" +
+	"\x11soft_cohort_clash\x18\x04 \x01(\bR\x0fsoftCohortClash\x122\n" +
+	"\x04pins\x18\x05 \x03(\v2\x1e.timetable.v1.PinnedAssignmentR\x04pins\x121\n" +
+	"\x15lock_from_schedule_id\x18\x06 \x01(\tR\x12lockFromScheduleId\x12\x12\n" +
+	"\x04seed\x18\a \x01(\x04R\x04seed\x12%\n" +
+	"\x0eportfolio_size\x18\b \x01(\x05R\rportfolioSize\"\xbf\x01\n" +
+	"\x10PinnedAssignment\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x01 \x01(\tR\tsubjectId\x12\x18\n" +
+	"\asession\x18\x02 \x01(\x05R\asession\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x03 \x01(\tR\tteacherId\x12\x17\n" +
+	"\aroom_id\x18\x04 \x01(\tR\x06roomId\x12 \n" +
+	"\ftime_slot_id\x18\x05 \x01(\tR\n" +
+	"timeSlotId\x12\x18\n" +
+	"\asection\x18\x06 \x01(\x05R\asection\"\xac\x01\n" +
 	"\x18GenerateScheduleResponse\x122\n" +
 	"\bschedule\x18\x01 \x01(\v2\x16.timetable.v1.ScheduleR\bschedule\x12\x1d\n" +
 	"\n" +
 	"is_partial\x18\x02 \x01(\bR\tisPartial\x12)\n" +
-	"\x10unassigned_count\x18\x03 \x01(\x05R\x0funassignedCount\"$\n" +
+	"\x10unassigned_count\x18\x03 \x01(\x05R\x0funassignedCount\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x04R\x04seed\"$\n" +
 	"\x12GetScheduleRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
 	"\x13GetScheduleResponse\x122\n" +
-	"\bschedule\x18\x01 \x01(\v2\x16.timetable.v1.ScheduleR\bschedule\"\xce\x02\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.timetable.v1.ScheduleR\bschedule\"\xe7\x02\n" +
 	"\x1aUpdateScheduleEntryRequest\x12\x1f\n" +
 	"\vschedule_id\x18\x01 \x01(\tR\n" +
 	"scheduleId\x12\x19\n" +
@@ -1218,44 +5496,382 @@ const file_timetable_v1_timetable_proto_rawDesc = "" +
 	"\fstart_period\x18\x05 \x01(\x05H\x02R\vstartPeriod\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"end_period\x18\x06 \x01(\x05H\x03R\tendPeriod\x88\x01\x01\x12\x17\n" +
-	"\x04room\x18\a \x01(\tH\x04R\x04room\x88\x01\x01B\r\n" +
+	"\x04room\x18\a \x01(\tH\x04R\x04room\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\b \x01(\bR\x06dryRunB\r\n" +
 	"\v_teacher_idB\x0e\n" +
 	"\f_day_of_weekB\x0f\n" +
 	"\r_start_periodB\r\n" +
 	"\v_end_periodB\a\n" +
-	"\x05_room\"P\n" +
+	"\x05_room\"\xf3\x01\n" +
 	"\x1bUpdateScheduleEntryResponse\x121\n" +
-	"\x05entry\x18\x01 \x01(\v2\x1b.timetable.v1.ScheduleEntryR\x05entry\"\x99\x01\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1b.timetable.v1.ScheduleEntryR\x05entry\x12?\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\x1f.timetable.v1.ScheduleViolationR\n" +
+	"violations\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12'\n" +
+	"\x0fhard_violations\x18\x04 \x01(\x05R\x0ehardViolations\x12!\n" +
+	"\fsoft_penalty\x18\x05 \x01(\x01R\vsoftPenalty\"\xf7\x01\n" +
 	"\x16SuggestTeachersRequest\x12\x1d\n" +
 	"\n" +
 	"subject_id\x18\x01 \x01(\tR\tsubjectId\x12\x1e\n" +
 	"\vday_of_week\x18\x02 \x01(\x05R\tdayOfWeek\x12!\n" +
 	"\fstart_period\x18\x03 \x01(\x05R\vstartPeriod\x12\x1d\n" +
 	"\n" +
-	"end_period\x18\x04 \x01(\x05R\tendPeriod\"\\\n" +
+	"end_period\x18\x04 \x01(\x05R\tendPeriod\x12\x1f\n" +
+	"\vschedule_id\x18\x05 \x01(\tR\n" +
+	"scheduleId\x12\x19\n" +
+	"\bentry_id\x18\x06 \x01(\tR\aentryId\x12 \n" +
+	"\ftime_slot_id\x18\a \x01(\tR\n" +
+	"timeSlotId\"\\\n" +
 	"\x17SuggestTeachersResponse\x12A\n" +
-	"\vsuggestions\x18\x01 \x03(\v2\x1f.timetable.v1.TeacherSuggestionR\vsuggestions\"k\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1f.timetable.v1.TeacherSuggestionR\vsuggestions\"\xa3\x01\n" +
 	"\x11TeacherSuggestion\x12\x1d\n" +
 	"\n" +
 	"teacher_id\x18\x01 \x01(\tR\tteacherId\x12!\n" +
 	"\fteacher_name\x18\x02 \x01(\tR\vteacherName\x12\x14\n" +
-	"\x05score\x18\x03 \x01(\x02R\x05score\"p\n" +
+	"\x05score\x18\x03 \x01(\x02R\x05score\x12\x18\n" +
+	"\areasons\x18\x04 \x03(\tR\areasons\x12\x1c\n" +
+	"\tavailable\x18\x05 \x01(\bR\tavailable\"\xc4\x01\n" +
 	"\x13ManualAssignRequest\x12\x1f\n" +
 	"\vschedule_id\x18\x01 \x01(\tR\n" +
 	"scheduleId\x12\x19\n" +
 	"\bentry_id\x18\x02 \x01(\tR\aentryId\x12\x1d\n" +
 	"\n" +
-	"teacher_id\x18\x03 \x01(\tR\tteacherId\"I\n" +
+	"teacher_id\x18\x03 \x01(\tR\tteacherId\x12\x17\n" +
+	"\aroom_id\x18\x04 \x01(\tR\x06roomId\x12 \n" +
+	"\ftime_slot_id\x18\x05 \x01(\tR\n" +
+	"timeSlotId\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"\xec\x01\n" +
 	"\x14ManualAssignResponse\x121\n" +
-	"\x05entry\x18\x01 \x01(\v2\x1b.timetable.v1.ScheduleEntryR\x05entry\"c\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1b.timetable.v1.ScheduleEntryR\x05entry\x12?\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\x1f.timetable.v1.ScheduleViolationR\n" +
+	"violations\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12'\n" +
+	"\x0fhard_violations\x18\x04 \x01(\x05R\x0ehardViolations\x12!\n" +
+	"\fsoft_penalty\x18\x05 \x01(\x01R\vsoftPenalty\"\x93\x01\n" +
+	"\x11ScheduleViolation\x12\x1e\n" +
+	"\n" +
+	"constraint\x18\x01 \x01(\tR\n" +
+	"constraint\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\tR\aentryId\x12$\n" +
+	"\x0eother_entry_id\x18\x03 \x01(\tR\fotherEntryId\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x04 \x01(\tR\tteacherId\"\xac\x02\n" +
 	"\x04Room\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcapacity\x18\x03 \x01(\x05R\bcapacity\x12\x1b\n" +
-	"\troom_type\x18\x04 \x01(\tR\broomType\"\x12\n" +
-	"\x10ListRoomsRequest\"=\n" +
+	"\troom_type\x18\x04 \x01(\tR\broomType\x12+\n" +
+	"\x11requires_approval\x18\x05 \x01(\bR\x10requiresApproval\x12\x1a\n" +
+	"\bfeatures\x18\x06 \x03(\tR\bfeatures\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x12\x1f\n" +
+	"\vbuilding_id\x18\b \x01(\tR\n" +
+	"buildingId\x12#\n" +
+	"\rbuilding_code\x18\t \x01(\tR\fbuildingCode\x12\x1b\n" +
+	"\tcampus_id\x18\n" +
+	" \x01(\tR\bcampusId\"=\n" +
+	"\x10ListRoomsRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"=\n" +
 	"\x11ListRoomsResponse\x12(\n" +
-	"\x05rooms\x18\x01 \x03(\v2\x12.timetable.v1.RoomR\x05rooms2\x94\x05\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x12.timetable.v1.RoomR\x05rooms\"=\n" +
+	"\x1aGetGenerationStatusRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\"\xe3\x01\n" +
+	"\x15RepairScheduleRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12.\n" +
+	"\x13removed_teacher_ids\x18\x02 \x03(\tR\x11removedTeacherIds\x12&\n" +
+	"\x0fclosed_room_ids\x18\x03 \x03(\tR\rclosedRoomIds\x12(\n" +
+	"\x10removed_slot_ids\x18\x04 \x03(\tR\x0eremovedSlotIds\x12'\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x05R\x0etimeoutSeconds\"\xfa\x01\n" +
+	"\x16RepairScheduleResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.timetable.v1.ScheduleR\bschedule\x12)\n" +
+	"\x10affected_entries\x18\x02 \x01(\x05R\x0faffectedEntries\x12-\n" +
+	"\x12reassigned_entries\x18\x03 \x01(\x05R\x11reassignedEntries\x12#\n" +
+	"\rmoved_entries\x18\x04 \x01(\x05R\fmovedEntries\x12-\n" +
+	"\x12unresolved_entries\x18\x05 \x01(\x05R\x11unresolvedEntries\"\xe0\x02\n" +
+	"\x1bGetGenerationStatusResponse\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\vis_complete\x18\x03 \x01(\bR\n" +
+	"isComplete\x12\x1d\n" +
+	"\n" +
+	"is_partial\x18\x04 \x01(\bR\tisPartial\x12\x1b\n" +
+	"\tis_failed\x18\x05 \x01(\bR\bisFailed\x12%\n" +
+	"\x0efailure_reason\x18\x06 \x01(\tR\rfailureReason\x12B\n" +
+	"\tdiagnosis\x18\a \x01(\v2$.timetable.v1.InfeasibilityDiagnosisR\tdiagnosis\x12\x1d\n" +
+	"\n" +
+	"job_status\x18\b \x01(\tR\tjobStatus\x12!\n" +
+	"\fjob_attempts\x18\t \x01(\x05R\vjobAttempts\":\n" +
+	"\x17CancelGenerationRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\"Z\n" +
+	"\x18CancelGenerationResponse\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x1d\n" +
+	"\n" +
+	"job_status\x18\x02 \x01(\tR\tjobStatus\"\xea\x01\n" +
+	"\x16InfeasibilityDiagnosis\x12>\n" +
+	"\rempty_domains\x18\x01 \x03(\v2\x19.timetable.v1.EmptyDomainR\femptyDomains\x12G\n" +
+	"\x11conflict_subjects\x18\x02 \x03(\v2\x1a.timetable.v1.DiagnosisRefR\x10conflictSubjects\x12G\n" +
+	"\x11conflict_teachers\x18\x03 \x03(\v2\x1a.timetable.v1.DiagnosisRefR\x10conflictTeachers\"\xcc\x02\n" +
+	"\vEmptyDomain\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x01 \x01(\tR\tsubjectId\x12!\n" +
+	"\fsubject_code\x18\x02 \x01(\tR\vsubjectCode\x12!\n" +
+	"\fsubject_name\x18\x03 \x01(\tR\vsubjectName\x12\x18\n" +
+	"\asession\x18\x04 \x01(\x05R\asession\x12\x1e\n" +
+	"\n" +
+	"candidates\x18\x05 \x01(\x05R\n" +
+	"candidates\x12\x14\n" +
+	"\x05cause\x18\x06 \x01(\tR\x05cause\x12I\n" +
+	"\n" +
+	"eliminated\x18\a \x03(\v2).timetable.v1.EmptyDomain.EliminatedEntryR\n" +
+	"eliminated\x1a=\n" +
+	"\x0fEliminatedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"2\n" +
+	"\fDiagnosisRef\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xd5\x02\n" +
+	"\x0fScheduleVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vsemester_id\x18\x02 \x01(\tR\n" +
+	"semesterId\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\x12'\n" +
+	"\x0fhard_violations\x18\x06 \x01(\x05R\x0ehardViolations\x12!\n" +
+	"\fsoft_penalty\x18\a \x01(\x01R\vsoftPenalty\x12=\n" +
+	"\fpublished_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x125\n" +
+	"\aentries\x18\t \x03(\v2\x1b.timetable.v1.ScheduleEntryR\aentries\"9\n" +
+	"\x16PublishScheduleRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\"\xba\x01\n" +
+	"\x17PublishScheduleResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.timetable.v1.ScheduleR\bschedule\x127\n" +
+	"\aversion\x18\x02 \x01(\v2\x1d.timetable.v1.ScheduleVersionR\aversion\x122\n" +
+	"\x15archived_schedule_ids\x18\x03 \x03(\tR\x13archivedScheduleIds\";\n" +
+	"\x18UnpublishScheduleRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\"O\n" +
+	"\x19UnpublishScheduleResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.timetable.v1.ScheduleR\bschedule\"9\n" +
+	"\x16ArchiveScheduleRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\"M\n" +
+	"\x17ArchiveScheduleResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.timetable.v1.ScheduleR\bschedule\">\n" +
+	"\x1bListScheduleVersionsRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\"Y\n" +
+	"\x1cListScheduleVersionsResponse\x129\n" +
+	"\bversions\x18\x01 \x03(\v2\x1d.timetable.v1.ScheduleVersionR\bversions\"V\n" +
+	"\x19GetScheduleVersionRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"U\n" +
+	"\x1aGetScheduleVersionResponse\x127\n" +
+	"\aversion\x18\x01 \x01(\v2\x1d.timetable.v1.ScheduleVersionR\aversion\"T\n" +
+	"\x17RollbackScheduleRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\xbb\x01\n" +
+	"\x18RollbackScheduleResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.timetable.v1.ScheduleR\bschedule\x127\n" +
+	"\aversion\x18\x02 \x01(\v2\x1d.timetable.v1.ScheduleVersionR\aversion\x122\n" +
+	"\x15archived_schedule_ids\x18\x03 \x03(\tR\x13archivedScheduleIds\"\xd9\x01\n" +
+	"\x14DiffSchedulesRequest\x12(\n" +
+	"\x10base_schedule_id\x18\x01 \x01(\tR\x0ebaseScheduleId\x12,\n" +
+	"\x12target_schedule_id\x18\x02 \x01(\tR\x10targetScheduleId\x12\x1f\n" +
+	"\vsemester_id\x18\x03 \x01(\tR\n" +
+	"semesterId\x12!\n" +
+	"\fbase_version\x18\x04 \x01(\x05R\vbaseVersion\x12%\n" +
+	"\x0etarget_version\x18\x05 \x01(\x05R\rtargetVersion\"\xbc\x02\n" +
+	"\x0eScheduleChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tR\tsubjectId\x123\n" +
+	"\x06before\x18\x03 \x01(\v2\x1b.timetable.v1.ScheduleEntryR\x06before\x121\n" +
+	"\x05after\x18\x04 \x01(\v2\x1b.timetable.v1.ScheduleEntryR\x05after\x12'\n" +
+	"\x0fteacher_changed\x18\x05 \x01(\bR\x0eteacherChanged\x12!\n" +
+	"\froom_changed\x18\x06 \x01(\bR\vroomChanged\x12!\n" +
+	"\fslot_changed\x18\a \x01(\bR\vslotChanged\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\"}\n" +
+	"\x15ResourceChangeSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06gained\x18\x03 \x01(\x05R\x06gained\x12\x12\n" +
+	"\x04lost\x18\x04 \x01(\x05R\x04lost\x12\x14\n" +
+	"\x05moved\x18\x05 \x01(\x05R\x05moved\"\xd6\x01\n" +
+	"\x16ExportICalendarRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x1f\n" +
+	"\vsemester_id\x18\x02 \x01(\tR\n" +
+	"semesterId\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x03 \x01(\tR\tteacherId\x12\x17\n" +
+	"\aroom_id\x18\x04 \x01(\tR\x06roomId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x05 \x01(\tR\tstudentId\x12#\n" +
+	"\rdepartment_id\x18\x06 \x01(\tR\fdepartmentId\"Q\n" +
+	"\x17ExportICalendarResponse\x12\x1a\n" +
+	"\bcalendar\x18\x01 \x01(\fR\bcalendar\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"\xe9\x01\n" +
+	"\x15DiffSchedulesResponse\x126\n" +
+	"\achanges\x18\x01 \x03(\v2\x1c.timetable.v1.ScheduleChangeR\achanges\x12?\n" +
+	"\bteachers\x18\x02 \x03(\v2#.timetable.v1.ResourceChangeSummaryR\bteachers\x129\n" +
+	"\x05rooms\x18\x03 \x03(\v2#.timetable.v1.ResourceChangeSummaryR\x05rooms\x12\x1c\n" +
+	"\tunchanged\x18\x04 \x01(\x05R\tunchanged\"O\n" +
+	"\vExamSession\x12!\n" +
+	"\fstart_period\x18\x01 \x01(\x05R\vstartPeriod\x12\x1d\n" +
+	"\n" +
+	"end_period\x18\x02 \x01(\x05R\tendPeriod\"\xd7\x01\n" +
+	"\fExamSettings\x125\n" +
+	"\bsessions\x18\x01 \x03(\v2\x19.timetable.v1.ExamSessionR\bsessions\x12\x1e\n" +
+	"\vmax_per_day\x18\x02 \x01(\x05R\tmaxPerDay\x12!\n" +
+	"\fseat_spacing\x18\x03 \x01(\x05R\vseatSpacing\x12 \n" +
+	"\fmin_gap_days\x18\x04 \x01(\x05R\n" +
+	"minGapDays\x12+\n" +
+	"\x11max_invigilations\x18\x05 \x01(\x05R\x10maxInvigilations\"\x8f\x03\n" +
+	"\tExamEntry\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x01 \x01(\tR\tsubjectId\x12!\n" +
+	"\fsubject_code\x18\x02 \x01(\tR\vsubjectCode\x12!\n" +
+	"\fsubject_name\x18\x03 \x01(\tR\vsubjectName\x12.\n" +
+	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12!\n" +
+	"\fstart_period\x18\x05 \x01(\x05R\vstartPeriod\x12\x1d\n" +
+	"\n" +
+	"end_period\x18\x06 \x01(\x05R\tendPeriod\x12\x17\n" +
+	"\aroom_id\x18\a \x01(\tR\x06roomId\x12\x1b\n" +
+	"\troom_name\x18\b \x01(\tR\broomName\x12%\n" +
+	"\x0einvigilator_id\x18\t \x01(\tR\rinvigilatorId\x12)\n" +
+	"\x10invigilator_name\x18\n" +
+	" \x01(\tR\x0finvigilatorName\x12#\n" +
+	"\rstudent_count\x18\v \x01(\x05R\fstudentCount\"\xdc\x03\n" +
+	"\fExamSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vsemester_id\x18\x02 \x01(\tR\n" +
+	"semesterId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x126\n" +
+	"\bsettings\x18\x06 \x01(\v2\x1a.timetable.v1.ExamSettingsR\bsettings\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\x12!\n" +
+	"\fsoft_penalty\x18\b \x01(\x01R\vsoftPenalty\x121\n" +
+	"\aentries\x18\t \x03(\v2\x17.timetable.v1.ExamEntryR\aentries\x126\n" +
+	"\x17unscheduled_subject_ids\x18\n" +
+	" \x03(\tR\x15unscheduledSubjectIds\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa5\x02\n" +
+	"\x1bGenerateExamScheduleRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x126\n" +
+	"\bsettings\x18\x05 \x01(\v2\x1a.timetable.v1.ExamSettingsR\bsettings\x12'\n" +
+	"\x0ftimeout_seconds\x18\x06 \x01(\x05R\x0etimeoutSeconds\"_\n" +
+	"\x1cGenerateExamScheduleResponse\x12?\n" +
+	"\rexam_schedule\x18\x01 \x01(\v2\x1a.timetable.v1.ExamScheduleR\fexamSchedule\"(\n" +
+	"\x16GetExamScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x17GetExamScheduleResponse\x12?\n" +
+	"\rexam_schedule\x18\x01 \x01(\v2\x1a.timetable.v1.ExamScheduleR\fexamSchedule\";\n" +
+	"\x18ListExamSchedulesRequest\x12\x1f\n" +
+	"\vsemester_id\x18\x01 \x01(\tR\n" +
+	"semesterId\"^\n" +
+	"\x19ListExamSchedulesResponse\x12A\n" +
+	"\x0eexam_schedules\x18\x01 \x03(\v2\x1a.timetable.v1.ExamScheduleR\rexamSchedules\"\xca\x01\n" +
+	"\x11CreateRoomRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12\x1b\n" +
+	"\troom_type\x18\x03 \x01(\tR\broomType\x12\x1a\n" +
+	"\bfeatures\x18\x04 \x03(\tR\bfeatures\x12+\n" +
+	"\x11requires_approval\x18\x05 \x01(\bR\x10requiresApproval\x12\x1f\n" +
+	"\vbuilding_id\x18\x06 \x01(\tR\n" +
+	"buildingId\"<\n" +
+	"\x12CreateRoomResponse\x12&\n" +
+	"\x04room\x18\x01 \x01(\v2\x12.timetable.v1.RoomR\x04room\" \n" +
+	"\x0eGetRoomRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x0fGetRoomResponse\x12&\n" +
+	"\x04room\x18\x01 \x01(\v2\x12.timetable.v1.RoomR\x04room\"\xe8\x02\n" +
+	"\x11UpdateRoomRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\bcapacity\x18\x03 \x01(\x05H\x01R\bcapacity\x88\x01\x01\x12 \n" +
+	"\troom_type\x18\x04 \x01(\tH\x02R\broomType\x88\x01\x01\x12\x1a\n" +
+	"\bfeatures\x18\x05 \x03(\tR\bfeatures\x12)\n" +
+	"\x10replace_features\x18\x06 \x01(\bR\x0freplaceFeatures\x120\n" +
+	"\x11requires_approval\x18\a \x01(\bH\x03R\x10requiresApproval\x88\x01\x01\x12$\n" +
+	"\vbuilding_id\x18\b \x01(\tH\x04R\n" +
+	"buildingId\x88\x01\x01B\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_capacityB\f\n" +
+	"\n" +
+	"_room_typeB\x14\n" +
+	"\x12_requires_approvalB\x0e\n" +
+	"\f_building_id\"<\n" +
+	"\x12UpdateRoomResponse\x12&\n" +
+	"\x04room\x18\x01 \x01(\v2\x12.timetable.v1.RoomR\x04room\"'\n" +
+	"\x15DeactivateRoomRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x16DeactivateRoomResponse\x12&\n" +
+	"\x04room\x18\x01 \x01(\v2\x12.timetable.v1.RoomR\x04room\"%\n" +
+	"\x13ActivateRoomRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x14ActivateRoomResponse\x12&\n" +
+	"\x04room\x18\x01 \x01(\v2\x12.timetable.v1.RoomR\x04room\"#\n" +
+	"\x11DeleteRoomRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteRoomResponse\"@\n" +
+	"\x06Campus\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"_\n" +
+	"\bBuilding\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcampus_id\x18\x02 \x01(\tR\bcampusId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\"t\n" +
+	"\x10CampusTravelTime\x12$\n" +
+	"\x0efrom_campus_id\x18\x01 \x01(\tR\ffromCampusId\x12 \n" +
+	"\fto_campus_id\x18\x02 \x01(\tR\n" +
+	"toCampusId\x12\x18\n" +
+	"\aminutes\x18\x03 \x01(\x05R\aminutes\"=\n" +
+	"\x13CreateCampusRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"D\n" +
+	"\x14CreateCampusResponse\x12,\n" +
+	"\x06campus\x18\x01 \x01(\v2\x14.timetable.v1.CampusR\x06campus\"\x15\n" +
+	"\x13ListCampusesRequest\"\x8b\x01\n" +
+	"\x14ListCampusesResponse\x120\n" +
+	"\bcampuses\x18\x01 \x03(\v2\x14.timetable.v1.CampusR\bcampuses\x12A\n" +
+	"\ftravel_times\x18\x02 \x03(\v2\x1e.timetable.v1.CampusTravelTimeR\vtravelTimes\"\\\n" +
+	"\x15CreateBuildingRequest\x12\x1b\n" +
+	"\tcampus_id\x18\x01 \x01(\tR\bcampusId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"L\n" +
+	"\x16CreateBuildingResponse\x122\n" +
+	"\bbuilding\x18\x01 \x01(\v2\x16.timetable.v1.BuildingR\bbuilding\"3\n" +
+	"\x14ListBuildingsRequest\x12\x1b\n" +
+	"\tcampus_id\x18\x01 \x01(\tR\bcampusId\"M\n" +
+	"\x15ListBuildingsResponse\x124\n" +
+	"\tbuildings\x18\x01 \x03(\v2\x16.timetable.v1.BuildingR\tbuildings\"~\n" +
+	"\x1aSetCampusTravelTimeRequest\x12$\n" +
+	"\x0efrom_campus_id\x18\x01 \x01(\tR\ffromCampusId\x12 \n" +
+	"\fto_campus_id\x18\x02 \x01(\tR\n" +
+	"toCampusId\x12\x18\n" +
+	"\aminutes\x18\x03 \x01(\x05R\aminutes\"^\n" +
+	"\x1bSetCampusTravelTimeResponse\x12?\n" +
+	"\vtravel_time\x18\x01 \x01(\v2\x1e.timetable.v1.CampusTravelTimeR\n" +
+	"travelTime2\xd0\x17\n" +
 	"\x10TimetableService\x12a\n" +
 	"\x10GenerateSchedule\x12%.timetable.v1.GenerateScheduleRequest\x1a&.timetable.v1.GenerateScheduleResponse\x12R\n" +
 	"\vGetSchedule\x12 .timetable.v1.GetScheduleRequest\x1a!.timetable.v1.GetScheduleResponse\x12X\n" +
@@ -1263,7 +5879,35 @@ const file_timetable_v1_timetable_proto_rawDesc = "" +
 	"\x13UpdateScheduleEntry\x12(.timetable.v1.UpdateScheduleEntryRequest\x1a).timetable.v1.UpdateScheduleEntryResponse\x12^\n" +
 	"\x0fSuggestTeachers\x12$.timetable.v1.SuggestTeachersRequest\x1a%.timetable.v1.SuggestTeachersResponse\x12U\n" +
 	"\fManualAssign\x12!.timetable.v1.ManualAssignRequest\x1a\".timetable.v1.ManualAssignResponse\x12L\n" +
-	"\tListRooms\x12\x1e.timetable.v1.ListRoomsRequest\x1a\x1f.timetable.v1.ListRoomsResponseBBZ@github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1;timetablev1b\x06proto3"
+	"\tListRooms\x12\x1e.timetable.v1.ListRoomsRequest\x1a\x1f.timetable.v1.ListRoomsResponse\x12j\n" +
+	"\x13GetGenerationStatus\x12(.timetable.v1.GetGenerationStatusRequest\x1a).timetable.v1.GetGenerationStatusResponse\x12[\n" +
+	"\x0eRepairSchedule\x12#.timetable.v1.RepairScheduleRequest\x1a$.timetable.v1.RepairScheduleResponse\x12a\n" +
+	"\x10CancelGeneration\x12%.timetable.v1.CancelGenerationRequest\x1a&.timetable.v1.CancelGenerationResponse\x12^\n" +
+	"\x0fPublishSchedule\x12$.timetable.v1.PublishScheduleRequest\x1a%.timetable.v1.PublishScheduleResponse\x12d\n" +
+	"\x11UnpublishSchedule\x12&.timetable.v1.UnpublishScheduleRequest\x1a'.timetable.v1.UnpublishScheduleResponse\x12^\n" +
+	"\x0fArchiveSchedule\x12$.timetable.v1.ArchiveScheduleRequest\x1a%.timetable.v1.ArchiveScheduleResponse\x12m\n" +
+	"\x14ListScheduleVersions\x12).timetable.v1.ListScheduleVersionsRequest\x1a*.timetable.v1.ListScheduleVersionsResponse\x12g\n" +
+	"\x12GetScheduleVersion\x12'.timetable.v1.GetScheduleVersionRequest\x1a(.timetable.v1.GetScheduleVersionResponse\x12a\n" +
+	"\x10RollbackSchedule\x12%.timetable.v1.RollbackScheduleRequest\x1a&.timetable.v1.RollbackScheduleResponse\x12X\n" +
+	"\rDiffSchedules\x12\".timetable.v1.DiffSchedulesRequest\x1a#.timetable.v1.DiffSchedulesResponse\x12^\n" +
+	"\x0fExportICalendar\x12$.timetable.v1.ExportICalendarRequest\x1a%.timetable.v1.ExportICalendarResponse\x12m\n" +
+	"\x14GenerateExamSchedule\x12).timetable.v1.GenerateExamScheduleRequest\x1a*.timetable.v1.GenerateExamScheduleResponse\x12^\n" +
+	"\x0fGetExamSchedule\x12$.timetable.v1.GetExamScheduleRequest\x1a%.timetable.v1.GetExamScheduleResponse\x12d\n" +
+	"\x11ListExamSchedules\x12&.timetable.v1.ListExamSchedulesRequest\x1a'.timetable.v1.ListExamSchedulesResponse\x12O\n" +
+	"\n" +
+	"CreateRoom\x12\x1f.timetable.v1.CreateRoomRequest\x1a .timetable.v1.CreateRoomResponse\x12F\n" +
+	"\aGetRoom\x12\x1c.timetable.v1.GetRoomRequest\x1a\x1d.timetable.v1.GetRoomResponse\x12O\n" +
+	"\n" +
+	"UpdateRoom\x12\x1f.timetable.v1.UpdateRoomRequest\x1a .timetable.v1.UpdateRoomResponse\x12[\n" +
+	"\x0eDeactivateRoom\x12#.timetable.v1.DeactivateRoomRequest\x1a$.timetable.v1.DeactivateRoomResponse\x12U\n" +
+	"\fActivateRoom\x12!.timetable.v1.ActivateRoomRequest\x1a\".timetable.v1.ActivateRoomResponse\x12O\n" +
+	"\n" +
+	"DeleteRoom\x12\x1f.timetable.v1.DeleteRoomRequest\x1a .timetable.v1.DeleteRoomResponse\x12U\n" +
+	"\fCreateCampus\x12!.timetable.v1.CreateCampusRequest\x1a\".timetable.v1.CreateCampusResponse\x12U\n" +
+	"\fListCampuses\x12!.timetable.v1.ListCampusesRequest\x1a\".timetable.v1.ListCampusesResponse\x12[\n" +
+	"\x0eCreateBuilding\x12#.timetable.v1.CreateBuildingRequest\x1a$.timetable.v1.CreateBuildingResponse\x12X\n" +
+	"\rListBuildings\x12\".timetable.v1.ListBuildingsRequest\x1a#.timetable.v1.ListBuildingsResponse\x12j\n" +
+	"\x13SetCampusTravelTime\x12(.timetable.v1.SetCampusTravelTimeRequest\x1a).timetable.v1.SetCampusTravelTimeResponseBBZ@github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1;timetablev1b\x06proto3"
 
 var (
 	file_timetable_v1_timetable_proto_rawDescOnce sync.Once
@@ -1277,57 +5921,223 @@ func file_timetable_v1_timetable_proto_rawDescGZIP() []byte {
 	return file_timetable_v1_timetable_proto_rawDescData
 }
 
-var file_timetable_v1_timetable_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_timetable_v1_timetable_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_timetable_v1_timetable_proto_goTypes = []any{
-	(*ScheduleEntry)(nil),               // 0: timetable.v1.ScheduleEntry
-	(*Schedule)(nil),                    // 1: timetable.v1.Schedule
-	(*ListSchedulesRequest)(nil),        // 2: timetable.v1.ListSchedulesRequest
-	(*ListSchedulesResponse)(nil),       // 3: timetable.v1.ListSchedulesResponse
-	(*GenerateScheduleRequest)(nil),     // 4: timetable.v1.GenerateScheduleRequest
-	(*GenerateScheduleResponse)(nil),    // 5: timetable.v1.GenerateScheduleResponse
-	(*GetScheduleRequest)(nil),          // 6: timetable.v1.GetScheduleRequest
-	(*GetScheduleResponse)(nil),         // 7: timetable.v1.GetScheduleResponse
-	(*UpdateScheduleEntryRequest)(nil),  // 8: timetable.v1.UpdateScheduleEntryRequest
-	(*UpdateScheduleEntryResponse)(nil), // 9: timetable.v1.UpdateScheduleEntryResponse
-	(*SuggestTeachersRequest)(nil),      // 10: timetable.v1.SuggestTeachersRequest
-	(*SuggestTeachersResponse)(nil),     // 11: timetable.v1.SuggestTeachersResponse
-	(*TeacherSuggestion)(nil),           // 12: timetable.v1.TeacherSuggestion
-	(*ManualAssignRequest)(nil),         // 13: timetable.v1.ManualAssignRequest
-	(*ManualAssignResponse)(nil),        // 14: timetable.v1.ManualAssignResponse
-	(*Room)(nil),                        // 15: timetable.v1.Room
-	(*ListRoomsRequest)(nil),            // 16: timetable.v1.ListRoomsRequest
-	(*ListRoomsResponse)(nil),           // 17: timetable.v1.ListRoomsResponse
-	(*timestamppb.Timestamp)(nil),       // 18: google.protobuf.Timestamp
+	(*ScheduleEntry)(nil),                // 0: timetable.v1.ScheduleEntry
+	(*EntryTeacher)(nil),                 // 1: timetable.v1.EntryTeacher
+	(*Schedule)(nil),                     // 2: timetable.v1.Schedule
+	(*ListSchedulesRequest)(nil),         // 3: timetable.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),        // 4: timetable.v1.ListSchedulesResponse
+	(*GenerateScheduleRequest)(nil),      // 5: timetable.v1.GenerateScheduleRequest
+	(*PinnedAssignment)(nil),             // 6: timetable.v1.PinnedAssignment
+	(*GenerateScheduleResponse)(nil),     // 7: timetable.v1.GenerateScheduleResponse
+	(*GetScheduleRequest)(nil),           // 8: timetable.v1.GetScheduleRequest
+	(*GetScheduleResponse)(nil),          // 9: timetable.v1.GetScheduleResponse
+	(*UpdateScheduleEntryRequest)(nil),   // 10: timetable.v1.UpdateScheduleEntryRequest
+	(*UpdateScheduleEntryResponse)(nil),  // 11: timetable.v1.UpdateScheduleEntryResponse
+	(*SuggestTeachersRequest)(nil),       // 12: timetable.v1.SuggestTeachersRequest
+	(*SuggestTeachersResponse)(nil),      // 13: timetable.v1.SuggestTeachersResponse
+	(*TeacherSuggestion)(nil),            // 14: timetable.v1.TeacherSuggestion
+	(*ManualAssignRequest)(nil),          // 15: timetable.v1.ManualAssignRequest
+	(*ManualAssignResponse)(nil),         // 16: timetable.v1.ManualAssignResponse
+	(*ScheduleViolation)(nil),            // 17: timetable.v1.ScheduleViolation
+	(*Room)(nil),                         // 18: timetable.v1.Room
+	(*ListRoomsRequest)(nil),             // 19: timetable.v1.ListRoomsRequest
+	(*ListRoomsResponse)(nil),            // 20: timetable.v1.ListRoomsResponse
+	(*GetGenerationStatusRequest)(nil),   // 21: timetable.v1.GetGenerationStatusRequest
+	(*RepairScheduleRequest)(nil),        // 22: timetable.v1.RepairScheduleRequest
+	(*RepairScheduleResponse)(nil),       // 23: timetable.v1.RepairScheduleResponse
+	(*GetGenerationStatusResponse)(nil),  // 24: timetable.v1.GetGenerationStatusResponse
+	(*CancelGenerationRequest)(nil),      // 25: timetable.v1.CancelGenerationRequest
+	(*CancelGenerationResponse)(nil),     // 26: timetable.v1.CancelGenerationResponse
+	(*InfeasibilityDiagnosis)(nil),       // 27: timetable.v1.InfeasibilityDiagnosis
+	(*EmptyDomain)(nil),                  // 28: timetable.v1.EmptyDomain
+	(*DiagnosisRef)(nil),                 // 29: timetable.v1.DiagnosisRef
+	(*ScheduleVersion)(nil),              // 30: timetable.v1.ScheduleVersion
+	(*PublishScheduleRequest)(nil),       // 31: timetable.v1.PublishScheduleRequest
+	(*PublishScheduleResponse)(nil),      // 32: timetable.v1.PublishScheduleResponse
+	(*UnpublishScheduleRequest)(nil),     // 33: timetable.v1.UnpublishScheduleRequest
+	(*UnpublishScheduleResponse)(nil),    // 34: timetable.v1.UnpublishScheduleResponse
+	(*ArchiveScheduleRequest)(nil),       // 35: timetable.v1.ArchiveScheduleRequest
+	(*ArchiveScheduleResponse)(nil),      // 36: timetable.v1.ArchiveScheduleResponse
+	(*ListScheduleVersionsRequest)(nil),  // 37: timetable.v1.ListScheduleVersionsRequest
+	(*ListScheduleVersionsResponse)(nil), // 38: timetable.v1.ListScheduleVersionsResponse
+	(*GetScheduleVersionRequest)(nil),    // 39: timetable.v1.GetScheduleVersionRequest
+	(*GetScheduleVersionResponse)(nil),   // 40: timetable.v1.GetScheduleVersionResponse
+	(*RollbackScheduleRequest)(nil),      // 41: timetable.v1.RollbackScheduleRequest
+	(*RollbackScheduleResponse)(nil),     // 42: timetable.v1.RollbackScheduleResponse
+	(*DiffSchedulesRequest)(nil),         // 43: timetable.v1.DiffSchedulesRequest
+	(*ScheduleChange)(nil),               // 44: timetable.v1.ScheduleChange
+	(*ResourceChangeSummary)(nil),        // 45: timetable.v1.ResourceChangeSummary
+	(*ExportICalendarRequest)(nil),       // 46: timetable.v1.ExportICalendarRequest
+	(*ExportICalendarResponse)(nil),      // 47: timetable.v1.ExportICalendarResponse
+	(*DiffSchedulesResponse)(nil),        // 48: timetable.v1.DiffSchedulesResponse
+	(*ExamSession)(nil),                  // 49: timetable.v1.ExamSession
+	(*ExamSettings)(nil),                 // 50: timetable.v1.ExamSettings
+	(*ExamEntry)(nil),                    // 51: timetable.v1.ExamEntry
+	(*ExamSchedule)(nil),                 // 52: timetable.v1.ExamSchedule
+	(*GenerateExamScheduleRequest)(nil),  // 53: timetable.v1.GenerateExamScheduleRequest
+	(*GenerateExamScheduleResponse)(nil), // 54: timetable.v1.GenerateExamScheduleResponse
+	(*GetExamScheduleRequest)(nil),       // 55: timetable.v1.GetExamScheduleRequest
+	(*GetExamScheduleResponse)(nil),      // 56: timetable.v1.GetExamScheduleResponse
+	(*ListExamSchedulesRequest)(nil),     // 57: timetable.v1.ListExamSchedulesRequest
+	(*ListExamSchedulesResponse)(nil),    // 58: timetable.v1.ListExamSchedulesResponse
+	(*CreateRoomRequest)(nil),            // 59: timetable.v1.CreateRoomRequest
+	(*CreateRoomResponse)(nil),           // 60: timetable.v1.CreateRoomResponse
+	(*GetRoomRequest)(nil),               // 61: timetable.v1.GetRoomRequest
+	(*GetRoomResponse)(nil),              // 62: timetable.v1.GetRoomResponse
+	(*UpdateRoomRequest)(nil),            // 63: timetable.v1.UpdateRoomRequest
+	(*UpdateRoomResponse)(nil),           // 64: timetable.v1.UpdateRoomResponse
+	(*DeactivateRoomRequest)(nil),        // 65: timetable.v1.DeactivateRoomRequest
+	(*DeactivateRoomResponse)(nil),       // 66: timetable.v1.DeactivateRoomResponse
+	(*ActivateRoomRequest)(nil),          // 67: timetable.v1.ActivateRoomRequest
+	(*ActivateRoomResponse)(nil),         // 68: timetable.v1.ActivateRoomResponse
+	(*DeleteRoomRequest)(nil),            // 69: timetable.v1.DeleteRoomRequest
+	(*DeleteRoomResponse)(nil),           // 70: timetable.v1.DeleteRoomResponse
+	(*Campus)(nil),                       // 71: timetable.v1.Campus
+	(*Building)(nil),                     // 72: timetable.v1.Building
+	(*CampusTravelTime)(nil),             // 73: timetable.v1.CampusTravelTime
+	(*CreateCampusRequest)(nil),          // 74: timetable.v1.CreateCampusRequest
+	(*CreateCampusResponse)(nil),         // 75: timetable.v1.CreateCampusResponse
+	(*ListCampusesRequest)(nil),          // 76: timetable.v1.ListCampusesRequest
+	(*ListCampusesResponse)(nil),         // 77: timetable.v1.ListCampusesResponse
+	(*CreateBuildingRequest)(nil),        // 78: timetable.v1.CreateBuildingRequest
+	(*CreateBuildingResponse)(nil),       // 79: timetable.v1.CreateBuildingResponse
+	(*ListBuildingsRequest)(nil),         // 80: timetable.v1.ListBuildingsRequest
+	(*ListBuildingsResponse)(nil),        // 81: timetable.v1.ListBuildingsResponse
+	(*SetCampusTravelTimeRequest)(nil),   // 82: timetable.v1.SetCampusTravelTimeRequest
+	(*SetCampusTravelTimeResponse)(nil),  // 83: timetable.v1.SetCampusTravelTimeResponse
+	nil,                                  // 84: timetable.v1.EmptyDomain.EliminatedEntry
+	(*timestamppb.Timestamp)(nil),        // 85: google.protobuf.Timestamp
 }
 var file_timetable_v1_timetable_proto_depIdxs = []int32{
-	0,  // 0: timetable.v1.Schedule.entries:type_name -> timetable.v1.ScheduleEntry
-	18, // 1: timetable.v1.Schedule.created_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: timetable.v1.ListSchedulesResponse.schedules:type_name -> timetable.v1.Schedule
-	1,  // 3: timetable.v1.GenerateScheduleResponse.schedule:type_name -> timetable.v1.Schedule
-	1,  // 4: timetable.v1.GetScheduleResponse.schedule:type_name -> timetable.v1.Schedule
-	0,  // 5: timetable.v1.UpdateScheduleEntryResponse.entry:type_name -> timetable.v1.ScheduleEntry
-	12, // 6: timetable.v1.SuggestTeachersResponse.suggestions:type_name -> timetable.v1.TeacherSuggestion
-	0,  // 7: timetable.v1.ManualAssignResponse.entry:type_name -> timetable.v1.ScheduleEntry
-	15, // 8: timetable.v1.ListRoomsResponse.rooms:type_name -> timetable.v1.Room
-	4,  // 9: timetable.v1.TimetableService.GenerateSchedule:input_type -> timetable.v1.GenerateScheduleRequest
-	6,  // 10: timetable.v1.TimetableService.GetSchedule:input_type -> timetable.v1.GetScheduleRequest
-	2,  // 11: timetable.v1.TimetableService.ListSchedules:input_type -> timetable.v1.ListSchedulesRequest
-	8,  // 12: timetable.v1.TimetableService.UpdateScheduleEntry:input_type -> timetable.v1.UpdateScheduleEntryRequest
-	10, // 13: timetable.v1.TimetableService.SuggestTeachers:input_type -> timetable.v1.SuggestTeachersRequest
-	13, // 14: timetable.v1.TimetableService.ManualAssign:input_type -> timetable.v1.ManualAssignRequest
-	16, // 15: timetable.v1.TimetableService.ListRooms:input_type -> timetable.v1.ListRoomsRequest
-	5,  // 16: timetable.v1.TimetableService.GenerateSchedule:output_type -> timetable.v1.GenerateScheduleResponse
-	7,  // 17: timetable.v1.TimetableService.GetSchedule:output_type -> timetable.v1.GetScheduleResponse
-	3,  // 18: timetable.v1.TimetableService.ListSchedules:output_type -> timetable.v1.ListSchedulesResponse
-	9,  // 19: timetable.v1.TimetableService.UpdateScheduleEntry:output_type -> timetable.v1.UpdateScheduleEntryResponse
-	11, // 20: timetable.v1.TimetableService.SuggestTeachers:output_type -> timetable.v1.SuggestTeachersResponse
-	14, // 21: timetable.v1.TimetableService.ManualAssign:output_type -> timetable.v1.ManualAssignResponse
-	17, // 22: timetable.v1.TimetableService.ListRooms:output_type -> timetable.v1.ListRoomsResponse
-	16, // [16:23] is the sub-list for method output_type
-	9,  // [9:16] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	1,  // 0: timetable.v1.ScheduleEntry.teachers:type_name -> timetable.v1.EntryTeacher
+	0,  // 1: timetable.v1.Schedule.entries:type_name -> timetable.v1.ScheduleEntry
+	85, // 2: timetable.v1.Schedule.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: timetable.v1.ListSchedulesResponse.schedules:type_name -> timetable.v1.Schedule
+	6,  // 4: timetable.v1.GenerateScheduleRequest.pins:type_name -> timetable.v1.PinnedAssignment
+	2,  // 5: timetable.v1.GenerateScheduleResponse.schedule:type_name -> timetable.v1.Schedule
+	2,  // 6: timetable.v1.GetScheduleResponse.schedule:type_name -> timetable.v1.Schedule
+	0,  // 7: timetable.v1.UpdateScheduleEntryResponse.entry:type_name -> timetable.v1.ScheduleEntry
+	17, // 8: timetable.v1.UpdateScheduleEntryResponse.violations:type_name -> timetable.v1.ScheduleViolation
+	14, // 9: timetable.v1.SuggestTeachersResponse.suggestions:type_name -> timetable.v1.TeacherSuggestion
+	0,  // 10: timetable.v1.ManualAssignResponse.entry:type_name -> timetable.v1.ScheduleEntry
+	17, // 11: timetable.v1.ManualAssignResponse.violations:type_name -> timetable.v1.ScheduleViolation
+	18, // 12: timetable.v1.ListRoomsResponse.rooms:type_name -> timetable.v1.Room
+	2,  // 13: timetable.v1.RepairScheduleResponse.schedule:type_name -> timetable.v1.Schedule
+	27, // 14: timetable.v1.GetGenerationStatusResponse.diagnosis:type_name -> timetable.v1.InfeasibilityDiagnosis
+	28, // 15: timetable.v1.InfeasibilityDiagnosis.empty_domains:type_name -> timetable.v1.EmptyDomain
+	29, // 16: timetable.v1.InfeasibilityDiagnosis.conflict_subjects:type_name -> timetable.v1.DiagnosisRef
+	29, // 17: timetable.v1.InfeasibilityDiagnosis.conflict_teachers:type_name -> timetable.v1.DiagnosisRef
+	84, // 18: timetable.v1.EmptyDomain.eliminated:type_name -> timetable.v1.EmptyDomain.EliminatedEntry
+	85, // 19: timetable.v1.ScheduleVersion.published_at:type_name -> google.protobuf.Timestamp
+	0,  // 20: timetable.v1.ScheduleVersion.entries:type_name -> timetable.v1.ScheduleEntry
+	2,  // 21: timetable.v1.PublishScheduleResponse.schedule:type_name -> timetable.v1.Schedule
+	30, // 22: timetable.v1.PublishScheduleResponse.version:type_name -> timetable.v1.ScheduleVersion
+	2,  // 23: timetable.v1.UnpublishScheduleResponse.schedule:type_name -> timetable.v1.Schedule
+	2,  // 24: timetable.v1.ArchiveScheduleResponse.schedule:type_name -> timetable.v1.Schedule
+	30, // 25: timetable.v1.ListScheduleVersionsResponse.versions:type_name -> timetable.v1.ScheduleVersion
+	30, // 26: timetable.v1.GetScheduleVersionResponse.version:type_name -> timetable.v1.ScheduleVersion
+	2,  // 27: timetable.v1.RollbackScheduleResponse.schedule:type_name -> timetable.v1.Schedule
+	30, // 28: timetable.v1.RollbackScheduleResponse.version:type_name -> timetable.v1.ScheduleVersion
+	0,  // 29: timetable.v1.ScheduleChange.before:type_name -> timetable.v1.ScheduleEntry
+	0,  // 30: timetable.v1.ScheduleChange.after:type_name -> timetable.v1.ScheduleEntry
+	44, // 31: timetable.v1.DiffSchedulesResponse.changes:type_name -> timetable.v1.ScheduleChange
+	45, // 32: timetable.v1.DiffSchedulesResponse.teachers:type_name -> timetable.v1.ResourceChangeSummary
+	45, // 33: timetable.v1.DiffSchedulesResponse.rooms:type_name -> timetable.v1.ResourceChangeSummary
+	49, // 34: timetable.v1.ExamSettings.sessions:type_name -> timetable.v1.ExamSession
+	85, // 35: timetable.v1.ExamEntry.date:type_name -> google.protobuf.Timestamp
+	85, // 36: timetable.v1.ExamSchedule.start_date:type_name -> google.protobuf.Timestamp
+	85, // 37: timetable.v1.ExamSchedule.end_date:type_name -> google.protobuf.Timestamp
+	50, // 38: timetable.v1.ExamSchedule.settings:type_name -> timetable.v1.ExamSettings
+	51, // 39: timetable.v1.ExamSchedule.entries:type_name -> timetable.v1.ExamEntry
+	85, // 40: timetable.v1.ExamSchedule.created_at:type_name -> google.protobuf.Timestamp
+	85, // 41: timetable.v1.GenerateExamScheduleRequest.start_date:type_name -> google.protobuf.Timestamp
+	85, // 42: timetable.v1.GenerateExamScheduleRequest.end_date:type_name -> google.protobuf.Timestamp
+	50, // 43: timetable.v1.GenerateExamScheduleRequest.settings:type_name -> timetable.v1.ExamSettings
+	52, // 44: timetable.v1.GenerateExamScheduleResponse.exam_schedule:type_name -> timetable.v1.ExamSchedule
+	52, // 45: timetable.v1.GetExamScheduleResponse.exam_schedule:type_name -> timetable.v1.ExamSchedule
+	52, // 46: timetable.v1.ListExamSchedulesResponse.exam_schedules:type_name -> timetable.v1.ExamSchedule
+	18, // 47: timetable.v1.CreateRoomResponse.room:type_name -> timetable.v1.Room
+	18, // 48: timetable.v1.GetRoomResponse.room:type_name -> timetable.v1.Room
+	18, // 49: timetable.v1.UpdateRoomResponse.room:type_name -> timetable.v1.Room
+	18, // 50: timetable.v1.DeactivateRoomResponse.room:type_name -> timetable.v1.Room
+	18, // 51: timetable.v1.ActivateRoomResponse.room:type_name -> timetable.v1.Room
+	71, // 52: timetable.v1.CreateCampusResponse.campus:type_name -> timetable.v1.Campus
+	71, // 53: timetable.v1.ListCampusesResponse.campuses:type_name -> timetable.v1.Campus
+	73, // 54: timetable.v1.ListCampusesResponse.travel_times:type_name -> timetable.v1.CampusTravelTime
+	72, // 55: timetable.v1.CreateBuildingResponse.building:type_name -> timetable.v1.Building
+	72, // 56: timetable.v1.ListBuildingsResponse.buildings:type_name -> timetable.v1.Building
+	73, // 57: timetable.v1.SetCampusTravelTimeResponse.travel_time:type_name -> timetable.v1.CampusTravelTime
+	5,  // 58: timetable.v1.TimetableService.GenerateSchedule:input_type -> timetable.v1.GenerateScheduleRequest
+	8,  // 59: timetable.v1.TimetableService.GetSchedule:input_type -> timetable.v1.GetScheduleRequest
+	3,  // 60: timetable.v1.TimetableService.ListSchedules:input_type -> timetable.v1.ListSchedulesRequest
+	10, // 61: timetable.v1.TimetableService.UpdateScheduleEntry:input_type -> timetable.v1.UpdateScheduleEntryRequest
+	12, // 62: timetable.v1.TimetableService.SuggestTeachers:input_type -> timetable.v1.SuggestTeachersRequest
+	15, // 63: timetable.v1.TimetableService.ManualAssign:input_type -> timetable.v1.ManualAssignRequest
+	19, // 64: timetable.v1.TimetableService.ListRooms:input_type -> timetable.v1.ListRoomsRequest
+	21, // 65: timetable.v1.TimetableService.GetGenerationStatus:input_type -> timetable.v1.GetGenerationStatusRequest
+	22, // 66: timetable.v1.TimetableService.RepairSchedule:input_type -> timetable.v1.RepairScheduleRequest
+	25, // 67: timetable.v1.TimetableService.CancelGeneration:input_type -> timetable.v1.CancelGenerationRequest
+	31, // 68: timetable.v1.TimetableService.PublishSchedule:input_type -> timetable.v1.PublishScheduleRequest
+	33, // 69: timetable.v1.TimetableService.UnpublishSchedule:input_type -> timetable.v1.UnpublishScheduleRequest
+	35, // 70: timetable.v1.TimetableService.ArchiveSchedule:input_type -> timetable.v1.ArchiveScheduleRequest
+	37, // 71: timetable.v1.TimetableService.ListScheduleVersions:input_type -> timetable.v1.ListScheduleVersionsRequest
+	39, // 72: timetable.v1.TimetableService.GetScheduleVersion:input_type -> timetable.v1.GetScheduleVersionRequest
+	41, // 73: timetable.v1.TimetableService.RollbackSchedule:input_type -> timetable.v1.RollbackScheduleRequest
+	43, // 74: timetable.v1.TimetableService.DiffSchedules:input_type -> timetable.v1.DiffSchedulesRequest
+	46, // 75: timetable.v1.TimetableService.ExportICalendar:input_type -> timetable.v1.ExportICalendarRequest
+	53, // 76: timetable.v1.TimetableService.GenerateExamSchedule:input_type -> timetable.v1.GenerateExamScheduleRequest
+	55, // 77: timetable.v1.TimetableService.GetExamSchedule:input_type -> timetable.v1.GetExamScheduleRequest
+	57, // 78: timetable.v1.TimetableService.ListExamSchedules:input_type -> timetable.v1.ListExamSchedulesRequest
+	59, // 79: timetable.v1.TimetableService.CreateRoom:input_type -> timetable.v1.CreateRoomRequest
+	61, // 80: timetable.v1.TimetableService.GetRoom:input_type -> timetable.v1.GetRoomRequest
+	63, // 81: timetable.v1.TimetableService.UpdateRoom:input_type -> timetable.v1.UpdateRoomRequest
+	65, // 82: timetable.v1.TimetableService.DeactivateRoom:input_type -> timetable.v1.DeactivateRoomRequest
+	67, // 83: timetable.v1.TimetableService.ActivateRoom:input_type -> timetable.v1.ActivateRoomRequest
+	69, // 84: timetable.v1.TimetableService.DeleteRoom:input_type -> timetable.v1.DeleteRoomRequest
+	74, // 85: timetable.v1.TimetableService.CreateCampus:input_type -> timetable.v1.CreateCampusRequest
+	76, // 86: timetable.v1.TimetableService.ListCampuses:input_type -> timetable.v1.ListCampusesRequest
+	78, // 87: timetable.v1.TimetableService.CreateBuilding:input_type -> timetable.v1.CreateBuildingRequest
+	80, // 88: timetable.v1.TimetableService.ListBuildings:input_type -> timetable.v1.ListBuildingsRequest
+	82, // 89: timetable.v1.TimetableService.SetCampusTravelTime:input_type -> timetable.v1.SetCampusTravelTimeRequest
+	7,  // 90: timetable.v1.TimetableService.GenerateSchedule:output_type -> timetable.v1.GenerateScheduleResponse
+	9,  // 91: timetable.v1.TimetableService.GetSchedule:output_type -> timetable.v1.GetScheduleResponse
+	4,  // 92: timetable.v1.TimetableService.ListSchedules:output_type -> timetable.v1.ListSchedulesResponse
+	11, // 93: timetable.v1.TimetableService.UpdateScheduleEntry:output_type -> timetable.v1.UpdateScheduleEntryResponse
+	13, // 94: timetable.v1.TimetableService.SuggestTeachers:output_type -> timetable.v1.SuggestTeachersResponse
+	16, // 95: timetable.v1.TimetableService.ManualAssign:output_type -> timetable.v1.ManualAssignResponse
+	20, // 96: timetable.v1.TimetableService.ListRooms:output_type -> timetable.v1.ListRoomsResponse
+	24, // 97: timetable.v1.TimetableService.GetGenerationStatus:output_type -> timetable.v1.GetGenerationStatusResponse
+	23, // 98: timetable.v1.TimetableService.RepairSchedule:output_type -> timetable.v1.RepairScheduleResponse
+	26, // 99: timetable.v1.TimetableService.CancelGeneration:output_type -> timetable.v1.CancelGenerationResponse
+	32, // 100: timetable.v1.TimetableService.PublishSchedule:output_type -> timetable.v1.PublishScheduleResponse
+	34, // 101: timetable.v1.TimetableService.UnpublishSchedule:output_type -> timetable.v1.UnpublishScheduleResponse
+	36, // 102: timetable.v1.TimetableService.ArchiveSchedule:output_type -> timetable.v1.ArchiveScheduleResponse
+	38, // 103: timetable.v1.TimetableService.ListScheduleVersions:output_type -> timetable.v1.ListScheduleVersionsResponse
+	40, // 104: timetable.v1.TimetableService.GetScheduleVersion:output_type -> timetable.v1.GetScheduleVersionResponse
+	42, // 105: timetable.v1.TimetableService.RollbackSchedule:output_type -> timetable.v1.RollbackScheduleResponse
+	48, // 106: timetable.v1.TimetableService.DiffSchedules:output_type -> timetable.v1.DiffSchedulesResponse
+	47, // 107: timetable.v1.TimetableService.ExportICalendar:output_type -> timetable.v1.ExportICalendarResponse
+	54, // 108: timetable.v1.TimetableService.GenerateExamSchedule:output_type -> timetable.v1.GenerateExamScheduleResponse
+	56, // 109: timetable.v1.TimetableService.GetExamSchedule:output_type -> timetable.v1.GetExamScheduleResponse
+	58, // 110: timetable.v1.TimetableService.ListExamSchedules:output_type -> timetable.v1.ListExamSchedulesResponse
+	60, // 111: timetable.v1.TimetableService.CreateRoom:output_type -> timetable.v1.CreateRoomResponse
+	62, // 112: timetable.v1.TimetableService.GetRoom:output_type -> timetable.v1.GetRoomResponse
+	64, // 113: timetable.v1.TimetableService.UpdateRoom:output_type -> timetable.v1.UpdateRoomResponse
+	66, // 114: timetable.v1.TimetableService.DeactivateRoom:output_type -> timetable.v1.DeactivateRoomResponse
+	68, // 115: timetable.v1.TimetableService.ActivateRoom:output_type -> timetable.v1.ActivateRoomResponse
+	70, // 116: timetable.v1.TimetableService.DeleteRoom:output_type -> timetable.v1.DeleteRoomResponse
+	75, // 117: timetable.v1.TimetableService.CreateCampus:output_type -> timetable.v1.CreateCampusResponse
+	77, // 118: timetable.v1.TimetableService.ListCampuses:output_type -> timetable.v1.ListCampusesResponse
+	79, // 119: timetable.v1.TimetableService.CreateBuilding:output_type -> timetable.v1.CreateBuildingResponse
+	81, // 120: timetable.v1.TimetableService.ListBuildings:output_type -> timetable.v1.ListBuildingsResponse
+	83, // 121: timetable.v1.TimetableService.SetCampusTravelTime:output_type -> timetable.v1.SetCampusTravelTimeResponse
+	90, // [90:122] is the sub-list for method output_type
+	58, // [58:90] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_timetable_v1_timetable_proto_init() }
@@ -1335,14 +6145,15 @@ func file_timetable_v1_timetable_proto_init() {
 	if File_timetable_v1_timetable_proto != nil {
 		return
 	}
-	file_timetable_v1_timetable_proto_msgTypes[8].OneofWrappers = []any{}
+	file_timetable_v1_timetable_proto_msgTypes[10].OneofWrappers = []any{}
+	file_timetable_v1_timetable_proto_msgTypes[63].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_timetable_v1_timetable_proto_rawDesc), len(file_timetable_v1_timetable_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
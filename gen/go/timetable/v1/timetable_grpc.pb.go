@@ -19,13 +19,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TimetableService_GenerateSchedule_FullMethodName    = "/timetable.v1.TimetableService/GenerateSchedule"
-	TimetableService_GetSchedule_FullMethodName         = "/timetable.v1.TimetableService/GetSchedule"
-	TimetableService_ListSchedules_FullMethodName       = "/timetable.v1.TimetableService/ListSchedules"
-	TimetableService_UpdateScheduleEntry_FullMethodName = "/timetable.v1.TimetableService/UpdateScheduleEntry"
-	TimetableService_SuggestTeachers_FullMethodName     = "/timetable.v1.TimetableService/SuggestTeachers"
-	TimetableService_ManualAssign_FullMethodName        = "/timetable.v1.TimetableService/ManualAssign"
-	TimetableService_ListRooms_FullMethodName           = "/timetable.v1.TimetableService/ListRooms"
+	TimetableService_GenerateSchedule_FullMethodName     = "/timetable.v1.TimetableService/GenerateSchedule"
+	TimetableService_GetSchedule_FullMethodName          = "/timetable.v1.TimetableService/GetSchedule"
+	TimetableService_ListSchedules_FullMethodName        = "/timetable.v1.TimetableService/ListSchedules"
+	TimetableService_UpdateScheduleEntry_FullMethodName  = "/timetable.v1.TimetableService/UpdateScheduleEntry"
+	TimetableService_SuggestTeachers_FullMethodName      = "/timetable.v1.TimetableService/SuggestTeachers"
+	TimetableService_ManualAssign_FullMethodName         = "/timetable.v1.TimetableService/ManualAssign"
+	TimetableService_ListRooms_FullMethodName            = "/timetable.v1.TimetableService/ListRooms"
+	TimetableService_GetGenerationStatus_FullMethodName  = "/timetable.v1.TimetableService/GetGenerationStatus"
+	TimetableService_RepairSchedule_FullMethodName       = "/timetable.v1.TimetableService/RepairSchedule"
+	TimetableService_CancelGeneration_FullMethodName     = "/timetable.v1.TimetableService/CancelGeneration"
+	TimetableService_PublishSchedule_FullMethodName      = "/timetable.v1.TimetableService/PublishSchedule"
+	TimetableService_UnpublishSchedule_FullMethodName    = "/timetable.v1.TimetableService/UnpublishSchedule"
+	TimetableService_ArchiveSchedule_FullMethodName      = "/timetable.v1.TimetableService/ArchiveSchedule"
+	TimetableService_ListScheduleVersions_FullMethodName = "/timetable.v1.TimetableService/ListScheduleVersions"
+	TimetableService_GetScheduleVersion_FullMethodName   = "/timetable.v1.TimetableService/GetScheduleVersion"
+	TimetableService_RollbackSchedule_FullMethodName     = "/timetable.v1.TimetableService/RollbackSchedule"
+	TimetableService_DiffSchedules_FullMethodName        = "/timetable.v1.TimetableService/DiffSchedules"
+	TimetableService_ExportICalendar_FullMethodName      = "/timetable.v1.TimetableService/ExportICalendar"
+	TimetableService_GenerateExamSchedule_FullMethodName = "/timetable.v1.TimetableService/GenerateExamSchedule"
+	TimetableService_GetExamSchedule_FullMethodName      = "/timetable.v1.TimetableService/GetExamSchedule"
+	TimetableService_ListExamSchedules_FullMethodName    = "/timetable.v1.TimetableService/ListExamSchedules"
+	TimetableService_CreateRoom_FullMethodName           = "/timetable.v1.TimetableService/CreateRoom"
+	TimetableService_GetRoom_FullMethodName              = "/timetable.v1.TimetableService/GetRoom"
+	TimetableService_UpdateRoom_FullMethodName           = "/timetable.v1.TimetableService/UpdateRoom"
+	TimetableService_DeactivateRoom_FullMethodName       = "/timetable.v1.TimetableService/DeactivateRoom"
+	TimetableService_ActivateRoom_FullMethodName         = "/timetable.v1.TimetableService/ActivateRoom"
+	TimetableService_DeleteRoom_FullMethodName           = "/timetable.v1.TimetableService/DeleteRoom"
+	TimetableService_CreateCampus_FullMethodName         = "/timetable.v1.TimetableService/CreateCampus"
+	TimetableService_ListCampuses_FullMethodName         = "/timetable.v1.TimetableService/ListCampuses"
+	TimetableService_CreateBuilding_FullMethodName       = "/timetable.v1.TimetableService/CreateBuilding"
+	TimetableService_ListBuildings_FullMethodName        = "/timetable.v1.TimetableService/ListBuildings"
+	TimetableService_SetCampusTravelTime_FullMethodName  = "/timetable.v1.TimetableService/SetCampusTravelTime"
 )
 
 // TimetableServiceClient is the client API for TimetableService service.
@@ -39,6 +64,48 @@ type TimetableServiceClient interface {
 	SuggestTeachers(ctx context.Context, in *SuggestTeachersRequest, opts ...grpc.CallOption) (*SuggestTeachersResponse, error)
 	ManualAssign(ctx context.Context, in *ManualAssignRequest, opts ...grpc.CallOption) (*ManualAssignResponse, error)
 	ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error)
+	GetGenerationStatus(ctx context.Context, in *GetGenerationStatusRequest, opts ...grpc.CallOption) (*GetGenerationStatusResponse, error)
+	// Re-solves only the entries of an existing schedule invalidated by a
+	// change, keeping manual overrides and every unaffected entry in place.
+	RepairSchedule(ctx context.Context, in *RepairScheduleRequest, opts ...grpc.CallOption) (*RepairScheduleResponse, error)
+	// Cancels a queued or running generation; the schedule is marked failed.
+	CancelGeneration(ctx context.Context, in *CancelGenerationRequest, opts ...grpc.CallOption) (*CancelGenerationResponse, error)
+	// Makes a draft or completed schedule the semester's published one,
+	// archiving the previous one and recording a new version.
+	PublishSchedule(ctx context.Context, in *PublishScheduleRequest, opts ...grpc.CallOption) (*PublishScheduleResponse, error)
+	// Returns a published schedule to draft; its versions are kept.
+	UnpublishSchedule(ctx context.Context, in *UnpublishScheduleRequest, opts ...grpc.CallOption) (*UnpublishScheduleResponse, error)
+	ArchiveSchedule(ctx context.Context, in *ArchiveScheduleRequest, opts ...grpc.CallOption) (*ArchiveScheduleResponse, error)
+	ListScheduleVersions(ctx context.Context, in *ListScheduleVersionsRequest, opts ...grpc.CallOption) (*ListScheduleVersionsResponse, error)
+	GetScheduleVersion(ctx context.Context, in *GetScheduleVersionRequest, opts ...grpc.CallOption) (*GetScheduleVersionResponse, error)
+	// Republishes a previous version as a new schedule and version.
+	RollbackSchedule(ctx context.Context, in *RollbackScheduleRequest, opts ...grpc.CallOption) (*RollbackScheduleResponse, error)
+	// Compares two schedules or published versions session by session.
+	DiffSchedules(ctx context.Context, in *DiffSchedulesRequest, opts ...grpc.CallOption) (*DiffSchedulesResponse, error)
+	// Renders a schedule as an RFC 5545 calendar of weekly recurring events.
+	ExportICalendar(ctx context.Context, in *ExportICalendarRequest, opts ...grpc.CallOption) (*ExportICalendarResponse, error)
+	// Places one final exam per offered subject between two dates, with an
+	// invigilator and a room. Runs synchronously and stores the result.
+	GenerateExamSchedule(ctx context.Context, in *GenerateExamScheduleRequest, opts ...grpc.CallOption) (*GenerateExamScheduleResponse, error)
+	GetExamSchedule(ctx context.Context, in *GetExamScheduleRequest, opts ...grpc.CallOption) (*GetExamScheduleResponse, error)
+	ListExamSchedules(ctx context.Context, in *ListExamSchedulesRequest, opts ...grpc.CallOption) (*ListExamSchedulesResponse, error)
+	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error)
+	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error)
+	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error)
+	// Withdraws a room from generation, bookings and room lists; schedules
+	// already using it are kept. ActivateRoom reverses it.
+	DeactivateRoom(ctx context.Context, in *DeactivateRoomRequest, opts ...grpc.CallOption) (*DeactivateRoomResponse, error)
+	ActivateRoom(ctx context.Context, in *ActivateRoomRequest, opts ...grpc.CallOption) (*ActivateRoomResponse, error)
+	// Deletes a room nothing refers to; FAILED_PRECONDITION while a published
+	// schedule, another schedule or a booking still uses it.
+	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error)
+	CreateCampus(ctx context.Context, in *CreateCampusRequest, opts ...grpc.CallOption) (*CreateCampusResponse, error)
+	// Lists campuses with the travel-time matrix between them.
+	ListCampuses(ctx context.Context, in *ListCampusesRequest, opts ...grpc.CallOption) (*ListCampusesResponse, error)
+	CreateBuilding(ctx context.Context, in *CreateBuildingRequest, opts ...grpc.CallOption) (*CreateBuildingResponse, error)
+	ListBuildings(ctx context.Context, in *ListBuildingsRequest, opts ...grpc.CallOption) (*ListBuildingsResponse, error)
+	// Sets the travel minutes between two campuses, in both directions.
+	SetCampusTravelTime(ctx context.Context, in *SetCampusTravelTimeRequest, opts ...grpc.CallOption) (*SetCampusTravelTimeResponse, error)
 }
 
 type timetableServiceClient struct {
@@ -119,6 +186,256 @@ func (c *timetableServiceClient) ListRooms(ctx context.Context, in *ListRoomsReq
 	return out, nil
 }
 
+func (c *timetableServiceClient) GetGenerationStatus(ctx context.Context, in *GetGenerationStatusRequest, opts ...grpc.CallOption) (*GetGenerationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGenerationStatusResponse)
+	err := c.cc.Invoke(ctx, TimetableService_GetGenerationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) RepairSchedule(ctx context.Context, in *RepairScheduleRequest, opts ...grpc.CallOption) (*RepairScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepairScheduleResponse)
+	err := c.cc.Invoke(ctx, TimetableService_RepairSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) CancelGeneration(ctx context.Context, in *CancelGenerationRequest, opts ...grpc.CallOption) (*CancelGenerationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelGenerationResponse)
+	err := c.cc.Invoke(ctx, TimetableService_CancelGeneration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) PublishSchedule(ctx context.Context, in *PublishScheduleRequest, opts ...grpc.CallOption) (*PublishScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishScheduleResponse)
+	err := c.cc.Invoke(ctx, TimetableService_PublishSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) UnpublishSchedule(ctx context.Context, in *UnpublishScheduleRequest, opts ...grpc.CallOption) (*UnpublishScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpublishScheduleResponse)
+	err := c.cc.Invoke(ctx, TimetableService_UnpublishSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) ArchiveSchedule(ctx context.Context, in *ArchiveScheduleRequest, opts ...grpc.CallOption) (*ArchiveScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveScheduleResponse)
+	err := c.cc.Invoke(ctx, TimetableService_ArchiveSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) ListScheduleVersions(ctx context.Context, in *ListScheduleVersionsRequest, opts ...grpc.CallOption) (*ListScheduleVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduleVersionsResponse)
+	err := c.cc.Invoke(ctx, TimetableService_ListScheduleVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetScheduleVersion(ctx context.Context, in *GetScheduleVersionRequest, opts ...grpc.CallOption) (*GetScheduleVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduleVersionResponse)
+	err := c.cc.Invoke(ctx, TimetableService_GetScheduleVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) RollbackSchedule(ctx context.Context, in *RollbackScheduleRequest, opts ...grpc.CallOption) (*RollbackScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackScheduleResponse)
+	err := c.cc.Invoke(ctx, TimetableService_RollbackSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) DiffSchedules(ctx context.Context, in *DiffSchedulesRequest, opts ...grpc.CallOption) (*DiffSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffSchedulesResponse)
+	err := c.cc.Invoke(ctx, TimetableService_DiffSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) ExportICalendar(ctx context.Context, in *ExportICalendarRequest, opts ...grpc.CallOption) (*ExportICalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportICalendarResponse)
+	err := c.cc.Invoke(ctx, TimetableService_ExportICalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GenerateExamSchedule(ctx context.Context, in *GenerateExamScheduleRequest, opts ...grpc.CallOption) (*GenerateExamScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateExamScheduleResponse)
+	err := c.cc.Invoke(ctx, TimetableService_GenerateExamSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetExamSchedule(ctx context.Context, in *GetExamScheduleRequest, opts ...grpc.CallOption) (*GetExamScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExamScheduleResponse)
+	err := c.cc.Invoke(ctx, TimetableService_GetExamSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) ListExamSchedules(ctx context.Context, in *ListExamSchedulesRequest, opts ...grpc.CallOption) (*ListExamSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExamSchedulesResponse)
+	err := c.cc.Invoke(ctx, TimetableService_ListExamSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoomResponse)
+	err := c.cc.Invoke(ctx, TimetableService_CreateRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoomResponse)
+	err := c.cc.Invoke(ctx, TimetableService_GetRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRoomResponse)
+	err := c.cc.Invoke(ctx, TimetableService_UpdateRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) DeactivateRoom(ctx context.Context, in *DeactivateRoomRequest, opts ...grpc.CallOption) (*DeactivateRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateRoomResponse)
+	err := c.cc.Invoke(ctx, TimetableService_DeactivateRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) ActivateRoom(ctx context.Context, in *ActivateRoomRequest, opts ...grpc.CallOption) (*ActivateRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivateRoomResponse)
+	err := c.cc.Invoke(ctx, TimetableService_ActivateRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoomResponse)
+	err := c.cc.Invoke(ctx, TimetableService_DeleteRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) CreateCampus(ctx context.Context, in *CreateCampusRequest, opts ...grpc.CallOption) (*CreateCampusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCampusResponse)
+	err := c.cc.Invoke(ctx, TimetableService_CreateCampus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) ListCampuses(ctx context.Context, in *ListCampusesRequest, opts ...grpc.CallOption) (*ListCampusesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampusesResponse)
+	err := c.cc.Invoke(ctx, TimetableService_ListCampuses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) CreateBuilding(ctx context.Context, in *CreateBuildingRequest, opts ...grpc.CallOption) (*CreateBuildingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBuildingResponse)
+	err := c.cc.Invoke(ctx, TimetableService_CreateBuilding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) ListBuildings(ctx context.Context, in *ListBuildingsRequest, opts ...grpc.CallOption) (*ListBuildingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBuildingsResponse)
+	err := c.cc.Invoke(ctx, TimetableService_ListBuildings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) SetCampusTravelTime(ctx context.Context, in *SetCampusTravelTimeRequest, opts ...grpc.CallOption) (*SetCampusTravelTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCampusTravelTimeResponse)
+	err := c.cc.Invoke(ctx, TimetableService_SetCampusTravelTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TimetableServiceServer is the server API for TimetableService service.
 // All implementations must embed UnimplementedTimetableServiceServer
 // for forward compatibility.
@@ -130,6 +447,48 @@ type TimetableServiceServer interface {
 	SuggestTeachers(context.Context, *SuggestTeachersRequest) (*SuggestTeachersResponse, error)
 	ManualAssign(context.Context, *ManualAssignRequest) (*ManualAssignResponse, error)
 	ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error)
+	GetGenerationStatus(context.Context, *GetGenerationStatusRequest) (*GetGenerationStatusResponse, error)
+	// Re-solves only the entries of an existing schedule invalidated by a
+	// change, keeping manual overrides and every unaffected entry in place.
+	RepairSchedule(context.Context, *RepairScheduleRequest) (*RepairScheduleResponse, error)
+	// Cancels a queued or running generation; the schedule is marked failed.
+	CancelGeneration(context.Context, *CancelGenerationRequest) (*CancelGenerationResponse, error)
+	// Makes a draft or completed schedule the semester's published one,
+	// archiving the previous one and recording a new version.
+	PublishSchedule(context.Context, *PublishScheduleRequest) (*PublishScheduleResponse, error)
+	// Returns a published schedule to draft; its versions are kept.
+	UnpublishSchedule(context.Context, *UnpublishScheduleRequest) (*UnpublishScheduleResponse, error)
+	ArchiveSchedule(context.Context, *ArchiveScheduleRequest) (*ArchiveScheduleResponse, error)
+	ListScheduleVersions(context.Context, *ListScheduleVersionsRequest) (*ListScheduleVersionsResponse, error)
+	GetScheduleVersion(context.Context, *GetScheduleVersionRequest) (*GetScheduleVersionResponse, error)
+	// Republishes a previous version as a new schedule and version.
+	RollbackSchedule(context.Context, *RollbackScheduleRequest) (*RollbackScheduleResponse, error)
+	// Compares two schedules or published versions session by session.
+	DiffSchedules(context.Context, *DiffSchedulesRequest) (*DiffSchedulesResponse, error)
+	// Renders a schedule as an RFC 5545 calendar of weekly recurring events.
+	ExportICalendar(context.Context, *ExportICalendarRequest) (*ExportICalendarResponse, error)
+	// Places one final exam per offered subject between two dates, with an
+	// invigilator and a room. Runs synchronously and stores the result.
+	GenerateExamSchedule(context.Context, *GenerateExamScheduleRequest) (*GenerateExamScheduleResponse, error)
+	GetExamSchedule(context.Context, *GetExamScheduleRequest) (*GetExamScheduleResponse, error)
+	ListExamSchedules(context.Context, *ListExamSchedulesRequest) (*ListExamSchedulesResponse, error)
+	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
+	GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error)
+	UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error)
+	// Withdraws a room from generation, bookings and room lists; schedules
+	// already using it are kept. ActivateRoom reverses it.
+	DeactivateRoom(context.Context, *DeactivateRoomRequest) (*DeactivateRoomResponse, error)
+	ActivateRoom(context.Context, *ActivateRoomRequest) (*ActivateRoomResponse, error)
+	// Deletes a room nothing refers to; FAILED_PRECONDITION while a published
+	// schedule, another schedule or a booking still uses it.
+	DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error)
+	CreateCampus(context.Context, *CreateCampusRequest) (*CreateCampusResponse, error)
+	// Lists campuses with the travel-time matrix between them.
+	ListCampuses(context.Context, *ListCampusesRequest) (*ListCampusesResponse, error)
+	CreateBuilding(context.Context, *CreateBuildingRequest) (*CreateBuildingResponse, error)
+	ListBuildings(context.Context, *ListBuildingsRequest) (*ListBuildingsResponse, error)
+	// Sets the travel minutes between two campuses, in both directions.
+	SetCampusTravelTime(context.Context, *SetCampusTravelTimeRequest) (*SetCampusTravelTimeResponse, error)
 	mustEmbedUnimplementedTimetableServiceServer()
 }
 
@@ -161,6 +520,81 @@ func (UnimplementedTimetableServiceServer) ManualAssign(context.Context, *Manual
 func (UnimplementedTimetableServiceServer) ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListRooms not implemented")
 }
+func (UnimplementedTimetableServiceServer) GetGenerationStatus(context.Context, *GetGenerationStatusRequest) (*GetGenerationStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGenerationStatus not implemented")
+}
+func (UnimplementedTimetableServiceServer) RepairSchedule(context.Context, *RepairScheduleRequest) (*RepairScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RepairSchedule not implemented")
+}
+func (UnimplementedTimetableServiceServer) CancelGeneration(context.Context, *CancelGenerationRequest) (*CancelGenerationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelGeneration not implemented")
+}
+func (UnimplementedTimetableServiceServer) PublishSchedule(context.Context, *PublishScheduleRequest) (*PublishScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishSchedule not implemented")
+}
+func (UnimplementedTimetableServiceServer) UnpublishSchedule(context.Context, *UnpublishScheduleRequest) (*UnpublishScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnpublishSchedule not implemented")
+}
+func (UnimplementedTimetableServiceServer) ArchiveSchedule(context.Context, *ArchiveScheduleRequest) (*ArchiveScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveSchedule not implemented")
+}
+func (UnimplementedTimetableServiceServer) ListScheduleVersions(context.Context, *ListScheduleVersionsRequest) (*ListScheduleVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduleVersions not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetScheduleVersion(context.Context, *GetScheduleVersionRequest) (*GetScheduleVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScheduleVersion not implemented")
+}
+func (UnimplementedTimetableServiceServer) RollbackSchedule(context.Context, *RollbackScheduleRequest) (*RollbackScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackSchedule not implemented")
+}
+func (UnimplementedTimetableServiceServer) DiffSchedules(context.Context, *DiffSchedulesRequest) (*DiffSchedulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffSchedules not implemented")
+}
+func (UnimplementedTimetableServiceServer) ExportICalendar(context.Context, *ExportICalendarRequest) (*ExportICalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportICalendar not implemented")
+}
+func (UnimplementedTimetableServiceServer) GenerateExamSchedule(context.Context, *GenerateExamScheduleRequest) (*GenerateExamScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateExamSchedule not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetExamSchedule(context.Context, *GetExamScheduleRequest) (*GetExamScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamSchedule not implemented")
+}
+func (UnimplementedTimetableServiceServer) ListExamSchedules(context.Context, *ListExamSchedulesRequest) (*ListExamSchedulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExamSchedules not implemented")
+}
+func (UnimplementedTimetableServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRoom not implemented")
+}
+func (UnimplementedTimetableServiceServer) UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRoom not implemented")
+}
+func (UnimplementedTimetableServiceServer) DeactivateRoom(context.Context, *DeactivateRoomRequest) (*DeactivateRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateRoom not implemented")
+}
+func (UnimplementedTimetableServiceServer) ActivateRoom(context.Context, *ActivateRoomRequest) (*ActivateRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivateRoom not implemented")
+}
+func (UnimplementedTimetableServiceServer) DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRoom not implemented")
+}
+func (UnimplementedTimetableServiceServer) CreateCampus(context.Context, *CreateCampusRequest) (*CreateCampusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCampus not implemented")
+}
+func (UnimplementedTimetableServiceServer) ListCampuses(context.Context, *ListCampusesRequest) (*ListCampusesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCampuses not implemented")
+}
+func (UnimplementedTimetableServiceServer) CreateBuilding(context.Context, *CreateBuildingRequest) (*CreateBuildingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBuilding not implemented")
+}
+func (UnimplementedTimetableServiceServer) ListBuildings(context.Context, *ListBuildingsRequest) (*ListBuildingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBuildings not implemented")
+}
+func (UnimplementedTimetableServiceServer) SetCampusTravelTime(context.Context, *SetCampusTravelTimeRequest) (*SetCampusTravelTimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCampusTravelTime not implemented")
+}
 func (UnimplementedTimetableServiceServer) mustEmbedUnimplementedTimetableServiceServer() {}
 func (UnimplementedTimetableServiceServer) testEmbeddedByValue()                          {}
 
@@ -308,40 +742,590 @@ func _TimetableService_ListRooms_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-// TimetableService_ServiceDesc is the grpc.ServiceDesc for TimetableService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TimetableService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "timetable.v1.TimetableService",
-	HandlerType: (*TimetableServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GenerateSchedule",
-			Handler:    _TimetableService_GenerateSchedule_Handler,
-		},
-		{
-			MethodName: "GetSchedule",
-			Handler:    _TimetableService_GetSchedule_Handler,
-		},
-		{
-			MethodName: "ListSchedules",
-			Handler:    _TimetableService_ListSchedules_Handler,
-		},
-		{
-			MethodName: "UpdateScheduleEntry",
-			Handler:    _TimetableService_UpdateScheduleEntry_Handler,
-		},
-		{
-			MethodName: "SuggestTeachers",
-			Handler:    _TimetableService_SuggestTeachers_Handler,
-		},
-		{
-			MethodName: "ManualAssign",
-			Handler:    _TimetableService_ManualAssign_Handler,
-		},
-		{
-			MethodName: "ListRooms",
-			Handler:    _TimetableService_ListRooms_Handler,
+func _TimetableService_GetGenerationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGenerationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetGenerationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetGenerationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetGenerationStatus(ctx, req.(*GetGenerationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_RepairSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).RepairSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_RepairSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).RepairSchedule(ctx, req.(*RepairScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_CancelGeneration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGenerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).CancelGeneration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_CancelGeneration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).CancelGeneration(ctx, req.(*CancelGenerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_PublishSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).PublishSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_PublishSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).PublishSchedule(ctx, req.(*PublishScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_UnpublishSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).UnpublishSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_UnpublishSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).UnpublishSchedule(ctx, req.(*UnpublishScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_ArchiveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).ArchiveSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_ArchiveSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).ArchiveSchedule(ctx, req.(*ArchiveScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_ListScheduleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).ListScheduleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_ListScheduleVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).ListScheduleVersions(ctx, req.(*ListScheduleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetScheduleVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetScheduleVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetScheduleVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetScheduleVersion(ctx, req.(*GetScheduleVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_RollbackSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).RollbackSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_RollbackSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).RollbackSchedule(ctx, req.(*RollbackScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_DiffSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).DiffSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_DiffSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).DiffSchedules(ctx, req.(*DiffSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_ExportICalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportICalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).ExportICalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_ExportICalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).ExportICalendar(ctx, req.(*ExportICalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GenerateExamSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateExamScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GenerateExamSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GenerateExamSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GenerateExamSchedule(ctx, req.(*GenerateExamScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetExamSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetExamSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetExamSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetExamSchedule(ctx, req.(*GetExamScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_ListExamSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExamSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).ListExamSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_ListExamSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).ListExamSchedules(ctx, req.(*ListExamSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_CreateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetRoom(ctx, req.(*GetRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).UpdateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_UpdateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_DeactivateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).DeactivateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_DeactivateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).DeactivateRoom(ctx, req.(*DeactivateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_ActivateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).ActivateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_ActivateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).ActivateRoom(ctx, req.(*ActivateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).DeleteRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_DeleteRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).DeleteRoom(ctx, req.(*DeleteRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_CreateCampus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).CreateCampus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_CreateCampus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).CreateCampus(ctx, req.(*CreateCampusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_ListCampuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).ListCampuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_ListCampuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).ListCampuses(ctx, req.(*ListCampusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_CreateBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBuildingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).CreateBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_CreateBuilding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).CreateBuilding(ctx, req.(*CreateBuildingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_ListBuildings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBuildingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).ListBuildings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_ListBuildings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).ListBuildings(ctx, req.(*ListBuildingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_SetCampusTravelTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCampusTravelTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).SetCampusTravelTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_SetCampusTravelTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).SetCampusTravelTime(ctx, req.(*SetCampusTravelTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimetableService_ServiceDesc is the grpc.ServiceDesc for TimetableService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimetableService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "timetable.v1.TimetableService",
+	HandlerType: (*TimetableServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateSchedule",
+			Handler:    _TimetableService_GenerateSchedule_Handler,
+		},
+		{
+			MethodName: "GetSchedule",
+			Handler:    _TimetableService_GetSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _TimetableService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "UpdateScheduleEntry",
+			Handler:    _TimetableService_UpdateScheduleEntry_Handler,
+		},
+		{
+			MethodName: "SuggestTeachers",
+			Handler:    _TimetableService_SuggestTeachers_Handler,
+		},
+		{
+			MethodName: "ManualAssign",
+			Handler:    _TimetableService_ManualAssign_Handler,
+		},
+		{
+			MethodName: "ListRooms",
+			Handler:    _TimetableService_ListRooms_Handler,
+		},
+		{
+			MethodName: "GetGenerationStatus",
+			Handler:    _TimetableService_GetGenerationStatus_Handler,
+		},
+		{
+			MethodName: "RepairSchedule",
+			Handler:    _TimetableService_RepairSchedule_Handler,
+		},
+		{
+			MethodName: "CancelGeneration",
+			Handler:    _TimetableService_CancelGeneration_Handler,
+		},
+		{
+			MethodName: "PublishSchedule",
+			Handler:    _TimetableService_PublishSchedule_Handler,
+		},
+		{
+			MethodName: "UnpublishSchedule",
+			Handler:    _TimetableService_UnpublishSchedule_Handler,
+		},
+		{
+			MethodName: "ArchiveSchedule",
+			Handler:    _TimetableService_ArchiveSchedule_Handler,
+		},
+		{
+			MethodName: "ListScheduleVersions",
+			Handler:    _TimetableService_ListScheduleVersions_Handler,
+		},
+		{
+			MethodName: "GetScheduleVersion",
+			Handler:    _TimetableService_GetScheduleVersion_Handler,
+		},
+		{
+			MethodName: "RollbackSchedule",
+			Handler:    _TimetableService_RollbackSchedule_Handler,
+		},
+		{
+			MethodName: "DiffSchedules",
+			Handler:    _TimetableService_DiffSchedules_Handler,
+		},
+		{
+			MethodName: "ExportICalendar",
+			Handler:    _TimetableService_ExportICalendar_Handler,
+		},
+		{
+			MethodName: "GenerateExamSchedule",
+			Handler:    _TimetableService_GenerateExamSchedule_Handler,
+		},
+		{
+			MethodName: "GetExamSchedule",
+			Handler:    _TimetableService_GetExamSchedule_Handler,
+		},
+		{
+			MethodName: "ListExamSchedules",
+			Handler:    _TimetableService_ListExamSchedules_Handler,
+		},
+		{
+			MethodName: "CreateRoom",
+			Handler:    _TimetableService_CreateRoom_Handler,
+		},
+		{
+			MethodName: "GetRoom",
+			Handler:    _TimetableService_GetRoom_Handler,
+		},
+		{
+			MethodName: "UpdateRoom",
+			Handler:    _TimetableService_UpdateRoom_Handler,
+		},
+		{
+			MethodName: "DeactivateRoom",
+			Handler:    _TimetableService_DeactivateRoom_Handler,
+		},
+		{
+			MethodName: "ActivateRoom",
+			Handler:    _TimetableService_ActivateRoom_Handler,
+		},
+		{
+			MethodName: "DeleteRoom",
+			Handler:    _TimetableService_DeleteRoom_Handler,
+		},
+		{
+			MethodName: "CreateCampus",
+			Handler:    _TimetableService_CreateCampus_Handler,
+		},
+		{
+			MethodName: "ListCampuses",
+			Handler:    _TimetableService_ListCampuses_Handler,
+		},
+		{
+			MethodName: "CreateBuilding",
+			Handler:    _TimetableService_CreateBuilding_Handler,
+		},
+		{
+			MethodName: "ListBuildings",
+			Handler:    _TimetableService_ListBuildings_Handler,
+		},
+		{
+			MethodName: "SetCampusTravelTime",
+			Handler:    _TimetableService_SetCampusTravelTime_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
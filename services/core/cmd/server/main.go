@@ -177,6 +177,13 @@ func main() {
 	chatMsgHandler := command.NewChatMessageHandler(llmProvider, toolRegistry, toolExecutor, zapLog)
 	chatHandler := httpif.NewChatHandler(chatMsgHandler, jwtSvc, zapLog)
 
+	// Calendar feeds sign their tokens with the JWT service and are recorded in
+	// core.calendar_feeds so they can be revoked
+	var calendarFeedHandler *httpif.CalendarFeedHandler
+	if modHandlers.TimetableClient != nil {
+		calendarFeedHandler = httpif.NewCalendarFeedHandler(modHandlers.TimetableClient, jwtSvc, persistence.NewCalendarFeedRepository(pool))
+	}
+
 	// 11. Router
 	router := httpif.NewRouter(httpif.RouterConfig{
 		AuthHandler:          authHandler,
@@ -192,6 +199,8 @@ func main() {
 		HRHandler:            modHandlers.HR,
 		SubjectHandler:       modHandlers.Subject,
 		TimetableHandler:     modHandlers.Timetable,
+		CalendarFeedHandler:  calendarFeedHandler,
+		RoomBookingHandler:   modHandlers.RoomBooking,
 		StudentHandler:       modHandlers.Student,
 		ImportHandler:        importHandler,
 		DashboardHandler:     modHandlers.Dashboard,
@@ -16,14 +16,16 @@ import (
 
 // moduleHandlers holds gateway handlers and gRPC connections for lifecycle management.
 type moduleHandlers struct {
-	HR            *httpif.HRHandler
-	Subject       *httpif.SubjectHandler
-	Timetable     *httpif.TimetableHandler
-	Student       *httpif.StudentHandler
-	Dashboard     *httpif.DashboardHandler
-	StudentClient studentv1.StudentServiceClient // exposed for AuthHandler
-	TeacherClient hrv1.TeacherServiceClient    // exposed for ImportHandler
-	conns         []*grpc.ClientConn
+	HR              *httpif.HRHandler
+	Subject         *httpif.SubjectHandler
+	Timetable       *httpif.TimetableHandler
+	RoomBooking     *httpif.RoomBookingHandler
+	Student         *httpif.StudentHandler
+	Dashboard       *httpif.DashboardHandler
+	StudentClient   studentv1.StudentServiceClient     // exposed for AuthHandler
+	TeacherClient   hrv1.TeacherServiceClient          // exposed for ImportHandler
+	TimetableClient timetablev1.TimetableServiceClient // exposed for CalendarFeedHandler
+	conns           []*grpc.ClientConn
 }
 
 // Close releases all gRPC client connections.
@@ -83,12 +85,14 @@ func buildModuleHandlers(v *viper.Viper, js jetstream.JetStream, log *zap.Logger
 		} else {
 			h.conns = append(h.conns, conn)
 			semesterClient = timetablev1.NewSemesterServiceClient(conn)
+			h.TimetableClient = timetablev1.NewTimetableServiceClient(conn)
 			h.Timetable = httpif.NewTimetableHandler(
-				timetablev1.NewTimetableServiceClient(conn),
+				h.TimetableClient,
 				semesterClient,
 				subjectClient, // for resolving offered subject UUIDs to names
 				js,
 			)
+			h.RoomBooking = httpif.NewRoomBookingHandler(timetablev1.NewRoomBookingServiceClient(conn))
 			log.Info("timetable handler ready", zap.String("addr", addr))
 		}
 	}
@@ -67,14 +67,17 @@ func TestBuildEndpoint_SubjectsGetPrerequisites(t *testing.T) {
 }
 
 func TestBuildEndpoint_TimetableGenerate(t *testing.T) {
-	args := map[string]interface{}{"semester_id": "sem-42"}
-	url, method, _ := buildEndpoint("http://localhost:8080", "timetable", "generate", args)
+	args := map[string]interface{}{"semester_id": "sem-42", "seed": float64(1234), "portfolio_size": float64(4)}
+	url, method, body := buildEndpoint("http://localhost:8080", "timetable", "generate", args)
 	if method != http.MethodPost {
 		t.Fatalf("expected POST, got %s", method)
 	}
 	if url != "http://localhost:8080/api/timetable/semesters/sem-42/generate" {
 		t.Fatalf("unexpected url: %s", url)
 	}
+	if string(body) != `{"portfolio_size":4,"seed":1234}` {
+		t.Fatalf("unexpected body: %s", body)
+	}
 }
 
 func TestBuildEndpoint_TimetableSuggestTeachers(t *testing.T) {
@@ -88,6 +91,14 @@ func TestBuildEndpoint_TimetableSuggestTeachers(t *testing.T) {
 	}
 }
 
+func TestBuildEndpoint_TimetableSuggestTeachersForEntry(t *testing.T) {
+	args := map[string]interface{}{"schedule_id": "sch1", "entry_id": "e1"}
+	url, _, _ := buildEndpoint("http://localhost:8080", "timetable", "suggest_teachers", args)
+	if url != "http://localhost:8080/api/timetable/suggest-teachers?entry_id=e1&schedule_id=sch1" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+}
+
 func TestBuildEndpoint_Default(t *testing.T) {
 	url, method, _ := buildEndpoint("http://localhost:8080", "unknown", "do_something", nil)
 	if method != http.MethodGet {
@@ -248,6 +259,221 @@ func TestBuildEndpoint_TimetableListRooms(t *testing.T) {
 	}
 }
 
+func TestBuildEndpoint_TimetableGetGenerationStatus(t *testing.T) {
+	args := map[string]interface{}{"schedule_id": "sched-1"}
+	url, method, body := buildEndpoint("http://localhost:8080", "timetable", "get_generation_status", args)
+	if method != http.MethodGet {
+		t.Fatalf("expected GET, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/schedules/sched-1/status" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+	if body != nil {
+		t.Fatal("expected nil body for GET")
+	}
+}
+
+func TestBuildEndpoint_TimetableSetSoftConstraints(t *testing.T) {
+	args := map[string]interface{}{
+		"semester_id":      "sem-1",
+		"soft_constraints": []interface{}{map[string]interface{}{"type": "teacher_gap", "weight": 2.0}},
+	}
+	url, method, body := buildEndpoint("http://localhost:8080", "timetable", "set_soft_constraints", args)
+	if method != http.MethodPut {
+		t.Fatalf("expected PUT, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/semesters/sem-1/soft-constraints" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+	if body == nil {
+		t.Fatal("expected body with soft_constraints")
+	}
+}
+
+func TestBuildEndpoint_TimetableSetWeekPattern(t *testing.T) {
+	args := map[string]interface{}{"semester_id": "sem-1", "subject_id": "sub-1", "pattern": "odd_weeks"}
+	url, method, body := buildEndpoint("http://localhost:8080", "timetable", "set_week_pattern", args)
+	if method != http.MethodPut {
+		t.Fatalf("expected PUT, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/semesters/sem-1/week-patterns/sub-1" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+	if body == nil {
+		t.Fatal("expected body with pattern")
+	}
+}
+
+func TestBuildEndpoint_TimetableSetSubjectSections(t *testing.T) {
+	args := map[string]interface{}{
+		"semester_id": "sem-1",
+		"subject_id":  "sub-1",
+		"sections":    []interface{}{map[string]interface{}{"number": 1}, map[string]interface{}{"number": 2, "assistants": 1}},
+	}
+	url, method, body := buildEndpoint("http://localhost:8080", "timetable", "set_subject_sections", args)
+	if method != http.MethodPut {
+		t.Fatalf("expected PUT, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/semesters/sem-1/sections/sub-1" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(body, &m); err != nil || m["sections"] == nil || m["subject_id"] != nil {
+		t.Fatalf("expected body with sections only, got %s", body)
+	}
+}
+
+func TestBuildEndpoint_TimetableGenerateExamSchedule(t *testing.T) {
+	args := map[string]interface{}{
+		"semester_id": "sem-1",
+		"start_date":  "2026-12-14",
+		"end_date":    "2026-12-23",
+		"settings":    map[string]interface{}{"max_per_day": 1},
+	}
+	url, method, body := buildEndpoint("http://localhost:8080", "timetable", "generate_exam_schedule", args)
+	if method != http.MethodPost {
+		t.Fatalf("expected POST, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/semesters/sem-1/exam-schedules" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(body, &m); err != nil || m["start_date"] != "2026-12-14" {
+		t.Fatalf("expected body with dates, got %s", body)
+	}
+	if _, ok := m["semester_id"]; ok {
+		t.Fatal("semester_id must not be sent in the body")
+	}
+}
+
+func TestBuildEndpoint_TimetableReviewRoomBooking(t *testing.T) {
+	args := map[string]interface{}{"booking_id": "b-1", "approve": false, "note": "exam week"}
+	url, method, body := buildEndpoint("http://localhost:8080", "timetable", "review_room_booking", args)
+	if method != http.MethodPost {
+		t.Fatalf("expected POST, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/room-bookings/b-1/reject" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(body, &m); err != nil || m["note"] != "exam week" {
+		t.Fatalf("expected body with note, got %s", body)
+	}
+	if _, ok := m["approve"]; ok {
+		t.Fatal("approve is encoded in the path and must not be sent in the body")
+	}
+}
+
+func TestBuildEndpoint_TimetableRoomLifecycle(t *testing.T) {
+	url, method, body := buildEndpoint("http://localhost:8080", "timetable", "update_room", map[string]interface{}{"room_id": "r-1", "capacity": 40})
+	if method != http.MethodPatch || url != "http://localhost:8080/api/timetable/rooms/r-1" {
+		t.Fatalf("unexpected endpoint: %s %s", method, url)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(body, &m); err != nil || m["capacity"] != float64(40) {
+		t.Fatalf("expected body with capacity, got %s", body)
+	}
+	if _, ok := m["room_id"]; ok {
+		t.Fatal("room_id is encoded in the path and must not be sent in the body")
+	}
+
+	url, method, body = buildEndpoint("http://localhost:8080", "timetable", "set_room_active", map[string]interface{}{"room_id": "r-1", "active": false})
+	if method != http.MethodPost || url != "http://localhost:8080/api/timetable/rooms/r-1/deactivate" || body != nil {
+		t.Fatalf("unexpected endpoint: %s %s %s", method, url, body)
+	}
+}
+
+func TestBuildEndpoint_HRUpdateTeacherPreferences(t *testing.T) {
+	args := map[string]interface{}{
+		"teacher_id":  "t-1",
+		"preferences": []interface{}{map[string]interface{}{"day_of_week": 1, "start_time": "07:00", "preference": "disliked"}},
+	}
+	url, method, body := buildEndpoint("http://localhost:8080", "hr", "update_teacher_preferences", args)
+	if method != http.MethodPut {
+		t.Fatalf("expected PUT, got %s", method)
+	}
+	if url != "http://localhost:8080/api/hr/teachers/t-1/preferences" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+	if body == nil {
+		t.Fatal("expected body with preferences")
+	}
+}
+
+func TestBuildEndpoint_TimetableRepairSchedule(t *testing.T) {
+	args := map[string]interface{}{
+		"schedule_id":         "s-1",
+		"removed_teacher_ids": []interface{}{"t-1"},
+	}
+	url, method, body := buildEndpoint("http://localhost:8080", "timetable", "repair_schedule", args)
+	if method != http.MethodPost {
+		t.Fatalf("expected POST, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/schedules/s-1/repair" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+	if body == nil {
+		t.Fatal("expected body with removed_teacher_ids")
+	}
+}
+
+func TestBuildEndpoint_TimetableCancelGeneration(t *testing.T) {
+	url, method, _ := buildEndpoint("http://localhost:8080", "timetable", "cancel_generation", map[string]interface{}{"schedule_id": "s-1"})
+	if method != http.MethodPost {
+		t.Fatalf("expected POST, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/schedules/s-1/cancel" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+}
+
+func TestBuildEndpoint_TimetablePublishSchedule(t *testing.T) {
+	url, method, _ := buildEndpoint("http://localhost:8080", "timetable", "publish_schedule", map[string]interface{}{"schedule_id": "s-1"})
+	if method != http.MethodPost {
+		t.Fatalf("expected POST, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/schedules/s-1/publish" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+}
+
+func TestBuildEndpoint_TimetableRollbackSchedule(t *testing.T) {
+	// JSON numbers arrive as float64 and must render without a fraction
+	args := map[string]interface{}{"semester_id": "sem-1", "version": float64(3)}
+	url, method, _ := buildEndpoint("http://localhost:8080", "timetable", "rollback_schedule", args)
+	if method != http.MethodPost {
+		t.Fatalf("expected POST, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/semesters/sem-1/versions/3/rollback" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+}
+
+func TestBuildEndpoint_TimetableDiffSchedules(t *testing.T) {
+	args := map[string]interface{}{"semester_id": "sem-1", "base_version": float64(1), "target_version": float64(2)}
+	url, method, _ := buildEndpoint("http://localhost:8080", "timetable", "diff_schedules", args)
+	if method != http.MethodGet {
+		t.Fatalf("expected GET, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/schedule-diff?base_version=1&semester_id=sem-1&target_version=2" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+}
+
+func TestBuildEndpoint_TimetableCreateCalendarFeed(t *testing.T) {
+	args := map[string]interface{}{"semester_id": "sem-1", "teacher_id": "t-1"}
+	url, method, body := buildEndpoint("http://localhost:8080", "timetable", "create_calendar_feed", args)
+	if method != http.MethodPost {
+		t.Fatalf("expected POST, got %s", method)
+	}
+	if url != "http://localhost:8080/api/timetable/calendar-feeds" {
+		t.Fatalf("unexpected url: %s", url)
+	}
+	if body == nil {
+		t.Fatal("expected body with semester_id and teacher_id")
+	}
+}
+
 // --- Mutation endpoint tests ---
 
 func TestBuildEndpoint_HRCreateTeacher(t *testing.T) {
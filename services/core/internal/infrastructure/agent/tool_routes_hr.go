@@ -42,6 +42,14 @@ func buildHREndpoint(method string, args map[string]interface{}) (string, string
 		id := stringArg(args, "teacher_id")
 		return fmt.Sprintf("/api/hr/teachers/%s/availability", id), http.MethodPut, copyWithout(args, "teacher_id"), nil
 
+	case "get_teacher_preferences":
+		id := stringArg(args, "teacher_id")
+		return fmt.Sprintf("/api/hr/teachers/%s/preferences", id), http.MethodGet, nil, nil
+
+	case "update_teacher_preferences":
+		id := stringArg(args, "teacher_id")
+		return fmt.Sprintf("/api/hr/teachers/%s/preferences", id), http.MethodPut, copyWithout(args, "teacher_id"), nil
+
 	case "create_department":
 		return "/api/hr/departments", http.MethodPost, args, nil
 
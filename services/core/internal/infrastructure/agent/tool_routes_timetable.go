@@ -14,11 +14,11 @@ func buildTimetableEndpoint(method string, args map[string]interface{}) (string,
 
 	case "generate":
 		id, _ := args["semester_id"].(string)
-		return fmt.Sprintf("/api/timetable/semesters/%s/generate", id), http.MethodPost, nil, nil
+		return fmt.Sprintf("/api/timetable/semesters/%s/generate", id), http.MethodPost, copyWithout(args, "semester_id"), nil
 
 	case "suggest_teachers":
 		path := "/api/timetable/suggest-teachers"
-		if params := buildQueryParams(args, "subject_id"); params != "" {
+		if params := buildQueryParams(args, "subject_id", "schedule_id", "entry_id", "time_slot_id", "day_of_week", "start_period", "end_period"); params != "" {
 			path += "?" + params
 		}
 		return path, http.MethodGet, nil, nil
@@ -38,8 +38,54 @@ func buildTimetableEndpoint(method string, args map[string]interface{}) (string,
 		id, _ := args["schedule_id"].(string)
 		return fmt.Sprintf("/api/timetable/schedules/%s", id), http.MethodGet, nil, nil
 
+	case "get_generation_status":
+		id := stringArg(args, "schedule_id")
+		return fmt.Sprintf("/api/timetable/schedules/%s/status", id), http.MethodGet, nil, nil
+
+	case "cancel_generation":
+		id := stringArg(args, "schedule_id")
+		return fmt.Sprintf("/api/timetable/schedules/%s/cancel", id), http.MethodPost, nil, nil
+
+	case "publish_schedule":
+		id := stringArg(args, "schedule_id")
+		return fmt.Sprintf("/api/timetable/schedules/%s/publish", id), http.MethodPost, nil, nil
+
+	case "unpublish_schedule":
+		id := stringArg(args, "schedule_id")
+		return fmt.Sprintf("/api/timetable/schedules/%s/unpublish", id), http.MethodPost, nil, nil
+
+	case "archive_schedule":
+		id := stringArg(args, "schedule_id")
+		return fmt.Sprintf("/api/timetable/schedules/%s/archive", id), http.MethodPost, nil, nil
+
+	case "list_schedule_versions":
+		id := stringArg(args, "semester_id")
+		return fmt.Sprintf("/api/timetable/semesters/%s/versions", id), http.MethodGet, nil, nil
+
+	case "get_schedule_version":
+		id := stringArg(args, "semester_id")
+		return fmt.Sprintf("/api/timetable/semesters/%s/versions/%v", id, args["version"]), http.MethodGet, nil, nil
+
+	case "rollback_schedule":
+		id := stringArg(args, "semester_id")
+		return fmt.Sprintf("/api/timetable/semesters/%s/versions/%v/rollback", id, args["version"]), http.MethodPost, nil, nil
+
+	case "diff_schedules":
+		path := "/api/timetable/schedule-diff"
+		if params := buildQueryParams(args, "base_schedule_id", "target_schedule_id", "semester_id", "base_version", "target_version"); params != "" {
+			path += "?" + params
+		}
+		return path, http.MethodGet, nil, nil
+
+	case "create_calendar_feed":
+		return "/api/timetable/calendar-feeds", http.MethodPost, args, nil
+
 	case "list_rooms":
-		return "/api/timetable/rooms", http.MethodGet, nil, nil
+		path := "/api/timetable/rooms"
+		if params := buildQueryParams(args, "include_inactive"); params != "" {
+			path += "?" + params
+		}
+		return path, http.MethodGet, nil, nil
 
 	case "create_semester":
 		return "/api/timetable/semesters", http.MethodPost, args, nil
@@ -48,6 +94,107 @@ func buildTimetableEndpoint(method string, args map[string]interface{}) (string,
 		id := stringArg(args, "semester_id")
 		return fmt.Sprintf("/api/timetable/semesters/%s/rooms", id), http.MethodPut, copyWithout(args, "semester_id"), nil
 
+	case "set_soft_constraints":
+		id := stringArg(args, "semester_id")
+		return fmt.Sprintf("/api/timetable/semesters/%s/soft-constraints", id), http.MethodPut, copyWithout(args, "semester_id"), nil
+
+	case "set_period_definitions":
+		id := stringArg(args, "semester_id")
+		return fmt.Sprintf("/api/timetable/semesters/%s/periods", id), http.MethodPut, copyWithout(args, "semester_id"), nil
+
+	case "set_holidays":
+		id := stringArg(args, "semester_id")
+		return fmt.Sprintf("/api/timetable/semesters/%s/holidays", id), http.MethodPut, copyWithout(args, "semester_id"), nil
+
+	case "set_week_pattern":
+		id := stringArg(args, "semester_id")
+		subjectID := stringArg(args, "subject_id")
+		return fmt.Sprintf("/api/timetable/semesters/%s/week-patterns/%s", id, subjectID), http.MethodPut, copyWithout(args, "semester_id", "subject_id"), nil
+
+	case "set_subject_sections":
+		id := stringArg(args, "semester_id")
+		subjectID := stringArg(args, "subject_id")
+		return fmt.Sprintf("/api/timetable/semesters/%s/sections/%s", id, subjectID), http.MethodPut, copyWithout(args, "semester_id", "subject_id"), nil
+
+	case "generate_exam_schedule":
+		id := stringArg(args, "semester_id")
+		return fmt.Sprintf("/api/timetable/semesters/%s/exam-schedules", id), http.MethodPost, copyWithout(args, "semester_id"), nil
+
+	case "list_exam_schedules":
+		id := stringArg(args, "semester_id")
+		return fmt.Sprintf("/api/timetable/semesters/%s/exam-schedules", id), http.MethodGet, nil, nil
+
+	case "get_exam_schedule":
+		id := stringArg(args, "exam_schedule_id")
+		return fmt.Sprintf("/api/timetable/exam-schedules/%s", id), http.MethodGet, nil, nil
+
+	case "find_free_rooms":
+		path := "/api/timetable/rooms/free"
+		if params := buildQueryParams(args, "start_date", "end_date", "day_of_week", "start_period", "end_period", "min_capacity", "room_type"); params != "" {
+			path += "?" + params
+		}
+		return path, http.MethodGet, nil, nil
+
+	case "create_room_booking":
+		return "/api/timetable/room-bookings", http.MethodPost, args, nil
+
+	case "list_room_bookings":
+		path := "/api/timetable/room-bookings"
+		if params := buildQueryParams(args, "room_id", "status", "from", "to", "mine"); params != "" {
+			path += "?" + params
+		}
+		return path, http.MethodGet, nil, nil
+
+	case "review_room_booking":
+		id := stringArg(args, "booking_id")
+		action := "reject"
+		if approve, _ := args["approve"].(bool); approve {
+			action = "approve"
+		}
+		return fmt.Sprintf("/api/timetable/room-bookings/%s/%s", id, action), http.MethodPost, copyWithout(args, "booking_id", "approve"), nil
+
+	case "cancel_room_booking":
+		id := stringArg(args, "booking_id")
+		return fmt.Sprintf("/api/timetable/room-bookings/%s/cancel", id), http.MethodPost, nil, nil
+
+	case "create_room":
+		return "/api/timetable/rooms", http.MethodPost, args, nil
+
+	case "update_room":
+		id := stringArg(args, "room_id")
+		return fmt.Sprintf("/api/timetable/rooms/%s", id), http.MethodPatch, copyWithout(args, "room_id"), nil
+
+	case "set_room_active":
+		id := stringArg(args, "room_id")
+		action := "deactivate"
+		if active, _ := args["active"].(bool); active {
+			action = "activate"
+		}
+		return fmt.Sprintf("/api/timetable/rooms/%s/%s", id, action), http.MethodPost, nil, nil
+
+	case "delete_room":
+		id := stringArg(args, "room_id")
+		return fmt.Sprintf("/api/timetable/rooms/%s", id), http.MethodDelete, nil, nil
+
+	case "list_campuses":
+		return "/api/timetable/campuses", http.MethodGet, nil, nil
+
+	case "create_campus":
+		return "/api/timetable/campuses", http.MethodPost, args, nil
+
+	case "list_buildings":
+		path := "/api/timetable/buildings"
+		if params := buildQueryParams(args, "campus_id"); params != "" {
+			path += "?" + params
+		}
+		return path, http.MethodGet, nil, nil
+
+	case "create_building":
+		return "/api/timetable/buildings", http.MethodPost, args, nil
+
+	case "set_campus_travel_time":
+		return "/api/timetable/campus-travel-times", http.MethodPut, args, nil
+
 	case "create_time_slot":
 		id := stringArg(args, "semester_id")
 		return fmt.Sprintf("/api/timetable/semesters/%s/slots", id), http.MethodPost, copyWithout(args, "semester_id"), nil
@@ -75,6 +222,10 @@ func buildTimetableEndpoint(method string, args map[string]interface{}) (string,
 		entryID := stringArg(args, "entry_id")
 		return fmt.Sprintf("/api/timetable/schedules/%s/entries/%s", schedID, entryID), http.MethodPut, copyWithout(args, "schedule_id", "entry_id"), nil
 
+	case "repair_schedule":
+		schedID := stringArg(args, "schedule_id")
+		return fmt.Sprintf("/api/timetable/schedules/%s/repair", schedID), http.MethodPost, copyWithout(args, "schedule_id"), nil
+
 	default:
 		return "", "", nil, fmt.Errorf("unknown timetable method: %s", method)
 	}
@@ -146,6 +146,49 @@ var HRTools = []RegisteredTool{
 		ModuleName: "hr",
 		MethodName: "update_teacher_availability",
 	},
+	{
+		Definition: llm.Tool{
+			Name:        "hr.get_teacher_preferences",
+			Description: "Get the availability slots a teacher marked as preferred or disliked.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"teacher_id": {"type": "string", "description": "UUID of the teacher"}
+				},
+				"required": ["teacher_id"]
+			}`),
+		},
+		ModuleName: "hr",
+		MethodName: "get_teacher_preferences",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "hr.update_teacher_preferences",
+			Description: "Mark a teacher's available slots as preferred or disliked (replaces existing preferences; unlisted slots become neutral). Slots must already be in the teacher's availability.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"teacher_id": {"type": "string", "description": "UUID of the teacher"},
+					"preferences": {
+						"type": "array",
+						"description": "Preference per availability slot",
+						"items": {
+							"type": "object",
+							"properties": {
+								"day_of_week": {"type": "integer", "description": "Day of week of the availability slot"},
+								"start_time":  {"type": "string",  "description": "Start time of the availability slot in HH:MM format"},
+								"preference":  {"type": "string",  "enum": ["neutral", "preferred", "disliked"]}
+							},
+							"required": ["day_of_week", "start_time", "preference"]
+						}
+					}
+				},
+				"required": ["teacher_id", "preferences"]
+			}`),
+		},
+		ModuleName: "hr",
+		MethodName: "update_teacher_preferences",
+	},
 	{
 		Definition: llm.Tool{
 			Name:        "hr.create_department",
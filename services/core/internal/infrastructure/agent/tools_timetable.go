@@ -23,7 +23,9 @@ var TimetableTools = []RegisteredTool{
 			Parameters: rawSchema(`{
 				"type": "object",
 				"properties": {
-					"semester_id": {"type": "string", "description": "UUID of the semester (get it from timetable.list_semesters)"}
+					"semester_id":    {"type": "string",  "description": "UUID of the semester (get it from timetable.list_semesters)"},
+					"seed":           {"type": "integer", "description": "Seed of an earlier run to reproduce its schedule; omit for a fresh one"},
+					"portfolio_size": {"type": "integer", "description": "Number of solver strategies to run in parallel, keeping the best (default 1)"}
 				},
 				"required": ["semester_id"]
 			}`),
@@ -34,13 +36,18 @@ var TimetableTools = []RegisteredTool{
 	{
 		Definition: llm.Tool{
 			Name:        "timetable.suggest_teachers",
-			Description: "Suggest available teachers for a given subject.",
+			Description: "Suggest teachers for a subject, optionally at a given time. With a schedule, teachers already teaching at that time, outside their availability or over their weekly hours are marked unavailable, with the reason.",
 			Parameters: rawSchema(`{
 				"type": "object",
 				"properties": {
-					"subject_id": {"type": "string", "description": "UUID of the subject"}
-				},
-				"required": ["subject_id"]
+					"subject_id": {"type": "string", "description": "UUID of the subject; optional when entry_id is given"},
+					"schedule_id": {"type": "string", "description": "UUID of the schedule to check conflicts against"},
+					"entry_id": {"type": "string", "description": "UUID of the schedule entry being reassigned; requires schedule_id"},
+					"time_slot_id": {"type": "string", "description": "UUID of a semester time slot; requires schedule_id"},
+					"day_of_week": {"type": "integer", "description": "Day of week: 0=Sunday … 6=Saturday"},
+					"start_period": {"type": "integer", "description": "First period of the slot"},
+					"end_period": {"type": "integer", "description": "Last period of the slot"}
+				}
 			}`),
 		},
 		ModuleName: "timetable",
@@ -90,13 +97,173 @@ var TimetableTools = []RegisteredTool{
 		ModuleName: "timetable",
 		MethodName: "get_schedule",
 	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.get_generation_status",
+			Description: "Check whether schedule generation finished. For failed runs, explains which subjects could not be placed, which constraint (specialization, availability, room, overlap) eliminated their candidates, and the minimal set of conflicting subjects and teachers.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"schedule_id": {"type": "string", "description": "UUID of the schedule returned by timetable.generate"}
+				},
+				"required": ["schedule_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "get_generation_status",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.cancel_generation",
+			Description: "Cancel a schedule generation that is still queued or running. The schedule is marked failed.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"schedule_id": {"type": "string", "description": "UUID of the schedule being generated"}
+				},
+				"required": ["schedule_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "cancel_generation",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.publish_schedule",
+			Description: "Publish a completed schedule with no hard violations. The semester's previously published schedule is archived and a new numbered version is recorded.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"schedule_id": {"type": "string", "description": "UUID of the schedule"}
+				},
+				"required": ["schedule_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "publish_schedule",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.unpublish_schedule",
+			Description: "Return a published schedule to draft so it can be edited. Version history is kept.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"schedule_id": {"type": "string", "description": "UUID of the schedule"}
+				},
+				"required": ["schedule_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "unpublish_schedule",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.archive_schedule",
+			Description: "Archive a schedule that is no longer needed.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"schedule_id": {"type": "string", "description": "UUID of the schedule"}
+				},
+				"required": ["schedule_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "archive_schedule",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.list_schedule_versions",
+			Description: "List the published versions of a semester's schedule, newest first, with their scores.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id": {"type": "string", "description": "UUID of the semester"}
+				},
+				"required": ["semester_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "list_schedule_versions",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.get_schedule_version",
+			Description: "Get one published version of a semester's schedule, including the entries as they were when published.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id": {"type": "string", "description": "UUID of the semester"},
+					"version": {"type": "integer", "description": "Version number from timetable.list_schedule_versions"}
+				},
+				"required": ["semester_id", "version"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "get_schedule_version",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.rollback_schedule",
+			Description: "Republish an earlier version of a semester's schedule. Creates a new schedule from the version's entries and records it as the next version.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id": {"type": "string", "description": "UUID of the semester"},
+					"version": {"type": "integer", "description": "Version number from timetable.list_schedule_versions"}
+				},
+				"required": ["semester_id", "version"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "rollback_schedule",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.diff_schedules",
+			Description: "Compare two schedules, or two published versions of a semester, session by session. Lists moved, reassigned, added and removed sessions with readable teacher, room and slot names, plus per-teacher and per-room change counts. Give each side as a schedule UUID, or give semester_id with a version number.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"base_schedule_id": {"type": "string", "description": "UUID of the schedule to compare from"},
+					"target_schedule_id": {"type": "string", "description": "UUID of the schedule to compare to"},
+					"semester_id": {"type": "string", "description": "UUID of the semester, required when comparing versions"},
+					"base_version": {"type": "integer", "description": "Version to compare from, instead of base_schedule_id"},
+					"target_version": {"type": "integer", "description": "Version to compare to, instead of target_schedule_id"}
+				}
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "diff_schedules",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.create_calendar_feed",
+			Description: "Create a read-only calendar subscription URL (iCalendar) for a semester's published timetable that can be added to Google Calendar or Outlook. Optionally narrow it to one teacher, room or department.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id": {"type": "string", "description": "UUID of the semester"},
+					"teacher_id": {"type": "string", "description": "Optional: only this teacher's classes"},
+					"room_id": {"type": "string", "description": "Optional: only classes in this room"},
+					"department_id": {"type": "string", "description": "Optional: only this department's subjects"}
+				},
+				"required": ["semester_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "create_calendar_feed",
+	},
 	{
 		Definition: llm.Tool{
 			Name:        "timetable.list_rooms",
-			Description: "List all available rooms.",
+			Description: "List the active rooms with capacity, type, features and building.",
 			Parameters: rawSchema(`{
 				"type": "object",
-				"properties": {}
+				"properties": {
+					"include_inactive": {"type": "boolean", "description": "Also list deactivated rooms"}
+				}
 			}`),
 		},
 		ModuleName: "timetable",
@@ -135,6 +302,457 @@ var TimetableTools = []RegisteredTool{
 		ModuleName: "timetable",
 		MethodName: "set_semester_rooms",
 	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.set_soft_constraints",
+			Description: "Configure the weighted soft constraints the solver optimises for a semester (replaces existing settings; empty list restores defaults). Types: teacher_gap, load_imbalance, preference_break, late_period (param after_period), max_consecutive (param max_periods), building_travel, lunch_break (params start_period, end_period), campus_travel (params break_minutes, default_minutes).",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id":      {"type": "string", "description": "UUID of the semester"},
+					"soft_constraints": {
+						"type": "array",
+						"description": "Soft constraint settings",
+						"items": {
+							"type": "object",
+							"properties": {
+								"type":   {"type": "string", "description": "Constraint type"},
+								"weight": {"type": "number", "description": "Penalty weight (0 disables)"},
+								"params": {"type": "object", "additionalProperties": {"type": "integer"}, "description": "Integer params for the constraint"}
+							},
+							"required": ["type", "weight"]
+						}
+					}
+				},
+				"required": ["semester_id", "soft_constraints"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "set_soft_constraints",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.set_period_definitions",
+			Description: "Set the clock times of a semester's teaching periods, either as an explicit table or generated from equal-length periods with breaks (replaces the table; empty restores the default 08:00-21:45 table). The table must cover every period used by the semester's time slots.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id": {"type": "string", "description": "UUID of the semester"},
+					"periods": {
+						"type": "array",
+						"description": "Explicit table, numbered from 1 in order",
+						"items": {
+							"type": "object",
+							"properties": {
+								"period": {"type": "integer"},
+								"start":  {"type": "string", "description": "HH:MM"},
+								"end":    {"type": "string", "description": "HH:MM"}
+							},
+							"required": ["period", "start", "end"]
+						}
+					},
+					"generate": {
+						"type": "object",
+						"description": "Generate the table instead of listing it",
+						"properties": {
+							"first_start":    {"type": "string", "description": "Start of period 1, HH:MM"},
+							"count":          {"type": "integer", "description": "Number of periods"},
+							"period_minutes": {"type": "integer", "description": "Length of each period"},
+							"break_minutes":  {"type": "integer", "description": "Break between periods"},
+							"long_breaks":    {"type": "object", "additionalProperties": {"type": "integer"}, "description": "Break minutes after a given period, e.g. {\"3\": 60} for lunch"}
+						},
+						"required": ["first_start", "count", "period_minutes"]
+					}
+				},
+				"required": ["semester_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "set_period_definitions",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.set_holidays",
+			Description: "Set a semester's holidays and other non-teaching days (replaces the calendar). Classes on these days are left out of calendar exports and workload hours.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id": {"type": "string", "description": "UUID of the semester"},
+					"holidays": {
+						"type": "array",
+						"items": {
+							"type": "object",
+							"properties": {
+								"name":       {"type": "string"},
+								"start_date": {"type": "string", "description": "YYYY-MM-DD"},
+								"end_date":   {"type": "string", "description": "YYYY-MM-DD, last day of a range (optional)"}
+							},
+							"required": ["start_date"]
+						}
+					}
+				},
+				"required": ["semester_id", "holidays"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "set_holidays",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.set_week_pattern",
+			Description: "Set which weeks an offered subject meets: weekly (default), odd_weeks, even_weeks, first_half or second_half of the semester.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id": {"type": "string", "description": "UUID of the semester"},
+					"subject_id":  {"type": "string", "description": "UUID of the offered subject"},
+					"pattern":     {"type": "string", "enum": ["weekly", "odd_weeks", "even_weeks", "first_half", "second_half"]}
+				},
+				"required": ["semester_id", "subject_id", "pattern"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "set_week_pattern",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.set_subject_sections",
+			Description: "Split an offered subject into sections scheduled separately, e.g. two lecture groups of 40. Each section may be reserved for a student group and may need assistant teachers alongside its lead. Pass a single section 1 to undo the split.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id": {"type": "string", "description": "UUID of the semester"},
+					"subject_id":  {"type": "string", "description": "UUID of the offered subject"},
+					"sections": {
+						"type": "array",
+						"items": {
+							"type": "object",
+							"properties": {
+								"number":        {"type": "integer", "description": "1-based section number, unique within the subject"},
+								"capacity":      {"type": "integer", "description": "Expected enrolment; rooms must seat it"},
+								"student_group": {"type": "string", "description": "Student group the section is reserved for"},
+								"assistants":    {"type": "integer", "description": "Assistant teachers needed per session (0-4)"}
+							},
+							"required": ["number"]
+						}
+					}
+				},
+				"required": ["semester_id", "subject_id", "sections"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "set_subject_sections",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.generate_exam_schedule",
+			Description: "Generate a final-exam timetable for a semester's offered subjects between two dates. Assigns a day, session, room and invigilating teacher to every exam; students never sit two exams at once nor more than max_per_day a day.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id":     {"type": "string", "description": "UUID of the semester"},
+					"start_date":      {"type": "string", "description": "First exam day, YYYY-MM-DD"},
+					"end_date":        {"type": "string", "description": "Last exam day, YYYY-MM-DD"},
+					"name":            {"type": "string", "description": "Optional name, e.g. Finals"},
+					"timeout_seconds": {"type": "integer", "description": "Solver timeout (default 20)"},
+					"settings": {
+						"type": "object",
+						"properties": {
+							"sessions": {
+								"type": "array",
+								"description": "Exam sittings per day as period ranges (default periods 1-3 and 4-6)",
+								"items": {
+									"type": "object",
+									"properties": {
+										"start_period": {"type": "integer"},
+										"end_period":   {"type": "integer"}
+									},
+									"required": ["start_period", "end_period"]
+								}
+							},
+							"max_per_day":       {"type": "integer", "description": "Most exams a student may sit in one day (default 2)"},
+							"seat_spacing":      {"type": "integer", "description": "Empty seats between candidates; room capacity is divided by spacing+1"},
+							"min_gap_days":      {"type": "integer", "description": "Preferred days between exams sharing students (default 2)"},
+							"max_invigilations": {"type": "integer", "description": "Most sittings a teacher invigilates (0 = no limit)"}
+						}
+					}
+				},
+				"required": ["semester_id", "start_date", "end_date"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "generate_exam_schedule",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.list_exam_schedules",
+			Description: "List the exam schedules generated for a semester, newest first (without entries).",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"semester_id": {"type": "string", "description": "UUID of the semester"}
+				},
+				"required": ["semester_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "list_exam_schedules",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.get_exam_schedule",
+			Description: "Get an exam schedule with every exam's date, session, room, invigilator and student count.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"exam_schedule_id": {"type": "string", "description": "UUID of the exam schedule"}
+				},
+				"required": ["exam_schedule_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "get_exam_schedule",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.find_free_rooms",
+			Description: "Find rooms that no published class or active booking holds during a period range on every day from start_date to end_date (optionally only on one weekday).",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"start_date":   {"type": "string",  "description": "First day, YYYY-MM-DD"},
+					"end_date":     {"type": "string",  "description": "Last day, YYYY-MM-DD (defaults to start_date)"},
+					"day_of_week":  {"type": "integer", "description": "Only check this weekday of the range: 0=Monday … 6=Sunday"},
+					"start_period": {"type": "integer", "description": "First period needed (1-based)"},
+					"end_period":   {"type": "integer", "description": "Last period needed, inclusive"},
+					"min_capacity": {"type": "integer", "description": "Minimum seats"},
+					"room_type":    {"type": "string",  "description": "Required room type, e.g. lab"}
+				},
+				"required": ["start_date", "start_period", "end_period"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "find_free_rooms",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.create_room_booking",
+			Description: "Book a room for an ad-hoc event (defence, meeting, make-up class) on the current user's behalf. Fails if a published class or another booking holds the room; rooms that require approval return a pending booking.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"room_id":      {"type": "string",  "description": "UUID of the room"},
+					"title":        {"type": "string",  "description": "Short title of the event"},
+					"purpose":      {"type": "string",  "description": "Optional details"},
+					"start_date":   {"type": "string",  "description": "First day, YYYY-MM-DD"},
+					"end_date":     {"type": "string",  "description": "Last day, YYYY-MM-DD (defaults to start_date)"},
+					"start_period": {"type": "integer", "description": "First period (1-based)"},
+					"end_period":   {"type": "integer", "description": "Last period, inclusive"}
+				},
+				"required": ["room_id", "title", "start_date", "start_period", "end_period"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "create_room_booking",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.list_room_bookings",
+			Description: "List room bookings, optionally filtered by room, status, date range or to the current user's own bookings.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"room_id": {"type": "string",  "description": "UUID of the room"},
+					"status":  {"type": "string",  "description": "Comma-separated statuses: pending, approved, rejected, cancelled"},
+					"from":    {"type": "string",  "description": "Bookings ending on or after this day, YYYY-MM-DD"},
+					"to":      {"type": "string",  "description": "Bookings starting on or before this day, YYYY-MM-DD"},
+					"mine":    {"type": "boolean", "description": "Only the current user's bookings"}
+				}
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "list_room_bookings",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.review_room_booking",
+			Description: "Approve or reject a pending booking of a restricted room (admin only).",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"booking_id": {"type": "string",  "description": "UUID of the booking"},
+					"approve":    {"type": "boolean", "description": "true to approve, false to reject"},
+					"note":       {"type": "string",  "description": "Optional note for the requester"}
+				},
+				"required": ["booking_id", "approve"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "review_room_booking",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.cancel_room_booking",
+			Description: "Cancel a pending or approved room booking and free the room.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"booking_id": {"type": "string", "description": "UUID of the booking"}
+				},
+				"required": ["booking_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "cancel_room_booking",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.create_room",
+			Description: "Create a room. Admin only.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"name":              {"type": "string",  "description": "Room name (unique)"},
+					"capacity":          {"type": "integer", "description": "Number of seats"},
+					"room_type":         {"type": "string",  "description": "classroom, lab or lecture_hall (default classroom)"},
+					"features":          {"type": "array", "items": {"type": "string"}, "description": "Equipment such as projector"},
+					"requires_approval": {"type": "boolean", "description": "Bookings of the room wait for admin approval"},
+					"building_id":       {"type": "string",  "description": "UUID of the building the room is in"}
+				},
+				"required": ["name", "capacity"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "create_room",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.update_room",
+			Description: "Change a room's details; omitted fields stay as they are. Admin only.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"room_id":           {"type": "string",  "description": "UUID of the room"},
+					"name":              {"type": "string",  "description": "New name"},
+					"capacity":          {"type": "integer", "description": "New number of seats"},
+					"room_type":         {"type": "string",  "description": "classroom, lab or lecture_hall"},
+					"features":          {"type": "array", "items": {"type": "string"}, "description": "Replaces the room's features"},
+					"requires_approval": {"type": "boolean", "description": "Bookings of the room wait for admin approval"},
+					"building_id":       {"type": "string",  "description": "UUID of the new building; empty string removes the building"}
+				},
+				"required": ["room_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "update_room",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.set_room_active",
+			Description: "Deactivate a room so the solver and bookings stop using it (existing schedules keep it), or activate it again. Admin only.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"room_id": {"type": "string",  "description": "UUID of the room"},
+					"active":  {"type": "boolean", "description": "true to activate, false to deactivate"}
+				},
+				"required": ["room_id", "active"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "set_room_active",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.delete_room",
+			Description: "Delete a room. Refused while a published schedule, draft schedule or booking uses it; deactivate it instead. Admin only.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"room_id": {"type": "string", "description": "UUID of the room"}
+				},
+				"required": ["room_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "delete_room",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.list_campuses",
+			Description: "List campuses with the travel times in minutes between them.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {}
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "list_campuses",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.create_campus",
+			Description: "Create a campus. Admin only.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"code": {"type": "string", "description": "Short unique code"},
+					"name": {"type": "string", "description": "Campus name"}
+				},
+				"required": ["code", "name"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "create_campus",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.list_buildings",
+			Description: "List buildings, optionally of one campus.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"campus_id": {"type": "string", "description": "UUID of the campus"}
+				}
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "list_buildings",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.create_building",
+			Description: "Create a building on a campus. Admin only.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"campus_id": {"type": "string", "description": "UUID of the campus"},
+					"code":      {"type": "string", "description": "Short unique code"},
+					"name":      {"type": "string", "description": "Building name"}
+				},
+				"required": ["campus_id", "code", "name"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "create_building",
+	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.set_campus_travel_time",
+			Description: "Set the minutes needed to travel between two campuses (both directions). Used by the campus_travel soft constraint. Admin only.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"from_campus_id": {"type": "string",  "description": "UUID of one campus"},
+					"to_campus_id":   {"type": "string",  "description": "UUID of the other campus"},
+					"minutes":        {"type": "integer", "description": "Travel time in minutes"}
+				},
+				"required": ["from_campus_id", "to_campus_id", "minutes"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "set_campus_travel_time",
+	},
 	{
 		Definition: llm.Tool{
 			Name:        "timetable.create_time_slot",
@@ -221,13 +839,16 @@ var TimetableTools = []RegisteredTool{
 	{
 		Definition: llm.Tool{
 			Name:        "timetable.manual_assign",
-			Description: "Manually assign a teacher to a specific schedule entry.",
+			Description: "Manually assign a teacher, and optionally move the entry to another room or time slot. Returns the hard constraint violations the edit would introduce; set dry_run to preview without saving.",
 			Parameters: rawSchema(`{
 				"type": "object",
 				"properties": {
-					"schedule_id": {"type": "string", "description": "UUID of the schedule"},
-					"entry_id":    {"type": "string", "description": "UUID of the schedule entry"},
-					"teacher_id":  {"type": "string", "description": "UUID of the teacher to assign"}
+					"schedule_id":  {"type": "string", "description": "UUID of the schedule"},
+					"entry_id":     {"type": "string", "description": "UUID of the schedule entry"},
+					"teacher_id":   {"type": "string", "description": "UUID of the teacher to assign"},
+					"room_id":      {"type": "string", "description": "UUID of the room to move to (optional)"},
+					"time_slot_id": {"type": "string", "description": "UUID of the time slot to move to (optional)"},
+					"dry_run":      {"type": "boolean", "description": "Only preview the violations and score; do not save"}
 				},
 				"required": ["schedule_id", "entry_id", "teacher_id"]
 			}`),
@@ -235,4 +856,23 @@ var TimetableTools = []RegisteredTool{
 		ModuleName: "timetable",
 		MethodName: "manual_assign",
 	},
+	{
+		Definition: llm.Tool{
+			Name:        "timetable.repair_schedule",
+			Description: "Repair an existing schedule after teachers leave, rooms close or slots are removed. Only the affected entries are re-solved; everything else stays where it is. Published schedules must be unpublished first.",
+			Parameters: rawSchema(`{
+				"type": "object",
+				"properties": {
+					"schedule_id":         {"type": "string", "description": "UUID of the schedule"},
+					"removed_teacher_ids": {"type": "array", "items": {"type": "string"}, "description": "UUIDs of teachers who can no longer teach"},
+					"closed_room_ids":     {"type": "array", "items": {"type": "string"}, "description": "UUIDs of rooms that can no longer be used"},
+					"removed_slot_ids":    {"type": "array", "items": {"type": "string"}, "description": "UUIDs of time slots being removed"},
+					"timeout_seconds":     {"type": "integer", "description": "Solver timeout in seconds (default 10)"}
+				},
+				"required": ["schedule_id"]
+			}`),
+		},
+		ModuleName: "timetable",
+		MethodName: "repair_schedule",
+	},
 }
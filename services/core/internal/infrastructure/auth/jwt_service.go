@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"fmt"
 	"time"
 
@@ -108,3 +110,55 @@ func (s *JWTService) ExtractClaims(token string) (userID, role, departmentID str
 	}
 	return claims.UserID, claims.Role, claims.DepartmentID, nil
 }
+
+// CalendarFeedClaims scope a read-only timetable subscription to a semester's
+// published schedule, optionally narrowed to one teacher, room, student or
+// department. Subject is the user who created the feed and ID (the jti) is
+// the feed's row in core.calendar_feeds, which is what revocation acts on.
+type CalendarFeedClaims struct {
+	SemesterID   string `json:"semester_id"`
+	TeacherID    string `json:"teacher_id,omitempty"`
+	RoomID       string `json:"room_id,omitempty"`
+	StudentID    string `json:"student_id,omitempty"`
+	DepartmentID string `json:"department_id,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// GenerateCalendarFeedToken signs a token for the stored feed feedID. Feed
+// tokens do not expire, since calendar clients cannot refresh them; they are
+// revoked through the feed store instead, and are signed with a key derived
+// from the secret so they are never accepted as access tokens.
+func (s *JWTService) GenerateCalendarFeedToken(userID, feedID string, feed CalendarFeedClaims) (string, error) {
+	feed.RegisteredClaims = jwt.RegisteredClaims{
+		ID:       feedID,
+		Subject:  userID,
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, feed)
+	return token.SignedString(s.calendarFeedKey())
+}
+
+// ValidateCalendarFeedToken verifies a token from GenerateCalendarFeedToken.
+// Callers must still check that the feed named by the jti is not revoked.
+func (s *JWTService) ValidateCalendarFeedToken(tokenStr string) (*CalendarFeedClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenStr, &CalendarFeedClaims{}, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return s.calendarFeedKey(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parse calendar feed token: %w", err)
+	}
+	claims, ok := token.Claims.(*CalendarFeedClaims)
+	if !ok || !token.Valid || claims.SemesterID == "" || claims.ID == "" {
+		return nil, fmt.Errorf("invalid calendar feed claims")
+	}
+	return claims, nil
+}
+
+func (s *JWTService) calendarFeedKey() []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte("calendar-feed"))
+	return mac.Sum(nil)
+}
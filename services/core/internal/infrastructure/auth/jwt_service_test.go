@@ -116,3 +116,29 @@ func TestJWTService_Validate_Wrapper(t *testing.T) {
 		t.Errorf("got %q want %q", userID, "user-42")
 	}
 }
+
+func TestJWTService_CalendarFeedToken_NotAnAccessToken(t *testing.T) {
+	svc := NewJWTService("secret", time.Hour, 7*24*time.Hour)
+	tok, err := svc.GenerateCalendarFeedToken("user-1", "feed-1", CalendarFeedClaims{SemesterID: "sem-1", TeacherID: "t-1"})
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	feed, err := svc.ValidateCalendarFeedToken(tok)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if feed.SemesterID != "sem-1" || feed.TeacherID != "t-1" || feed.Subject != "user-1" || feed.ID != "feed-1" {
+		t.Errorf("unexpected claims: %+v", feed)
+	}
+	if _, err := svc.ValidateToken(tok); err == nil {
+		t.Error("feed token must not validate as an access token")
+	}
+	unrevocable, _ := svc.GenerateCalendarFeedToken("user-1", "", CalendarFeedClaims{SemesterID: "sem-1"})
+	if _, err := svc.ValidateCalendarFeedToken(unrevocable); err == nil {
+		t.Error("a feed token without a jti must be rejected")
+	}
+	access, _ := svc.GenerateAccessToken("user-1", "admin", "", "")
+	if _, err := svc.ValidateCalendarFeedToken(access); err == nil {
+		t.Error("access token must not validate as a feed token")
+	}
+}
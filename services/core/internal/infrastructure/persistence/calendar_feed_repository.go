@@ -0,0 +1,114 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrCalendarFeedNotFound is returned when a feed id is unknown or already revoked.
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+// CalendarFeedRow is an issued calendar subscription. Scope ids are empty
+// strings when unset.
+type CalendarFeedRow struct {
+	ID           string
+	CreatedBy    string
+	SemesterID   string
+	TeacherID    string
+	RoomID       string
+	StudentID    string
+	DepartmentID string
+	RevokedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// CalendarFeedRepository provides read/write access to core.calendar_feeds.
+type CalendarFeedRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewCalendarFeedRepository(pool *pgxpool.Pool) *CalendarFeedRepository {
+	return &CalendarFeedRepository{pool: pool}
+}
+
+// Create records a new feed and returns its id, which becomes the token's jti.
+func (r *CalendarFeedRepository) Create(ctx context.Context, feed CalendarFeedRow) (string, error) {
+	return insertCalendarFeed(ctx, r.pool, feed)
+}
+
+// GetActive returns a feed that has not been revoked.
+func (r *CalendarFeedRepository) GetActive(ctx context.Context, id string) (*CalendarFeedRow, error) {
+	var f CalendarFeedRow
+	err := r.pool.QueryRow(ctx, `
+		SELECT id, created_by, semester_id, COALESCE(teacher_id, ''), COALESCE(room_id, ''),
+		       COALESCE(student_id, ''), COALESCE(department_id, ''), revoked_at, created_at
+		FROM core.calendar_feeds
+		WHERE id = $1::uuid AND revoked_at IS NULL`,
+		id,
+	).Scan(&f.ID, &f.CreatedBy, &f.SemesterID, &f.TeacherID, &f.RoomID,
+		&f.StudentID, &f.DepartmentID, &f.RevokedAt, &f.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCalendarFeedNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Revoke stops a feed from being served.
+func (r *CalendarFeedRepository) Revoke(ctx context.Context, id string) error {
+	return revokeCalendarFeed(ctx, r.pool, id)
+}
+
+// Regenerate revokes a feed and issues a copy of it under a new id in one
+// transaction, so a leaked URL can be replaced without changing its scope.
+func (r *CalendarFeedRepository) Regenerate(ctx context.Context, feed CalendarFeedRow) (string, error) {
+	var id string
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := revokeCalendarFeed(ctx, tx, feed.ID); err != nil {
+			return err
+		}
+		var err error
+		id, err = insertCalendarFeed(ctx, tx, feed)
+		return err
+	})
+	return id, err
+}
+
+// calendarFeedDB is satisfied by both the pool and a transaction.
+type calendarFeedDB interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func insertCalendarFeed(ctx context.Context, db calendarFeedDB, feed CalendarFeedRow) (string, error) {
+	var id string
+	err := db.QueryRow(ctx, `
+		INSERT INTO core.calendar_feeds (created_by, semester_id, teacher_id, room_id, student_id, department_id)
+		VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''))
+		RETURNING id`,
+		feed.CreatedBy, feed.SemesterID, feed.TeacherID, feed.RoomID, feed.StudentID, feed.DepartmentID,
+	).Scan(&id)
+	return id, err
+}
+
+func revokeCalendarFeed(ctx context.Context, db calendarFeedDB, id string) error {
+	tag, err := db.Exec(ctx, `
+		UPDATE core.calendar_feeds SET revoked_at = NOW()
+		WHERE id = $1::uuid AND revoked_at IS NULL`,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCalendarFeedNotFound
+	}
+	return nil
+}
@@ -18,7 +18,7 @@ func grpcToHTTPStatus(err error) int {
 		return http.StatusNotFound
 	case codes.InvalidArgument:
 		return http.StatusBadRequest
-	case codes.AlreadyExists:
+	case codes.AlreadyExists, codes.FailedPrecondition:
 		return http.StatusConflict
 	case codes.PermissionDenied:
 		return http.StatusForbidden
@@ -47,7 +47,7 @@ func hrTimeToSlot(t string) int32 {
 	return 0
 }
 
-// availabilityToJSON converts proto TimeSlot list to frontend [{day_of_week, start_time, end_time}] format.
+// availabilityToJSON converts proto TimeSlot list to frontend [{day_of_week, start_time, end_time, preference}] format.
 func availabilityToJSON(slots []*hrv1.TimeSlot) []gin.H {
 	result := make([]gin.H, 0, len(slots))
 	for _, s := range slots {
@@ -56,10 +56,15 @@ func availabilityToJSON(slots []*hrv1.TimeSlot) []gin.H {
 		if start == "" {
 			continue
 		}
+		preference := s.Preference
+		if preference == "" {
+			preference = "neutral"
+		}
 		result = append(result, gin.H{
 			"day_of_week": s.DayOfWeek,
 			"start_time":  start,
 			"end_time":    end,
+			"preference":  preference,
 		})
 	}
 	return result
@@ -84,9 +89,10 @@ func (h *HRHandler) GetTeacherAvailability(c *gin.Context) {
 func (h *HRHandler) UpdateTeacherAvailability(c *gin.Context) {
 	var body struct {
 		AvailableSlots []struct {
-			DayOfWeek int32  `json:"day_of_week"`
-			StartTime string `json:"start_time"`
-			EndTime   string `json:"end_time"`
+			DayOfWeek  int32  `json:"day_of_week"`
+			StartTime  string `json:"start_time"`
+			EndTime    string `json:"end_time"`
+			Preference string `json:"preference"`
 		} `json:"available_slots"`
 	}
 	if err := c.ShouldBindJSON(&body); err != nil {
@@ -105,6 +111,7 @@ func (h *HRHandler) UpdateTeacherAvailability(c *gin.Context) {
 			DayOfWeek:   s.DayOfWeek,
 			StartPeriod: sp,
 			EndPeriod:   sp + 1,
+			Preference:  s.Preference,
 		})
 	}
 
@@ -121,3 +128,62 @@ func (h *HRHandler) UpdateTeacherAvailability(c *gin.Context) {
 		"availability": availabilityToJSON(resp.AvailableSlots),
 	})
 }
+
+// GetTeacherPreferences GET /teachers/:id/preferences
+func (h *HRHandler) GetTeacherPreferences(c *gin.Context) {
+	resp, err := h.teachers.ListTeacherPreferences(c.Request.Context(), &hrv1.ListTeacherPreferencesRequest{
+		TeacherId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"teacher_id":  resp.TeacherId,
+		"preferences": availabilityToJSON(resp.Preferences),
+	})
+}
+
+// UpdateTeacherPreferences PUT /teachers/:id/preferences
+// Marks available slots as preferred or disliked; unlisted slots become neutral.
+func (h *HRHandler) UpdateTeacherPreferences(c *gin.Context) {
+	var body struct {
+		Preferences []struct {
+			DayOfWeek  int32  `json:"day_of_week"`
+			StartTime  string `json:"start_time"`
+			Preference string `json:"preference" binding:"required"`
+		} `json:"preferences"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	prefs := make([]*hrv1.TimeSlot, 0, len(body.Preferences))
+	for _, p := range body.Preferences {
+		sp := hrTimeToSlot(p.StartTime)
+		if sp == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid start_time: %s", p.StartTime)})
+			return
+		}
+		prefs = append(prefs, &hrv1.TimeSlot{
+			DayOfWeek:   p.DayOfWeek,
+			StartPeriod: sp,
+			EndPeriod:   sp + 1,
+			Preference:  p.Preference,
+		})
+	}
+
+	resp, err := h.teachers.UpdateTeacherPreferences(c.Request.Context(), &hrv1.UpdateTeacherPreferencesRequest{
+		TeacherId:   c.Param("id"),
+		Preferences: prefs,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"teacher_id":  resp.TeacherId,
+		"preferences": availabilityToJSON(resp.Preferences),
+	})
+}
@@ -27,6 +27,8 @@ type RouterConfig struct {
 	HRHandler            *HRHandler
 	SubjectHandler       *SubjectHandler
 	TimetableHandler     *TimetableHandler
+	CalendarFeedHandler  *CalendarFeedHandler
+	RoomBookingHandler   *RoomBookingHandler
 	StudentHandler       *StudentHandler
 	StudentPortalHandler *StudentPortalHandler
 	DashboardHandler     *DashboardHandler
@@ -82,6 +84,11 @@ func NewRouter(cfg RouterConfig) *gin.Engine {
 		}
 	}
 
+	// Calendar subscriptions (public; the signed token is the credential)
+	if cfg.CalendarFeedHandler != nil {
+		api.GET("/calendar/:token", middleware.RateLimitMiddleware(60, time.Minute), cfg.CalendarFeedHandler.ServeFeed)
+	}
+
 	// Protected routes
 	protected := api.Group("")
 	protected.Use(middleware.AuthMiddleware(cfg.JWTService))
@@ -21,6 +21,8 @@ func registerModuleRoutes(protected *gin.RouterGroup, cfg RouterConfig) {
 			hr.DELETE("/teachers/:id", middleware.RequireRole("admin", "super_admin"), cfg.HRHandler.DeleteTeacher)
 			hr.GET("/teachers/:id/availability", cfg.HRHandler.GetTeacherAvailability)
 			hr.PUT("/teachers/:id/availability", middleware.RequireRole("admin", "super_admin", "dept_head"), cfg.HRHandler.UpdateTeacherAvailability)
+			hr.GET("/teachers/:id/preferences", cfg.HRHandler.GetTeacherPreferences)
+			hr.PUT("/teachers/:id/preferences", middleware.RequireRole("admin", "super_admin", "dept_head"), cfg.HRHandler.UpdateTeacherPreferences)
 			hr.GET("/departments", cfg.HRHandler.ListDepartments)
 			hr.POST("/departments", middleware.RequireRole("admin", "super_admin"), cfg.HRHandler.CreateDepartment)
 		}
@@ -51,21 +53,65 @@ func registerModuleRoutes(protected *gin.RouterGroup, cfg RouterConfig) {
 		tt := protected.Group("/timetable")
 		{
 			tt.GET("/rooms", cfg.TimetableHandler.ListRooms)
+			tt.POST("/rooms", middleware.RequireRole("admin", "super_admin"), cfg.TimetableHandler.CreateRoom)
+			tt.GET("/rooms/:id", cfg.TimetableHandler.GetRoom)
+			tt.PATCH("/rooms/:id", middleware.RequireRole("admin", "super_admin"), cfg.TimetableHandler.UpdateRoom)
+			tt.DELETE("/rooms/:id", middleware.RequireRole("admin", "super_admin"), cfg.TimetableHandler.DeleteRoom)
+			tt.POST("/rooms/:id/deactivate", middleware.RequireRole("admin", "super_admin"), cfg.TimetableHandler.DeactivateRoom)
+			tt.POST("/rooms/:id/activate", middleware.RequireRole("admin", "super_admin"), cfg.TimetableHandler.ActivateRoom)
+			tt.GET("/campuses", cfg.TimetableHandler.ListCampuses)
+			tt.POST("/campuses", middleware.RequireRole("admin", "super_admin"), cfg.TimetableHandler.CreateCampus)
+			tt.PUT("/campus-travel-times", middleware.RequireRole("admin", "super_admin"), cfg.TimetableHandler.SetCampusTravelTime)
+			tt.GET("/buildings", cfg.TimetableHandler.ListBuildings)
+			tt.POST("/buildings", middleware.RequireRole("admin", "super_admin"), cfg.TimetableHandler.CreateBuilding)
 			tt.GET("/semesters", cfg.TimetableHandler.ListSemesters)
 			tt.POST("/semesters", cfg.TimetableHandler.CreateSemester)
 			tt.GET("/semesters/:id", cfg.TimetableHandler.GetSemester)
 			tt.PUT("/semesters/:id/rooms", cfg.TimetableHandler.SetSemesterRooms)
+			tt.PUT("/semesters/:id/soft-constraints", cfg.TimetableHandler.SetSoftConstraints)
+			tt.PUT("/semesters/:id/periods", cfg.TimetableHandler.SetPeriodDefinitions)
+			tt.PUT("/semesters/:id/holidays", cfg.TimetableHandler.SetHolidays)
+			tt.PUT("/semesters/:id/week-patterns/:subjectId", cfg.TimetableHandler.SetWeekPattern)
+			tt.PUT("/semesters/:id/sections/:subjectId", cfg.TimetableHandler.SetSubjectSections)
 			tt.POST("/semesters/:id/slots", cfg.TimetableHandler.CreateTimeSlot)
 			tt.DELETE("/semesters/:id/slots/:slotId", cfg.TimetableHandler.DeleteTimeSlot)
 			tt.POST("/semesters/:id/slots/preset", cfg.TimetableHandler.ApplyTimeSlotPreset)
 			tt.POST("/semesters/:id/offered-subjects", cfg.TimetableHandler.AddOfferedSubject)
 			tt.DELETE("/semesters/:id/offered-subjects/:subjectId", cfg.TimetableHandler.RemoveOfferedSubject)
 			tt.POST("/semesters/:id/generate", cfg.TimetableHandler.GenerateSchedule)
+			tt.GET("/semesters/:id/versions", cfg.TimetableHandler.ListScheduleVersions)
+			tt.GET("/semesters/:id/versions/:version", cfg.TimetableHandler.GetScheduleVersion)
+			tt.POST("/semesters/:id/versions/:version/rollback", cfg.TimetableHandler.RollbackSchedule)
+			tt.GET("/semesters/:id/exam-schedules", cfg.TimetableHandler.ListExamSchedules)
+			tt.POST("/semesters/:id/exam-schedules", cfg.TimetableHandler.GenerateExamSchedule)
+			tt.GET("/exam-schedules/:id", cfg.TimetableHandler.GetExamSchedule)
 			tt.GET("/schedules", cfg.TimetableHandler.ListSchedules)
 			tt.GET("/schedules/:id", cfg.TimetableHandler.GetSchedule)
+			tt.GET("/schedules/:id/status", cfg.TimetableHandler.GetGenerationStatus)
 			tt.PUT("/schedules/:id/entries/:entryId", cfg.TimetableHandler.ManualAssign)
+			tt.POST("/schedules/:id/repair", cfg.TimetableHandler.RepairSchedule)
+			tt.POST("/schedules/:id/cancel", cfg.TimetableHandler.CancelGeneration)
+			tt.POST("/schedules/:id/publish", cfg.TimetableHandler.PublishSchedule)
+			tt.POST("/schedules/:id/unpublish", cfg.TimetableHandler.UnpublishSchedule)
+			tt.POST("/schedules/:id/archive", cfg.TimetableHandler.ArchiveSchedule)
 			tt.GET("/suggest-teachers", cfg.TimetableHandler.SuggestTeachers)
+			tt.GET("/schedule-diff", cfg.TimetableHandler.DiffSchedules)
 			tt.GET("/schedules/:id/stream", cfg.TimetableHandler.StreamScheduleStatus)
+			tt.GET("/schedules/:id/ical", cfg.TimetableHandler.ExportScheduleICal)
+			if cfg.CalendarFeedHandler != nil {
+				tt.POST("/calendar-feeds", cfg.CalendarFeedHandler.CreateFeed)
+				tt.POST("/calendar-feeds/:id/revoke", cfg.CalendarFeedHandler.RevokeFeed)
+				tt.POST("/calendar-feeds/:id/regenerate", cfg.CalendarFeedHandler.RegenerateFeed)
+			}
+			if cfg.RoomBookingHandler != nil {
+				tt.GET("/rooms/free", cfg.RoomBookingHandler.FindFreeRooms)
+				tt.GET("/room-bookings", cfg.RoomBookingHandler.ListBookings)
+				tt.POST("/room-bookings", cfg.RoomBookingHandler.CreateBooking)
+				tt.GET("/room-bookings/:id", cfg.RoomBookingHandler.GetBooking)
+				tt.POST("/room-bookings/:id/cancel", cfg.RoomBookingHandler.CancelBooking)
+				tt.POST("/room-bookings/:id/approve", middleware.RequireRole("admin", "super_admin"), cfg.RoomBookingHandler.ApproveBooking)
+				tt.POST("/room-bookings/:id/reject", middleware.RequireRole("admin", "super_admin"), cfg.RoomBookingHandler.RejectBooking)
+			}
 		}
 	}
 
@@ -109,6 +155,12 @@ func registerModuleRoutes(protected *gin.RouterGroup, cfg RouterConfig) {
 				portal.GET("/enrollments/check-prerequisites", cfg.StudentPortalHandler.CheckMyPrerequisites)
 				portal.GET("/transcript", cfg.StudentPortalHandler.GetMyTranscript)
 				portal.GET("/transcript/export", cfg.StudentPortalHandler.ExportTranscript)
+				if cfg.CalendarFeedHandler != nil {
+					portal.POST("/calendar-feed", cfg.CalendarFeedHandler.CreateMyFeed)
+				}
+				if cfg.TimetableHandler != nil {
+					portal.GET("/schedules/:id/ical", cfg.TimetableHandler.ExportMyScheduleICal)
+				}
 			}
 		}
 	}
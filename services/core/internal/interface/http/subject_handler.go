@@ -19,8 +19,26 @@ func NewSubjectHandler(subjects subjectv1.SubjectServiceClient, prerequisites su
 	return &SubjectHandler{subjects: subjects, prerequisites: prerequisites}
 }
 
+// roomRequirementsBody is the JSON shape of a subject's room requirements.
+type roomRequirementsBody struct {
+	MinCapacity int32    `json:"min_capacity"`
+	RoomType    string   `json:"room_type"`
+	Features    []string `json:"features"`
+}
+
+func (b *roomRequirementsBody) toProto() *subjectv1.RoomRequirements {
+	if b == nil {
+		return nil
+	}
+	return &subjectv1.RoomRequirements{MinCapacity: b.MinCapacity, RoomType: b.RoomType, Features: b.Features}
+}
+
 // subjectToJSON converts a proto Subject to a frontend-compatible JSON map.
 func subjectToJSON(s *subjectv1.Subject) gin.H {
+	roomFeatures := s.GetRoomRequirements().GetFeatures()
+	if roomFeatures == nil {
+		roomFeatures = []string{}
+	}
 	return gin.H{
 		"id":            s.Id,
 		"code":          s.Code,
@@ -30,6 +48,11 @@ func subjectToJSON(s *subjectv1.Subject) gin.H {
 		"description":   s.Description,
 		"weekly_hours":  s.WeeklyHours,
 		"is_active":     s.IsActive,
+		"room_requirements": gin.H{
+			"min_capacity": s.GetRoomRequirements().GetMinCapacity(),
+			"room_type":    s.GetRoomRequirements().GetRoomType(),
+			"features":     roomFeatures,
+		},
 		"prerequisites": []gin.H{}, // populated separately via /prerequisites endpoint
 		"created_at":    protoTime(s.CreatedAt),
 		"updated_at":    protoTime(s.UpdatedAt),
@@ -73,6 +96,8 @@ func (h *SubjectHandler) CreateSubject(c *gin.Context) {
 		DepartmentID string `json:"department_id" binding:"required"`
 		Description  string `json:"description"`
 		WeeklyHours  int32  `json:"weekly_hours"`
+
+		RoomRequirements *roomRequirementsBody `json:"room_requirements"`
 	}
 	if err := c.ShouldBindJSON(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -86,6 +111,8 @@ func (h *SubjectHandler) CreateSubject(c *gin.Context) {
 		DepartmentId: body.DepartmentID,
 		Description:  body.Description,
 		WeeklyHours:  body.WeeklyHours,
+
+		RoomRequirements: body.RoomRequirements.toProto(),
 	})
 	if err != nil {
 		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
@@ -113,6 +140,8 @@ func (h *SubjectHandler) UpdateSubject(c *gin.Context) {
 		DepartmentID *string `json:"department_id"`
 		Description  *string `json:"description"`
 		WeeklyHours  *int32  `json:"weekly_hours"`
+
+		RoomRequirements *roomRequirementsBody `json:"room_requirements"`
 	}
 	if err := c.ShouldBindJSON(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -127,6 +156,8 @@ func (h *SubjectHandler) UpdateSubject(c *gin.Context) {
 		DepartmentId: body.DepartmentID,
 		Description:  body.Description,
 		WeeklyHours:  body.WeeklyHours,
+
+		RoomRequirements: body.RoomRequirements.toProto(),
 	})
 	if err != nil {
 		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
@@ -0,0 +1,260 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/core/internal/infrastructure/auth"
+	"github.com/HuynhHoangPhuc/myrmex/services/core/internal/infrastructure/persistence"
+)
+
+// ExportScheduleICal downloads a schedule as an .ics file via
+// GET /schedules/:id/ical, optionally narrowed by one of teacher_id, room_id,
+// student_id or department_id. A student's timetable is personal: only
+// admins may narrow by student_id, and students use ExportMyScheduleICal.
+func (h *TimetableHandler) ExportScheduleICal(c *gin.Context) {
+	if c.Query("student_id") != "" && !isAdminRole(c.GetString("user_role")) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only admins may export a student's timetable"})
+		return
+	}
+	h.exportICal(c, &timetablev1.ExportICalendarRequest{
+		ScheduleId:   c.Param("id"),
+		TeacherId:    c.Query("teacher_id"),
+		RoomId:       c.Query("room_id"),
+		StudentId:    c.Query("student_id"),
+		DepartmentId: c.Query("department_id"),
+	})
+}
+
+// ExportMyScheduleICal downloads the calling student's own classes of a
+// schedule via GET /student/schedules/:id/ical.
+func (h *TimetableHandler) ExportMyScheduleICal(c *gin.Context) {
+	h.exportICal(c, &timetablev1.ExportICalendarRequest{
+		ScheduleId: c.Param("id"),
+		StudentId:  c.GetString("student_id"),
+	})
+}
+
+func (h *TimetableHandler) exportICal(c *gin.Context, req *timetablev1.ExportICalendarRequest) {
+	resp, err := h.timetable.ExportICalendar(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.Filename))
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", resp.Calendar)
+}
+
+// CalendarFeedHandler issues and serves tokenized, read-only iCalendar
+// subscriptions. A feed always renders the semester's currently published
+// schedule, so subscribers see republished timetables without resubscribing.
+// Every feed is recorded in the feed store, and its token is only served
+// while that record is not revoked.
+type CalendarFeedHandler struct {
+	timetable timetablev1.TimetableServiceClient
+	jwt       *auth.JWTService
+	feeds     *persistence.CalendarFeedRepository
+}
+
+func NewCalendarFeedHandler(timetable timetablev1.TimetableServiceClient, jwt *auth.JWTService, feeds *persistence.CalendarFeedRepository) *CalendarFeedHandler {
+	return &CalendarFeedHandler{timetable: timetable, jwt: jwt, feeds: feeds}
+}
+
+// CreateFeed issues a subscription URL via POST /timetable/calendar-feeds
+// (body: semester_id and at most one of teacher_id, room_id, student_id,
+// department_id). Student feeds expose a student's enrollments, so only
+// admins may scope a feed to student_id; students use CreateMyFeed.
+func (h *CalendarFeedHandler) CreateFeed(c *gin.Context) {
+	var body struct {
+		SemesterID   string `json:"semester_id" binding:"required"`
+		TeacherID    string `json:"teacher_id"`
+		RoomID       string `json:"room_id"`
+		StudentID    string `json:"student_id"`
+		DepartmentID string `json:"department_id"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if body.StudentID != "" && !isAdminRole(c.GetString("user_role")) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only admins may create a feed for a student"})
+		return
+	}
+	h.issue(c, auth.CalendarFeedClaims{
+		SemesterID:   body.SemesterID,
+		TeacherID:    body.TeacherID,
+		RoomID:       body.RoomID,
+		StudentID:    body.StudentID,
+		DepartmentID: body.DepartmentID,
+	})
+}
+
+// CreateMyFeed issues a subscription to the calling student's own timetable
+// via POST /student/calendar-feed (body: semester_id).
+func (h *CalendarFeedHandler) CreateMyFeed(c *gin.Context) {
+	var body struct {
+		SemesterID string `json:"semester_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	studentID, _ := c.Get("student_id")
+	h.issue(c, auth.CalendarFeedClaims{SemesterID: body.SemesterID, StudentID: studentID.(string)})
+}
+
+// RevokeFeed stops serving a feed via POST /timetable/calendar-feeds/:id/revoke,
+// where :id is the feed id returned when it was issued. Users may revoke
+// their own feeds; admins may revoke any.
+func (h *CalendarFeedHandler) RevokeFeed(c *gin.Context) {
+	feed, ok := h.ownedFeed(c)
+	if !ok {
+		return
+	}
+	if err := h.feeds.Revoke(c.Request.Context(), feed.ID); err != nil {
+		h.feedError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RegenerateFeed replaces a feed's URL via
+// POST /timetable/calendar-feeds/:id/regenerate: the old token stops working
+// and a new one with the same scope is returned. Ownership rules match
+// RevokeFeed.
+func (h *CalendarFeedHandler) RegenerateFeed(c *gin.Context) {
+	feed, ok := h.ownedFeed(c)
+	if !ok {
+		return
+	}
+	id, err := h.feeds.Regenerate(c.Request.Context(), *feed)
+	if err != nil {
+		h.feedError(c, err)
+		return
+	}
+	h.respond(c, feed.CreatedBy, id, auth.CalendarFeedClaims{
+		SemesterID:   feed.SemesterID,
+		TeacherID:    feed.TeacherID,
+		RoomID:       feed.RoomID,
+		StudentID:    feed.StudentID,
+		DepartmentID: feed.DepartmentID,
+	})
+}
+
+// ServeFeed renders a feed via the public GET /calendar/:token; the token is
+// the only credential, and a trailing ".ics" is accepted for calendar clients
+// that look at the extension. Revoked feeds are reported as unknown.
+func (h *CalendarFeedHandler) ServeFeed(c *gin.Context) {
+	feed, err := h.jwt.ValidateCalendarFeedToken(strings.TrimSuffix(c.Param("token"), ".ics"))
+	if err == nil {
+		_, err = h.feeds.GetActive(c.Request.Context(), feed.ID)
+		if err != nil && !errors.Is(err, persistence.ErrCalendarFeedNotFound) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load calendar feed"})
+			return
+		}
+	}
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "unknown calendar feed"})
+		return
+	}
+	resp, err := h.timetable.ExportICalendar(c.Request.Context(), feedRequest(feed))
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Header("Cache-Control", "private, max-age=900")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", resp.Calendar)
+}
+
+func (h *CalendarFeedHandler) issue(c *gin.Context, feed auth.CalendarFeedClaims) {
+	// Validate the scope now rather than on the subscriber's first poll; a
+	// semester without a published schedule is fine, it may be published later
+	if _, err := h.timetable.ExportICalendar(c.Request.Context(), feedRequest(&feed)); err != nil && grpcToHTTPStatus(err) != http.StatusNotFound {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	uid := c.GetString("user_id")
+	id, err := h.feeds.Create(c.Request.Context(), persistence.CalendarFeedRow{
+		CreatedBy:    uid,
+		SemesterID:   feed.SemesterID,
+		TeacherID:    feed.TeacherID,
+		RoomID:       feed.RoomID,
+		StudentID:    feed.StudentID,
+		DepartmentID: feed.DepartmentID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to issue calendar feed"})
+		return
+	}
+	h.respond(c, uid, id, feed)
+}
+
+// respond signs the token for a stored feed and returns its URLs.
+func (h *CalendarFeedHandler) respond(c *gin.Context, userID, feedID string, feed auth.CalendarFeedClaims) {
+	token, err := h.jwt.GenerateCalendarFeedToken(userID, feedID, feed)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to issue calendar feed"})
+		return
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	path := "/api/calendar/" + token + ".ics"
+	c.JSON(http.StatusCreated, gin.H{
+		"id":         feedID,
+		"token":      token,
+		"url":        scheme + "://" + c.Request.Host + path,
+		"webcal_url": "webcal://" + c.Request.Host + path,
+	})
+}
+
+// ownedFeed loads the active feed named by :id, writing a 404 when it is
+// unknown or revoked and a 403 when the caller neither created it nor is an
+// admin.
+func (h *CalendarFeedHandler) ownedFeed(c *gin.Context) (*persistence.CalendarFeedRow, bool) {
+	if _, err := uuid.Parse(c.Param("id")); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": persistence.ErrCalendarFeedNotFound.Error()})
+		return nil, false
+	}
+	feed, err := h.feeds.GetActive(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.feedError(c, err)
+		return nil, false
+	}
+	if feed.CreatedBy != c.GetString("user_id") && !isAdminRole(c.GetString("user_role")) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "insufficient permissions"})
+		return nil, false
+	}
+	return feed, true
+}
+
+func (h *CalendarFeedHandler) feedError(c *gin.Context, err error) {
+	if errors.Is(err, persistence.ErrCalendarFeedNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update calendar feed"})
+}
+
+// isAdminRole reports whether a role may read any student's timetable.
+func isAdminRole(role string) bool {
+	return role == "admin" || role == "super_admin"
+}
+
+func feedRequest(feed *auth.CalendarFeedClaims) *timetablev1.ExportICalendarRequest {
+	return &timetablev1.ExportICalendarRequest{
+		SemesterId:   feed.SemesterID,
+		TeacherId:    feed.TeacherID,
+		RoomId:       feed.RoomID,
+		StudentId:    feed.StudentID,
+		DepartmentId: feed.DepartmentID,
+	}
+}
@@ -0,0 +1,73 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+)
+
+// fakeICalTimetable records the export requests it serves.
+type fakeICalTimetable struct {
+	timetablev1.TimetableServiceClient
+	requests []*timetablev1.ExportICalendarRequest
+}
+
+func (f *fakeICalTimetable) ExportICalendar(_ context.Context, in *timetablev1.ExportICalendarRequest, _ ...grpc.CallOption) (*timetablev1.ExportICalendarResponse, error) {
+	f.requests = append(f.requests, in)
+	return &timetablev1.ExportICalendarResponse{Calendar: []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), Filename: "schedule.ics"}, nil
+}
+
+func TestExportScheduleICalRestrictsStudentTimetables(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		role      string
+		studentID string // resolved student of the caller, as set by ResolveStudentMiddleware
+		path      string
+		want      int
+		wantScope string
+	}{
+		{"teacher reads a student", "teacher", "", "/schedules/s1/ical?student_id=st-1", http.StatusForbidden, ""},
+		{"student reads another student", "student", "st-2", "/schedules/s1/ical?student_id=st-1", http.StatusForbidden, ""},
+		{"admin reads a student", "admin", "", "/schedules/s1/ical?student_id=st-1", http.StatusOK, "st-1"},
+		{"teacher reads a room", "teacher", "", "/schedules/s1/ical?room_id=r-1", http.StatusOK, ""},
+		{"student reads their own", "student", "st-2", "/student/schedules/s1/ical", http.StatusOK, "st-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeICalTimetable{}
+			h := NewTimetableHandler(fake, nil, nil, nil)
+			r := gin.New()
+			r.Use(func(c *gin.Context) {
+				c.Set("user_role", tt.role)
+				if tt.studentID != "" {
+					c.Set("student_id", tt.studentID)
+				}
+			})
+			r.GET("/schedules/:id/ical", h.ExportScheduleICal)
+			r.GET("/student/schedules/:id/ical", h.ExportMyScheduleICal)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.want, w.Body.String())
+			}
+			if tt.want == http.StatusForbidden {
+				if len(fake.requests) != 0 {
+					t.Fatal("a refused export must not reach the timetable service")
+				}
+				return
+			}
+			if len(fake.requests) != 1 || fake.requests[0].StudentId != tt.wantScope {
+				t.Fatalf("export requests = %v, want student %q", fake.requests, tt.wantScope)
+			}
+		})
+	}
+}
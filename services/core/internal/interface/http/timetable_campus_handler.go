@@ -0,0 +1,117 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+)
+
+// ListCampuses returns the campuses with the travel times between them.
+func (h *TimetableHandler) ListCampuses(c *gin.Context) {
+	resp, err := h.timetable.ListCampuses(c.Request.Context(), &timetablev1.ListCampusesRequest{})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	campuses := make([]gin.H, len(resp.Campuses))
+	for i, cp := range resp.Campuses {
+		campuses[i] = campusToJSON(cp)
+	}
+	travel := make([]gin.H, len(resp.TravelTimes))
+	for i, t := range resp.TravelTimes {
+		travel[i] = travelTimeToJSON(t)
+	}
+	c.JSON(http.StatusOK, gin.H{"data": campuses, "travel_times": travel})
+}
+
+// CreateCampus POST /timetable/campuses
+func (h *TimetableHandler) CreateCampus(c *gin.Context) {
+	var body struct {
+		Code string `json:"code" binding:"required"`
+		Name string `json:"name" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	resp, err := h.timetable.CreateCampus(c.Request.Context(), &timetablev1.CreateCampusRequest{Code: body.Code, Name: body.Name})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, campusToJSON(resp.Campus))
+}
+
+// ListBuildings returns the buildings, optionally of one ?campus_id.
+func (h *TimetableHandler) ListBuildings(c *gin.Context) {
+	resp, err := h.timetable.ListBuildings(c.Request.Context(), &timetablev1.ListBuildingsRequest{CampusId: c.Query("campus_id")})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	buildings := make([]gin.H, len(resp.Buildings))
+	for i, b := range resp.Buildings {
+		buildings[i] = buildingToJSON(b)
+	}
+	c.JSON(http.StatusOK, gin.H{"data": buildings})
+}
+
+// CreateBuilding POST /timetable/buildings
+func (h *TimetableHandler) CreateBuilding(c *gin.Context) {
+	var body struct {
+		CampusID string `json:"campus_id" binding:"required"`
+		Code     string `json:"code" binding:"required"`
+		Name     string `json:"name" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	resp, err := h.timetable.CreateBuilding(c.Request.Context(), &timetablev1.CreateBuildingRequest{
+		CampusId: body.CampusID,
+		Code:     body.Code,
+		Name:     body.Name,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, buildingToJSON(resp.Building))
+}
+
+// SetCampusTravelTime PUT /timetable/campus-travel-times — sets the minutes
+// needed between two campuses, in either direction.
+func (h *TimetableHandler) SetCampusTravelTime(c *gin.Context) {
+	var body struct {
+		FromCampusID string `json:"from_campus_id" binding:"required"`
+		ToCampusID   string `json:"to_campus_id" binding:"required"`
+		Minutes      *int32 `json:"minutes" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	resp, err := h.timetable.SetCampusTravelTime(c.Request.Context(), &timetablev1.SetCampusTravelTimeRequest{
+		FromCampusId: body.FromCampusID,
+		ToCampusId:   body.ToCampusID,
+		Minutes:      *body.Minutes,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, travelTimeToJSON(resp.TravelTime))
+}
+
+func campusToJSON(cp *timetablev1.Campus) gin.H {
+	return gin.H{"id": cp.Id, "code": cp.Code, "name": cp.Name}
+}
+
+func buildingToJSON(b *timetablev1.Building) gin.H {
+	return gin.H{"id": b.Id, "campus_id": b.CampusId, "code": b.Code, "name": b.Name}
+}
+
+func travelTimeToJSON(t *timetablev1.CampusTravelTime) gin.H {
+	return gin.H{"from_campus_id": t.FromCampusId, "to_campus_id": t.ToCampusId, "minutes": t.Minutes}
+}
@@ -0,0 +1,149 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+)
+
+// --- Exam timetable handlers (methods on TimetableHandler) ---
+
+// GenerateExamSchedule places the semester's final exams via
+// POST /semesters/:id/exam-schedules (body: start_date, end_date, optional
+// name, timeout_seconds and settings). It waits for the solver and returns
+// the stored exam schedule; 409 when the exams cannot all be fitted.
+func (h *TimetableHandler) GenerateExamSchedule(c *gin.Context) {
+	var body struct {
+		Name           string `json:"name"`
+		StartDate      string `json:"start_date" binding:"required"`
+		EndDate        string `json:"end_date" binding:"required"`
+		TimeoutSeconds int32  `json:"timeout_seconds"`
+		Settings       struct {
+			Sessions []struct {
+				StartPeriod int32 `json:"start_period" binding:"required"`
+				EndPeriod   int32 `json:"end_period" binding:"required"`
+			} `json:"sessions"`
+			MaxPerDay        int32 `json:"max_per_day"`
+			SeatSpacing      int32 `json:"seat_spacing"`
+			MinGapDays       int32 `json:"min_gap_days"`
+			MaxInvigilations int32 `json:"max_invigilations"`
+		} `json:"settings"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	start, err := parseDay(body.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid start_date"})
+		return
+	}
+	end, err := parseDay(body.EndDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid end_date"})
+		return
+	}
+
+	settings := &timetablev1.ExamSettings{
+		MaxPerDay:        body.Settings.MaxPerDay,
+		SeatSpacing:      body.Settings.SeatSpacing,
+		MinGapDays:       body.Settings.MinGapDays,
+		MaxInvigilations: body.Settings.MaxInvigilations,
+	}
+	for _, s := range body.Settings.Sessions {
+		settings.Sessions = append(settings.Sessions, &timetablev1.ExamSession{StartPeriod: s.StartPeriod, EndPeriod: s.EndPeriod})
+	}
+	resp, err := h.timetable.GenerateExamSchedule(c.Request.Context(), &timetablev1.GenerateExamScheduleRequest{
+		SemesterId:     c.Param("id"),
+		Name:           body.Name,
+		StartDate:      timestamppb.New(start),
+		EndDate:        timestamppb.New(end),
+		Settings:       settings,
+		TimeoutSeconds: body.TimeoutSeconds,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, examScheduleToJSON(resp.ExamSchedule))
+}
+
+// ListExamSchedules lists a semester's exam schedules, newest first, via
+// GET /semesters/:id/exam-schedules. Entries are omitted.
+func (h *TimetableHandler) ListExamSchedules(c *gin.Context) {
+	resp, err := h.timetable.ListExamSchedules(c.Request.Context(), &timetablev1.ListExamSchedulesRequest{
+		SemesterId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	exams := make([]gin.H, len(resp.ExamSchedules))
+	for i, e := range resp.ExamSchedules {
+		exams[i] = examScheduleToJSON(e)
+	}
+	c.JSON(http.StatusOK, gin.H{"data": exams})
+}
+
+// GetExamSchedule returns one exam schedule with its entries via
+// GET /exam-schedules/:id.
+func (h *TimetableHandler) GetExamSchedule(c *gin.Context) {
+	resp, err := h.timetable.GetExamSchedule(c.Request.Context(), &timetablev1.GetExamScheduleRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, examScheduleToJSON(resp.ExamSchedule))
+}
+
+func examScheduleToJSON(e *timetablev1.ExamSchedule) gin.H {
+	sessions := make([]gin.H, len(e.Settings.GetSessions()))
+	for i, s := range e.Settings.GetSessions() {
+		sessions[i] = gin.H{"start_period": s.StartPeriod, "end_period": s.EndPeriod}
+	}
+	entries := make([]gin.H, len(e.Entries))
+	for i, en := range e.Entries {
+		entries[i] = gin.H{
+			"subject_id":       en.SubjectId,
+			"subject_code":     en.SubjectCode,
+			"subject_name":     en.SubjectName,
+			"date":             en.Date.AsTime().Format(time.DateOnly),
+			"start_period":     en.StartPeriod,
+			"end_period":       en.EndPeriod,
+			"room_id":          en.RoomId,
+			"room_name":        en.RoomName,
+			"invigilator_id":   en.InvigilatorId,
+			"invigilator_name": en.InvigilatorName,
+			"student_count":    en.StudentCount,
+		}
+	}
+	unscheduled := e.UnscheduledSubjectIds
+	if unscheduled == nil {
+		unscheduled = []string{}
+	}
+	return gin.H{
+		"id":          e.Id,
+		"semester_id": e.SemesterId,
+		"name":        e.Name,
+		"start_date":  e.StartDate.AsTime().Format(time.DateOnly),
+		"end_date":    e.EndDate.AsTime().Format(time.DateOnly),
+		"settings": gin.H{
+			"sessions":          sessions,
+			"max_per_day":       e.Settings.GetMaxPerDay(),
+			"seat_spacing":      e.Settings.GetSeatSpacing(),
+			"min_gap_days":      e.Settings.GetMinGapDays(),
+			"max_invigilations": e.Settings.GetMaxInvigilations(),
+		},
+		"score":                   e.Score,
+		"soft_penalty":            e.SoftPenalty,
+		"entries":                 entries,
+		"unscheduled_subject_ids": unscheduled,
+		"created_at":              e.CreatedAt.AsTime(),
+	}
+}
@@ -83,11 +83,34 @@ func semesterToJSON(s *timetablev1.Semester) gin.H {
 		"offered_subject_ids": offeredSubjectIDs,
 		"time_slots":          []gin.H{},
 		"rooms":               []gin.H{},
+		"soft_constraints":    softConstraintsToJSON(s.SoftConstraints),
+		"periods":             periodsToJSON(s.Periods),
+		"holidays":            holidaysToJSON(s.Holidays),
+		"week_patterns":       weekPatternsToJSON(s.WeekPatterns),
+		"sections":            sectionsToJSON(s.Sections),
 		"created_at":          "",
 		"updated_at":          "",
 	}
 }
 
+// softConstraintsToJSON converts semester soft-constraint settings; an empty
+// list means the solver defaults apply.
+func softConstraintsToJSON(settings []*timetablev1.SoftConstraintSetting) []gin.H {
+	result := make([]gin.H, len(settings))
+	for i, sc := range settings {
+		params := sc.Params
+		if params == nil {
+			params = map[string]int32{}
+		}
+		result[i] = gin.H{
+			"type":   sc.Type,
+			"weight": sc.Weight,
+			"params": params,
+		}
+	}
+	return result
+}
+
 // --- Semester handlers ---
 
 func (h *TimetableHandler) ListSemesters(c *gin.Context) {
@@ -239,3 +262,33 @@ func (h *TimetableHandler) RemoveOfferedSubject(c *gin.Context) {
 	}
 	c.Status(http.StatusNoContent)
 }
+
+// SetSoftConstraints replaces the semester's weighted soft constraints.
+// An empty list restores the solver defaults.
+func (h *TimetableHandler) SetSoftConstraints(c *gin.Context) {
+	var body struct {
+		SoftConstraints []struct {
+			Type   string           `json:"type" binding:"required"`
+			Weight float64          `json:"weight"`
+			Params map[string]int32 `json:"params"`
+		} `json:"soft_constraints"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	settings := make([]*timetablev1.SoftConstraintSetting, len(body.SoftConstraints))
+	for i, sc := range body.SoftConstraints {
+		settings[i] = &timetablev1.SoftConstraintSetting{Type: sc.Type, Weight: sc.Weight, Params: sc.Params}
+	}
+	resp, err := h.semesters.SetSoftConstraints(c.Request.Context(), &timetablev1.SetSoftConstraintsRequest{
+		SemesterId:      c.Param("id"),
+		SoftConstraints: settings,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, semesterToJSON(resp.Semester))
+}
@@ -0,0 +1,200 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+)
+
+// --- Schedule lifecycle and version handlers (methods on TimetableHandler) ---
+
+// PublishSchedule publishes a schedule via POST /schedules/:id/publish. The
+// semester's previously published schedule is archived and a new version is
+// recorded; 409 when the schedule still breaks hard constraints.
+func (h *TimetableHandler) PublishSchedule(c *gin.Context) {
+	resp, err := h.timetable.PublishSchedule(c.Request.Context(), &timetablev1.PublishScheduleRequest{
+		ScheduleId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"schedule":              scheduleToJSON(resp.Schedule),
+		"version":               versionToJSON(resp.Version),
+		"archived_schedule_ids": resp.ArchivedScheduleIds,
+	})
+}
+
+// UnpublishSchedule returns a published schedule to draft via
+// POST /schedules/:id/unpublish.
+func (h *TimetableHandler) UnpublishSchedule(c *gin.Context) {
+	resp, err := h.timetable.UnpublishSchedule(c.Request.Context(), &timetablev1.UnpublishScheduleRequest{
+		ScheduleId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, scheduleToJSON(resp.Schedule))
+}
+
+// ArchiveSchedule archives a schedule via POST /schedules/:id/archive.
+func (h *TimetableHandler) ArchiveSchedule(c *gin.Context) {
+	resp, err := h.timetable.ArchiveSchedule(c.Request.Context(), &timetablev1.ArchiveScheduleRequest{
+		ScheduleId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, scheduleToJSON(resp.Schedule))
+}
+
+// ListScheduleVersions lists a semester's published versions, newest first,
+// via GET /semesters/:id/versions. Entries are omitted.
+func (h *TimetableHandler) ListScheduleVersions(c *gin.Context) {
+	resp, err := h.timetable.ListScheduleVersions(c.Request.Context(), &timetablev1.ListScheduleVersionsRequest{
+		SemesterId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	versions := make([]gin.H, len(resp.Versions))
+	for i, v := range resp.Versions {
+		versions[i] = versionToJSON(v)
+	}
+	c.JSON(http.StatusOK, gin.H{"data": versions})
+}
+
+// GetScheduleVersion returns one published version with its entries via
+// GET /semesters/:id/versions/:version.
+func (h *TimetableHandler) GetScheduleVersion(c *gin.Context) {
+	version, ok := versionParam(c)
+	if !ok {
+		return
+	}
+	resp, err := h.timetable.GetScheduleVersion(c.Request.Context(), &timetablev1.GetScheduleVersionRequest{
+		SemesterId: c.Param("id"),
+		Version:    version,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, versionToJSON(resp.Version))
+}
+
+// RollbackSchedule republishes an earlier version as a new schedule via
+// POST /semesters/:id/versions/:version/rollback. History is never rewritten:
+// the rollback itself becomes the next version.
+func (h *TimetableHandler) RollbackSchedule(c *gin.Context) {
+	version, ok := versionParam(c)
+	if !ok {
+		return
+	}
+	resp, err := h.timetable.RollbackSchedule(c.Request.Context(), &timetablev1.RollbackScheduleRequest{
+		SemesterId: c.Param("id"),
+		Version:    version,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"schedule":              scheduleToJSON(resp.Schedule),
+		"version":               versionToJSON(resp.Version),
+		"archived_schedule_ids": resp.ArchivedScheduleIds,
+	})
+}
+
+// versionParam parses the :version path parameter, answering 400 when it is
+// not a positive number.
+func versionParam(c *gin.Context) (int32, bool) {
+	v, err := strconv.ParseInt(c.Param("version"), 10, 32)
+	if err != nil || v < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "version must be a positive integer"})
+		return 0, false
+	}
+	return int32(v), true
+}
+
+// versionToJSON converts a proto ScheduleVersion to a JSON-friendly map.
+func versionToJSON(v *timetablev1.ScheduleVersion) gin.H {
+	if v == nil {
+		return nil
+	}
+	publishedAt := ""
+	if v.PublishedAt != nil {
+		publishedAt = v.PublishedAt.AsTime().Format(time.RFC3339)
+	}
+	return gin.H{
+		"id":              v.Id,
+		"semester_id":     v.SemesterId,
+		"schedule_id":     v.ScheduleId,
+		"version":         v.Version,
+		"score":           v.Score,
+		"hard_violations": v.HardViolations,
+		"soft_penalty":    v.SoftPenalty,
+		"published_at":    publishedAt,
+		"entries":         entriesToJSON(v.Entries),
+	}
+}
+
+// DiffSchedules compares two schedules or published versions via
+// GET /schedule-diff?base_schedule_id=&target_schedule_id= or, for versions,
+// ?semester_id=&base_version=&target_version=; the two forms can be mixed.
+func (h *TimetableHandler) DiffSchedules(c *gin.Context) {
+	baseVersion, _ := strconv.ParseInt(c.Query("base_version"), 10, 32)
+	targetVersion, _ := strconv.ParseInt(c.Query("target_version"), 10, 32)
+	resp, err := h.timetable.DiffSchedules(c.Request.Context(), &timetablev1.DiffSchedulesRequest{
+		BaseScheduleId:   c.Query("base_schedule_id"),
+		TargetScheduleId: c.Query("target_schedule_id"),
+		SemesterId:       c.Query("semester_id"),
+		BaseVersion:      int32(baseVersion),   // safe: ParseInt bitSize=32
+		TargetVersion:    int32(targetVersion), // safe: ParseInt bitSize=32
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	changes := make([]gin.H, len(resp.Changes))
+	for i, ch := range resp.Changes {
+		changes[i] = gin.H{
+			"kind":            ch.Kind,
+			"subject_id":      ch.SubjectId,
+			"before":          diffEntryToJSON(ch.Before),
+			"after":           diffEntryToJSON(ch.After),
+			"teacher_changed": ch.TeacherChanged,
+			"room_changed":    ch.RoomChanged,
+			"slot_changed":    ch.SlotChanged,
+			"description":     ch.Description,
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"changes":   changes,
+		"teachers":  resourceChangesToJSON(resp.Teachers),
+		"rooms":     resourceChangesToJSON(resp.Rooms),
+		"unchanged": resp.Unchanged,
+	})
+}
+
+func diffEntryToJSON(e *timetablev1.ScheduleEntry) gin.H {
+	if e == nil {
+		return nil
+	}
+	return entriesToJSON([]*timetablev1.ScheduleEntry{e})[0]
+}
+
+func resourceChangesToJSON(in []*timetablev1.ResourceChangeSummary) []gin.H {
+	out := make([]gin.H, len(in))
+	for i, r := range in {
+		out[i] = gin.H{"id": r.Id, "name": r.Name, "gained": r.Gained, "lost": r.Lost, "moved": r.Moved}
+	}
+	return out
+}
@@ -0,0 +1,241 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+)
+
+// RoomBookingHandler proxies ad-hoc room bookings and free-room searches to
+// the timetable module's RoomBookingService. The requester is always the
+// authenticated user.
+type RoomBookingHandler struct {
+	bookings timetablev1.RoomBookingServiceClient
+}
+
+func NewRoomBookingHandler(bookings timetablev1.RoomBookingServiceClient) *RoomBookingHandler {
+	return &RoomBookingHandler{bookings: bookings}
+}
+
+// CreateBooking books a room via POST /timetable/room-bookings (body:
+// room_id, title, start_date, start_period, end_period, optional end_date
+// and purpose). Restricted rooms answer with a pending booking; 409 when
+// the room is taken.
+func (h *RoomBookingHandler) CreateBooking(c *gin.Context) {
+	var body struct {
+		RoomID      string `json:"room_id" binding:"required"`
+		Title       string `json:"title" binding:"required"`
+		Purpose     string `json:"purpose"`
+		StartDate   string `json:"start_date" binding:"required"`
+		EndDate     string `json:"end_date"`
+		StartPeriod int32  `json:"start_period" binding:"required"`
+		EndPeriod   int32  `json:"end_period" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req := &timetablev1.CreateRoomBookingRequest{
+		RoomId:      body.RoomID,
+		Title:       body.Title,
+		Purpose:     body.Purpose,
+		RequestedBy: c.GetString("user_id"),
+		StartPeriod: body.StartPeriod,
+		EndPeriod:   body.EndPeriod,
+	}
+	var ok bool
+	if req.StartDate, ok = dateParam(c, "start_date", body.StartDate); !ok {
+		return
+	}
+	if body.EndDate != "" {
+		if req.EndDate, ok = dateParam(c, "end_date", body.EndDate); !ok {
+			return
+		}
+	}
+	resp, err := h.bookings.CreateRoomBooking(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, roomBookingToJSON(resp.Booking))
+}
+
+// ListBookings lists bookings via GET /timetable/room-bookings. Optional
+// query params: room_id, status (comma-separated), from, to (YYYY-MM-DD,
+// bookings overlapping the range) and mine=true for the caller's own.
+func (h *RoomBookingHandler) ListBookings(c *gin.Context) {
+	req := &timetablev1.ListRoomBookingsRequest{RoomId: c.Query("room_id")}
+	if c.Query("mine") == "true" {
+		req.RequestedBy = c.GetString("user_id")
+	}
+	if s := c.Query("status"); s != "" {
+		req.Statuses = strings.Split(s, ",")
+	}
+	var ok bool
+	if s := c.Query("from"); s != "" {
+		if req.From, ok = dateParam(c, "from", s); !ok {
+			return
+		}
+	}
+	if s := c.Query("to"); s != "" {
+		if req.To, ok = dateParam(c, "to", s); !ok {
+			return
+		}
+	}
+	resp, err := h.bookings.ListRoomBookings(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	bookings := make([]gin.H, len(resp.Bookings))
+	for i, b := range resp.Bookings {
+		bookings[i] = roomBookingToJSON(b)
+	}
+	c.JSON(http.StatusOK, gin.H{"data": bookings})
+}
+
+// GetBooking returns one booking via GET /timetable/room-bookings/:id.
+func (h *RoomBookingHandler) GetBooking(c *gin.Context) {
+	resp, err := h.bookings.GetRoomBooking(c.Request.Context(), &timetablev1.GetRoomBookingRequest{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, roomBookingToJSON(resp.Booking))
+}
+
+// ApproveBooking approves a pending booking via
+// POST /timetable/room-bookings/:id/approve (optional body: note).
+func (h *RoomBookingHandler) ApproveBooking(c *gin.Context) {
+	h.review(c, true)
+}
+
+// RejectBooking rejects a pending booking via
+// POST /timetable/room-bookings/:id/reject (optional body: note).
+func (h *RoomBookingHandler) RejectBooking(c *gin.Context) {
+	h.review(c, false)
+}
+
+func (h *RoomBookingHandler) review(c *gin.Context, approve bool) {
+	var body struct {
+		Note string `json:"note"`
+	}
+	_ = c.ShouldBindJSON(&body) // the note is optional
+	resp, err := h.bookings.ReviewRoomBooking(c.Request.Context(), &timetablev1.ReviewRoomBookingRequest{
+		Id:         c.Param("id"),
+		ReviewerId: c.GetString("user_id"),
+		Approve:    approve,
+		Note:       body.Note,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, roomBookingToJSON(resp.Booking))
+}
+
+// CancelBooking withdraws a booking via POST /timetable/room-bookings/:id/cancel.
+// Users may cancel their own bookings; admins may cancel any.
+func (h *RoomBookingHandler) CancelBooking(c *gin.Context) {
+	role := c.GetString("user_role")
+	resp, err := h.bookings.CancelRoomBooking(c.Request.Context(), &timetablev1.CancelRoomBookingRequest{
+		Id:       c.Param("id"),
+		UserId:   c.GetString("user_id"),
+		Override: role == "admin" || role == "super_admin",
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, roomBookingToJSON(resp.Booking))
+}
+
+// FindFreeRooms searches rooms via GET /timetable/rooms/free. Query params:
+// start_date (YYYY-MM-DD), start_period, end_period; optional end_date,
+// day_of_week (0=Monday, only those days of the range), min_capacity and
+// room_type.
+func (h *RoomBookingHandler) FindFreeRooms(c *gin.Context) {
+	startPeriod, _ := strconv.ParseInt(c.Query("start_period"), 10, 32)
+	endPeriod, _ := strconv.ParseInt(c.Query("end_period"), 10, 32)
+	minCapacity, _ := strconv.ParseInt(c.Query("min_capacity"), 10, 32)
+	req := &timetablev1.FindFreeRoomsRequest{
+		StartPeriod: int32(startPeriod), // safe: ParseInt bitSize=32
+		EndPeriod:   int32(endPeriod),   // safe: ParseInt bitSize=32
+		MinCapacity: int32(minCapacity), // safe: ParseInt bitSize=32
+		RoomType:    c.Query("room_type"),
+	}
+	var ok bool
+	if req.StartDate, ok = dateParam(c, "start_date", c.Query("start_date")); !ok {
+		return
+	}
+	if s := c.Query("end_date"); s != "" {
+		if req.EndDate, ok = dateParam(c, "end_date", s); !ok {
+			return
+		}
+	}
+	if s := c.Query("day_of_week"); s != "" {
+		day, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid day_of_week"})
+			return
+		}
+		d := int32(day)
+		req.DayOfWeek = &d
+	}
+	resp, err := h.bookings.FindFreeRooms(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	rooms := make([]gin.H, len(resp.Rooms))
+	for i, r := range resp.Rooms {
+		rooms[i] = gin.H{
+			"id":                r.Id,
+			"name":              r.Name,
+			"capacity":          r.Capacity,
+			"room_type":         r.RoomType,
+			"requires_approval": r.RequiresApproval,
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"data": rooms})
+}
+
+// dateParam parses a YYYY-MM-DD (or RFC 3339) value, answering 400 naming
+// the field when it is invalid.
+func dateParam(c *gin.Context, field, value string) (*timestamppb.Timestamp, bool) {
+	t, err := parseDay(value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + field})
+		return nil, false
+	}
+	return timestamppb.New(t), true
+}
+
+func roomBookingToJSON(b *timetablev1.RoomBooking) gin.H {
+	out := gin.H{
+		"id":           b.Id,
+		"room_id":      b.RoomId,
+		"room_name":    b.RoomName,
+		"title":        b.Title,
+		"purpose":      b.Purpose,
+		"requested_by": b.RequestedBy,
+		"start_date":   b.StartDate.AsTime().Format(time.DateOnly),
+		"end_date":     b.EndDate.AsTime().Format(time.DateOnly),
+		"start_period": b.StartPeriod,
+		"end_period":   b.EndPeriod,
+		"status":       b.Status,
+		"reviewed_by":  b.ReviewedBy,
+		"review_note":  b.ReviewNote,
+		"created_at":   b.CreatedAt.AsTime(),
+	}
+	if b.ReviewedAt != nil {
+		out["reviewed_at"] = b.ReviewedAt.AsTime()
+	}
+	return out
+}
@@ -11,19 +11,36 @@ import (
 func roomsToJSON(rooms []*timetablev1.Room) []gin.H {
 	result := make([]gin.H, len(rooms))
 	for i, r := range rooms {
-		result[i] = gin.H{
-			"id":        r.Id,
-			"name":      r.Name,
-			"capacity":  r.Capacity,
-			"room_type": r.RoomType,
-		}
+		result[i] = roomToJSON(r)
 	}
 	return result
 }
 
-// ListRooms returns all active rooms for use in the room selector.
+func roomToJSON(r *timetablev1.Room) gin.H {
+	features := r.Features
+	if features == nil {
+		features = []string{}
+	}
+	return gin.H{
+		"id":                r.Id,
+		"name":              r.Name,
+		"capacity":          r.Capacity,
+		"room_type":         r.RoomType,
+		"features":          features,
+		"requires_approval": r.RequiresApproval,
+		"is_active":         r.IsActive,
+		"building_id":       r.BuildingId,
+		"building_code":     r.BuildingCode,
+		"campus_id":         r.CampusId,
+	}
+}
+
+// ListRooms returns the active rooms for use in the room selector;
+// ?include_inactive=true adds deactivated ones.
 func (h *TimetableHandler) ListRooms(c *gin.Context) {
-	resp, err := h.timetable.ListRooms(c.Request.Context(), &timetablev1.ListRoomsRequest{})
+	resp, err := h.timetable.ListRooms(c.Request.Context(), &timetablev1.ListRoomsRequest{
+		IncludeInactive: c.Query("include_inactive") == "true",
+	})
 	if err != nil {
 		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
 		return
@@ -31,6 +48,111 @@ func (h *TimetableHandler) ListRooms(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"data": roomsToJSON(resp.GetRooms())})
 }
 
+// CreateRoom POST /timetable/rooms
+func (h *TimetableHandler) CreateRoom(c *gin.Context) {
+	var body struct {
+		Name             string   `json:"name" binding:"required"`
+		Capacity         int32    `json:"capacity" binding:"required"`
+		RoomType         string   `json:"room_type"`
+		Features         []string `json:"features"`
+		RequiresApproval bool     `json:"requires_approval"`
+		BuildingID       string   `json:"building_id"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	resp, err := h.timetable.CreateRoom(c.Request.Context(), &timetablev1.CreateRoomRequest{
+		Name:             body.Name,
+		Capacity:         body.Capacity,
+		RoomType:         body.RoomType,
+		Features:         body.Features,
+		RequiresApproval: body.RequiresApproval,
+		BuildingId:       body.BuildingID,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, roomToJSON(resp.Room))
+}
+
+// GetRoom GET /timetable/rooms/:id
+func (h *TimetableHandler) GetRoom(c *gin.Context) {
+	resp, err := h.timetable.GetRoom(c.Request.Context(), &timetablev1.GetRoomRequest{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, roomToJSON(resp.Room))
+}
+
+// UpdateRoom PATCH /timetable/rooms/:id — omitted fields are left as they
+// are; "features" replaces the whole list and "building_id": "" moves the
+// room out of its building.
+func (h *TimetableHandler) UpdateRoom(c *gin.Context) {
+	var body struct {
+		Name             *string   `json:"name"`
+		Capacity         *int32    `json:"capacity"`
+		RoomType         *string   `json:"room_type"`
+		Features         *[]string `json:"features"`
+		RequiresApproval *bool     `json:"requires_approval"`
+		BuildingID       *string   `json:"building_id"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req := &timetablev1.UpdateRoomRequest{
+		Id:               c.Param("id"),
+		Name:             body.Name,
+		Capacity:         body.Capacity,
+		RoomType:         body.RoomType,
+		RequiresApproval: body.RequiresApproval,
+		BuildingId:       body.BuildingID,
+	}
+	if body.Features != nil {
+		req.Features, req.ReplaceFeatures = *body.Features, true
+	}
+	resp, err := h.timetable.UpdateRoom(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, roomToJSON(resp.Room))
+}
+
+// DeactivateRoom POST /timetable/rooms/:id/deactivate — the solver and
+// bookings stop using the room; existing schedules keep it.
+func (h *TimetableHandler) DeactivateRoom(c *gin.Context) {
+	resp, err := h.timetable.DeactivateRoom(c.Request.Context(), &timetablev1.DeactivateRoomRequest{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, roomToJSON(resp.Room))
+}
+
+// ActivateRoom POST /timetable/rooms/:id/activate
+func (h *TimetableHandler) ActivateRoom(c *gin.Context) {
+	resp, err := h.timetable.ActivateRoom(c.Request.Context(), &timetablev1.ActivateRoomRequest{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, roomToJSON(resp.Room))
+}
+
+// DeleteRoom DELETE /timetable/rooms/:id — 409 while a schedule or booking
+// still uses the room.
+func (h *TimetableHandler) DeleteRoom(c *gin.Context) {
+	if _, err := h.timetable.DeleteRoom(c.Request.Context(), &timetablev1.DeleteRoomRequest{Id: c.Param("id")}); err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
 // SetSemesterRooms replaces the room selection for a semester.
 func (h *TimetableHandler) SetSemesterRooms(c *gin.Context) {
 	var body struct {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/grpc/status"
 
 	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
 )
@@ -18,7 +19,20 @@ import (
 // GenerateSchedule triggers async CSP schedule generation; returns 202 Accepted.
 func (h *TimetableHandler) GenerateSchedule(c *gin.Context) {
 	var body struct {
-		TimeoutSeconds int32 `json:"timeout_seconds"`
+		TimeoutSeconds     int32 `json:"timeout_seconds"`
+		BindSessionTeacher bool  `json:"bind_session_teacher"`
+		SoftCohortClash    bool  `json:"soft_cohort_clash"`
+		Pins               []struct {
+			SubjectID  string `json:"subject_id"`
+			Section    int32  `json:"section"`
+			Session    int32  `json:"session"`
+			TeacherID  string `json:"teacher_id"`
+			RoomID     string `json:"room_id"`
+			TimeSlotID string `json:"time_slot_id"`
+		} `json:"pins"`
+		LockFromScheduleID string `json:"lock_from_schedule_id"`
+		Seed               uint64 `json:"seed"`
+		PortfolioSize      int32  `json:"portfolio_size"`
 	}
 	// body is optional — ignore bind error
 	_ = c.ShouldBindJSON(&body)
@@ -26,16 +40,37 @@ func (h *TimetableHandler) GenerateSchedule(c *gin.Context) {
 		body.TimeoutSeconds = 30
 	}
 
+	pins := make([]*timetablev1.PinnedAssignment, len(body.Pins))
+	for i, p := range body.Pins {
+		pins[i] = &timetablev1.PinnedAssignment{
+			SubjectId:  p.SubjectID,
+			Section:    p.Section,
+			Session:    p.Session,
+			TeacherId:  p.TeacherID,
+			RoomId:     p.RoomID,
+			TimeSlotId: p.TimeSlotID,
+		}
+	}
+
 	resp, err := h.timetable.GenerateSchedule(c.Request.Context(), &timetablev1.GenerateScheduleRequest{
-		SemesterId:     c.Param("id"),
-		TimeoutSeconds: body.TimeoutSeconds,
+		SemesterId:         c.Param("id"),
+		TimeoutSeconds:     body.TimeoutSeconds,
+		BindSessionTeacher: body.BindSessionTeacher,
+		SoftCohortClash:    body.SoftCohortClash,
+		Pins:               pins,
+		LockFromScheduleId: body.LockFromScheduleID,
+		Seed:               body.Seed,
+		PortfolioSize:      body.PortfolioSize,
 	})
 	if err != nil {
 		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
 		return
 	}
-	// Return a full Schedule object so the frontend can use data.id for SSE/polling
-	c.JSON(http.StatusAccepted, scheduleToJSON(resp.Schedule))
+	// Return a full Schedule object so the frontend can use data.id for SSE/polling;
+	// seed lets the caller reproduce the run
+	out := scheduleToJSON(resp.Schedule)
+	out["seed"] = resp.Seed
+	c.JSON(http.StatusAccepted, out)
 }
 
 func (h *TimetableHandler) GetSchedule(c *gin.Context) {
@@ -77,6 +112,71 @@ func (h *TimetableHandler) ListSchedules(c *gin.Context) {
 	})
 }
 
+// GetGenerationStatus reports whether generation finished, and if it failed,
+// which subjects could not be placed and why.
+// GET /timetable/schedules/:id/status
+func (h *TimetableHandler) GetGenerationStatus(c *gin.Context) {
+	resp, err := h.timetable.GetGenerationStatus(c.Request.Context(), &timetablev1.GetGenerationStatusRequest{
+		ScheduleId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	body := gin.H{
+		"schedule_id":    resp.ScheduleId,
+		"status":         resp.Status,
+		"is_complete":    resp.IsComplete,
+		"is_partial":     resp.IsPartial,
+		"is_failed":      resp.IsFailed,
+		"failure_reason": resp.FailureReason,
+		"diagnosis":      nil,
+		"job_status":     resp.JobStatus,
+		"job_attempts":   resp.JobAttempts,
+	}
+	if d := resp.Diagnosis; d != nil {
+		emptyDomains := make([]gin.H, len(d.EmptyDomains))
+		for i, e := range d.EmptyDomains {
+			emptyDomains[i] = gin.H{
+				"subject_id":   e.SubjectId,
+				"subject_code": e.SubjectCode,
+				"subject_name": e.SubjectName,
+				"session":      e.Session,
+				"candidates":   e.Candidates,
+				"cause":        e.Cause,
+				"eliminated":   e.Eliminated,
+			}
+		}
+		body["diagnosis"] = gin.H{
+			"empty_domains":     emptyDomains,
+			"conflict_subjects": diagnosisRefsToJSON(d.ConflictSubjects),
+			"conflict_teachers": diagnosisRefsToJSON(d.ConflictTeachers),
+		}
+	}
+	c.JSON(http.StatusOK, body)
+}
+
+// CancelGeneration stops a queued or running generation via
+// POST /schedules/:id/cancel; the schedule ends up failed.
+func (h *TimetableHandler) CancelGeneration(c *gin.Context) {
+	resp, err := h.timetable.CancelGeneration(c.Request.Context(), &timetablev1.CancelGenerationRequest{
+		ScheduleId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"schedule_id": resp.ScheduleId, "job_status": resp.JobStatus})
+}
+
+func diagnosisRefsToJSON(refs []*timetablev1.DiagnosisRef) []gin.H {
+	out := make([]gin.H, len(refs))
+	for i, r := range refs {
+		out[i] = gin.H{"id": r.Id, "name": r.Name}
+	}
+	return out
+}
+
 // scheduleToJSON converts a proto Schedule to a JSON-friendly map that matches
 // the frontend Schedule type. Avoids proto Timestamp serialization issues.
 func scheduleToJSON(s *timetablev1.Schedule) gin.H {
@@ -84,24 +184,7 @@ func scheduleToJSON(s *timetablev1.Schedule) gin.H {
 	if s.CreatedAt != nil {
 		createdAt = s.CreatedAt.AsTime().Format(time.RFC3339)
 	}
-	entries := make([]gin.H, len(s.Entries))
-	for i, e := range s.Entries {
-		entries[i] = gin.H{
-			"id":                 e.Id,
-			"subject_id":         e.SubjectId,
-			"subject_code":       e.SubjectCode,
-			"subject_name":       e.SubjectName,
-			"teacher_id":         e.TeacherId,
-			"teacher_name":       e.TeacherName,
-			"room_id":            e.Room,
-			"room_name":          e.RoomName,
-			"day_of_week":        e.DayOfWeek,
-			"start_period":       e.StartPeriod,
-			"end_period":         e.EndPeriod,
-			"is_manual_override": e.IsManualOverride,
-			"department_id":      e.DepartmentId,
-		}
-	}
+	entries := entriesToJSON(s.Entries)
 	return gin.H{
 		"id":              s.Id,
 		"semester_id":     s.SemesterId,
@@ -109,15 +192,21 @@ func scheduleToJSON(s *timetablev1.Schedule) gin.H {
 		"score":           s.Score,
 		"hard_violations": s.HardViolations,
 		"soft_violations": s.SoftViolations,
+		"cohort_clashes":  s.CohortClashes,
 		"entries":         entries,
 		"created_at":      createdAt,
 	}
 }
 
-// ManualAssign assigns a teacher to a schedule entry via PUT /schedules/:id/entries/:entryId.
+// ManualAssign overrides the teacher, and optionally the room and slot, of a
+// schedule entry via PUT /schedules/:id/entries/:entryId. The edit is checked
+// against the whole schedule; with dry_run it is only previewed, not saved.
 func (h *TimetableHandler) ManualAssign(c *gin.Context) {
 	var body struct {
-		TeacherID string `json:"teacher_id" binding:"required"`
+		TeacherID  string `json:"teacher_id" binding:"required"`
+		RoomID     string `json:"room_id"`
+		TimeSlotID string `json:"time_slot_id"`
+		DryRun     bool   `json:"dry_run"`
 	}
 	if err := c.ShouldBindJSON(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -128,20 +217,92 @@ func (h *TimetableHandler) ManualAssign(c *gin.Context) {
 		ScheduleId: c.Param("id"),
 		EntryId:    c.Param("entryId"),
 		TeacherId:  body.TeacherID,
+		RoomId:     body.RoomID,
+		TimeSlotId: body.TimeSlotID,
+		DryRun:     body.DryRun,
 	})
 	if err != nil {
+		// An edit double-booking a slot is refused with the clashes as details
+		var clashes []*timetablev1.ScheduleViolation
+		for _, d := range status.Convert(err).Details() {
+			if v, ok := d.(*timetablev1.ScheduleViolation); ok {
+				clashes = append(clashes, v)
+			}
+		}
+		if len(clashes) > 0 {
+			c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error(), "violations": violationsToJSON(clashes)})
+			return
+		}
 		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"entry": resp.Entry})
+	c.JSON(http.StatusOK, gin.H{
+		"entry":           resp.Entry,
+		"dry_run":         body.DryRun,
+		"violations":      violationsToJSON(resp.Violations),
+		"score":           resp.Score,
+		"hard_violations": resp.HardViolations,
+		"soft_penalty":    resp.SoftPenalty,
+	})
+}
+
+func violationsToJSON(violations []*timetablev1.ScheduleViolation) []gin.H {
+	out := make([]gin.H, 0, len(violations))
+	for _, v := range violations {
+		out = append(out, gin.H{
+			"constraint":     v.Constraint,
+			"entry_id":       v.EntryId,
+			"other_entry_id": v.OtherEntryId,
+			"teacher_id":     v.TeacherId,
+		})
+	}
+	return out
 }
 
-// SuggestTeachers returns teacher suggestions for a given slot.
-// GET /suggest-teachers?subject_id=&day_of_week=&start_period=&end_period=
+// RepairSchedule re-solves only the entries invalidated by removed teachers,
+// closed rooms or removed slots via POST /schedules/:id/repair.
+func (h *TimetableHandler) RepairSchedule(c *gin.Context) {
+	var body struct {
+		RemovedTeacherIDs []string `json:"removed_teacher_ids"`
+		ClosedRoomIDs     []string `json:"closed_room_ids"`
+		RemovedSlotIDs    []string `json:"removed_slot_ids"`
+		TimeoutSeconds    int32    `json:"timeout_seconds"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.timetable.RepairSchedule(c.Request.Context(), &timetablev1.RepairScheduleRequest{
+		ScheduleId:        c.Param("id"),
+		RemovedTeacherIds: body.RemovedTeacherIDs,
+		ClosedRoomIds:     body.ClosedRoomIDs,
+		RemovedSlotIds:    body.RemovedSlotIDs,
+		TimeoutSeconds:    body.TimeoutSeconds,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"schedule":           scheduleToJSON(resp.Schedule),
+		"affected_entries":   resp.AffectedEntries,
+		"reassigned_entries": resp.ReassignedEntries,
+		"moved_entries":      resp.MovedEntries,
+		"unresolved_entries": resp.UnresolvedEntries,
+	})
+}
+
+// SuggestTeachers returns teacher suggestions for a given slot. With
+// schedule_id, teachers busy at that time or out of weekly hours in the
+// schedule come last with is_available false; entry_id takes the subject and
+// slot from the entry being reassigned.
+// GET /suggest-teachers?subject_id=&day_of_week=&start_period=&end_period=&schedule_id=&entry_id=&time_slot_id=
 func (h *TimetableHandler) SuggestTeachers(c *gin.Context) {
 	subjectID := c.Query("subject_id")
-	if subjectID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "subject_id is required"})
+	entryID := c.Query("entry_id")
+	if subjectID == "" && entryID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "subject_id or entry_id is required"})
 		return
 	}
 
@@ -154,6 +315,9 @@ func (h *TimetableHandler) SuggestTeachers(c *gin.Context) {
 		DayOfWeek:   int32(dayOfWeek),   // safe: ParseInt bitSize=32
 		StartPeriod: int32(startPeriod), // safe: ParseInt bitSize=32
 		EndPeriod:   int32(endPeriod),   // safe: ParseInt bitSize=32
+		ScheduleId:  c.Query("schedule_id"),
+		EntryId:     entryID,
+		TimeSlotId:  c.Query("time_slot_id"),
 	})
 	if err != nil {
 		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
@@ -161,18 +325,24 @@ func (h *TimetableHandler) SuggestTeachers(c *gin.Context) {
 	}
 	suggestions := make([]gin.H, len(resp.Suggestions))
 	for i, s := range resp.Suggestions {
+		reasons := s.Reasons
+		if reasons == nil {
+			reasons = []string{}
+		}
 		suggestions[i] = gin.H{
 			"teacher_id":   s.TeacherId,
 			"teacher_name": s.TeacherName,
 			"score":        s.Score,
-			"reasons":      []string{},
-			"is_available": true,
+			"reasons":      reasons,
+			"is_available": s.Available,
 		}
 	}
 	c.JSON(http.StatusOK, suggestions)
 }
 
-// StreamScheduleStatus streams schedule generation events via SSE.
+// StreamScheduleStatus streams schedule generation events via SSE: started,
+// progress (search, twice a second), optimizing (local search), and the
+// terminal completed or failed, after which the stream closes.
 // GET /timetable/schedules/:id/stream
 func (h *TimetableHandler) StreamScheduleStatus(c *gin.Context) {
 	if h.natsJS == nil {
@@ -192,9 +362,15 @@ func (h *TimetableHandler) StreamScheduleStatus(c *gin.Context) {
 		return
 	}
 
+	// Progress events arrive steadily; never block the consumer once the
+	// client has gone
+	ctx := c.Request.Context()
 	msgCh := make(chan jetstream.Msg, 10)
 	cc, err := cons.Consume(func(msg jetstream.Msg) {
-		msgCh <- msg
+		select {
+		case msgCh <- msg:
+		case <-ctx.Done():
+		}
 	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "consume failed"})
@@ -207,7 +383,7 @@ func (h *TimetableHandler) StreamScheduleStatus(c *gin.Context) {
 
 	for {
 		select {
-		case <-c.Request.Context().Done():
+		case <-ctx.Done():
 			return
 		case <-heartbeat.C:
 			sse.SendHeartbeat()
@@ -221,3 +397,41 @@ func (h *TimetableHandler) StreamScheduleStatus(c *gin.Context) {
 		}
 	}
 }
+
+// entriesToJSON converts proto schedule entries for scheduleToJSON and versionToJSON.
+func entriesToJSON(in []*timetablev1.ScheduleEntry) []gin.H {
+	entries := make([]gin.H, len(in))
+	for i, e := range in {
+		entries[i] = gin.H{
+			"id":                 e.Id,
+			"subject_id":         e.SubjectId,
+			"subject_code":       e.SubjectCode,
+			"subject_name":       e.SubjectName,
+			"teacher_id":         e.TeacherId,
+			"teacher_name":       e.TeacherName,
+			"room_id":            e.Room,
+			"room_name":          e.RoomName,
+			"day_of_week":        e.DayOfWeek,
+			"start_period":       e.StartPeriod,
+			"end_period":         e.EndPeriod,
+			"is_manual_override": e.IsManualOverride,
+			"department_id":      e.DepartmentId,
+			"section":            e.Section,
+			"teachers":           entryTeachersToJSON(e.Teachers),
+		}
+	}
+	return entries
+}
+
+// entryTeachersToJSON lists everyone teaching a session, the lead first.
+func entryTeachersToJSON(teachers []*timetablev1.EntryTeacher) []gin.H {
+	result := make([]gin.H, len(teachers))
+	for i, t := range teachers {
+		result[i] = gin.H{
+			"teacher_id":   t.TeacherId,
+			"teacher_name": t.TeacherName,
+			"role":         t.Role,
+		}
+	}
+	return result
+}
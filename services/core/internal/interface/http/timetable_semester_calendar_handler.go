@@ -0,0 +1,221 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+)
+
+// SetPeriodDefinitions replaces the semester's period clock times via
+// PUT /semesters/:id/periods. The body holds either periods (period, start,
+// end as "HH:MM") or generate (first_start, count, period_minutes,
+// break_minutes, long_breaks); an empty body restores the default table.
+func (h *TimetableHandler) SetPeriodDefinitions(c *gin.Context) {
+	var body struct {
+		Periods []struct {
+			Period int32  `json:"period" binding:"required"`
+			Start  string `json:"start" binding:"required"`
+			End    string `json:"end" binding:"required"`
+		} `json:"periods"`
+		Generate *struct {
+			FirstStart    string          `json:"first_start" binding:"required"`
+			Count         int32           `json:"count" binding:"required"`
+			PeriodMinutes int32           `json:"period_minutes" binding:"required"`
+			BreakMinutes  int32           `json:"break_minutes"`
+			LongBreaks    map[int32]int32 `json:"long_breaks"`
+		} `json:"generate"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &timetablev1.SetPeriodDefinitionsRequest{SemesterId: c.Param("id")}
+	for _, p := range body.Periods {
+		req.Periods = append(req.Periods, &timetablev1.PeriodDefinition{Period: p.Period, Start: p.Start, End: p.End})
+	}
+	if g := body.Generate; g != nil {
+		req.Generate = &timetablev1.PeriodGenerator{
+			FirstStart:    g.FirstStart,
+			Count:         g.Count,
+			PeriodMinutes: g.PeriodMinutes,
+			BreakMinutes:  g.BreakMinutes,
+			LongBreaks:    g.LongBreaks,
+		}
+	}
+	resp, err := h.semesters.SetPeriodDefinitions(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, semesterToJSON(resp.Semester))
+}
+
+// SetHolidays replaces the semester's non-teaching days via
+// PUT /semesters/:id/holidays (body: holidays[] of name, start_date and
+// optional end_date, as YYYY-MM-DD or RFC 3339).
+func (h *TimetableHandler) SetHolidays(c *gin.Context) {
+	var body struct {
+		Holidays []struct {
+			Name      string `json:"name"`
+			StartDate string `json:"start_date" binding:"required"`
+			EndDate   string `json:"end_date"`
+		} `json:"holidays"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	holidays := make([]*timetablev1.Holiday, 0, len(body.Holidays))
+	for _, hd := range body.Holidays {
+		holiday := &timetablev1.Holiday{Name: hd.Name}
+		start, err := parseDay(hd.StartDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("holiday %q: invalid start_date", hd.Name)})
+			return
+		}
+		holiday.StartDate = timestamppb.New(start)
+		if hd.EndDate != "" {
+			end, err := parseDay(hd.EndDate)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("holiday %q: invalid end_date", hd.Name)})
+				return
+			}
+			holiday.EndDate = timestamppb.New(end)
+		}
+		holidays = append(holidays, holiday)
+	}
+	resp, err := h.semesters.SetHolidays(c.Request.Context(), &timetablev1.SetHolidaysRequest{
+		SemesterId: c.Param("id"),
+		Holidays:   holidays,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, semesterToJSON(resp.Semester))
+}
+
+// SetWeekPattern sets how often an offered subject meets via
+// PUT /semesters/:id/week-patterns/:subjectId (body: pattern, one of weekly,
+// odd_weeks, even_weeks, first_half, second_half).
+func (h *TimetableHandler) SetWeekPattern(c *gin.Context) {
+	var body struct {
+		Pattern string `json:"pattern" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	resp, err := h.semesters.SetWeekPattern(c.Request.Context(), &timetablev1.SetWeekPatternRequest{
+		SemesterId: c.Param("id"),
+		SubjectId:  c.Param("subjectId"),
+		Pattern:    body.Pattern,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, semesterToJSON(resp.Semester))
+}
+
+// SetSubjectSections splits an offered subject into sections via
+// PUT /semesters/:id/sections/:subjectId (body: sections, each with number
+// and optional capacity, student_group and assistants). A single section 1
+// with nothing else set puts the subject back to one section.
+func (h *TimetableHandler) SetSubjectSections(c *gin.Context) {
+	var body struct {
+		Sections []struct {
+			Number       int32  `json:"number"`
+			Capacity     int32  `json:"capacity"`
+			StudentGroup string `json:"student_group"`
+			Assistants   int32  `json:"assistants"`
+		} `json:"sections" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	sections := make([]*timetablev1.CourseSection, len(body.Sections))
+	for i, s := range body.Sections {
+		sections[i] = &timetablev1.CourseSection{
+			Number:       s.Number,
+			Capacity:     s.Capacity,
+			StudentGroup: s.StudentGroup,
+			Assistants:   s.Assistants,
+		}
+	}
+	resp, err := h.semesters.SetSubjectSections(c.Request.Context(), &timetablev1.SetSubjectSectionsRequest{
+		SemesterId: c.Param("id"),
+		SubjectId:  c.Param("subjectId"),
+		Sections:   sections,
+	})
+	if err != nil {
+		c.JSON(grpcToHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, semesterToJSON(resp.Semester))
+}
+
+// parseDay accepts a calendar date as YYYY-MM-DD or an RFC 3339 timestamp.
+func parseDay(s string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func periodsToJSON(periods []*timetablev1.PeriodDefinition) []gin.H {
+	result := make([]gin.H, len(periods))
+	for i, p := range periods {
+		result[i] = gin.H{
+			"period":        p.Period,
+			"start":         p.Start,
+			"end":           p.End,
+			"break_minutes": p.BreakMinutes,
+		}
+	}
+	return result
+}
+
+func holidaysToJSON(holidays []*timetablev1.Holiday) []gin.H {
+	result := make([]gin.H, len(holidays))
+	for i, hd := range holidays {
+		result[i] = gin.H{
+			"name":       hd.Name,
+			"start_date": hd.StartDate.AsTime().Format(time.DateOnly),
+			"end_date":   hd.EndDate.AsTime().Format(time.DateOnly),
+		}
+	}
+	return result
+}
+
+func weekPatternsToJSON(patterns map[string]string) map[string]string {
+	if patterns == nil {
+		return map[string]string{}
+	}
+	return patterns
+}
+
+func sectionsToJSON(sections map[string]*timetablev1.SubjectSections) map[string][]gin.H {
+	result := make(map[string][]gin.H, len(sections))
+	for subjectID, ss := range sections {
+		list := make([]gin.H, len(ss.GetSections()))
+		for i, cs := range ss.GetSections() {
+			list[i] = gin.H{
+				"number":        cs.Number,
+				"capacity":      cs.Capacity,
+				"student_group": cs.StudentGroup,
+				"assistants":    cs.Assistants,
+			}
+		}
+		result[subjectID] = list
+	}
+	return result
+}
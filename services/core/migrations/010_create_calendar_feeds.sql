@@ -0,0 +1,19 @@
+-- +goose Up
+-- Issued calendar subscriptions. A feed token carries its row id as the jti
+-- and is only served while the row is not revoked.
+CREATE TABLE core.calendar_feeds (
+    id            UUID        NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    created_by    VARCHAR(64) NOT NULL, -- user id, or "internal-service" for agent tools
+    semester_id   VARCHAR(64) NOT NULL,
+    teacher_id    VARCHAR(64),
+    room_id       VARCHAR(64),
+    student_id    VARCHAR(64),
+    department_id VARCHAR(64),
+    revoked_at    TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_calendar_feeds_created_by ON core.calendar_feeds (created_by, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS core.calendar_feeds;
@@ -22,7 +22,7 @@ func NewExcelGenerator(repo *persistence.AnalyticsRepository) *ExcelGenerator {
 }
 
 // GenerateWorkloadReport returns an Excel workbook with teacher workload data.
-// Columns: Teacher | Department | Subject | Hours/Week | Total Hours
+// Columns: Teacher | Department | Subject | Role | Hours/Week | Total Hours
 func (g *ExcelGenerator) GenerateWorkloadReport(ctx context.Context, semesterID string) ([]byte, error) {
 	sid, err := parseSemID(semesterID)
 	if err != nil {
@@ -40,7 +40,7 @@ func (g *ExcelGenerator) GenerateWorkloadReport(ctx context.Context, semesterID
 	sheet := "Workload - " + g.semesterLabel(ctx, sid)
 	f.SetSheetName("Sheet1", sheet)
 
-	headers := []string{"Teacher", "Department", "Subject", "Hours/Week", "Total Hours"}
+	headers := []string{"Teacher", "Department", "Subject", "Role", "Hours/Week", "Total Hours"}
 	if err := writeExcelHeader(f, sheet, headers); err != nil {
 		return nil, err
 	}
@@ -51,6 +51,7 @@ func (g *ExcelGenerator) GenerateWorkloadReport(ctx context.Context, semesterID
 			s.TeacherName,
 			s.DepartmentName,
 			s.SubjectCode,
+			s.Role,
 			s.HoursPerWeek,
 			s.TotalHours,
 		}
@@ -59,7 +60,7 @@ func (g *ExcelGenerator) GenerateWorkloadReport(ctx context.Context, semesterID
 		}
 	}
 
-	setColumnWidths(f, sheet, []float64{30, 26, 14, 14, 14})
+	setColumnWidths(f, sheet, []float64{30, 26, 14, 12, 14, 14})
 
 	return excelBytes(f)
 }
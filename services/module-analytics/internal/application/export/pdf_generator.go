@@ -22,7 +22,7 @@ func NewPDFGenerator(repo *persistence.AnalyticsRepository) *PDFGenerator {
 }
 
 // GenerateWorkloadReport returns a PDF with teacher workload data for the given semester.
-// Columns: Teacher | Department | Subject | Hours/Week | Total Hours
+// Columns: Teacher | Department | Subject | Role | Hours/Week | Total Hours
 func (g *PDFGenerator) GenerateWorkloadReport(ctx context.Context, semesterID string) ([]byte, error) {
 	sid, err := parseSemID(semesterID)
 	if err != nil {
@@ -36,8 +36,8 @@ func (g *PDFGenerator) GenerateWorkloadReport(ctx context.Context, semesterID st
 
 	title := "Teacher Workload Report - " + g.semesterLabel(ctx, sid)
 	pdf := newPDF(title)
-	headers := []string{"Teacher", "Department", "Subject", "Hours/Week", "Total Hours"}
-	widths := []float64{60, 55, 40, 32, 33}
+	headers := []string{"Teacher", "Department", "Subject", "Role", "Hours/Week", "Total Hours"}
+	widths := []float64{50, 45, 35, 25, 32, 33}
 	tbl := newTableRenderer(pdf, widths)
 	tbl.header(headers)
 
@@ -46,6 +46,7 @@ func (g *PDFGenerator) GenerateWorkloadReport(ctx context.Context, semesterID st
 			s.TeacherName,
 			s.DepartmentName,
 			s.SubjectCode,
+			s.Role,
 			fmt.Sprintf("%.1f", s.HoursPerWeek),
 			fmt.Sprintf("%.1f", s.TotalHours),
 		})
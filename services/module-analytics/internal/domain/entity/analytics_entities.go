@@ -51,6 +51,7 @@ type FactWorkload struct {
 	TeacherID    uuid.UUID
 	SemesterID   uuid.UUID
 	SubjectID    uuid.UUID
+	Role         string // lead or assistant
 	HoursPerWeek float64
 	TotalHours   float64
 	CreatedAt    time.Time
@@ -102,6 +103,7 @@ type WorkloadStat struct {
 	SemesterID     uuid.UUID `json:"semester_id"`
 	SubjectID      uuid.UUID `json:"subject_id"`
 	SubjectCode    string    `json:"subject_code"`
+	Role           string    `json:"role"`
 	HoursPerWeek   float64   `json:"hours_per_week"`
 	TotalHours     float64   `json:"total_hours"`
 }
@@ -189,6 +189,20 @@ type scheduleGeneratedEvent struct {
 	Entries []scheduleEntryEvent `json:"entries"`
 }
 
+type workloadEvent struct {
+	TeacherID    string  `json:"teacher_id"`
+	SubjectID    string  `json:"subject_id"`
+	Role         string  `json:"role"` // empty from publishers before roles: lead
+	HoursPerWeek float64 `json:"hours_per_week"`
+	TotalHours   float64 `json:"total_hours"`
+}
+
+type schedulePublishedEvent struct {
+	ScheduleID string          `json:"schedule_id"`
+	SemesterID string          `json:"semester_id"`
+	Workload   []workloadEvent `json:"workload"`
+}
+
 func (c *Consumer) handleTimetableMessage(msg *messaging.Message) error {
 	switch msg.Subject {
 	case "timetable.semester.created":
@@ -232,10 +246,44 @@ func (c *Consumer) handleTimetableMessage(msg *messaging.Message) error {
 				c.log.Error("upsert schedule entry", zap.Error(err), zap.String("schedule_id", e.ScheduleID))
 			}
 		}
+
+	case "timetable.schedule.published":
+		var ev schedulePublishedEvent
+		if err := json.Unmarshal(msg.Data, &ev); err != nil {
+			c.log.Error("unmarshal timetable schedule published event", zap.Error(err))
+			return nil
+		}
+		if ev.Workload == nil {
+			return nil // publisher could not compute hours; keep the previous facts
+		}
+		if err := c.repo.ReplaceWorkload(context.Background(), mustParseUUID(ev.SemesterID), workloadRows(ev, time.Now())); err != nil {
+			c.log.Error("replace workload", zap.Error(err), zap.String("schedule_id", ev.ScheduleID))
+		}
 	}
 	return nil
 }
 
+// workloadRows turns a published schedule's workload into fact rows.
+func workloadRows(ev schedulePublishedEvent, now time.Time) []entity.FactWorkload {
+	rows := make([]entity.FactWorkload, len(ev.Workload))
+	for i, w := range ev.Workload {
+		role := w.Role
+		if role == "" {
+			role = "lead"
+		}
+		rows[i] = entity.FactWorkload{
+			TeacherID:    mustParseUUID(w.TeacherID),
+			SemesterID:   mustParseUUID(ev.SemesterID),
+			SubjectID:    mustParseUUID(w.SubjectID),
+			Role:         role,
+			HoursPerWeek: w.HoursPerWeek,
+			TotalHours:   w.TotalHours,
+			CreatedAt:    now,
+		}
+	}
+	return rows
+}
+
 // --- Student events ---
 
 type studentEnrollmentApprovedEvent struct {
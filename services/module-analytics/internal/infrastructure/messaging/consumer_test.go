@@ -8,6 +8,7 @@ package messaging
 
 import (
 	"testing"
+	"time"
 
 	pkgmsg "github.com/HuynhHoangPhuc/myrmex/pkg/messaging"
 	"github.com/google/uuid"
@@ -81,7 +82,7 @@ func TestHandleSubjectMessage_UnknownSubject_ReturnsNil(t *testing.T) {
 // --- Timetable bad-JSON / unknown-subject ---
 
 func TestHandleTimetableMessage_BadJSON_ReturnsNil(t *testing.T) {
-	subjects := []string{"timetable.semester.created", "timetable.schedule.generated"}
+	subjects := []string{"timetable.semester.created", "timetable.schedule.generated", "timetable.schedule.published"}
 	c := consumerWithNilRepo(t)
 	for _, subj := range subjects {
 		t.Run(subj, func(t *testing.T) {
@@ -92,6 +93,35 @@ func TestHandleTimetableMessage_BadJSON_ReturnsNil(t *testing.T) {
 	}
 }
 
+func TestHandleTimetableMessage_PublishedWithoutWorkload_ReturnsNil(t *testing.T) {
+	c := consumerWithNilRepo(t)
+	msg := pkgmsg.NewMessage("timetable.schedule.published", []byte(`{"schedule_id":"x","semester_id":"y"}`), nil, nil)
+	if err := c.handleTimetableMessage(msg); err != nil {
+		t.Errorf("handleTimetableMessage() without workload: got error %v, want nil", err)
+	}
+}
+
+func TestWorkloadRows_DefaultsRoleToLead(t *testing.T) {
+	teacherID, subjectID, semesterID := uuid.New(), uuid.New(), uuid.New()
+	ev := schedulePublishedEvent{
+		SemesterID: semesterID.String(),
+		Workload: []workloadEvent{
+			{TeacherID: teacherID.String(), SubjectID: subjectID.String(), HoursPerWeek: 3, TotalHours: 45},
+			{TeacherID: teacherID.String(), SubjectID: subjectID.String(), Role: "assistant", HoursPerWeek: 1.5, TotalHours: 22.5},
+		},
+	}
+	rows := workloadRows(ev, time.Now())
+	if len(rows) != 2 {
+		t.Fatalf("workloadRows() = %d rows, want 2", len(rows))
+	}
+	if rows[0].Role != "lead" || rows[1].Role != "assistant" {
+		t.Errorf("workloadRows() roles = %q, %q; want lead, assistant", rows[0].Role, rows[1].Role)
+	}
+	if rows[1].SemesterID != semesterID || rows[1].TotalHours != 22.5 {
+		t.Errorf("workloadRows() row = %+v", rows[1])
+	}
+}
+
 func TestHandleTimetableMessage_UnknownSubject_ReturnsNil(t *testing.T) {
 	c := consumerWithNilRepo(t)
 	if err := c.handleTimetableMessage(newUnknownMsg("timetable")); err != nil {
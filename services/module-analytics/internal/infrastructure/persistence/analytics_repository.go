@@ -114,6 +114,32 @@ func (r *AnalyticsRepository) UpsertScheduleEntry(ctx context.Context, e entity.
 	return err
 }
 
+// ReplaceWorkload swaps a semester's workload facts for those of its newly
+// published schedule. Rows for teachers not yet in dim_teacher are skipped.
+func (r *AnalyticsRepository) ReplaceWorkload(ctx context.Context, semesterID uuid.UUID, rows []entity.FactWorkload) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin workload tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM analytics.fact_workload WHERE semester_id = $1`, semesterID); err != nil {
+		return fmt.Errorf("clear workload: %w", err)
+	}
+	for _, w := range rows {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO analytics.fact_workload
+				(teacher_id, semester_id, subject_id, role, hours_per_week, total_hours, created_at)
+			SELECT $1,$2,$3,$4,$5,$6,$7
+			WHERE EXISTS (SELECT 1 FROM analytics.dim_teacher WHERE teacher_id = $1)`,
+			w.TeacherID, semesterID, w.SubjectID, w.Role, w.HoursPerWeek, w.TotalHours, w.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("insert workload: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
 // UpsertStudent inserts or updates a student dimension record.
 func (r *AnalyticsRepository) UpsertStudent(ctx context.Context, s entity.DimStudent) error {
 	_, err := r.pool.Exec(ctx, `
@@ -159,6 +185,7 @@ func (r *AnalyticsRepository) GetWorkloadStats(ctx context.Context, semesterID u
 			w.semester_id,
 			w.subject_id,
 			COALESCE(s.code, ''),
+			w.role,
 			w.hours_per_week,
 			w.total_hours
 		FROM analytics.fact_workload w
@@ -178,7 +205,7 @@ func (r *AnalyticsRepository) GetWorkloadStats(ctx context.Context, semesterID u
 		var s entity.WorkloadStat
 		if err := rows.Scan(
 			&s.TeacherID, &s.TeacherName, &s.DepartmentID, &s.DepartmentName,
-			&s.SemesterID, &s.SubjectID, &s.SubjectCode, &s.HoursPerWeek, &s.TotalHours,
+			&s.SemesterID, &s.SubjectID, &s.SubjectCode, &s.Role, &s.HoursPerWeek, &s.TotalHours,
 		); err != nil {
 			return nil, fmt.Errorf("scan workload row: %w", err)
 		}
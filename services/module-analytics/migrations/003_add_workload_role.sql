@@ -0,0 +1,19 @@
+-- +goose Up
+-- Teachers may lead one section of a subject and assist in another, so
+-- workload facts are kept per role.
+ALTER TABLE analytics.fact_workload
+    ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'lead';
+ALTER TABLE analytics.fact_workload
+    DROP CONSTRAINT fact_workload_teacher_id_semester_id_subject_id_key;
+ALTER TABLE analytics.fact_workload
+    ADD CONSTRAINT fact_workload_teacher_semester_subject_role_key
+    UNIQUE (teacher_id, semester_id, subject_id, role);
+
+-- +goose Down
+DELETE FROM analytics.fact_workload WHERE role <> 'lead';
+ALTER TABLE analytics.fact_workload
+    DROP CONSTRAINT fact_workload_teacher_semester_subject_role_key;
+ALTER TABLE analytics.fact_workload
+    ADD CONSTRAINT fact_workload_teacher_id_semester_id_subject_id_key
+    UNIQUE (teacher_id, semester_id, subject_id);
+ALTER TABLE analytics.fact_workload DROP COLUMN role;
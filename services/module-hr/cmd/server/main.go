@@ -85,6 +85,7 @@ func main() {
 	updateTeacherHandler := command.NewUpdateTeacherHandler(teacherRepo, publisher)
 	deleteTeacherHandler := command.NewDeleteTeacherHandler(teacherRepo, publisher)
 	updateAvailabilityHandler := command.NewUpdateAvailabilityHandler(teacherRepo, publisher)
+	updatePreferencesHandler := command.NewUpdatePreferencesHandler(teacherRepo, publisher)
 	createDepartmentHandler := command.NewCreateDepartmentHandler(deptRepo, publisher)
 
 	// 7. Query handlers
@@ -99,6 +100,7 @@ func main() {
 		updateTeacherHandler,
 		deleteTeacherHandler,
 		updateAvailabilityHandler,
+		updatePreferencesHandler,
 		getTeacherHandler,
 		listTeachersHandler,
 		getAvailabilityHandler,
@@ -10,10 +10,12 @@ import (
 )
 
 // AvailabilitySlot represents a single weekly time slot input.
+// An empty Preference keeps the level the slot already had (neutral if new).
 type AvailabilitySlot struct {
 	DayOfWeek   int
 	StartPeriod int
 	EndPeriod   int
+	Preference  entity.Preference
 }
 
 // UpdateAvailabilityCommand replaces all availability slots for a teacher.
@@ -40,12 +42,23 @@ func (h *UpdateAvailabilityHandler) Handle(ctx context.Context, cmd UpdateAvaila
 			DayOfWeek:   s.DayOfWeek,
 			StartPeriod: s.StartPeriod,
 			EndPeriod:   s.EndPeriod,
+			Preference:  s.Preference,
 		}
 		if err := slot.Validate(); err != nil {
 			return nil, fmt.Errorf("invalid slot: %w", err)
 		}
 	}
 
+	// Remember current preferences so a plain availability edit keeps them
+	existing, err := h.repo.ListAvailability(ctx, cmd.TeacherID)
+	if err != nil {
+		return nil, fmt.Errorf("list availability: %w", err)
+	}
+	previous := make(map[[2]int]entity.Preference, len(existing))
+	for _, a := range existing {
+		previous[[2]int{a.DayOfWeek, a.StartPeriod}] = a.Preference
+	}
+
 	// Clear existing slots then upsert new ones
 	if err := h.repo.DeleteAvailability(ctx, cmd.TeacherID); err != nil {
 		return nil, fmt.Errorf("clear availability: %w", err)
@@ -58,6 +71,10 @@ func (h *UpdateAvailabilityHandler) Handle(ctx context.Context, cmd UpdateAvaila
 			DayOfWeek:   s.DayOfWeek,
 			StartPeriod: s.StartPeriod,
 			EndPeriod:   s.EndPeriod,
+			Preference:  s.Preference,
+		}
+		if slot.Preference == "" {
+			slot.Preference = previous[[2]int{s.DayOfWeek, s.StartPeriod}]
 		}
 		created, err := h.repo.UpsertAvailability(ctx, slot)
 		if err != nil {
@@ -15,6 +15,11 @@ type availabilityMockRepo struct {
 	deleteAvailErr  error
 	upsertAvailErr  error
 	upsertedSlots   []*entity.Availability
+	existing        []*entity.Availability
+}
+
+func (m *availabilityMockRepo) ListAvailability(_ context.Context, _ uuid.UUID) ([]*entity.Availability, error) {
+	return m.existing, nil
 }
 
 func (m *availabilityMockRepo) DeleteAvailability(_ context.Context, _ uuid.UUID) error {
@@ -117,3 +122,24 @@ func TestUpdateAvailabilityHandler_UpsertError(t *testing.T) {
 		t.Fatal("expected error from upsert")
 	}
 }
+
+func TestUpdateAvailabilityHandler_KeepsExistingPreference(t *testing.T) {
+	repo := &availabilityMockRepo{existing: []*entity.Availability{
+		{DayOfWeek: 1, StartPeriod: 1, EndPeriod: 2, Preference: entity.PreferenceDisliked},
+	}}
+	h := NewUpdateAvailabilityHandler(repo, NewNoopPublisher())
+
+	slots, err := h.Handle(context.Background(), UpdateAvailabilityCommand{
+		TeacherID: uuid.New(),
+		Slots: []AvailabilitySlot{
+			{DayOfWeek: 1, StartPeriod: 1, EndPeriod: 2},
+			{DayOfWeek: 2, StartPeriod: 1, EndPeriod: 2, Preference: entity.PreferencePreferred},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if slots[0].Preference != entity.PreferenceDisliked || slots[1].Preference != entity.PreferencePreferred {
+		t.Fatalf("unexpected preferences: %v, %v", slots[0].Preference, slots[1].Preference)
+	}
+}
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-hr/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-hr/internal/domain/repository"
+)
+
+// ErrPreferenceOutsideAvailability is returned when a preference targets a
+// slot the teacher has not declared as available.
+var ErrPreferenceOutsideAvailability = errors.New("preference slot is not in teacher availability")
+
+// SlotPreference sets the preference level of one availability slot,
+// identified by its day and start period.
+type SlotPreference struct {
+	DayOfWeek   int
+	StartPeriod int
+	Preference  entity.Preference
+}
+
+// UpdatePreferencesCommand replaces all preference levels for a teacher.
+// Slots not listed become neutral.
+type UpdatePreferencesCommand struct {
+	TeacherID   uuid.UUID
+	Preferences []SlotPreference
+}
+
+// UpdatePreferencesHandler marks availability slots as preferred or disliked.
+type UpdatePreferencesHandler struct {
+	repo      repository.TeacherRepository
+	publisher EventPublisher
+}
+
+func NewUpdatePreferencesHandler(repo repository.TeacherRepository, publisher EventPublisher) *UpdatePreferencesHandler {
+	return &UpdatePreferencesHandler{repo: repo, publisher: publisher}
+}
+
+func (h *UpdatePreferencesHandler) Handle(ctx context.Context, cmd UpdatePreferencesCommand) ([]*entity.Availability, error) {
+	available, err := h.repo.ListAvailability(ctx, cmd.TeacherID)
+	if err != nil {
+		return nil, fmt.Errorf("list availability: %w", err)
+	}
+	known := make(map[[2]int]bool, len(available))
+	for _, a := range available {
+		known[[2]int{a.DayOfWeek, a.StartPeriod}] = true
+	}
+
+	prefs := make([]*entity.Availability, 0, len(cmd.Preferences))
+	for _, p := range cmd.Preferences {
+		if !p.Preference.IsValid() {
+			return nil, fmt.Errorf("invalid preference %q", p.Preference)
+		}
+		if !known[[2]int{p.DayOfWeek, p.StartPeriod}] {
+			return nil, fmt.Errorf("%w: day %d period %d", ErrPreferenceOutsideAvailability, p.DayOfWeek, p.StartPeriod)
+		}
+		prefs = append(prefs, &entity.Availability{
+			TeacherID:   cmd.TeacherID,
+			DayOfWeek:   p.DayOfWeek,
+			StartPeriod: p.StartPeriod,
+			Preference:  p.Preference,
+		})
+	}
+
+	result, err := h.repo.SetPreferences(ctx, cmd.TeacherID, prefs)
+	if err != nil {
+		return nil, fmt.Errorf("set preferences: %w", err)
+	}
+	_ = h.publisher.Publish(ctx, "hr.preferences.updated", map[string]any{
+		"teacher_id": cmd.TeacherID, "preferences": result,
+	})
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-hr/internal/domain/entity"
+)
+
+// preferencesMockRepo extends mockTeacherRepo with availability and preferences.
+type preferencesMockRepo struct {
+	mockTeacherRepo
+	available []*entity.Availability
+	set       []*entity.Availability
+}
+
+func (m *preferencesMockRepo) ListAvailability(_ context.Context, _ uuid.UUID) ([]*entity.Availability, error) {
+	return m.available, nil
+}
+
+func (m *preferencesMockRepo) SetPreferences(_ context.Context, _ uuid.UUID, prefs []*entity.Availability) ([]*entity.Availability, error) {
+	m.set = prefs
+	return prefs, nil
+}
+
+func TestUpdatePreferencesHandler_Success(t *testing.T) {
+	repo := &preferencesMockRepo{available: []*entity.Availability{
+		{DayOfWeek: 0, StartPeriod: 1, EndPeriod: 2},
+		{DayOfWeek: 0, StartPeriod: 5, EndPeriod: 6},
+	}}
+	h := NewUpdatePreferencesHandler(repo, NewNoopPublisher())
+
+	result, err := h.Handle(context.Background(), UpdatePreferencesCommand{
+		TeacherID: uuid.New(),
+		Preferences: []SlotPreference{
+			{DayOfWeek: 0, StartPeriod: 1, Preference: entity.PreferencePreferred},
+			{DayOfWeek: 0, StartPeriod: 5, Preference: entity.PreferenceDisliked},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 2 || len(repo.set) != 2 {
+		t.Fatalf("expected 2 preferences stored, got %d", len(repo.set))
+	}
+}
+
+func TestUpdatePreferencesHandler_OutsideAvailability(t *testing.T) {
+	repo := &preferencesMockRepo{available: []*entity.Availability{{DayOfWeek: 0, StartPeriod: 1, EndPeriod: 2}}}
+	h := NewUpdatePreferencesHandler(repo, NewNoopPublisher())
+
+	_, err := h.Handle(context.Background(), UpdatePreferencesCommand{
+		TeacherID:   uuid.New(),
+		Preferences: []SlotPreference{{DayOfWeek: 3, StartPeriod: 1, Preference: entity.PreferencePreferred}},
+	})
+	if !errors.Is(err, ErrPreferenceOutsideAvailability) {
+		t.Fatalf("expected ErrPreferenceOutsideAvailability, got %v", err)
+	}
+	if repo.set != nil {
+		t.Fatal("nothing should be stored on error")
+	}
+}
+
+func TestUpdatePreferencesHandler_InvalidLevel(t *testing.T) {
+	repo := &preferencesMockRepo{available: []*entity.Availability{{DayOfWeek: 0, StartPeriod: 1, EndPeriod: 2}}}
+	h := NewUpdatePreferencesHandler(repo, NewNoopPublisher())
+
+	_, err := h.Handle(context.Background(), UpdatePreferencesCommand{
+		TeacherID:   uuid.New(),
+		Preferences: []SlotPreference{{DayOfWeek: 0, StartPeriod: 1, Preference: "adored"}},
+	})
+	if err == nil {
+		t.Fatal("expected error for unknown preference level")
+	}
+}
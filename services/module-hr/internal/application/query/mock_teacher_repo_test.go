@@ -80,3 +80,7 @@ func (m *mockTeacherRepo) DeleteAvailability(_ context.Context, _ uuid.UUID) err
 func (m *mockTeacherRepo) ListAvailability(_ context.Context, _ uuid.UUID) ([]*entity.Availability, error) {
 	panic("not implemented")
 }
+
+func (m *mockTeacherRepo) SetPreferences(_ context.Context, _ uuid.UUID, _ []*entity.Availability) ([]*entity.Availability, error) {
+	panic("not implemented")
+}
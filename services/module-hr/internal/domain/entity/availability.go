@@ -6,15 +6,35 @@ import (
 	"github.com/google/uuid"
 )
 
+// Preference is how much a teacher wants to teach in an available slot.
+// It never makes a slot unavailable; the timetable solver scores it softly.
+type Preference string
+
+const (
+	PreferenceNeutral   Preference = "neutral"
+	PreferencePreferred Preference = "preferred"
+	PreferenceDisliked  Preference = "disliked"
+)
+
+func (p Preference) IsValid() bool {
+	switch p {
+	case PreferenceNeutral, PreferencePreferred, PreferenceDisliked:
+		return true
+	}
+	return false
+}
+
 // Availability represents a weekly recurring time slot for a teacher.
 // DayOfWeek: 0=Monday, 6=Sunday.
 // StartPeriod/EndPeriod are period indices (e.g., 1=first period of day).
+// An empty Preference is treated as neutral.
 type Availability struct {
 	ID          uuid.UUID
 	TeacherID   uuid.UUID
 	DayOfWeek   int
 	StartPeriod int
 	EndPeriod   int
+	Preference  Preference
 }
 
 // Validate checks the slot is well-formed.
@@ -25,5 +45,8 @@ func (a *Availability) Validate() error {
 	if a.StartPeriod >= a.EndPeriod {
 		return fmt.Errorf("start_period must be less than end_period")
 	}
+	if a.Preference != "" && !a.Preference.IsValid() {
+		return fmt.Errorf("preference must be neutral, preferred or disliked")
+	}
 	return nil
 }
@@ -40,6 +40,16 @@ func TestAvailability_Validate(t *testing.T) {
 			slot:    Availability{DayOfWeek: 2, StartPeriod: 5, EndPeriod: 2},
 			wantErr: true,
 		},
+		{
+			name:    "preferred slot",
+			slot:    Availability{DayOfWeek: 0, StartPeriod: 1, EndPeriod: 2, Preference: PreferencePreferred},
+			wantErr: false,
+		},
+		{
+			name:    "unknown preference",
+			slot:    Availability{DayOfWeek: 0, StartPeriod: 1, EndPeriod: 2, Preference: "love"},
+			wantErr: true,
+		},
 		{
 			name:    "zero start zero end",
 			slot:    Availability{DayOfWeek: 0, StartPeriod: 0, EndPeriod: 0},
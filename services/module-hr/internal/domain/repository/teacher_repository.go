@@ -28,4 +28,6 @@ type TeacherRepository interface {
 	UpsertAvailability(ctx context.Context, slot *entity.Availability) (*entity.Availability, error)
 	DeleteAvailability(ctx context.Context, teacherID uuid.UUID) error
 	ListAvailability(ctx context.Context, teacherID uuid.UUID) ([]*entity.Availability, error)
+	// SetPreferences replaces the preference levels on existing availability slots.
+	SetPreferences(ctx context.Context, teacherID uuid.UUID, prefs []*entity.Availability) ([]*entity.Availability, error)
 }
@@ -21,7 +21,7 @@ func (q *Queries) DeleteTeacherAvailability(ctx context.Context, teacherID pgtyp
 }
 
 const listTeacherAvailability = `-- name: ListTeacherAvailability :many
-SELECT id, teacher_id, day_of_week, start_period, end_period FROM hr.teacher_availability
+SELECT id, teacher_id, day_of_week, start_period, end_period, preference FROM hr.teacher_availability
 WHERE teacher_id = $1
 ORDER BY day_of_week, start_period
 `
@@ -41,6 +41,7 @@ func (q *Queries) ListTeacherAvailability(ctx context.Context, teacherID pgtype.
 			&i.DayOfWeek,
 			&i.StartPeriod,
 			&i.EndPeriod,
+			&i.Preference,
 		); err != nil {
 			return nil, err
 		}
@@ -52,12 +53,53 @@ func (q *Queries) ListTeacherAvailability(ctx context.Context, teacherID pgtype.
 	return items, nil
 }
 
+const resetTeacherPreferences = `-- name: ResetTeacherPreferences :exec
+UPDATE hr.teacher_availability SET preference = 'neutral' WHERE teacher_id = $1
+`
+
+func (q *Queries) ResetTeacherPreferences(ctx context.Context, teacherID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, resetTeacherPreferences, teacherID)
+	return err
+}
+
+const setAvailabilityPreference = `-- name: SetAvailabilityPreference :one
+UPDATE hr.teacher_availability SET preference = $4
+WHERE teacher_id = $1 AND day_of_week = $2 AND start_period = $3
+RETURNING id, teacher_id, day_of_week, start_period, end_period, preference
+`
+
+type SetAvailabilityPreferenceParams struct {
+	TeacherID   pgtype.UUID `json:"teacher_id"`
+	DayOfWeek   int32       `json:"day_of_week"`
+	StartPeriod int32       `json:"start_period"`
+	Preference  string      `json:"preference"`
+}
+
+func (q *Queries) SetAvailabilityPreference(ctx context.Context, arg SetAvailabilityPreferenceParams) (HrTeacherAvailability, error) {
+	row := q.db.QueryRow(ctx, setAvailabilityPreference,
+		arg.TeacherID,
+		arg.DayOfWeek,
+		arg.StartPeriod,
+		arg.Preference,
+	)
+	var i HrTeacherAvailability
+	err := row.Scan(
+		&i.ID,
+		&i.TeacherID,
+		&i.DayOfWeek,
+		&i.StartPeriod,
+		&i.EndPeriod,
+		&i.Preference,
+	)
+	return i, err
+}
+
 const upsertAvailabilitySlot = `-- name: UpsertAvailabilitySlot :one
-INSERT INTO hr.teacher_availability (teacher_id, day_of_week, start_period, end_period)
-VALUES ($1, $2, $3, $4)
+INSERT INTO hr.teacher_availability (teacher_id, day_of_week, start_period, end_period, preference)
+VALUES ($1, $2, $3, $4, $5)
 ON CONFLICT (teacher_id, day_of_week, start_period)
-DO UPDATE SET end_period = EXCLUDED.end_period
-RETURNING id, teacher_id, day_of_week, start_period, end_period
+DO UPDATE SET end_period = EXCLUDED.end_period, preference = EXCLUDED.preference
+RETURNING id, teacher_id, day_of_week, start_period, end_period, preference
 `
 
 type UpsertAvailabilitySlotParams struct {
@@ -65,6 +107,7 @@ type UpsertAvailabilitySlotParams struct {
 	DayOfWeek   int32       `json:"day_of_week"`
 	StartPeriod int32       `json:"start_period"`
 	EndPeriod   int32       `json:"end_period"`
+	Preference  string      `json:"preference"`
 }
 
 func (q *Queries) UpsertAvailabilitySlot(ctx context.Context, arg UpsertAvailabilitySlotParams) (HrTeacherAvailability, error) {
@@ -73,6 +116,7 @@ func (q *Queries) UpsertAvailabilitySlot(ctx context.Context, arg UpsertAvailabi
 		arg.DayOfWeek,
 		arg.StartPeriod,
 		arg.EndPeriod,
+		arg.Preference,
 	)
 	var i HrTeacherAvailability
 	err := row.Scan(
@@ -81,6 +125,7 @@ func (q *Queries) UpsertAvailabilitySlot(ctx context.Context, arg UpsertAvailabi
 		&i.DayOfWeek,
 		&i.StartPeriod,
 		&i.EndPeriod,
+		&i.Preference,
 	)
 	return i, err
 }
@@ -55,6 +55,7 @@ type HrTeacherAvailability struct {
 	DayOfWeek   int32       `json:"day_of_week"`
 	StartPeriod int32       `json:"start_period"`
 	EndPeriod   int32       `json:"end_period"`
+	Preference  string      `json:"preference"`
 }
 
 type HrTeacherSpecialization struct {
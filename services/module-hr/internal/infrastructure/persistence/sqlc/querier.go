@@ -26,8 +26,10 @@ type Querier interface {
 	ListTeachers(ctx context.Context, arg ListTeachersParams) ([]HrTeacher, error)
 	ListTeachersByDepartment(ctx context.Context, arg ListTeachersByDepartmentParams) ([]HrTeacher, error)
 	RemoveSpecialization(ctx context.Context, arg RemoveSpecializationParams) error
+	ResetTeacherPreferences(ctx context.Context, teacherID pgtype.UUID) error
 	SearchTeachersByName(ctx context.Context, arg SearchTeachersByNameParams) ([]HrTeacher, error)
 	SearchTeachersBySpecialization(ctx context.Context, specialization string) ([]HrTeacher, error)
+	SetAvailabilityPreference(ctx context.Context, arg SetAvailabilityPreferenceParams) (HrTeacherAvailability, error)
 	UpdateTeacher(ctx context.Context, arg UpdateTeacherParams) (HrTeacher, error)
 	UpsertAvailabilitySlot(ctx context.Context, arg UpsertAvailabilitySlotParams) (HrTeacherAvailability, error)
 }
@@ -132,6 +132,7 @@ func (r *TeacherRepositoryImpl) UpsertAvailability(ctx context.Context, slot *en
 		DayOfWeek:   int32(slot.DayOfWeek),
 		StartPeriod: int32(slot.StartPeriod),
 		EndPeriod:   int32(slot.EndPeriod),
+		Preference:  string(preferenceOrNeutral(slot.Preference)),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("upsert availability: %w", err)
@@ -155,8 +156,37 @@ func (r *TeacherRepositoryImpl) ListAvailability(ctx context.Context, teacherID
 	return result, nil
 }
 
+// SetPreferences resets every slot of the teacher to neutral, then applies the
+// given preferences to the slots matching (day_of_week, start_period).
+func (r *TeacherRepositoryImpl) SetPreferences(ctx context.Context, teacherID uuid.UUID, prefs []*entity.Availability) ([]*entity.Availability, error) {
+	if err := r.queries.ResetTeacherPreferences(ctx, uuidToPgtype(teacherID)); err != nil {
+		return nil, fmt.Errorf("reset preferences: %w", err)
+	}
+	result := make([]*entity.Availability, 0, len(prefs))
+	for _, p := range prefs {
+		row, err := r.queries.SetAvailabilityPreference(ctx, sqlc.SetAvailabilityPreferenceParams{
+			TeacherID:   uuidToPgtype(teacherID),
+			DayOfWeek:   int32(p.DayOfWeek),
+			StartPeriod: int32(p.StartPeriod),
+			Preference:  string(preferenceOrNeutral(p.Preference)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("set preference day %d period %d: %w", p.DayOfWeek, p.StartPeriod, err)
+		}
+		result = append(result, hrAvailabilityToEntity(row))
+	}
+	return result, nil
+}
+
 // --- mapping helpers ---
 
+func preferenceOrNeutral(p entity.Preference) entity.Preference {
+	if p == "" {
+		return entity.PreferenceNeutral
+	}
+	return p
+}
+
 func hrTeacherToEntity(t sqlc.HrTeacher) *entity.Teacher {
 	e := &entity.Teacher{
 		ID:              pgtypeToUUID(t.ID),
@@ -196,6 +226,7 @@ func hrAvailabilityToEntity(a sqlc.HrTeacherAvailability) *entity.Availability {
 		DayOfWeek:   int(a.DayOfWeek),
 		StartPeriod: int(a.StartPeriod),
 		EndPeriod:   int(a.EndPeriod),
+		Preference:  entity.Preference(a.Preference),
 	}
 }
 
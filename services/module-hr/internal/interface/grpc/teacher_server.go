@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -23,6 +24,7 @@ type TeacherServer struct {
 	updateTeacher      *command.UpdateTeacherHandler
 	deleteTeacher      *command.DeleteTeacherHandler
 	updateAvailability *command.UpdateAvailabilityHandler
+	updatePreferences  *command.UpdatePreferencesHandler
 	getTeacher         *query.GetTeacherHandler
 	listTeachers       *query.ListTeachersHandler
 	getAvailability    *query.GetAvailabilityHandler
@@ -33,6 +35,7 @@ func NewTeacherServer(
 	updateTeacher *command.UpdateTeacherHandler,
 	deleteTeacher *command.DeleteTeacherHandler,
 	updateAvailability *command.UpdateAvailabilityHandler,
+	updatePreferences *command.UpdatePreferencesHandler,
 	getTeacher *query.GetTeacherHandler,
 	listTeachers *query.ListTeachersHandler,
 	getAvailability *query.GetAvailabilityHandler,
@@ -42,6 +45,7 @@ func NewTeacherServer(
 		updateTeacher:      updateTeacher,
 		deleteTeacher:      deleteTeacher,
 		updateAvailability: updateAvailability,
+		updatePreferences:  updatePreferences,
 		getTeacher:         getTeacher,
 		listTeachers:       listTeachers,
 		getAvailability:    getAvailability,
@@ -248,6 +252,7 @@ func (s *TeacherServer) UpdateTeacherAvailability(ctx context.Context, req *hrv1
 			DayOfWeek:   int(s.DayOfWeek),
 			StartPeriod: int(s.StartPeriod),
 			EndPeriod:   int(s.EndPeriod),
+			Preference:  entity.Preference(s.Preference),
 		}
 	}
 
@@ -270,6 +275,68 @@ func (s *TeacherServer) UpdateTeacherAvailability(ctx context.Context, req *hrv1
 	}, nil
 }
 
+// ListTeacherPreferences returns the availability slots a teacher marked as
+// preferred or disliked.
+func (s *TeacherServer) ListTeacherPreferences(ctx context.Context, req *hrv1.ListTeacherPreferencesRequest) (*hrv1.ListTeacherPreferencesResponse, error) {
+	teacherID, err := uuid.Parse(req.TeacherId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid teacher_id")
+	}
+
+	slots, err := s.getAvailability.Handle(ctx, query.GetAvailabilityQuery{TeacherID: teacherID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list availability: %v", err)
+	}
+
+	resp := &hrv1.ListTeacherPreferencesResponse{TeacherId: req.TeacherId}
+	for _, slot := range slots {
+		if slot.Preference == entity.PreferencePreferred || slot.Preference == entity.PreferenceDisliked {
+			resp.Preferences = append(resp.Preferences, availabilityToProto(slot))
+		}
+	}
+	return resp, nil
+}
+
+func (s *TeacherServer) UpdateTeacherPreferences(ctx context.Context, req *hrv1.UpdateTeacherPreferencesRequest) (*hrv1.UpdateTeacherPreferencesResponse, error) {
+	teacherID, err := uuid.Parse(req.TeacherId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid teacher_id")
+	}
+
+	prefs := make([]command.SlotPreference, len(req.Preferences))
+	for i, p := range req.Preferences {
+		level := entity.Preference(p.Preference)
+		if !level.IsValid() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid preference %q", p.Preference)
+		}
+		prefs[i] = command.SlotPreference{
+			DayOfWeek:   int(p.DayOfWeek),
+			StartPeriod: int(p.StartPeriod),
+			Preference:  level,
+		}
+	}
+
+	result, err := s.updatePreferences.Handle(ctx, command.UpdatePreferencesCommand{
+		TeacherID:   teacherID,
+		Preferences: prefs,
+	})
+	if err != nil {
+		if errors.Is(err, command.ErrPreferenceOutsideAvailability) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "update preferences: %v", err)
+	}
+
+	protoSlots := make([]*hrv1.TimeSlot, len(result))
+	for i, slot := range result {
+		protoSlots[i] = availabilityToProto(slot)
+	}
+	return &hrv1.UpdateTeacherPreferencesResponse{
+		TeacherId:   req.TeacherId,
+		Preferences: protoSlots,
+	}, nil
+}
+
 // --- proto mapping helpers ---
 
 func teacherToProto(t *entity.Teacher) *hrv1.Teacher {
@@ -297,6 +364,7 @@ func availabilityToProto(a *entity.Availability) *hrv1.TimeSlot {
 		DayOfWeek:   int32(a.DayOfWeek),
 		StartPeriod: int32(a.StartPeriod),
 		EndPeriod:   int32(a.EndPeriod),
+		Preference:  string(a.Preference),
 	}
 }
 
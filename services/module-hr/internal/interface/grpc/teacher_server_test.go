@@ -130,6 +130,10 @@ func (m *mockTeacherRepository) ListAvailability(_ context.Context, _ uuid.UUID)
 	return nil, nil
 }
 
+func (m *mockTeacherRepository) SetPreferences(_ context.Context, _ uuid.UUID, _ []*entity.Availability) ([]*entity.Availability, error) {
+	return nil, nil
+}
+
 func TestTeacherServer_CreateTeacher_Success(t *testing.T) {
 	teacherID := uuid.New()
 	now := time.Now()
@@ -144,7 +148,7 @@ func TestTeacherServer_CreateTeacher_Success(t *testing.T) {
 	}}
 	createHandler := command.NewCreateTeacherHandler(repo, command.NewNoopPublisher())
 	conn := startHRTestServer(t, func(server *grpc.Server) {
-		hrv1.RegisterTeacherServiceServer(server, NewTeacherServer(createHandler, nil, nil, nil, nil, nil, nil))
+		hrv1.RegisterTeacherServiceServer(server, NewTeacherServer(createHandler, nil, nil, nil, nil, nil, nil, nil))
 	})
 
 	client := hrv1.NewTeacherServiceClient(conn)
@@ -166,7 +170,7 @@ func TestTeacherServer_CreateTeacher_Success(t *testing.T) {
 
 func TestTeacherServer_CreateTeacher_InvalidArgument(t *testing.T) {
 	conn := startHRTestServer(t, func(server *grpc.Server) {
-		hrv1.RegisterTeacherServiceServer(server, NewTeacherServer(nil, nil, nil, nil, nil, nil, nil))
+		hrv1.RegisterTeacherServiceServer(server, NewTeacherServer(nil, nil, nil, nil, nil, nil, nil, nil))
 	})
 
 	client := hrv1.NewTeacherServiceClient(conn)
@@ -196,7 +200,7 @@ func TestTeacherServer_GetTeacher_Success(t *testing.T) {
 	}
 	getHandler := query.NewGetTeacherHandler(repo)
 	conn := startHRTestServer(t, func(server *grpc.Server) {
-		hrv1.RegisterTeacherServiceServer(server, NewTeacherServer(nil, nil, nil, nil, getHandler, nil, nil))
+		hrv1.RegisterTeacherServiceServer(server, NewTeacherServer(nil, nil, nil, nil, nil, getHandler, nil, nil))
 	})
 
 	client := hrv1.NewTeacherServiceClient(conn)
@@ -213,7 +217,7 @@ func TestTeacherServer_GetTeacher_NotFound(t *testing.T) {
 	repo := &mockTeacherRepository{getErr: errors.New("teacher not found")}
 	getHandler := query.NewGetTeacherHandler(repo)
 	conn := startHRTestServer(t, func(server *grpc.Server) {
-		hrv1.RegisterTeacherServiceServer(server, NewTeacherServer(nil, nil, nil, nil, getHandler, nil, nil))
+		hrv1.RegisterTeacherServiceServer(server, NewTeacherServer(nil, nil, nil, nil, nil, getHandler, nil, nil))
 	})
 
 	client := hrv1.NewTeacherServiceClient(conn)
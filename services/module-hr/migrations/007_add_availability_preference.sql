@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE hr.teacher_availability
+    ADD COLUMN preference TEXT NOT NULL DEFAULT 'neutral'
+    CHECK (preference IN ('neutral', 'preferred', 'disliked'));
+
+-- +goose Down
+ALTER TABLE hr.teacher_availability DROP COLUMN IF EXISTS preference;
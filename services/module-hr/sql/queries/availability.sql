@@ -1,8 +1,8 @@
 -- name: UpsertAvailabilitySlot :one
-INSERT INTO hr.teacher_availability (teacher_id, day_of_week, start_period, end_period)
-VALUES ($1, $2, $3, $4)
+INSERT INTO hr.teacher_availability (teacher_id, day_of_week, start_period, end_period, preference)
+VALUES ($1, $2, $3, $4, $5)
 ON CONFLICT (teacher_id, day_of_week, start_period)
-DO UPDATE SET end_period = EXCLUDED.end_period
+DO UPDATE SET end_period = EXCLUDED.end_period, preference = EXCLUDED.preference
 RETURNING *;
 
 -- name: DeleteTeacherAvailability :exec
@@ -12,3 +12,11 @@ DELETE FROM hr.teacher_availability WHERE teacher_id = $1;
 SELECT * FROM hr.teacher_availability
 WHERE teacher_id = $1
 ORDER BY day_of_week, start_period;
+
+-- name: ResetTeacherPreferences :exec
+UPDATE hr.teacher_availability SET preference = 'neutral' WHERE teacher_id = $1;
+
+-- name: SetAvailabilityPreference :one
+UPDATE hr.teacher_availability SET preference = $4
+WHERE teacher_id = $1 AND day_of_week = $2 AND start_period = $3
+RETURNING *;
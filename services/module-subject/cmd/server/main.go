@@ -73,6 +73,7 @@ func main() {
 	queries := sqlc.New(pool)
 	subjectRepo := persistence.NewSubjectRepository(queries)
 	prereqRepo := persistence.NewPrerequisiteRepository(queries)
+	offeringRepo := persistence.NewSemesterOfferingRepository(queries)
 
 	// 5. Domain services
 	dagService := service.NewDAGService(prereqRepo)
@@ -99,6 +100,7 @@ func main() {
 	topoSortHandler := query.NewTopologicalSortHandler(dagService)
 	fullDAGHandler := query.NewGetFullDAGHandler(subjectRepo, prereqRepo)
 	checkConflictsHandler := query.NewCheckConflictsHandler(dagService, subjectRepo)
+	listOfferingsHandler := query.NewListSemesterOfferingsHandler(offeringRepo)
 
 	// 9. gRPC servers
 	subjectServer := grpcif.NewSubjectServer(
@@ -107,6 +109,7 @@ func main() {
 		deleteSubjectHandler,
 		getSubjectHandler,
 		listSubjectsHandler,
+		listOfferingsHandler,
 	)
 	prereqServer := grpcif.NewPrerequisiteServer(
 		addPrereqHandler,
@@ -16,6 +16,10 @@ type CreateSubjectCommand struct {
 	Description  string
 	DepartmentID string
 	WeeklyHours  int32
+
+	MinRoomCapacity      int32
+	RequiredRoomType     string
+	RequiredRoomFeatures []string
 }
 
 // CreateSubjectHandler handles CreateSubjectCommand and returns the created Subject.
@@ -39,6 +43,10 @@ func (h *CreateSubjectHandler) Handle(ctx context.Context, cmd CreateSubjectComm
 		DepartmentID: cmd.DepartmentID,
 		WeeklyHours:  cmd.WeeklyHours,
 		IsActive:     true,
+
+		MinRoomCapacity:      cmd.MinRoomCapacity,
+		RequiredRoomType:     cmd.RequiredRoomType,
+		RequiredRoomFeatures: cmd.RequiredRoomFeatures,
 	}
 
 	if err := subject.Validate(); err != nil {
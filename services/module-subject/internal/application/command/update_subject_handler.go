@@ -19,6 +19,10 @@ type UpdateSubjectCommand struct {
 	DepartmentID *string
 	WeeklyHours  *int32
 	IsActive     *bool
+
+	MinRoomCapacity      *int32
+	RequiredRoomType     *string
+	RequiredRoomFeatures *[]string
 }
 
 // UpdateSubjectHandler handles UpdateSubjectCommand.
@@ -62,6 +66,15 @@ func (h *UpdateSubjectHandler) Handle(ctx context.Context, cmd UpdateSubjectComm
 	if cmd.IsActive != nil {
 		existing.IsActive = *cmd.IsActive
 	}
+	if cmd.MinRoomCapacity != nil {
+		existing.MinRoomCapacity = *cmd.MinRoomCapacity
+	}
+	if cmd.RequiredRoomType != nil {
+		existing.RequiredRoomType = *cmd.RequiredRoomType
+	}
+	if cmd.RequiredRoomFeatures != nil {
+		existing.RequiredRoomFeatures = *cmd.RequiredRoomFeatures
+	}
 
 	if err := existing.Validate(); err != nil {
 		return nil, fmt.Errorf("validate subject: %w", err)
@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-subject/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-subject/internal/domain/repository"
+)
+
+// ListSemesterOfferingsQuery carries the semester ID to list offerings for.
+type ListSemesterOfferingsQuery struct {
+	SemesterID uuid.UUID
+}
+
+// ListSemesterOfferingsHandler handles ListSemesterOfferingsQuery.
+type ListSemesterOfferingsHandler struct {
+	offeringRepo repository.SemesterOfferingRepository
+}
+
+// NewListSemesterOfferingsHandler constructs a ListSemesterOfferingsHandler.
+func NewListSemesterOfferingsHandler(offeringRepo repository.SemesterOfferingRepository) *ListSemesterOfferingsHandler {
+	return &ListSemesterOfferingsHandler{offeringRepo: offeringRepo}
+}
+
+// Handle returns every subject offering of the given semester.
+func (h *ListSemesterOfferingsHandler) Handle(ctx context.Context, q ListSemesterOfferingsQuery) ([]*entity.SemesterOffering, error) {
+	offerings, err := h.offeringRepo.ListBySemester(ctx, q.SemesterID)
+	if err != nil {
+		return nil, fmt.Errorf("list semester offerings: %w", err)
+	}
+	return offerings, nil
+}
@@ -19,6 +19,11 @@ type Subject struct {
 	IsActive     bool
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+
+	// Room requirements enforced by the timetable solver; zero values mean "any room".
+	MinRoomCapacity      int32
+	RequiredRoomType     string
+	RequiredRoomFeatures []string
 }
 
 // Validate enforces invariants on the Subject entity.
@@ -35,6 +40,9 @@ func (s *Subject) Validate() error {
 	if s.WeeklyHours < 0 {
 		return fmt.Errorf("weekly hours cannot be negative")
 	}
+	if s.MinRoomCapacity < 0 {
+		return fmt.Errorf("min room capacity cannot be negative")
+	}
 	return nil
 }
 
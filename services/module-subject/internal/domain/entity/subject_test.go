@@ -35,6 +35,11 @@ func TestSubject_Validate(t *testing.T) {
 			subject: Subject{Code: "CS101", Name: "Intro", Credits: 3, WeeklyHours: -1},
 			wantErr: true,
 		},
+		{
+			name:    "negative min room capacity",
+			subject: Subject{Code: "CS101", Name: "Intro", Credits: 3, MinRoomCapacity: -1},
+			wantErr: true,
+		},
 		{
 			name:    "zero credits allowed",
 			subject: Subject{Code: "CS101", Name: "Intro", Credits: 0, WeeklyHours: 0},
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-subject/internal/domain/entity"
+)
+
+// SemesterOfferingRepository defines read access to the subjects offered per semester.
+type SemesterOfferingRepository interface {
+	ListBySemester(ctx context.Context, semesterID uuid.UUID) ([]*entity.SemesterOffering, error)
+}
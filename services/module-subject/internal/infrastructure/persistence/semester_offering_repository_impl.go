@@ -0,0 +1,38 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-subject/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-subject/internal/infrastructure/persistence/sqlc"
+)
+
+// SemesterOfferingRepositoryImpl implements repository.SemesterOfferingRepository using sqlc.
+type SemesterOfferingRepositoryImpl struct {
+	queries *sqlc.Queries
+}
+
+// NewSemesterOfferingRepository constructs a SemesterOfferingRepositoryImpl.
+func NewSemesterOfferingRepository(queries *sqlc.Queries) *SemesterOfferingRepositoryImpl {
+	return &SemesterOfferingRepositoryImpl{queries: queries}
+}
+
+func (r *SemesterOfferingRepositoryImpl) ListBySemester(ctx context.Context, semesterID uuid.UUID) ([]*entity.SemesterOffering, error) {
+	rows, err := r.queries.ListSemesterOfferingsBySemester(ctx, uuidToPgtype(semesterID))
+	if err != nil {
+		return nil, fmt.Errorf("list semester offerings: %w", err)
+	}
+	offerings := make([]*entity.SemesterOffering, len(rows))
+	for i, row := range rows {
+		offerings[i] = &entity.SemesterOffering{
+			ID:            pgtypeToUUID(row.ID),
+			SubjectID:     pgtypeToUUID(row.SubjectID),
+			SemesterID:    pgtypeToUUID(row.SemesterID),
+			MaxEnrollment: row.MaxEnrollment,
+			CreatedAt:     row.CreatedAt.Time,
+		}
+	}
+	return offerings, nil
+}
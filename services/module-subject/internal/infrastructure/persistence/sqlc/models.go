@@ -20,6 +20,10 @@ type SubjectSubject struct {
 	IsActive     bool               `json:"is_active"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+
+	MinRoomCapacity      int32    `json:"min_room_capacity"`
+	RequiredRoomType     string   `json:"required_room_type"`
+	RequiredRoomFeatures []string `json:"required_room_features"`
 }
 
 // SubjectPrerequisite maps to subject.prerequisites table.
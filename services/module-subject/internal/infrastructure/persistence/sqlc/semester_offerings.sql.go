@@ -50,6 +50,27 @@ func (q *Queries) ListSemesterOfferingsBySubject(ctx context.Context, subjectID
 	return items, rows.Err()
 }
 
+const listSemesterOfferingsBySemester = `-- name: ListSemesterOfferingsBySemester :many
+SELECT id, subject_id, semester_id, max_enrollment, created_at FROM subject.semester_offerings WHERE semester_id = $1 ORDER BY created_at DESC
+`
+
+func (q *Queries) ListSemesterOfferingsBySemester(ctx context.Context, semesterID pgtype.UUID) ([]SubjectSemesterOffering, error) {
+	rows, err := q.db.Query(ctx, listSemesterOfferingsBySemester, semesterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SubjectSemesterOffering
+	for rows.Next() {
+		var i SubjectSemesterOffering
+		if err := rows.Scan(&i.ID, &i.SubjectID, &i.SemesterID, &i.MaxEnrollment, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, rows.Err()
+}
+
 const getSemesterOffering = `-- name: GetSemesterOffering :one
 SELECT id, subject_id, semester_id, max_enrollment, created_at FROM subject.semester_offerings WHERE id = $1
 `
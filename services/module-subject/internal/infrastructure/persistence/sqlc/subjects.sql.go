@@ -12,8 +12,9 @@ import (
 )
 
 const createSubject = `-- name: CreateSubject :one
-INSERT INTO subject.subjects (code, name, credits, description, department_id, weekly_hours, is_active)
-VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at
+INSERT INTO subject.subjects (code, name, credits, description, department_id, weekly_hours, is_active,
+                              min_room_capacity, required_room_type, required_room_features)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at, min_room_capacity, required_room_type, required_room_features
 `
 
 type CreateSubjectParams struct {
@@ -24,22 +25,28 @@ type CreateSubjectParams struct {
 	DepartmentID string `json:"department_id"`
 	WeeklyHours  int32  `json:"weekly_hours"`
 	IsActive     bool   `json:"is_active"`
+
+	MinRoomCapacity      int32    `json:"min_room_capacity"`
+	RequiredRoomType     string   `json:"required_room_type"`
+	RequiredRoomFeatures []string `json:"required_room_features"`
 }
 
 func (q *Queries) CreateSubject(ctx context.Context, arg CreateSubjectParams) (SubjectSubject, error) {
 	row := q.db.QueryRow(ctx, createSubject,
 		arg.Code, arg.Name, arg.Credits, arg.Description, arg.DepartmentID, arg.WeeklyHours, arg.IsActive,
+		arg.MinRoomCapacity, arg.RequiredRoomType, arg.RequiredRoomFeatures,
 	)
 	var i SubjectSubject
 	err := row.Scan(
 		&i.ID, &i.Code, &i.Name, &i.Credits, &i.Description,
 		&i.DepartmentID, &i.WeeklyHours, &i.IsActive, &i.CreatedAt, &i.UpdatedAt,
+		&i.MinRoomCapacity, &i.RequiredRoomType, &i.RequiredRoomFeatures,
 	)
 	return i, err
 }
 
 const getSubjectByID = `-- name: GetSubjectByID :one
-SELECT id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at FROM subject.subjects WHERE id = $1
+SELECT id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at, min_room_capacity, required_room_type, required_room_features FROM subject.subjects WHERE id = $1
 `
 
 func (q *Queries) GetSubjectByID(ctx context.Context, id pgtype.UUID) (SubjectSubject, error) {
@@ -48,12 +55,13 @@ func (q *Queries) GetSubjectByID(ctx context.Context, id pgtype.UUID) (SubjectSu
 	err := row.Scan(
 		&i.ID, &i.Code, &i.Name, &i.Credits, &i.Description,
 		&i.DepartmentID, &i.WeeklyHours, &i.IsActive, &i.CreatedAt, &i.UpdatedAt,
+		&i.MinRoomCapacity, &i.RequiredRoomType, &i.RequiredRoomFeatures,
 	)
 	return i, err
 }
 
 const getSubjectByCode = `-- name: GetSubjectByCode :one
-SELECT id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at FROM subject.subjects WHERE code = $1
+SELECT id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at, min_room_capacity, required_room_type, required_room_features FROM subject.subjects WHERE code = $1
 `
 
 func (q *Queries) GetSubjectByCode(ctx context.Context, code string) (SubjectSubject, error) {
@@ -62,12 +70,13 @@ func (q *Queries) GetSubjectByCode(ctx context.Context, code string) (SubjectSub
 	err := row.Scan(
 		&i.ID, &i.Code, &i.Name, &i.Credits, &i.Description,
 		&i.DepartmentID, &i.WeeklyHours, &i.IsActive, &i.CreatedAt, &i.UpdatedAt,
+		&i.MinRoomCapacity, &i.RequiredRoomType, &i.RequiredRoomFeatures,
 	)
 	return i, err
 }
 
 const listSubjects = `-- name: ListSubjects :many
-SELECT id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at FROM subject.subjects ORDER BY created_at DESC LIMIT $1 OFFSET $2
+SELECT id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at, min_room_capacity, required_room_type, required_room_features FROM subject.subjects ORDER BY created_at DESC LIMIT $1 OFFSET $2
 `
 
 type ListSubjectsParams struct {
@@ -87,6 +96,7 @@ func (q *Queries) ListSubjects(ctx context.Context, arg ListSubjectsParams) ([]S
 		if err := rows.Scan(
 			&i.ID, &i.Code, &i.Name, &i.Credits, &i.Description,
 			&i.DepartmentID, &i.WeeklyHours, &i.IsActive, &i.CreatedAt, &i.UpdatedAt,
+			&i.MinRoomCapacity, &i.RequiredRoomType, &i.RequiredRoomFeatures,
 		); err != nil {
 			return nil, err
 		}
@@ -96,7 +106,7 @@ func (q *Queries) ListSubjects(ctx context.Context, arg ListSubjectsParams) ([]S
 }
 
 const listSubjectsByDepartment = `-- name: ListSubjectsByDepartment :many
-SELECT id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at FROM subject.subjects WHERE department_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3
+SELECT id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at, min_room_capacity, required_room_type, required_room_features FROM subject.subjects WHERE department_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3
 `
 
 type ListSubjectsByDepartmentParams struct {
@@ -117,6 +127,7 @@ func (q *Queries) ListSubjectsByDepartment(ctx context.Context, arg ListSubjects
 		if err := rows.Scan(
 			&i.ID, &i.Code, &i.Name, &i.Credits, &i.Description,
 			&i.DepartmentID, &i.WeeklyHours, &i.IsActive, &i.CreatedAt, &i.UpdatedAt,
+			&i.MinRoomCapacity, &i.RequiredRoomType, &i.RequiredRoomFeatures,
 		); err != nil {
 			return nil, err
 		}
@@ -154,8 +165,11 @@ SET code         = COALESCE($1, code),
     department_id = COALESCE($5, department_id),
     weekly_hours = COALESCE($6, weekly_hours),
     is_active    = COALESCE($7, is_active),
+    min_room_capacity      = COALESCE($8, min_room_capacity),
+    required_room_type     = COALESCE($9, required_room_type),
+    required_room_features = COALESCE($10, required_room_features),
     updated_at   = NOW()
-WHERE id = $8 RETURNING id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at
+WHERE id = $11 RETURNING id, code, name, credits, description, department_id, weekly_hours, is_active, created_at, updated_at, min_room_capacity, required_room_type, required_room_features
 `
 
 type UpdateSubjectParams struct {
@@ -166,18 +180,25 @@ type UpdateSubjectParams struct {
 	DepartmentID pgtype.Text `json:"department_id"`
 	WeeklyHours  pgtype.Int4 `json:"weekly_hours"`
 	IsActive     pgtype.Bool `json:"is_active"`
-	ID           pgtype.UUID `json:"id"`
+
+	MinRoomCapacity      pgtype.Int4 `json:"min_room_capacity"`
+	RequiredRoomType     pgtype.Text `json:"required_room_type"`
+	RequiredRoomFeatures []string    `json:"required_room_features"`
+
+	ID pgtype.UUID `json:"id"`
 }
 
 func (q *Queries) UpdateSubject(ctx context.Context, arg UpdateSubjectParams) (SubjectSubject, error) {
 	row := q.db.QueryRow(ctx, updateSubject,
 		arg.Code, arg.Name, arg.Credits, arg.Description,
-		arg.DepartmentID, arg.WeeklyHours, arg.IsActive, arg.ID,
+		arg.DepartmentID, arg.WeeklyHours, arg.IsActive,
+		arg.MinRoomCapacity, arg.RequiredRoomType, arg.RequiredRoomFeatures, arg.ID,
 	)
 	var i SubjectSubject
 	err := row.Scan(
 		&i.ID, &i.Code, &i.Name, &i.Credits, &i.Description,
 		&i.DepartmentID, &i.WeeklyHours, &i.IsActive, &i.CreatedAt, &i.UpdatedAt,
+		&i.MinRoomCapacity, &i.RequiredRoomType, &i.RequiredRoomFeatures,
 	)
 	return i, err
 }
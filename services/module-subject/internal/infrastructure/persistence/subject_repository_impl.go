@@ -29,6 +29,10 @@ func (r *SubjectRepositoryImpl) Create(ctx context.Context, s *entity.Subject) (
 		DepartmentID: s.DepartmentID,
 		WeeklyHours:  s.WeeklyHours,
 		IsActive:     s.IsActive,
+
+		MinRoomCapacity:      s.MinRoomCapacity,
+		RequiredRoomType:     s.RequiredRoomType,
+		RequiredRoomFeatures: nonNilFeatures(s.RequiredRoomFeatures),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create subject: %w", err)
@@ -88,6 +92,10 @@ func (r *SubjectRepositoryImpl) Update(ctx context.Context, s *entity.Subject) (
 		DepartmentID: pgtype.Text{String: s.DepartmentID, Valid: s.DepartmentID != ""},
 		WeeklyHours:  pgtype.Int4{Int32: s.WeeklyHours, Valid: true},
 		IsActive:     pgtype.Bool{Bool: s.IsActive, Valid: true},
+
+		MinRoomCapacity:      pgtype.Int4{Int32: s.MinRoomCapacity, Valid: true},
+		RequiredRoomType:     pgtype.Text{String: s.RequiredRoomType, Valid: true},
+		RequiredRoomFeatures: nonNilFeatures(s.RequiredRoomFeatures),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("update subject: %w", err)
@@ -125,6 +133,10 @@ func subjectRowToEntity(row sqlc.SubjectSubject) *entity.Subject {
 		IsActive:     row.IsActive,
 		CreatedAt:    row.CreatedAt.Time,
 		UpdatedAt:    row.UpdatedAt.Time,
+
+		MinRoomCapacity:      row.MinRoomCapacity,
+		RequiredRoomType:     row.RequiredRoomType,
+		RequiredRoomFeatures: row.RequiredRoomFeatures,
 	}
 }
 
@@ -136,6 +148,15 @@ func subjectRowsToEntities(rows []sqlc.SubjectSubject) []*entity.Subject {
 	return result
 }
 
+// nonNilFeatures maps a nil slice to an empty one so the NOT NULL array column
+// is written as '{}' rather than NULL.
+func nonNilFeatures(features []string) []string {
+	if features == nil {
+		return []string{}
+	}
+	return features
+}
+
 func uuidToPgtype(id uuid.UUID) pgtype.UUID {
 	return pgtype.UUID{Bytes: id, Valid: true}
 }
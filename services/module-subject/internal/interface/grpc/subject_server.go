@@ -23,6 +23,8 @@ type SubjectServer struct {
 	deleteHandler *command.DeleteSubjectHandler
 	getHandler    *query.GetSubjectHandler
 	listHandler   *query.ListSubjectsHandler
+
+	offeringsHandler *query.ListSemesterOfferingsHandler
 }
 
 // NewSubjectServer constructs a SubjectServer with all required handlers.
@@ -32,13 +34,15 @@ func NewSubjectServer(
 	deleteHandler *command.DeleteSubjectHandler,
 	getHandler *query.GetSubjectHandler,
 	listHandler *query.ListSubjectsHandler,
+	offeringsHandler *query.ListSemesterOfferingsHandler,
 ) *SubjectServer {
 	return &SubjectServer{
-		createHandler: createHandler,
-		updateHandler: updateHandler,
-		deleteHandler: deleteHandler,
-		getHandler:    getHandler,
-		listHandler:   listHandler,
+		createHandler:    createHandler,
+		updateHandler:    updateHandler,
+		deleteHandler:    deleteHandler,
+		getHandler:       getHandler,
+		listHandler:      listHandler,
+		offeringsHandler: offeringsHandler,
 	}
 }
 
@@ -51,6 +55,10 @@ func (s *SubjectServer) CreateSubject(ctx context.Context, req *subjectv1.Create
 		Description:  req.GetDescription(),
 		DepartmentID: req.GetDepartmentId(),
 		WeeklyHours:  req.GetWeeklyHours(),
+
+		MinRoomCapacity:      req.GetRoomRequirements().GetMinCapacity(),
+		RequiredRoomType:     req.GetRoomRequirements().GetRoomType(),
+		RequiredRoomFeatures: req.GetRoomRequirements().GetFeatures(),
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "create subject: %v", err)
@@ -145,6 +153,12 @@ func (s *SubjectServer) UpdateSubject(ctx context.Context, req *subjectv1.Update
 		v := req.GetWeeklyHours()
 		cmd.WeeklyHours = &v
 	}
+	if rr := req.GetRoomRequirements(); rr != nil {
+		minCap, roomType, features := rr.GetMinCapacity(), rr.GetRoomType(), rr.GetFeatures()
+		cmd.MinRoomCapacity = &minCap
+		cmd.RequiredRoomType = &roomType
+		cmd.RequiredRoomFeatures = &features
+	}
 
 	subject, err := s.updateHandler.Handle(ctx, cmd)
 	if err != nil {
@@ -165,6 +179,28 @@ func (s *SubjectServer) DeleteSubject(ctx context.Context, req *subjectv1.Delete
 	return &subjectv1.DeleteSubjectResponse{}, nil
 }
 
+// ListSemesterOfferings returns the subjects offered in a semester with their enrollment caps.
+func (s *SubjectServer) ListSemesterOfferings(ctx context.Context, req *subjectv1.ListSemesterOfferingsRequest) (*subjectv1.ListSemesterOfferingsResponse, error) {
+	semesterID, err := uuid.Parse(req.GetSemesterId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid semester id: %v", err)
+	}
+	offerings, err := s.offeringsHandler.Handle(ctx, query.ListSemesterOfferingsQuery{SemesterID: semesterID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list semester offerings: %v", err)
+	}
+	protos := make([]*subjectv1.SemesterOffering, len(offerings))
+	for i, o := range offerings {
+		protos[i] = &subjectv1.SemesterOffering{
+			Id:            o.ID.String(),
+			SubjectId:     o.SubjectID.String(),
+			SemesterId:    o.SemesterID.String(),
+			MaxEnrollment: o.MaxEnrollment,
+		}
+	}
+	return &subjectv1.ListSemesterOfferingsResponse{Offerings: protos}, nil
+}
+
 // subjectToProto maps a domain Subject entity to its protobuf representation.
 func subjectToProto(s *entity.Subject) *subjectv1.Subject {
 	return &subjectv1.Subject{
@@ -178,5 +214,10 @@ func subjectToProto(s *entity.Subject) *subjectv1.Subject {
 		IsActive:     s.IsActive,
 		CreatedAt:    timestamppb.New(s.CreatedAt),
 		UpdatedAt:    timestamppb.New(s.UpdatedAt),
+		RoomRequirements: &subjectv1.RoomRequirements{
+			MinCapacity: s.MinRoomCapacity,
+			RoomType:    s.RequiredRoomType,
+			Features:    s.RequiredRoomFeatures,
+		},
 	}
 }
@@ -158,7 +158,7 @@ func TestSubjectServer_CreateSubject_Success(t *testing.T) {
 	}}
 	createHandler := command.NewCreateSubjectHandler(repo, command.NewNoopPublisher())
 	conn := startSubjectTestServer(t, func(server *grpc.Server) {
-		subjectv1.RegisterSubjectServiceServer(server, NewSubjectServer(createHandler, nil, nil, nil, nil))
+		subjectv1.RegisterSubjectServiceServer(server, NewSubjectServer(createHandler, nil, nil, nil, nil, nil))
 	})
 
 	client := subjectv1.NewSubjectServiceClient(conn)
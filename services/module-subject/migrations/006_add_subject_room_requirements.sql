@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE subject.subjects
+    ADD COLUMN min_room_capacity INT NOT NULL DEFAULT 0,
+    ADD COLUMN required_room_type VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN required_room_features TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE subject.subjects
+    DROP COLUMN IF EXISTS required_room_features,
+    DROP COLUMN IF EXISTS required_room_type,
+    DROP COLUMN IF EXISTS min_room_capacity;
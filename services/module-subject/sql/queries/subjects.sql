@@ -1,6 +1,7 @@
 -- name: CreateSubject :one
-INSERT INTO subject.subjects (code, name, credits, description, department_id, weekly_hours, is_active)
-VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *;
+INSERT INTO subject.subjects (code, name, credits, description, department_id, weekly_hours, is_active,
+                              min_room_capacity, required_room_type, required_room_features)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING *;
 
 -- name: GetSubjectByID :one
 SELECT * FROM subject.subjects WHERE id = $1;
@@ -29,6 +30,9 @@ SET code         = COALESCE(sqlc.narg('code'), code),
     department_id = COALESCE(sqlc.narg('department_id'), department_id),
     weekly_hours = COALESCE(sqlc.narg('weekly_hours'), weekly_hours),
     is_active    = COALESCE(sqlc.narg('is_active'), is_active),
+    min_room_capacity      = COALESCE(sqlc.narg('min_room_capacity'), min_room_capacity),
+    required_room_type     = COALESCE(sqlc.narg('required_room_type'), required_room_type),
+    required_room_features = COALESCE(sqlc.narg('required_room_features'), required_room_features),
     updated_at   = NOW()
 WHERE id = sqlc.arg('id') RETURNING *;
 
@@ -22,7 +22,6 @@ import (
 	msgpubsub "github.com/HuynhHoangPhuc/myrmex/pkg/messaging/pubsub"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/command"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/query"
-	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
 	infragrpc "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/grpc"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/messaging"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/persistence"
@@ -75,6 +74,11 @@ func main() {
 	semesterRepo := persistence.NewSemesterRepository(queries)
 	roomRepo := persistence.NewRoomRepository(queries)
 	scheduleRepo := persistence.NewScheduleRepository(queries)
+	jobRepo := persistence.NewGenerationJobRepository(queries)
+	versionRepo := persistence.NewScheduleVersionRepository(queries)
+	examRepo := persistence.NewExamScheduleRepository(queries)
+	bookingRepo := persistence.NewRoomBookingRepository(queries)
+	campusRepo := persistence.NewCampusRepository(queries)
 
 	// 5. Infrastructure gRPC clients
 	hrClient, err := infragrpc.NewHRClient(v.GetString("hr.grpc_addr"))
@@ -87,6 +91,11 @@ func main() {
 		zapLog.Fatal("connect to Subject service", zap.Error(err))
 	}
 
+	studentClient, err := infragrpc.NewStudentClient(v.GetString("student.grpc_addr"))
+	if err != nil {
+		zapLog.Fatal("connect to Student service", zap.Error(err))
+	}
+
 	// 6. Messaging publisher — select backend via MESSAGING_BACKEND env var
 	pub := newPublisher(ctx, v, zapLog, pkgmessaging.StreamConfig{
 		Name: "TIMETABLE", Subjects: []string{"timetable.>"},
@@ -94,45 +103,115 @@ func main() {
 	defer pub.Close() //nolint:errcheck
 	publisher := command.EventPublisher(messaging.NewEventPublisher(pub))
 
-	// 7. Domain services
-	emptyChecker := service.NewConstraintChecker(nil, nil, nil, nil)
-	ranker := service.NewTeacherRanker(emptyChecker)
-
-	// 8. Command handlers
+	// 7. Command handlers
 	createSemesterHandler := command.NewCreateSemesterHandler(semesterRepo, publisher)
-	createRoomHandler := command.NewCreateRoomHandler(roomRepo)
+	createRoomHandler := command.NewCreateRoomHandler(roomRepo, campusRepo)
 	generateScheduleHandler := command.NewGenerateScheduleHandler(
-		semesterRepo, scheduleRepo, roomRepo, hrClient, subjectClient, publisher,
+		semesterRepo, scheduleRepo, jobRepo, roomRepo, campusRepo, hrClient, subjectClient, studentClient, publisher,
+	)
+	manualAssignHandler := command.NewManualAssignHandler(
+		semesterRepo, scheduleRepo, jobRepo, roomRepo, campusRepo, hrClient, subjectClient, studentClient, publisher,
+	)
+	repairScheduleHandler := command.NewRepairScheduleHandler(
+		semesterRepo, scheduleRepo, jobRepo, roomRepo, campusRepo, hrClient, subjectClient, studentClient, publisher,
 	)
-	manualAssignHandler := command.NewManualAssignHandler(scheduleRepo, publisher)
-	publishScheduleHandler := command.NewPublishScheduleHandler(scheduleRepo)
-	_ = createRoomHandler
-	_ = publishScheduleHandler
+	publishScheduleHandler := command.NewPublishScheduleHandler(scheduleRepo, versionRepo, semesterRepo, publisher)
+	unpublishScheduleHandler := command.NewUnpublishScheduleHandler(scheduleRepo, publisher)
+	archiveScheduleHandler := command.NewArchiveScheduleHandler(scheduleRepo, publisher)
+	rollbackScheduleHandler := command.NewRollbackScheduleHandler(versionRepo, publishScheduleHandler)
+	generateExamScheduleHandler := command.NewGenerateExamScheduleHandler(
+		semesterRepo, examRepo, roomRepo, campusRepo, hrClient, subjectClient, studentClient,
+	)
+	occupancyLoader := query.NewRoomOccupancyLoader(semesterRepo, scheduleRepo, bookingRepo)
+	createRoomBookingHandler := command.NewCreateRoomBookingHandler(roomRepo, bookingRepo, occupancyLoader, publisher)
+	reviewRoomBookingHandler := command.NewReviewRoomBookingHandler(bookingRepo, occupancyLoader, publisher)
+	cancelRoomBookingHandler := command.NewCancelRoomBookingHandler(bookingRepo, publisher)
+	updateRoomHandler := command.NewUpdateRoomHandler(roomRepo, campusRepo, publisher)
+	setRoomActiveHandler := command.NewSetRoomActiveHandler(roomRepo, publisher)
+	deleteRoomHandler := command.NewDeleteRoomHandler(roomRepo, publisher)
+	campusHandler := command.NewCampusHandler(campusRepo)
+
+	// Generation worker — runs queued jobs, capped per instance
+	workerID := v.GetString("generation.worker_id")
+	if workerID == "" {
+		workerID, _ = os.Hostname()
+	}
+	workerCtx, stopWorker := context.WithCancel(ctx)
+	worker := command.NewGenerationWorker(jobRepo, generateScheduleHandler, workerID, v.GetInt("generation.max_concurrent"), zapLog)
+	workerDone := make(chan struct{})
+	go func() {
+		worker.Start(workerCtx)
+		close(workerDone)
+	}()
 
-	// 9. Query handlers
+	// 8. Query handlers
 	getScheduleHandler := query.NewGetScheduleHandler(scheduleRepo)
 	listSchedulesHandler := query.NewListSchedulesHandler(scheduleRepo)
-	suggestTeachersHandler := query.NewSuggestTeachersHandler(hrClient, ranker)
+	suggestTeachersHandler := query.NewSuggestTeachersHandler(hrClient, subjectClient, semesterRepo, scheduleRepo)
+	generationStatusHandler := query.NewGetGenerationStatusHandler(scheduleRepo, jobRepo)
+	listScheduleVersionsHandler := query.NewListScheduleVersionsHandler(versionRepo)
+	getScheduleVersionHandler := query.NewGetScheduleVersionHandler(versionRepo)
+	diffSchedulesHandler := query.NewDiffSchedulesHandler(scheduleRepo, versionRepo)
+	exportICalendarHandler := query.NewExportICalendarHandler(semesterRepo, scheduleRepo, studentClient)
+	getExamScheduleHandler := query.NewGetExamScheduleHandler(examRepo)
+	listExamSchedulesHandler := query.NewListExamSchedulesHandler(examRepo)
+	getRoomBookingHandler := query.NewGetRoomBookingHandler(bookingRepo)
+	listRoomBookingsHandler := query.NewListRoomBookingsHandler(bookingRepo)
+	findFreeRoomsHandler := query.NewFindFreeRoomsHandler(roomRepo, occupancyLoader)
 
-	// 10. gRPC servers
+	// 9. gRPC servers
 	timetableServer := grpcif.NewTimetableServer(
 		generateScheduleHandler,
 		manualAssignHandler,
+		repairScheduleHandler,
 		getScheduleHandler,
 		listSchedulesHandler,
 		suggestTeachersHandler,
+		generationStatusHandler,
 		roomRepo,
+		grpcif.ScheduleLifecycle{
+			Publish:   publishScheduleHandler,
+			Unpublish: unpublishScheduleHandler,
+			Archive:   archiveScheduleHandler,
+			Rollback:  rollbackScheduleHandler,
+			Versions:  listScheduleVersionsHandler,
+			Version:   getScheduleVersionHandler,
+			Diff:      diffSchedulesHandler,
+		},
+		exportICalendarHandler,
+		grpcif.ExamScheduling{
+			Generate: generateExamScheduleHandler,
+			Get:      getExamScheduleHandler,
+			List:     listExamSchedulesHandler,
+		},
+		grpcif.RoomManagement{
+			Create:     createRoomHandler,
+			Update:     updateRoomHandler,
+			SetActive:  setRoomActiveHandler,
+			Delete:     deleteRoomHandler,
+			Campuses:   campusHandler,
+			CampusRepo: campusRepo,
+		},
 	)
 	semesterServer := grpcif.NewSemesterServer(
 		createSemesterHandler,
 		listSchedulesHandler,
 		semesterRepo,
 	)
+	roomBookingServer := grpcif.NewRoomBookingServer(
+		createRoomBookingHandler,
+		reviewRoomBookingHandler,
+		cancelRoomBookingHandler,
+		getRoomBookingHandler,
+		listRoomBookingsHandler,
+		findFreeRoomsHandler,
+	)
 
-	// 11. Start gRPC
+	// 10. Start gRPC
 	grpcServer := grpc.NewServer()
 	timetablev1.RegisterTimetableServiceServer(grpcServer, timetableServer)
 	timetablev1.RegisterSemesterServiceServer(grpcServer, semesterServer)
+	timetablev1.RegisterRoomBookingServiceServer(grpcServer, roomBookingServer)
 
 	grpcPort := v.GetInt("server.grpc_port")
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
@@ -147,12 +226,14 @@ func main() {
 		}
 	}()
 
-	// 12. Graceful shutdown
+	// 11. Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	zapLog.Info("shutting down Timetable module...")
 	grpcServer.GracefulStop()
+	stopWorker()
+	<-workerDone
 	zapLog.Info("Timetable module stopped")
 }
 
@@ -0,0 +1,171 @@
+// Command solverbench runs the timetable CSP solver on benchmark instances and
+// reports feasibility, score, hard violations, search nodes and wall time, so
+// that solver changes can be measured before they ship.
+//
+// Usage:
+//
+//	solverbench [flags] instance...
+//
+// Instances are JSON snapshots (see benchmark.Instance) or ITC-2007
+// curriculum-based course timetabling files (.ctt).
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/benchmark"
+)
+
+func main() {
+	format := flag.String("format", "auto", "instance format: json, itc2007, or auto (.ctt files are itc2007)")
+	timeout := flag.Duration("timeout", 30*time.Second, "time budget per run")
+	seed := flag.Uint64("seed", 1, "seed of the first run")
+	runs := flag.Int("runs", 1, "runs per instance, with seeds seed, seed+1, ...")
+	portfolio := flag.Int("portfolio", 1, "search strategies run in parallel")
+	localSearch := flag.Bool("local-search", false, "improve soft penalties for the rest of the timeout")
+	ignoreCapacity := flag.Bool("ignore-capacity", false, "itc2007: do not rule out rooms that are too small")
+	jsonOut := flag.Bool("json", false, "print one JSON report per line instead of a table")
+	snapshot := flag.String("snapshot", "", "write the single instance given as a JSON snapshot to this file and exit")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] instance...\n", filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 || *runs < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	instances := make([]*benchmark.Instance, 0, flag.NArg())
+	for _, path := range flag.Args() {
+		inst, err := load(path, *format, benchmark.ITCOptions{IgnoreCapacity: *ignoreCapacity})
+		if err != nil {
+			fatalf("%s: %v", path, err)
+		}
+		instances = append(instances, inst)
+	}
+
+	if *snapshot != "" {
+		if len(instances) != 1 {
+			fatalf("-snapshot takes exactly one instance, got %d", len(instances))
+		}
+		if err := writeSnapshot(*snapshot, instances[0]); err != nil {
+			fatalf("write snapshot: %v", err)
+		}
+		return
+	}
+
+	out := newPrinter(*jsonOut)
+	for _, inst := range instances {
+		for r := range *runs {
+			opts := benchmark.RunOptions{
+				Timeout:     *timeout,
+				Seed:        *seed + uint64(r),
+				Portfolio:   *portfolio,
+				LocalSearch: *localSearch,
+			}
+			report, err := benchmark.Run(context.Background(), inst, opts)
+			if err != nil {
+				fatalf("%s: %v", inst.Name, err)
+			}
+			out.print(report)
+		}
+	}
+	out.flush()
+}
+
+// load reads one instance file in the given format.
+func load(path, format string, itc benchmark.ITCOptions) (*benchmark.Instance, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if format == "auto" {
+		format = "json"
+		if strings.EqualFold(filepath.Ext(path), ".ctt") {
+			format = "itc2007"
+		}
+	}
+	var inst *benchmark.Instance
+	switch format {
+	case "json":
+		inst, err = benchmark.ReadSnapshot(f)
+	case "itc2007":
+		inst, err = benchmark.ReadITC2007(f, itc)
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if inst.Name == "" {
+		inst.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return inst, nil
+}
+
+func writeSnapshot(path string, inst *benchmark.Instance) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := inst.WriteSnapshot(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// printer writes reports as an aligned table or as JSON lines.
+type printer struct {
+	json  *json.Encoder
+	table *tabwriter.Writer
+	notes []string // infeasibility details, printed below the table
+}
+
+func newPrinter(jsonOut bool) *printer {
+	if jsonOut {
+		return &printer{json: json.NewEncoder(os.Stdout)}
+	}
+	p := &printer{table: tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)}
+	fmt.Fprintln(p.table, "instance\tsubjects\tsessions\tseed\tstatus\tplaced\tscore\thard\tnodes\ttime\t")
+	return p
+}
+
+func (p *printer) print(r *benchmark.Report) {
+	if p.json != nil {
+		_ = p.json.Encode(r)
+		return
+	}
+	fmt.Fprintf(p.table, "%s\t%d\t%d\t%d\t%s\t%d\t%.2f\t%d\t%d\t%.3fs\t\n",
+		r.Instance, r.Subjects, r.Sessions, r.Seed, r.Status, r.Placed, r.Score, r.HardViolations, r.Nodes,
+		r.WallTime.Seconds())
+	if r.Detail != "" {
+		p.notes = append(p.notes, fmt.Sprintf("%s (seed %d): %s", r.Instance, r.Seed, r.Detail))
+	}
+}
+
+func (p *printer) flush() {
+	if p.table == nil {
+		return
+	}
+	_ = p.table.Flush()
+	for _, note := range p.notes {
+		fmt.Println(note)
+	}
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "solverbench: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,37 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// ArchiveScheduleCommand requests retiring a schedule.
+type ArchiveScheduleCommand struct {
+	ScheduleID uuid.UUID
+}
+
+// ArchiveScheduleHandler archives a schedule. Archiving the published one
+// leaves the semester without a published timetable.
+type ArchiveScheduleHandler struct {
+	repo      repository.ScheduleRepository
+	publisher EventPublisher
+}
+
+func NewArchiveScheduleHandler(repo repository.ScheduleRepository, publisher EventPublisher) *ArchiveScheduleHandler {
+	return &ArchiveScheduleHandler{repo: repo, publisher: publisher}
+}
+
+func (h *ArchiveScheduleHandler) Handle(ctx context.Context, cmd ArchiveScheduleCommand) (*entity.Schedule, error) {
+	schedule, err := h.repo.GetByID(ctx, cmd.ScheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("get schedule: %w", err)
+	}
+	if err := schedule.Archive(); err != nil {
+		return nil, fmt.Errorf("archive schedule: %w", err)
+	}
+	return changeScheduleStatus(ctx, h.repo, h.publisher, schedule, "ScheduleArchived", "timetable.schedule.archived")
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// ErrInvalidLocation is returned when a campus, building or travel time is
+// missing fields or inconsistent.
+var ErrInvalidLocation = errors.New("invalid campus or building")
+
+// CreateCampusCommand carries data for a new campus.
+type CreateCampusCommand struct {
+	Code string
+	Name string
+}
+
+// CreateBuildingCommand carries data for a new building on a campus.
+type CreateBuildingCommand struct {
+	CampusID uuid.UUID
+	Code     string
+	Name     string
+}
+
+// SetCampusTravelTimeCommand sets the travel minutes between two campuses,
+// in both directions.
+type SetCampusTravelTimeCommand struct {
+	FromCampusID uuid.UUID
+	ToCampusID   uuid.UUID
+	Minutes      int
+}
+
+// CampusHandler manages campuses, their buildings and the travel-time
+// matrix the solver uses to penalise moves between campuses.
+type CampusHandler struct {
+	repo repository.CampusRepository
+}
+
+func NewCampusHandler(repo repository.CampusRepository) *CampusHandler {
+	return &CampusHandler{repo: repo}
+}
+
+func (h *CampusHandler) CreateCampus(ctx context.Context, cmd CreateCampusCommand) (*entity.Campus, error) {
+	c := &entity.Campus{ID: uuid.New(), Code: cmd.Code, Name: cmd.Name}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLocation, err)
+	}
+	return h.repo.CreateCampus(ctx, c)
+}
+
+func (h *CampusHandler) CreateBuilding(ctx context.Context, cmd CreateBuildingCommand) (*entity.Building, error) {
+	b := &entity.Building{ID: uuid.New(), CampusID: cmd.CampusID, Code: cmd.Code, Name: cmd.Name}
+	if err := b.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLocation, err)
+	}
+	if _, err := h.repo.GetCampusByID(ctx, b.CampusID); err != nil {
+		return nil, fmt.Errorf("get campus: %w", err)
+	}
+	return h.repo.CreateBuilding(ctx, b)
+}
+
+func (h *CampusHandler) SetTravelTime(ctx context.Context, cmd SetCampusTravelTimeCommand) error {
+	if cmd.FromCampusID == cmd.ToCampusID {
+		return fmt.Errorf("%w: travel time needs two different campuses", ErrInvalidLocation)
+	}
+	if cmd.Minutes < 0 {
+		return fmt.Errorf("%w: travel minutes must be >= 0", ErrInvalidLocation)
+	}
+	return h.repo.SetTravelTime(ctx, cmd.FromCampusID, cmd.ToCampusID, cmd.Minutes)
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -9,34 +10,60 @@ import (
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
 )
 
+// ErrInvalidRoom is returned when a room's fields are missing or invalid.
+var ErrInvalidRoom = errors.New("invalid room")
+
 // CreateRoomCommand carries data for creating a new room.
 type CreateRoomCommand struct {
 	Name     string
 	Capacity int
 	Type     string
 	Features []string
+	// RequiresApproval restricts the room: its bookings wait for approval.
+	RequiresApproval bool
+	BuildingID       uuid.UUID // uuid.Nil = location unknown
 }
 
 // CreateRoomHandler executes the CreateRoom use case.
 type CreateRoomHandler struct {
-	repo repository.RoomRepository
+	repo       repository.RoomRepository
+	campusRepo repository.CampusRepository
 }
 
-func NewCreateRoomHandler(repo repository.RoomRepository) *CreateRoomHandler {
-	return &CreateRoomHandler{repo: repo}
+func NewCreateRoomHandler(repo repository.RoomRepository, campusRepo repository.CampusRepository) *CreateRoomHandler {
+	return &CreateRoomHandler{repo: repo, campusRepo: campusRepo}
 }
 
 func (h *CreateRoomHandler) Handle(ctx context.Context, cmd CreateRoomCommand) (*entity.Room, error) {
 	r := &entity.Room{
-		ID:       uuid.New(),
-		Name:     cmd.Name,
-		Capacity: cmd.Capacity,
-		Type:     cmd.Type,
-		Features: cmd.Features,
-		IsActive: true,
+		ID:               uuid.New(),
+		Name:             cmd.Name,
+		Capacity:         cmd.Capacity,
+		Type:             cmd.Type,
+		Features:         cmd.Features,
+		IsActive:         true,
+		RequiresApproval: cmd.RequiresApproval,
+		BuildingID:       cmd.BuildingID,
 	}
 	if err := r.Validate(); err != nil {
-		return nil, fmt.Errorf("validate room: %w", err)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRoom, err)
+	}
+	if err := checkBuilding(ctx, h.campusRepo, r.BuildingID); err != nil {
+		return nil, err
 	}
 	return h.repo.Create(ctx, r)
 }
+
+// checkBuilding verifies that a room's building exists; uuid.Nil is allowed.
+func checkBuilding(ctx context.Context, campusRepo repository.CampusRepository, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return nil
+	}
+	if _, err := campusRepo.GetBuildingByID(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrBuildingNotFound) {
+			return fmt.Errorf("%w: building %s does not exist", ErrInvalidRoom, id)
+		}
+		return fmt.Errorf("get building: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	infragrpc "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/grpc"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// GenerateExamScheduleCommand requests a final-exam timetable for the
+// semester's offered subjects between StartDate and EndDate.
+type GenerateExamScheduleCommand struct {
+	SemesterID     uuid.UUID
+	Name           string // defaults to "Exams-<semester>"
+	StartDate      time.Time
+	EndDate        time.Time
+	Settings       entity.ExamSettings // unset fields take their defaults
+	TimeoutSeconds int                 // solver wall-clock timeout (default 20)
+}
+
+// ErrInvalidExamPeriod is returned when the exam window or settings cannot be used.
+var ErrInvalidExamPeriod = errors.New("invalid exam period")
+
+// GenerateExamScheduleHandler places one exam per offered subject with the
+// teaching CSP solver, under exam rules: students never sit two exams at once
+// nor more than MaxPerDay a day, rooms seat the enrolled students with seat
+// spacing, HR teachers invigilate, and exams sharing students are spread
+// apart. Generation runs synchronously within the timeout; only a result is
+// stored, never a failed attempt.
+type GenerateExamScheduleHandler struct {
+	semesterRepo  repository.SemesterRepository
+	examRepo      repository.ExamScheduleRepository
+	roomRepo      repository.RoomRepository
+	campusRepo    repository.CampusRepository
+	hrClient      *infragrpc.HRClient
+	subjectClient *infragrpc.SubjectClient
+	studentClient *infragrpc.StudentClient // nil-safe — student rules are skipped if nil
+}
+
+func NewGenerateExamScheduleHandler(
+	semesterRepo repository.SemesterRepository,
+	examRepo repository.ExamScheduleRepository,
+	roomRepo repository.RoomRepository,
+	campusRepo repository.CampusRepository,
+	hrClient *infragrpc.HRClient,
+	subjectClient *infragrpc.SubjectClient,
+	studentClient *infragrpc.StudentClient,
+) *GenerateExamScheduleHandler {
+	return &GenerateExamScheduleHandler{
+		semesterRepo:  semesterRepo,
+		examRepo:      examRepo,
+		roomRepo:      roomRepo,
+		campusRepo:    campusRepo,
+		hrClient:      hrClient,
+		subjectClient: subjectClient,
+		studentClient: studentClient,
+	}
+}
+
+func (h *GenerateExamScheduleHandler) Handle(ctx context.Context, cmd GenerateExamScheduleCommand) (*entity.ExamSchedule, error) {
+	semester, err := h.semesterRepo.GetByID(ctx, cmd.SemesterID)
+	if err != nil {
+		return nil, fmt.Errorf("get semester: %w", err)
+	}
+	exam := &entity.ExamSchedule{
+		SemesterID: semester.ID,
+		Name:       cmd.Name,
+		StartDate:  cmd.StartDate,
+		EndDate:    cmd.EndDate,
+		Settings:   cmd.Settings.WithDefaults(),
+	}
+	if exam.Name == "" {
+		exam.Name = fmt.Sprintf("Exams-%s", semester.Name)
+	}
+	if err := exam.ValidateWindow(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExamPeriod, err)
+	}
+	if err := exam.Settings.Validate(semester); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExamPeriod, err)
+	}
+	if len(semester.OfferedSubjectIDs) == 0 {
+		return nil, fmt.Errorf("%w: semester offers no subjects", ErrInvalidExamPeriod)
+	}
+	slots, dates := service.ExamSlots(semester, exam.StartDate, exam.EndDate, exam.Settings.Sessions)
+	if len(slots) == 0 {
+		return nil, fmt.Errorf("%w: no exam days between the dates", ErrInvalidExamPeriod)
+	}
+
+	timeout := cmd.TimeoutSeconds
+	if timeout <= 0 {
+		timeout = 20
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	in, err := (&solverInputLoader{
+		semesterRepo:  h.semesterRepo,
+		roomRepo:      h.roomRepo,
+		campusRepo:    h.campusRepo,
+		hrClient:      h.hrClient,
+		subjectClient: h.subjectClient,
+		studentClient: h.studentClient,
+	}).load(ctx, semester, solverOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	rooms := service.ExamRooms(in.rooms, exam.Settings.SeatSpacing)
+	checker, err := in.examChecker(rooms, dates, exam.Settings)
+	if err != nil {
+		return nil, err
+	}
+	slotMap := make(map[uuid.UUID]*entity.TimeSlot, len(slots))
+	for _, sl := range slots {
+		slotMap[sl.ID] = sl
+	}
+
+	// One sitting per subject: no session expansion
+	variables := make([]service.ScheduleVariable, 0, len(in.subjects))
+	for _, s := range in.subjects {
+		variables = append(variables, service.ScheduleVariable{SubjectID: s.ID, SubjectCode: s.Code})
+	}
+	solver := service.NewCSPSolverFromCandidates(variables, buildCandidates(in.teachers, rooms, slots), slotMap, checker)
+	solver.SetLocalSearch(&service.LocalSearchOptions{})
+	result, err := solver.Solve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("solver: %w", err)
+	}
+
+	roomNames := make(map[uuid.UUID]string, len(rooms))
+	for _, r := range rooms {
+		roomNames[r.ID] = r.Name
+	}
+	for _, v := range variables {
+		a, ok := result.Assignment[v.Key()]
+		if !ok {
+			exam.Unscheduled = append(exam.Unscheduled, v.SubjectID)
+			continue
+		}
+		slot := slotMap[a.SlotID]
+		exam.Entries = append(exam.Entries, entity.ExamEntry{
+			SubjectID:       v.SubjectID,
+			SubjectCode:     in.subjectCodes[v.SubjectID],
+			SubjectName:     in.subjectNames[v.SubjectID],
+			Date:            dates[slot.DayOfWeek],
+			StartPeriod:     slot.StartPeriod,
+			EndPeriod:       slot.EndPeriod,
+			RoomID:          a.RoomID,
+			RoomName:        roomNames[a.RoomID],
+			InvigilatorID:   a.TeacherID,
+			InvigilatorName: in.teacherNames[a.TeacherID],
+			StudentCount:    len(in.enrolled[v.SubjectID]),
+		})
+	}
+	sort.Slice(exam.Entries, func(i, j int) bool {
+		a, b := exam.Entries[i], exam.Entries[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		if a.StartPeriod != b.StartPeriod {
+			return a.StartPeriod < b.StartPeriod
+		}
+		return a.SubjectCode < b.SubjectCode
+	})
+	exam.Score = result.Score
+	exam.SoftPenalty = result.SoftPenalty
+
+	// The solve may have used up ctx; storing the result must not fail for it
+	created, err := h.examRepo.Create(context.WithoutCancel(ctx), exam)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// examChecker configures a constraint checker for an exam period over the
+// loaded teachers, availability and enrollments.
+func (in *solverInputs) examChecker(
+	rooms []*entity.Room,
+	dates map[int]time.Time,
+	settings entity.ExamSettings,
+) (*service.ConstraintChecker, error) {
+	// The invigilation cap is enforced in periods, counting each sitting as
+	// the longest session
+	longest := 0
+	for _, s := range settings.Sessions {
+		longest = max(longest, max(s.EndPeriod-s.StartPeriod+1, 1))
+	}
+	var invigilationCap map[uuid.UUID]int
+	if settings.MaxInvigilations > 0 {
+		invigilationCap = make(map[uuid.UUID]int, len(in.teachers))
+		for _, t := range in.teachers {
+			invigilationCap[t.ID] = settings.MaxInvigilations * longest
+		}
+	}
+
+	// Any teacher may invigilate; the room only has to seat the candidates
+	requirements := make(map[uuid.UUID]service.RoomRequirement, len(in.subjects))
+	for _, s := range in.subjects {
+		requirements[s.ID] = service.RoomRequirement{MinCapacity: len(in.enrolled[s.ID])}
+	}
+
+	checker := service.NewConstraintChecker(service.ExamAvailability(in.availability, dates), nil, nil, invigilationCap)
+	checker.SetRoomRequirements(rooms, requirements)
+	checker.SetCohortOverlap(service.BuildCohortOverlap(in.enrolled), true)
+	checker.SetStudentDailyLimit(in.enrolled, settings.MaxPerDay)
+	soft, err := service.BuildSoftConstraints([]entity.SoftConstraintSetting{
+		{Type: valueobject.ConstraintExamSpread, Weight: 1.0, Params: map[string]int{"min_gap_days": settings.MinGapDays}},
+		{Type: valueobject.ConstraintLoadImbalance, Weight: 0.5},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("exam soft constraints: %w", err)
+	}
+	checker.SetSoftConstraints(soft)
+	return checker, nil
+}
@@ -3,7 +3,9 @@ package command
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"runtime"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,10 +19,36 @@ import (
 
 // GenerateScheduleCommand requests async schedule generation for a semester.
 type GenerateScheduleCommand struct {
-	SemesterID     uuid.UUID
-	TimeoutSeconds int // solver wall-clock timeout (default 30)
+	SemesterID         uuid.UUID
+	TimeoutSeconds     int  // solver wall-clock timeout (default 30)
+	BindSessionTeacher bool // all weekly sessions of a subject share one teacher
+	SoftCohortClash    bool // subjects sharing students may overlap at a penalty
+	// Pins pre-place sessions before search; they are seeded into the domains.
+	Pins []PinnedAssignment
+	// LockedFromScheduleID carries over the manual-override entries of a
+	// previous schedule of the same semester (uuid.Nil = none).
+	LockedFromScheduleID uuid.UUID
+	// Seed fixes the solver's random choices so a run can be reproduced.
+	Seed uint64
+	// PortfolioSize is how many solver strategies run concurrently; the best
+	// result wins (<= 1 = a single run, capped at GOMAXPROCS).
+	PortfolioSize int
 }
 
+// ErrInvalidPin is returned when a pin or lock source does not fit the semester.
+var ErrInvalidPin = errors.New("invalid pinned assignment")
+
+// ErrGenerationCancelled is the cause a run's context carries when an admin
+// cancelled it.
+var ErrGenerationCancelled = errors.New("generation cancelled")
+
+// maxGenerationAttempts bounds how often a run interrupted by a restart is retried.
+const maxGenerationAttempts = 3
+
+// progressReportEvery throttles "progress" events so that a long search
+// updates the client twice a second without flooding the stream.
+const progressReportEvery = 500 * time.Millisecond
+
 // GenerateScheduleResult holds the outcome of an async generation run.
 type GenerateScheduleResult struct {
 	ScheduleID     uuid.UUID
@@ -35,33 +63,42 @@ type GenerateScheduleResult struct {
 type GenerateScheduleHandler struct {
 	semesterRepo repository.SemesterRepository
 	scheduleRepo repository.ScheduleRepository
+	jobRepo      repository.GenerationJobRepository
 	roomRepo     repository.RoomRepository
+	campusRepo   repository.CampusRepository
 	hrClient     *infragrpc.HRClient
 	subjectClient *infragrpc.SubjectClient
+	studentClient *infragrpc.StudentClient // nil-safe — cohort clashes are ignored if nil
 	publisher    EventPublisher
 }
 
 func NewGenerateScheduleHandler(
 	semesterRepo repository.SemesterRepository,
 	scheduleRepo repository.ScheduleRepository,
+	jobRepo      repository.GenerationJobRepository,
 	roomRepo     repository.RoomRepository,
+	campusRepo   repository.CampusRepository,
 	hrClient     *infragrpc.HRClient,
 	subjectClient *infragrpc.SubjectClient,
+	studentClient *infragrpc.StudentClient,
 	publisher    EventPublisher,
 ) *GenerateScheduleHandler {
 	return &GenerateScheduleHandler{
 		semesterRepo:  semesterRepo,
 		scheduleRepo:  scheduleRepo,
+		jobRepo:       jobRepo,
 		roomRepo:      roomRepo,
+		campusRepo:    campusRepo,
 		hrClient:      hrClient,
 		subjectClient: subjectClient,
+		studentClient: studentClient,
 		publisher:     publisher,
 	}
 }
 
-// Handle creates a draft schedule record, runs the CSP solver in a goroutine,
-// and returns the schedule ID immediately.  The caller can poll GetSchedule
-// to observe the final status.
+// Handle creates a generating schedule record, queues a generation job for a
+// GenerationWorker to run, and returns the schedule ID immediately. The caller
+// can poll GetSchedule or GetGenerationStatus to observe the final status.
 func (h *GenerateScheduleHandler) Handle(ctx context.Context, cmd GenerateScheduleCommand) (uuid.UUID, error) {
 	// 1. Validate semester
 	semester, err := h.semesterRepo.GetByID(ctx, cmd.SemesterID)
@@ -69,6 +106,19 @@ func (h *GenerateScheduleHandler) Handle(ctx context.Context, cmd GenerateSchedu
 		return uuid.Nil, fmt.Errorf("get semester: %w", err)
 	}
 
+	if err := validatePins(cmd.Pins, semester); err != nil {
+		return uuid.Nil, err
+	}
+	if cmd.LockedFromScheduleID != uuid.Nil {
+		previous, err := h.scheduleRepo.GetByID(ctx, cmd.LockedFromScheduleID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("get locked schedule: %w", err)
+		}
+		if previous.SemesterID != semester.ID {
+			return uuid.Nil, fmt.Errorf("%w: schedule %s belongs to another semester", ErrInvalidPin, previous.ID)
+		}
+	}
+
 	// 2. Create a generating schedule row immediately so callers can track it
 	schedule := &entity.Schedule{
 		SemesterID: semester.ID,
@@ -80,172 +130,269 @@ func (h *GenerateScheduleHandler) Handle(ctx context.Context, cmd GenerateSchedu
 		return uuid.Nil, fmt.Errorf("create schedule record: %w", err)
 	}
 
-	timeout := cmd.TimeoutSeconds
-	if timeout <= 0 {
-		timeout = 30
+	// 3. Queue the run — a worker claims it, so it survives a restart
+	params, err := json.Marshal(cmd)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("encode generation params: %w", err)
+	}
+	_, err = h.jobRepo.Create(ctx, &entity.GenerationJob{
+		ScheduleID:  created.ID,
+		SemesterID:  semester.ID,
+		Params:      params,
+		MaxAttempts: maxGenerationAttempts,
+	})
+	if err != nil {
+		h.markFailed(created.ID, "could not queue generation")
+		return uuid.Nil, fmt.Errorf("queue generation: %w", err)
 	}
-
-	// 3. Run solver asynchronously — do not block the gRPC call
-	go h.runSolver(created.ID, semester, timeout)
 
 	return created.ID, nil
 }
 
-// runSolver executes the full CSP pipeline and updates the DB with results.
-func (h *GenerateScheduleHandler) runSolver(scheduleID uuid.UUID, semester *entity.Semester, timeoutSec int) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
-	defer cancel()
-
-	// Publish "started" SSE event
-	h.publishScheduleEvent(scheduleID, "started", map[string]any{
-		"schedule_id": scheduleID.String(),
-		"total":       len(semester.OfferedSubjectIDs),
-	})
-
-	// --- Pre-fetch all data before solver begins (no IO during search) ---
-
-	// 4a. Fetch offered subjects
-	subjects, err := h.subjectClient.ListSubjectsByIDs(ctx, semester.OfferedSubjectIDs)
+// Cancel stops the schedule's queued or running generation. A queued job is
+// cancelled at once; a running one is stopped by its worker at the next lease
+// renewal. Returns repository.ErrNoActiveJob when nothing is in progress.
+func (h *GenerateScheduleHandler) Cancel(ctx context.Context, scheduleID uuid.UUID) (*entity.GenerationJob, error) {
+	job, err := h.jobRepo.RequestCancel(ctx, scheduleID)
 	if err != nil {
-		h.markFailed(scheduleID, fmt.Sprintf("fetch subjects: %v", err))
-		return
+		return nil, err
 	}
-
-	// 4b. Fetch available rooms — use semester-specific rooms if configured, else all active rooms
-	var rooms []*entity.Room
-	if len(semester.RoomIDs) > 0 {
-		for _, rid := range semester.RoomIDs {
-			r, err := h.roomRepo.GetByID(ctx, rid)
-			if err != nil {
-				continue // skip missing/inactive rooms
-			}
-			rooms = append(rooms, r)
-		}
-	} else {
-		rooms, err = h.roomRepo.List(ctx, 200, 0)
-		if err != nil {
-			h.markFailed(scheduleID, fmt.Sprintf("fetch rooms: %v", err))
-			return
-		}
+	if job.Status == valueobject.JobStatusCancelled {
+		h.markFailed(scheduleID, ErrGenerationCancelled.Error())
 	}
+	return job, nil
+}
 
-	// 4c. Fetch time slots for the semester
-	slots, err := h.semesterRepo.ListTimeSlots(ctx, semester.ID)
-	if err != nil {
-		h.markFailed(scheduleID, fmt.Sprintf("fetch slots: %v", err))
-		return
+// Run executes a claimed generation job. ctx carries the worker's cause when
+// the run is stopped: ErrGenerationCancelled marks the schedule failed, while
+// a lost lease or worker shutdown leaves it for the next claim. A retried run
+// first clears entries the interrupted attempt may have written.
+func (h *GenerateScheduleHandler) Run(ctx context.Context, job *entity.GenerationJob) error {
+	var cmd GenerateScheduleCommand
+	if err := json.Unmarshal(job.Params, &cmd); err != nil {
+		h.markFailed(job.ScheduleID, "invalid generation params")
+		return fmt.Errorf("decode generation params: %w", err)
 	}
-
-	// 4d. Fetch teachers from HR module
-	teachers, err := h.hrClient.ListTeachers(ctx)
+	semester, err := h.semesterRepo.GetByID(ctx, job.SemesterID)
 	if err != nil {
-		h.markFailed(scheduleID, fmt.Sprintf("fetch teachers: %v", err))
-		return
-	}
-
-	// 4e. Fetch availability for each teacher
-	teacherAvailability := make(map[uuid.UUID][]*entity.TimeSlot, len(teachers))
-	for _, t := range teachers {
-		avail, err := h.hrClient.GetTeacherAvailability(ctx, t.ID)
-		if err == nil {
-			teacherAvailability[t.ID] = avail
+		if stop := h.interrupted(ctx, job.ScheduleID); stop != nil {
+			return stop
 		}
+		h.markFailed(job.ScheduleID, "semester not found")
+		return fmt.Errorf("get semester: %w", err)
 	}
-
-	// 5. Build CSP inputs + name lookup maps for denormalised fields
-	teacherSpecs := make(map[uuid.UUID]map[string]bool, len(teachers))
-	for _, t := range teachers {
-		specSet := make(map[string]bool, len(t.Specializations))
-		for _, s := range t.Specializations {
-			specSet[s] = true
+	if job.Attempts > 1 {
+		if err := h.scheduleRepo.DeleteAllEntries(ctx, job.ScheduleID); err != nil {
+			if stop := h.interrupted(ctx, job.ScheduleID); stop != nil {
+				return stop
+			}
+			h.markFailed(job.ScheduleID, "could not clear interrupted run")
+			return fmt.Errorf("clear interrupted entries: %w", err)
 		}
-		teacherSpecs[t.ID] = specSet
 	}
 
-	teacherMaxHours := make(map[uuid.UUID]int, len(teachers))
-	for _, t := range teachers {
-		teacherMaxHours[t.ID] = t.MaxHoursPerWeek
+	timeout := cmd.TimeoutSeconds
+	if timeout <= 0 {
+		timeout = 30
 	}
+	return h.runSolver(ctx, job.ScheduleID, semester, timeout, cmd)
+}
 
-	subjectSpecs := make(map[uuid.UUID][]string, len(subjects))
-	subjectNames := make(map[uuid.UUID]string, len(subjects))
-	subjectCodes := make(map[uuid.UUID]string, len(subjects))
-	subjectDepts := make(map[uuid.UUID]uuid.UUID, len(subjects))
-	for _, s := range subjects {
-		subjectSpecs[s.ID] = s.RequiredSpecializations
-		subjectNames[s.ID] = s.Name
-		subjectCodes[s.ID] = s.Code
-		subjectDepts[s.ID] = s.DepartmentID
-	}
+// runSolver executes the full CSP pipeline and updates the DB with results.
+func (h *GenerateScheduleHandler) runSolver(ctx context.Context, scheduleID uuid.UUID, semester *entity.Semester, timeoutSec int, cmd GenerateScheduleCommand) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
+	defer cancel()
 
-	teacherNames := make(map[uuid.UUID]string, len(teachers))
-	for _, t := range teachers {
-		teacherNames[t.ID] = t.FullName
-	}
+	// Publish "started" SSE event
+	h.publishScheduleEvent(scheduleID, "started", map[string]any{
+		"schedule_id": scheduleID.String(),
+		"total":       len(semester.OfferedSubjectIDs),
+	})
 
-	checker := service.NewConstraintChecker(teacherAvailability, teacherSpecs, subjectSpecs, teacherMaxHours)
+	// --- Pre-fetch all data before solver begins (no IO during search) ---
 
-	// 6. Build slot lookup map
-	slotMap := make(map[uuid.UUID]*entity.TimeSlot, len(slots))
-	for _, sl := range slots {
-		slotMap[sl.ID] = sl
+	// 4-7. Fetch subjects, rooms, slots, teachers and enrollments, then build
+	// the constraint checker and one variable per weekly session
+	in, err := h.inputs().load(ctx, semester, solverOptions{
+		BindSessionTeacher: cmd.BindSessionTeacher,
+		SoftCohortClash:    cmd.SoftCohortClash,
+	})
+	if stop := h.interrupted(ctx, scheduleID); stop != nil {
+		return stop
 	}
-
-	// 7. Build variables and initial domains
-	variables := make([]service.ScheduleVariable, 0, len(subjects))
-	for _, s := range subjects {
-		variables = append(variables, service.ScheduleVariable{
-			SubjectID:               s.ID,
-			SubjectCode:             s.Code,
-			WeeklyHours:             s.Credits,
-			RequiredSpecializations: s.RequiredSpecializations,
-		})
+	if err != nil {
+		h.markFailed(scheduleID, err.Error())
+		return err
+	}
+	variables := in.variables()
+	preplaced, locksDropped, err := preplacements(ctx, h.scheduleRepo, cmd, variables, in)
+	if err != nil {
+		h.markFailed(scheduleID, err.Error())
+		return err
 	}
 
-	domains := buildDomains(variables, teachers, rooms, slots)
-
-	// 8. Solve
-	solver := service.NewCSPSolver(variables, domains, slotMap, checker)
-	result, err := solver.Solve(ctx)
+	// 8. Solve, then spend the remaining budget improving soft penalties.
+	// Search progress streams on the per-schedule "progress" subject and the
+	// penalty trajectory on "optimizing". A portfolio runs several strategies
+	// side by side and keeps the best.
+	solver := service.NewCSPSolverFromCandidates(variables, buildCandidates(in.teachers, in.rooms, in.slots), in.slotMap, in.checker)
+	solver.SetPreplaced(preplaced)
+	solver.SetProgress(&service.ProgressOptions{
+		ReportEvery: progressReportEvery,
+		OnProgress: func(p service.SearchProgress) {
+			h.publishScheduleEvent(scheduleID, "progress", map[string]any{
+				"schedule_id":   scheduleID.String(),
+				"member":        p.Member,
+				"assigned":      p.Assigned,
+				"total":         p.Total,
+				"best_assigned": p.BestAssigned,
+				"backtracks":    p.Backtracks,
+				"nodes":         p.Nodes,
+				"soft_penalty":  p.SoftPenalty,
+				"elapsed_ms":    p.Elapsed.Milliseconds(),
+			})
+		},
+	})
+	solver.SetLocalSearch(&service.LocalSearchOptions{
+		OnProgress: func(p service.LocalSearchProgress) {
+			h.publishScheduleEvent(scheduleID, "optimizing", map[string]any{
+				"schedule_id":  scheduleID.String(),
+				"member":       p.Member,
+				"iteration":    p.Iteration,
+				"elapsed_ms":   p.Elapsed.Milliseconds(),
+				"penalty":      p.Penalty,
+				"best_penalty": p.BestPenalty,
+				"temperature":  p.Temperature,
+			})
+		},
+	})
+	portfolio := portfolioSize(cmd.PortfolioSize)
+	result, err := solver.SolvePortfolio(ctx, service.PortfolioStrategies(cmd.Seed, portfolio))
+	if stop := h.interrupted(ctx, scheduleID); stop != nil {
+		return stop
+	}
 	if err != nil {
+		var infeasible *service.InfeasibleError
+		if errors.As(err, &infeasible) {
+			diagnosis := diagnosisFromReport(infeasible.Report, in.subjectNames, in.subjectCodes, in.teacherNames)
+			h.markFailedWithDiagnosis(scheduleID, fmt.Sprintf("solver: %v", err), diagnosis)
+			return fmt.Errorf("solver: %w", err)
+		}
 		h.markFailed(scheduleID, fmt.Sprintf("solver: %v", err))
-		return
+		return fmt.Errorf("solver: %w", err)
 	}
 
-	// 9. Persist entries with denormalised names
+	// 9. Persist entries with denormalised names; pre-placed sessions stay
+	// locked so the next regeneration can carry them over again
+	locked := lockedSlots(variables, preplaced, result.Assignment)
 	for _, entry := range result.Entries {
+		entry.IsManualOverride = locked[lockedSlot{entry.SubjectID, entry.SectionNumber(), entry.TimeSlotID}]
 		entry.ScheduleID   = scheduleID
-		entry.SubjectName  = subjectNames[entry.SubjectID]
-		entry.SubjectCode  = subjectCodes[entry.SubjectID]
-		entry.TeacherName  = teacherNames[entry.TeacherID]
-		entry.DepartmentID = subjectDepts[entry.SubjectID]
+		entry.SubjectName  = in.subjectNames[entry.SubjectID]
+		entry.SubjectCode  = in.subjectCodes[entry.SubjectID]
+		entry.TeacherName  = in.teacherNames[entry.TeacherID]
+		entry.DepartmentID = in.subjectDepts[entry.SubjectID]
+		for i := range entry.Assistants {
+			entry.Assistants[i].TeacherName = in.teacherNames[entry.Assistants[i].TeacherID]
+		}
 		_, _ = h.scheduleRepo.CreateEntry(context.Background(), entry)
 	}
 
 	// 10. Update schedule with results and mark as completed
 	_, _ = h.scheduleRepo.UpdateResult(context.Background(), scheduleID,
 		result.Score, result.HardViolations, result.SoftPenalty)
+	_, _ = h.scheduleRepo.UpdateCohortClashes(context.Background(), scheduleID, result.CohortClashes)
 	_, _ = h.scheduleRepo.UpdateStatus(context.Background(), scheduleID, valueobject.ScheduleStatusCompleted)
 
 	// 11. Append event + publish (both legacy flat subject and per-schedule SSE subject)
+	overloads := make([]map[string]any, 0, len(result.TeacherOverloads))
+	for _, o := range result.TeacherOverloads {
+		overloads = append(overloads, map[string]any{
+			"teacher_id":       o.TeacherID.String(),
+			"teacher_name":     in.teacherNames[o.TeacherID],
+			"assigned_periods": o.AssignedPeriods,
+			"max_periods":      o.MaxPeriods,
+		})
+	}
 	completedData := map[string]any{
-		"schedule_id": scheduleID.String(),
-		"score":       result.Score,
-		"is_partial":  result.IsPartial,
+		"schedule_id":       scheduleID.String(),
+		"score":             result.Score,
+		"is_partial":        result.IsPartial,
+		"hard_violations":   result.HardViolations,
+		"teacher_overloads": overloads,
+		"unstaffed":         result.Unstaffed,
+		"initial_penalty":   result.InitialPenalty,
+		"soft_penalty":      result.SoftPenalty,
+		"cohort_clashes":    result.CohortClashes,
+		"preplaced":         len(preplaced),
+		"locks_dropped":     locksDropped,
+		"seed":              cmd.Seed,
+		"portfolio_size":    portfolio,
+		"portfolio_member":  result.Member,
 	}
 	payload, _ := json.Marshal(completedData)
 	_ = h.scheduleRepo.AppendEvent(context.Background(), scheduleID, "Schedule", "ScheduleGenerated", payload)
 	_ = h.publisher.Publish(context.Background(), "timetable.schedule.generated", completedData)
 	h.publishScheduleEvent(scheduleID, "completed", completedData)
+	return nil
+}
+
+// portfolioSize clamps a requested portfolio to 1..GOMAXPROCS; more members
+// than cores would only share the same timeout.
+func portfolioSize(requested int) int {
+	if limit := runtime.GOMAXPROCS(0); requested > limit {
+		return limit
+	}
+	if requested < 1 {
+		return 1
+	}
+	return requested
+}
+
+// interrupted returns the cause when the worker stopped the run. A cancelled
+// run marks the schedule failed; a run whose lease was lost, or whose worker
+// is shutting down, leaves the schedule to whoever claims the job next.
+func (h *GenerateScheduleHandler) interrupted(ctx context.Context, scheduleID uuid.UUID) error {
+	cause := context.Cause(ctx)
+	switch {
+	case errors.Is(cause, ErrGenerationCancelled):
+		h.markFailed(scheduleID, cause.Error())
+		return cause
+	case errors.Is(cause, repository.ErrLeaseLost), errors.Is(cause, ErrWorkerStopped):
+		return cause
+	}
+	return nil
+}
+
+// inputs returns a loader over the handler's repositories and module clients.
+func (h *GenerateScheduleHandler) inputs() *solverInputLoader {
+	return &solverInputLoader{
+		semesterRepo:  h.semesterRepo,
+		roomRepo:      h.roomRepo,
+		campusRepo:    h.campusRepo,
+		hrClient:      h.hrClient,
+		subjectClient: h.subjectClient,
+		studentClient: h.studentClient,
+	}
 }
 
 func (h *GenerateScheduleHandler) markFailed(scheduleID uuid.UUID, reason string) {
+	h.markFailedWithDiagnosis(scheduleID, reason, nil)
+}
+
+// markFailedWithDiagnosis marks the schedule failed and stores why; diagnosis
+// is nil unless the solver proved the problem infeasible.
+func (h *GenerateScheduleHandler) markFailedWithDiagnosis(scheduleID uuid.UUID, reason string, diagnosis *entity.GenerationDiagnosis) {
 	_, _ = h.scheduleRepo.UpdateResult(context.Background(), scheduleID, -1, 0, 0)
 	_, _ = h.scheduleRepo.UpdateStatus(context.Background(), scheduleID, valueobject.ScheduleStatusFailed)
+	_, _ = h.scheduleRepo.UpdateFailure(context.Background(), scheduleID, reason, diagnosis)
 	failedData := map[string]any{
 		"schedule_id": scheduleID.String(),
 		"error":       reason,
 	}
+	if diagnosis != nil {
+		failedData["diagnosis"] = diagnosis
+	}
 	payload, _ := json.Marshal(failedData)
 	_ = h.scheduleRepo.AppendEvent(context.Background(), scheduleID, "Schedule", "ScheduleGenerationFailed", payload)
 	h.publishScheduleEvent(scheduleID, "failed", failedData)
@@ -257,30 +404,64 @@ func (h *GenerateScheduleHandler) publishScheduleEvent(scheduleID uuid.UUID, eve
 	_ = h.publisher.Publish(context.Background(), subject, data)
 }
 
-// buildDomains creates the initial domain (all valid assignments) for each subject variable.
-// Each assignment is a (teacher, room, slot) triple; hard constraints are NOT checked here —
-// that is the solver's job.  We only enumerate structurally possible combinations.
-func buildDomains(
-	variables []service.ScheduleVariable,
+// buildCandidates lists the teachers, rooms and slots every session may take.
+// Each (teacher, room, slot) triple is a structurally possible assignment;
+// hard constraints are NOT checked here — that is the solver's job. Its
+// node-consistency pass drops unsuitable teachers and rooms and records why
+// for diagnosis.
+func buildCandidates(
 	teachers []service.TeacherInfo,
 	rooms []*entity.Room,
 	slots []*entity.TimeSlot,
-) map[string][]service.Assignment {
-	domains := make(map[string][]service.Assignment, len(variables))
-	for _, v := range variables {
-		var combos []service.Assignment
-		for _, t := range teachers {
-			for _, r := range rooms {
-				for _, sl := range slots {
-					combos = append(combos, service.Assignment{
-						TeacherID: t.ID,
-						RoomID:    r.ID,
-						SlotID:    sl.ID,
-					})
-				}
-			}
+) service.Candidates {
+	c := service.Candidates{
+		TeacherIDs: make([]uuid.UUID, 0, len(teachers)),
+		RoomIDs:    make([]uuid.UUID, 0, len(rooms)),
+		SlotIDs:    make([]uuid.UUID, 0, len(slots)),
+	}
+	for _, t := range teachers {
+		c.TeacherIDs = append(c.TeacherIDs, t.ID)
+	}
+	for _, r := range rooms {
+		c.RoomIDs = append(c.RoomIDs, r.ID)
+	}
+	for _, sl := range slots {
+		c.SlotIDs = append(c.SlotIDs, sl.ID)
+	}
+	return c
+}
+
+// diagnosisFromReport attaches subject and teacher names to the solver's
+// infeasibility report so admins can act on it without looking up IDs.
+func diagnosisFromReport(
+	report *service.InfeasibilityReport,
+	subjectNames, subjectCodes, teacherNames map[uuid.UUID]string,
+) *entity.GenerationDiagnosis {
+	d := &entity.GenerationDiagnosis{
+		EmptyDomains:     make([]entity.EmptyDomainDiagnosis, 0, len(report.EmptyDomains)),
+		ConflictSubjects: make([]entity.DiagnosisRef, 0, len(report.ConflictSubjects)),
+		ConflictTeachers: make([]entity.DiagnosisRef, 0, len(report.ConflictTeachers)),
+	}
+	for _, e := range report.EmptyDomains {
+		code := e.SubjectCode
+		if code == "" {
+			code = subjectCodes[e.SubjectID]
 		}
-		domains[v.SubjectID.String()] = combos
+		d.EmptyDomains = append(d.EmptyDomains, entity.EmptyDomainDiagnosis{
+			SubjectID:   e.SubjectID,
+			SubjectCode: code,
+			SubjectName: subjectNames[e.SubjectID],
+			Session:     e.Session,
+			Candidates:  e.Candidates,
+			Cause:       e.Cause(),
+			Eliminated:  e.Eliminated,
+		})
+	}
+	for _, id := range report.ConflictSubjects {
+		d.ConflictSubjects = append(d.ConflictSubjects, entity.DiagnosisRef{ID: id, Name: subjectNames[id]})
+	}
+	for _, id := range report.ConflictTeachers {
+		d.ConflictTeachers = append(d.ConflictTeachers, entity.DiagnosisRef{ID: id, Name: teacherNames[id]})
 	}
-	return domains
+	return d
 }
@@ -0,0 +1,184 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+const (
+	generationLease        = 30 * time.Second
+	generationPollInterval = 2 * time.Second
+)
+
+// ErrWorkerStopped is the cause a run's context carries when its worker is
+// shutting down; the job is released so another instance can pick it up.
+var ErrWorkerStopped = errors.New("generation worker stopped")
+
+// GenerationWorker claims queued generation jobs and runs at most
+// maxConcurrent of them at a time. Each running job holds a lease that is
+// renewed while the solver works; if the instance dies the lease expires and
+// any instance claims the job again.
+type GenerationWorker struct {
+	jobs         repository.GenerationJobRepository
+	generate     *GenerateScheduleHandler
+	owner        string
+	lease        time.Duration
+	pollInterval time.Duration
+	slots        chan struct{}
+	running      sync.WaitGroup
+	log          *zap.Logger
+}
+
+// NewGenerationWorker creates a worker identified by owner, which should be
+// stable across restarts of the same instance (e.g. the hostname) so that
+// jobs it held when it crashed are recovered immediately on startup.
+func NewGenerationWorker(
+	jobs repository.GenerationJobRepository,
+	generate *GenerateScheduleHandler,
+	owner string,
+	maxConcurrent int,
+	log *zap.Logger,
+) *GenerationWorker {
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+	return &GenerationWorker{
+		jobs:         jobs,
+		generate:     generate,
+		owner:        owner,
+		lease:        generationLease,
+		pollInterval: generationPollInterval,
+		slots:        make(chan struct{}, maxConcurrent),
+		log:          log,
+	}
+}
+
+// Start recovers jobs this owner held before a restart, then polls for work
+// until ctx is cancelled. On shutdown it stops running jobs and releases them
+// without counting the interrupted attempt.
+func (w *GenerationWorker) Start(ctx context.Context) {
+	if n, err := w.jobs.Release(ctx, w.owner, false); err != nil {
+		w.log.Error("recover generation jobs failed", zap.Error(err))
+	} else if n > 0 {
+		w.log.Info("recovered interrupted generation jobs", zap.Int("count", n))
+	}
+	w.log.Info("generation worker started",
+		zap.String("owner", w.owner), zap.Int("max_concurrent", cap(w.slots)))
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+	for {
+		w.reap(ctx)
+		w.claimAvailable(ctx)
+		select {
+		case <-ctx.Done():
+			w.running.Wait()
+			if _, err := w.jobs.Release(context.WithoutCancel(ctx), w.owner, true); err != nil {
+				w.log.Error("release generation jobs failed", zap.Error(err))
+			}
+			w.log.Info("generation worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reap marks the schedules of jobs that can no longer run as failed.
+func (w *GenerationWorker) reap(ctx context.Context) {
+	jobs, err := w.jobs.Reap(ctx)
+	if err != nil {
+		w.log.Error("reap generation jobs failed", zap.Error(err))
+		return
+	}
+	for _, job := range jobs {
+		w.log.Warn("generation job ended without finishing",
+			zap.String("schedule_id", job.ScheduleID.String()), zap.String("reason", job.ErrorMessage))
+		w.generate.markFailed(job.ScheduleID, job.ErrorMessage)
+	}
+}
+
+// claimAvailable claims jobs while a concurrency slot is free.
+func (w *GenerationWorker) claimAvailable(ctx context.Context) {
+	for {
+		select {
+		case w.slots <- struct{}{}:
+		default:
+			return
+		}
+		job, err := w.jobs.Claim(ctx, w.owner, w.lease)
+		if err != nil || job == nil {
+			<-w.slots
+			if err != nil {
+				w.log.Error("claim generation job failed", zap.Error(err))
+			}
+			return
+		}
+		w.running.Add(1)
+		go w.run(ctx, job)
+	}
+}
+
+// run executes one job and records its outcome. The run context is detached
+// from ctx so that shutdown reaches the solver as ErrWorkerStopped.
+func (w *GenerationWorker) run(ctx context.Context, job *entity.GenerationJob) {
+	defer w.running.Done()
+	defer func() { <-w.slots }()
+
+	runCtx, cancel := context.WithCancelCause(context.WithoutCancel(ctx))
+	defer cancel(nil)
+	stop := context.AfterFunc(ctx, func() { cancel(ErrWorkerStopped) })
+	defer stop()
+	go w.heartbeat(runCtx, job, cancel)
+
+	w.log.Info("generation job started",
+		zap.String("schedule_id", job.ScheduleID.String()), zap.Int("attempt", job.Attempts))
+	err := w.generate.Run(runCtx, job)
+
+	status, msg := valueobject.JobStatusSucceeded, ""
+	switch {
+	case errors.Is(err, repository.ErrLeaseLost), errors.Is(err, ErrWorkerStopped):
+		w.log.Warn("generation job interrupted",
+			zap.String("schedule_id", job.ScheduleID.String()), zap.Error(err))
+		return
+	case errors.Is(err, ErrGenerationCancelled):
+		status, msg = valueobject.JobStatusCancelled, err.Error()
+	case err != nil:
+		status, msg = valueobject.JobStatusFailed, err.Error()
+	}
+	if err := w.jobs.Finish(context.WithoutCancel(ctx), job.ID, w.owner, status, msg); err != nil {
+		w.log.Error("finish generation job failed", zap.String("schedule_id", job.ScheduleID.String()), zap.Error(err))
+	}
+}
+
+// heartbeat renews the lease until the run ends, and stops the run when a
+// cancel was requested or the lease was lost.
+func (w *GenerationWorker) heartbeat(ctx context.Context, job *entity.GenerationJob, cancel context.CancelCauseFunc) {
+	ticker := time.NewTicker(w.lease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		cancelRequested, err := w.jobs.RenewLease(ctx, job.ID, w.owner, w.lease)
+		switch {
+		case errors.Is(err, repository.ErrLeaseLost):
+			cancel(repository.ErrLeaseLost)
+			return
+		case err != nil:
+			w.log.Warn("renew generation lease failed", zap.String("schedule_id", job.ScheduleID.String()), zap.Error(err))
+		case cancelRequested:
+			cancel(ErrGenerationCancelled)
+			return
+		}
+	}
+}
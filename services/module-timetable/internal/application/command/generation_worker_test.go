@@ -0,0 +1,412 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	hrv1 "github.com/HuynhHoangPhuc/myrmex/gen/go/hr/v1"
+	subjectv1 "github.com/HuynhHoangPhuc/myrmex/gen/go/subject/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	infragrpc "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/grpc"
+)
+
+func TestGenerationWorkerReclaimsCrashedLease(t *testing.T) {
+	f := newWorkerFixture(t)
+	expired := time.Now().Add(-time.Minute)
+	// Both jobs were running on an instance that died mid-run
+	retried := f.queue(entity.GenerationJob{Status: valueobject.JobStatusRunning, Attempts: 1, LeaseOwner: "crashed", LeaseExpiresAt: &expired})
+	exhausted := f.queue(entity.GenerationJob{Status: valueobject.JobStatusRunning, Attempts: maxGenerationAttempts, LeaseOwner: "crashed", LeaseExpiresAt: &expired})
+	f.schedules.entries[retried.ScheduleID] = 1 // written by the crashed attempt
+
+	stop := f.start("survivor")
+	job := f.jobs.waitFinished(t, retried.ID)
+	stop()
+
+	if job.Status != valueobject.JobStatusSucceeded || job.LeaseOwner != "survivor" || job.Attempts != 2 {
+		t.Fatalf("expected the survivor to finish the second attempt, got %+v", job)
+	}
+	if !f.schedules.cleared[retried.ScheduleID] || f.schedules.entries[retried.ScheduleID] != 1 {
+		t.Fatalf("the retry should replace the crashed attempt's entry, got %d entries", f.schedules.entries[retried.ScheduleID])
+	}
+	if got := f.schedules.status[retried.ScheduleID]; got != valueobject.ScheduleStatusCompleted {
+		t.Fatalf("expected the retried schedule completed, got %s", got)
+	}
+
+	reaped := f.jobs.get(exhausted.ID)
+	if reaped.Status != valueobject.JobStatusFailed || reaped.Attempts != maxGenerationAttempts {
+		t.Fatalf("a job out of attempts should be reaped, not claimed: %+v", reaped)
+	}
+	if got := f.schedules.status[exhausted.ScheduleID]; got != valueobject.ScheduleStatusFailed {
+		t.Fatalf("expected the reaped schedule failed, got %s", got)
+	}
+}
+
+func TestGenerationWorkerCancelledJobWritesNoSchedule(t *testing.T) {
+	f := newWorkerFixture(t)
+	f.semesters.block = make(chan struct{})
+	job := f.queue(entity.GenerationJob{Status: valueobject.JobStatusQueued})
+
+	stop := f.start("worker")
+	defer stop()
+	<-f.semesters.block // the run is loading its inputs
+	if _, err := f.handler.Cancel(context.Background(), job.ScheduleID); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	finished := f.jobs.waitFinished(t, job.ID)
+
+	if finished.Status != valueobject.JobStatusCancelled {
+		t.Fatalf("expected the job cancelled, got %s", finished.Status)
+	}
+	f.schedules.mu.Lock()
+	defer f.schedules.mu.Unlock()
+	if f.schedules.entries[job.ScheduleID] != 0 || f.schedules.results[job.ScheduleID] {
+		t.Fatal("a cancelled run must not write entries or a result")
+	}
+	if got := f.schedules.status[job.ScheduleID]; got != valueobject.ScheduleStatusFailed {
+		t.Fatalf("expected the schedule failed, got %s", got)
+	}
+}
+
+// workerFixture wires a GenerateScheduleHandler over in-memory repositories
+// and one teacher, room, slot and subject, so that every run is solvable.
+type workerFixture struct {
+	t         *testing.T
+	semester  *entity.Semester
+	jobs      *fakeJobRepository
+	schedules *fakeScheduleRepository
+	semesters *fakeSemesterRepository
+	handler   *GenerateScheduleHandler
+}
+
+func newWorkerFixture(t *testing.T) *workerFixture {
+	subject := uuid.New()
+	semester := &entity.Semester{ID: uuid.New(), Name: "Fall", OfferedSubjectIDs: []uuid.UUID{subject}}
+	f := &workerFixture{
+		t:         t,
+		semester:  semester,
+		jobs:      &fakeJobRepository{jobs: map[uuid.UUID]*entity.GenerationJob{}, finished: make(chan uuid.UUID, 8)},
+		schedules: newFakeScheduleRepository(),
+		semesters: &fakeSemesterRepository{
+			semester: semester,
+			slots:    []*entity.TimeSlot{{ID: uuid.New(), SemesterID: semester.ID, DayOfWeek: 0, StartPeriod: 1, EndPeriod: 2}},
+		},
+	}
+	f.handler = NewGenerateScheduleHandler(
+		f.semesters, f.schedules, f.jobs,
+		&fakeRoomRepository{rooms: []*entity.Room{{ID: uuid.New(), Name: "R1", Capacity: 30, IsActive: true}}},
+		nil,
+		infragrpc.NewHRClientWithTeacherClient(&fakeTeacherClient{id: uuid.New()}),
+		infragrpc.NewSubjectClientWithServices(&fakeSubjectClient{id: subject}, nil),
+		nil, fakePublisher{},
+	)
+	return f
+}
+
+// queue stores a job for a fresh schedule of the fixture's semester.
+func (f *workerFixture) queue(job entity.GenerationJob) *entity.GenerationJob {
+	params, _ := json.Marshal(GenerateScheduleCommand{SemesterID: f.semester.ID, TimeoutSeconds: 5})
+	job.ID, job.ScheduleID, job.SemesterID = uuid.New(), uuid.New(), f.semester.ID
+	job.Params, job.MaxAttempts, job.CreatedAt = params, maxGenerationAttempts, time.Now()
+	f.jobs.jobs[job.ID] = &job
+	f.schedules.status[job.ScheduleID] = valueobject.ScheduleStatusGenerating
+	return &job
+}
+
+// start runs a worker with a short lease and poll interval; the returned
+// func stops it and waits for it to return.
+func (f *workerFixture) start(owner string) func() {
+	w := NewGenerationWorker(f.jobs, f.handler, owner, 1, zap.NewNop())
+	w.lease, w.pollInterval = 30*time.Millisecond, 5*time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		w.Start(ctx)
+		close(done)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// fakeJobRepository keeps jobs in memory with the lease rules of the SQL
+// queries: expired leases are claimed again until MaxAttempts, then reaped.
+type fakeJobRepository struct {
+	mu       sync.Mutex
+	jobs     map[uuid.UUID]*entity.GenerationJob
+	finished chan uuid.UUID
+}
+
+func (r *fakeJobRepository) get(id uuid.UUID) entity.GenerationJob {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return *r.jobs[id]
+}
+
+func (r *fakeJobRepository) waitFinished(t *testing.T, id uuid.UUID) entity.GenerationJob {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case got := <-r.finished:
+			if got == id {
+				return r.get(id)
+			}
+		case <-timeout:
+			t.Fatalf("job %s did not finish: %+v", id, r.get(id))
+		}
+	}
+}
+
+func (r *fakeJobRepository) expired(j *entity.GenerationJob, now time.Time) bool {
+	return j.Status == valueobject.JobStatusRunning && j.LeaseExpiresAt != nil && j.LeaseExpiresAt.Before(now)
+}
+
+func (r *fakeJobRepository) Create(_ context.Context, job *entity.GenerationJob) (*entity.GenerationJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job.ID, job.Status = uuid.New(), valueobject.JobStatusQueued
+	r.jobs[job.ID] = job
+	return job, nil
+}
+
+func (r *fakeJobRepository) GetBySchedule(_ context.Context, scheduleID uuid.UUID) (*entity.GenerationJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, j := range r.jobs {
+		if j.ScheduleID == scheduleID {
+			c := *j
+			return &c, nil
+		}
+	}
+	return nil, repository.ErrNoActiveJob
+}
+
+func (r *fakeJobRepository) Claim(_ context.Context, owner string, lease time.Duration) (*entity.GenerationJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for _, j := range r.jobs {
+		if j.CancelRequested || j.Attempts >= j.MaxAttempts {
+			continue
+		}
+		if j.Status == valueobject.JobStatusQueued || r.expired(j, now) {
+			expires := now.Add(lease)
+			j.Status, j.LeaseOwner, j.LeaseExpiresAt = valueobject.JobStatusRunning, owner, &expires
+			j.Attempts++
+			c := *j
+			return &c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeJobRepository) RenewLease(_ context.Context, id uuid.UUID, owner string, lease time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	j := r.jobs[id]
+	if j == nil || j.LeaseOwner != owner || j.Status != valueobject.JobStatusRunning {
+		return false, repository.ErrLeaseLost
+	}
+	expires := time.Now().Add(lease)
+	j.LeaseExpiresAt = &expires
+	return j.CancelRequested, nil
+}
+
+func (r *fakeJobRepository) Finish(_ context.Context, id uuid.UUID, owner string, status valueobject.JobStatus, errMsg string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	j := r.jobs[id]
+	if j == nil || j.LeaseOwner != owner || j.Status != valueobject.JobStatusRunning {
+		return repository.ErrLeaseLost
+	}
+	j.Status, j.ErrorMessage, j.LeaseExpiresAt = status, errMsg, nil
+	r.finished <- id
+	return nil
+}
+
+func (r *fakeJobRepository) RequestCancel(_ context.Context, scheduleID uuid.UUID) (*entity.GenerationJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, j := range r.jobs {
+		if j.ScheduleID != scheduleID || j.Status.IsTerminal() {
+			continue
+		}
+		j.CancelRequested = true
+		if j.Status == valueobject.JobStatusQueued {
+			j.Status = valueobject.JobStatusCancelled
+		}
+		c := *j
+		return &c, nil
+	}
+	return nil, repository.ErrNoActiveJob
+}
+
+func (r *fakeJobRepository) Release(_ context.Context, owner string, refund bool) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, j := range r.jobs {
+		if j.Status != valueobject.JobStatusRunning || j.LeaseOwner != owner {
+			continue
+		}
+		j.Status, j.LeaseOwner, j.LeaseExpiresAt = valueobject.JobStatusQueued, "", nil
+		if refund {
+			j.Attempts = max(j.Attempts-1, 0)
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (r *fakeJobRepository) Reap(_ context.Context) ([]*entity.GenerationJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	var reaped []*entity.GenerationJob
+	for _, j := range r.jobs {
+		if !(r.expired(j, now) && (j.CancelRequested || j.Attempts >= j.MaxAttempts)) &&
+			!(j.Status == valueobject.JobStatusQueued && j.Attempts >= j.MaxAttempts) {
+			continue
+		}
+		j.Status, j.ErrorMessage, j.LeaseExpiresAt = valueobject.JobStatusFailed, fmt.Sprintf("generation interrupted %d times", j.Attempts), nil
+		if j.CancelRequested {
+			j.Status, j.ErrorMessage = valueobject.JobStatusCancelled, "generation cancelled"
+		}
+		c := *j
+		reaped = append(reaped, &c)
+	}
+	return reaped, nil
+}
+
+// fakeScheduleRepository records what runs write to each schedule.
+type fakeScheduleRepository struct {
+	repository.ScheduleRepository
+	mu      sync.Mutex
+	status  map[uuid.UUID]valueobject.ScheduleStatus
+	entries map[uuid.UUID]int
+	cleared map[uuid.UUID]bool
+	results map[uuid.UUID]bool // a successful result was stored
+}
+
+func newFakeScheduleRepository() *fakeScheduleRepository {
+	return &fakeScheduleRepository{
+		status:  map[uuid.UUID]valueobject.ScheduleStatus{},
+		entries: map[uuid.UUID]int{},
+		cleared: map[uuid.UUID]bool{},
+		results: map[uuid.UUID]bool{},
+	}
+}
+
+func (r *fakeScheduleRepository) DeleteAllEntries(_ context.Context, scheduleID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[scheduleID], r.cleared[scheduleID] = 0, true
+	return nil
+}
+
+func (r *fakeScheduleRepository) CreateEntry(_ context.Context, e *entity.ScheduleEntry) (*entity.ScheduleEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[e.ScheduleID]++
+	return e, nil
+}
+
+func (r *fakeScheduleRepository) UpdateResult(_ context.Context, id uuid.UUID, score float64, _ int, _ float64) (*entity.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results[id] = score >= 0 // failures store -1
+	return &entity.Schedule{ID: id}, nil
+}
+
+func (r *fakeScheduleRepository) UpdateStatus(_ context.Context, id uuid.UUID, status valueobject.ScheduleStatus) (*entity.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status[id] = status
+	return &entity.Schedule{ID: id, Status: status}, nil
+}
+
+func (r *fakeScheduleRepository) UpdateFailure(_ context.Context, id uuid.UUID, _ string, _ *entity.GenerationDiagnosis) (*entity.Schedule, error) {
+	return &entity.Schedule{ID: id}, nil
+}
+
+func (r *fakeScheduleRepository) UpdateCohortClashes(_ context.Context, id uuid.UUID, _ int) (*entity.Schedule, error) {
+	return &entity.Schedule{ID: id}, nil
+}
+
+func (r *fakeScheduleRepository) AppendEvent(context.Context, uuid.UUID, string, string, json.RawMessage) error {
+	return nil
+}
+
+// fakeSemesterRepository serves one semester. With block set, GetByID closes
+// it and then waits for the run to be stopped.
+type fakeSemesterRepository struct {
+	repository.SemesterRepository
+	semester *entity.Semester
+	slots    []*entity.TimeSlot
+	block    chan struct{}
+}
+
+func (r *fakeSemesterRepository) GetByID(ctx context.Context, _ uuid.UUID) (*entity.Semester, error) {
+	if r.block != nil {
+		close(r.block)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return r.semester, nil
+}
+
+func (r *fakeSemesterRepository) ListTimeSlots(context.Context, uuid.UUID) ([]*entity.TimeSlot, error) {
+	return r.slots, nil
+}
+
+type fakeRoomRepository struct {
+	repository.RoomRepository
+	rooms []*entity.Room
+}
+
+func (r *fakeRoomRepository) List(context.Context, int32, int32) ([]*entity.Room, error) {
+	return r.rooms, nil
+}
+
+// fakeTeacherClient lists one active teacher with no availability limits.
+type fakeTeacherClient struct {
+	hrv1.TeacherServiceClient
+	id uuid.UUID
+}
+
+func (c *fakeTeacherClient) ListTeachers(context.Context, *hrv1.ListTeachersRequest, ...grpc.CallOption) (*hrv1.ListTeachersResponse, error) {
+	return &hrv1.ListTeachersResponse{Teachers: []*hrv1.Teacher{{Id: c.id.String(), FullName: "Teacher", IsActive: true}}}, nil
+}
+
+func (c *fakeTeacherClient) ListTeacherAvailability(context.Context, *hrv1.ListTeacherAvailabilityRequest, ...grpc.CallOption) (*hrv1.ListTeacherAvailabilityResponse, error) {
+	return &hrv1.ListTeacherAvailabilityResponse{}, nil
+}
+
+// fakeSubjectClient lists one subject and no offerings.
+type fakeSubjectClient struct {
+	subjectv1.SubjectServiceClient
+	id uuid.UUID
+}
+
+func (c *fakeSubjectClient) ListSubjects(context.Context, *subjectv1.ListSubjectsRequest, ...grpc.CallOption) (*subjectv1.ListSubjectsResponse, error) {
+	return &subjectv1.ListSubjectsResponse{Subjects: []*subjectv1.Subject{{Id: c.id.String(), Code: "MATH1"}}}, nil
+}
+
+func (c *fakeSubjectClient) ListSemesterOfferings(context.Context, *subjectv1.ListSemesterOfferingsRequest, ...grpc.CallOption) (*subjectv1.ListSemesterOfferingsResponse, error) {
+	return &subjectv1.ListSemesterOfferingsResponse{}, nil
+}
+
+type fakePublisher struct{}
+
+func (fakePublisher) Publish(context.Context, string, any) error { return nil }
@@ -3,18 +3,74 @@ package command
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
+	infragrpc "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/grpc"
+
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 )
 
-// ManualAssignCommand requests assigning a specific teacher to an existing entry.
+// ManualAssignCommand requests overriding the teacher, room or slot of an
+// existing entry. Zero values keep the entry's current teacher, room and slot.
 type ManualAssignCommand struct {
 	ScheduleID uuid.UUID
 	EntryID    uuid.UUID
 	TeacherID  uuid.UUID
+	RoomID     uuid.UUID
+	RoomName   string // alternative to RoomID
+	SlotID     uuid.UUID
+	SlotAt     *SlotRef // alternative to SlotID
+	DryRun     bool     // validate and score the edit without saving it
+}
+
+// SlotRef locates a semester time slot by day and periods instead of by ID.
+type SlotRef struct {
+	DayOfWeek   int
+	StartPeriod int
+	EndPeriod   int
+}
+
+// ErrInvalidEdit is returned when a manual edit names a teacher, room or slot
+// that is not available to the schedule's semester.
+var ErrInvalidEdit = errors.New("invalid schedule edit")
+
+// SlotTakenError rejects an edit that would book one of its teachers (lead or
+// assistant) or its room twice in the same time slot. Such an edit cannot be saved, unlike other violations;
+// Violations lists the clashes. It unwraps to repository.ErrSlotTaken.
+type SlotTakenError struct {
+	Violations []EntryViolation
+}
+
+func (e *SlotTakenError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, fmt.Sprintf("%s with entry %s", v.Constraint, v.OtherEntryID))
+	}
+	return fmt.Sprintf("%s: %s", repository.ErrSlotTaken, strings.Join(parts, ", "))
+}
+
+func (e *SlotTakenError) Unwrap() error { return repository.ErrSlotTaken }
+
+// EntryViolation is a hard constraint broken by a schedule, expressed in
+// terms of its entries.
+type EntryViolation struct {
+	Constraint   valueobject.ConstraintType
+	EntryID      uuid.UUID // uuid.Nil for teacher overloads
+	OtherEntryID uuid.UUID // set only for conflicts between two entries
+	TeacherID    uuid.UUID // set only for teacher overloads and assistant violations
+}
+
+// ManualAssignResult is the outcome of a manual edit or its dry run.
+type ManualAssignResult struct {
+	Entry      *entity.ScheduleEntry // the edited entry; not saved on a dry run
+	Schedule   *entity.Schedule      // score and violation counts after the edit
+	Violations []EntryViolation      // hard violations the edit introduces
 }
 
 // EventPublisher is the minimal interface the handler needs for publishing.
@@ -22,50 +78,283 @@ type EventPublisher interface {
 	Publish(ctx context.Context, subject string, payload any) error
 }
 
-// ManualAssignHandler executes the ManualAssign use case.
+// ManualAssignHandler executes the ManualAssign use case. Every edit is
+// checked against the hard constraints of the whole schedule; it is saved
+// even when it breaks some, but the schedule's violation count, score and
+// penalty are recomputed so that Publish refuses it until they are fixed.
+// Assistants are checked too, and revalidated when the entry moves. The
+// exception is an edit booking one of its teachers or its room twice in one
+// slot, which the schedule cannot store and is refused with a SlotTakenError.
 type ManualAssignHandler struct {
-	repo      repository.ScheduleRepository
-	publisher EventPublisher
+	semesterRepo  repository.SemesterRepository
+	scheduleRepo  repository.ScheduleRepository
+	jobRepo       repository.GenerationJobRepository
+	roomRepo      repository.RoomRepository
+	campusRepo    repository.CampusRepository
+	hrClient      *infragrpc.HRClient
+	subjectClient *infragrpc.SubjectClient
+	studentClient *infragrpc.StudentClient
+	publisher     EventPublisher
 }
 
-func NewManualAssignHandler(repo repository.ScheduleRepository, publisher EventPublisher) *ManualAssignHandler {
-	return &ManualAssignHandler{repo: repo, publisher: publisher}
+func NewManualAssignHandler(
+	semesterRepo repository.SemesterRepository,
+	scheduleRepo repository.ScheduleRepository,
+	jobRepo repository.GenerationJobRepository,
+	roomRepo repository.RoomRepository,
+	campusRepo repository.CampusRepository,
+	hrClient *infragrpc.HRClient,
+	subjectClient *infragrpc.SubjectClient,
+	studentClient *infragrpc.StudentClient,
+	publisher EventPublisher,
+) *ManualAssignHandler {
+	return &ManualAssignHandler{
+		semesterRepo:  semesterRepo,
+		scheduleRepo:  scheduleRepo,
+		jobRepo:       jobRepo,
+		roomRepo:      roomRepo,
+		campusRepo:    campusRepo,
+		hrClient:      hrClient,
+		subjectClient: subjectClient,
+		studentClient: studentClient,
+		publisher:     publisher,
+	}
 }
 
-func (h *ManualAssignHandler) Handle(ctx context.Context, cmd ManualAssignCommand) (*entity.ScheduleEntry, error) {
-	// 1. Load existing entry
-	entry, err := h.repo.GetEntry(ctx, cmd.EntryID)
+func (h *ManualAssignHandler) Handle(ctx context.Context, cmd ManualAssignCommand) (*ManualAssignResult, error) {
+	// 1. Load the schedule and locate the entry among its siblings
+	schedule, err := h.scheduleRepo.GetByID(ctx, cmd.ScheduleID)
 	if err != nil {
-		return nil, fmt.Errorf("get entry: %w", err)
+		return nil, fmt.Errorf("get schedule: %w", err)
 	}
-	if entry.ScheduleID != cmd.ScheduleID {
+	entries, err := h.scheduleRepo.ListEntries(ctx, schedule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list entries: %w", err)
+	}
+	keyed := keyEntries(entries)
+	editedKey := ""
+	for key, ke := range keyed {
+		if ke.entry.ID == cmd.EntryID {
+			editedKey = key
+		}
+	}
+	if editedKey == "" {
 		return nil, fmt.Errorf("entry %s does not belong to schedule %s", cmd.EntryID, cmd.ScheduleID)
 	}
+	entry := keyed[editedKey].entry
 
-	// 2. Apply override
-	entry.TeacherID = cmd.TeacherID
-	entry.IsManualOverride = true
+	// 2. Build a checker configured like the run that produced the schedule
+	semester, err := h.semesterRepo.GetByID(ctx, schedule.SemesterID)
+	if err != nil {
+		return nil, fmt.Errorf("get semester: %w", err)
+	}
+	loader := &solverInputLoader{
+		semesterRepo:  h.semesterRepo,
+		roomRepo:      h.roomRepo,
+		campusRepo:    h.campusRepo,
+		hrClient:      h.hrClient,
+		subjectClient: h.subjectClient,
+		studentClient: h.studentClient,
+	}
+	in, err := loader.load(ctx, semester, generationOptions(ctx, h.jobRepo, schedule.ID))
+	if err != nil {
+		return nil, err
+	}
 
-	// 3. Persist
-	updated, err := h.repo.UpdateEntry(ctx, entry)
+	// 3. Resolve the new value, keeping whatever the command leaves unset
+	val, err := resolveEdit(cmd, entry, in)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Compare the schedule's violations before and after the edit
+	assignment := make(map[string]service.Assignment, len(keyed))
+	assistants := map[string][]uuid.UUID{}
+	for key, ke := range keyed {
+		assignment[key] = service.Assignment{TeacherID: ke.entry.TeacherID, RoomID: ke.entry.RoomID, SlotID: ke.entry.TimeSlotID}
+		for _, a := range ke.entry.Assistants {
+			assistants[key] = append(assistants[key], a.TeacherID)
+		}
+	}
+	violations := func() []service.Violation {
+		return append(in.checker.Violations(assignment, in.slotMap),
+			in.checker.AssistantViolations(assignment, assistants, in.slotMap)...)
+	}
+	before := violations()
+	assignment[editedKey] = val
+	after := violations()
+	added := service.NewViolations(before, after)
+
+	penalty := in.checker.EvaluateSoftConstraints(assignment, in.slotMap)
+	preview := *schedule
+	preview.Score = 100.0 - penalty
+	preview.HardViolations = len(after)
+	preview.SoftPenalty = penalty
+	preview.CohortClashes = in.checker.CohortClashes(assignment, in.slotMap)
+
+	edited := *entry
+	edited.TeacherID = val.TeacherID
+	edited.RoomID = val.RoomID
+	edited.TimeSlotID = val.SlotID
+	edited.TeacherName = in.teacherNames[val.TeacherID]
+	edited.IsManualOverride = true
+	if slot := in.slotMap[val.SlotID]; slot != nil {
+		edited.DayOfWeek, edited.StartPeriod, edited.EndPeriod = slot.DayOfWeek, slot.StartPeriod, slot.EndPeriod
+	}
+
+	result := &ManualAssignResult{
+		Entry:      &edited,
+		Schedule:   &preview,
+		Violations: entryViolations(added, keyed),
+	}
+	if cmd.DryRun {
+		return result, nil
+	}
+	if clashes := slotClashes(&edited, entries); len(clashes) > 0 {
+		return nil, &SlotTakenError{Violations: clashes}
+	}
+
+	// 5. Persist the entry and the recomputed schedule totals
+	updated, err := h.scheduleRepo.UpdateEntry(ctx, &edited)
 	if err != nil {
 		return nil, fmt.Errorf("update entry: %w", err)
 	}
+	result.Entry = updated
+	if _, err := h.scheduleRepo.UpdateResult(ctx, schedule.ID, preview.Score, preview.HardViolations, preview.SoftPenalty); err != nil {
+		return nil, fmt.Errorf("update schedule result: %w", err)
+	}
+	_, _ = h.scheduleRepo.UpdateCohortClashes(ctx, schedule.ID, preview.CohortClashes)
+
+	// 6. Append event to event store
+	data := map[string]any{
+		"schedule_id":     cmd.ScheduleID.String(),
+		"entry_id":        cmd.EntryID.String(),
+		"teacher_id":      val.TeacherID.String(),
+		"room_id":         val.RoomID.String(),
+		"time_slot_id":    val.SlotID.String(),
+		"new_violations":  len(added),
+		"hard_violations": preview.HardViolations,
+	}
+	payload, _ := json.Marshal(data)
+	_ = h.scheduleRepo.AppendEvent(ctx, cmd.ScheduleID, "Schedule", "ManualAssignment", payload)
+
+	// 7. Publish to NATS (non-blocking — don't fail the operation on publish error)
+	_ = h.publisher.Publish(ctx, "timetable.entry.assigned", data)
+
+	return result, nil
+}
+
+// generationOptions returns the solver switches the schedule was generated
+// with, so that a rule the run relaxed is not reported as broken. Schedules
+// without a job record are checked with the defaults.
+func generationOptions(ctx context.Context, jobRepo repository.GenerationJobRepository, scheduleID uuid.UUID) solverOptions {
+	if jobRepo == nil {
+		return solverOptions{}
+	}
+	job, err := jobRepo.GetBySchedule(ctx, scheduleID)
+	if err != nil {
+		return solverOptions{}
+	}
+	var cmd GenerateScheduleCommand
+	if err := json.Unmarshal(job.Params, &cmd); err != nil {
+		return solverOptions{}
+	}
+	return solverOptions{BindSessionTeacher: cmd.BindSessionTeacher, SoftCohortClash: cmd.SoftCohortClash}
+}
+
+// resolveEdit turns the command into a full assignment for the entry.
+func resolveEdit(cmd ManualAssignCommand, entry *entity.ScheduleEntry, in *solverInputs) (service.Assignment, error) {
+	val := service.Assignment{TeacherID: entry.TeacherID, RoomID: entry.RoomID, SlotID: entry.TimeSlotID}
+
+	if cmd.TeacherID != uuid.Nil {
+		if _, ok := in.teacherNames[cmd.TeacherID]; !ok {
+			return val, fmt.Errorf("%w: teacher %s is not an active teacher", ErrInvalidEdit, cmd.TeacherID)
+		}
+		val.TeacherID = cmd.TeacherID
+	}
 
-	// 4. Append event to event store
-	payload, _ := json.Marshal(map[string]string{
-		"schedule_id": cmd.ScheduleID.String(),
-		"entry_id":    cmd.EntryID.String(),
-		"teacher_id":  cmd.TeacherID.String(),
-	})
-	_ = h.repo.AppendEvent(ctx, cmd.ScheduleID, "Schedule", "ManualAssignment", payload)
+	if cmd.RoomID != uuid.Nil || cmd.RoomName != "" {
+		found := false
+		for _, r := range in.rooms {
+			if r.ID == cmd.RoomID || (cmd.RoomID == uuid.Nil && r.Name == cmd.RoomName) {
+				val.RoomID, found = r.ID, true
+				break
+			}
+		}
+		if !found {
+			room := cmd.RoomName
+			if cmd.RoomID != uuid.Nil {
+				room = cmd.RoomID.String()
+			}
+			return val, fmt.Errorf("%w: room %s is not available to the semester", ErrInvalidEdit, room)
+		}
+	}
 
-	// 5. Publish to NATS (non-blocking — don't fail the operation on publish error)
-	_ = h.publisher.Publish(ctx, "timetable.entry.assigned", map[string]string{
-		"schedule_id": cmd.ScheduleID.String(),
-		"entry_id":    cmd.EntryID.String(),
-		"teacher_id":  cmd.TeacherID.String(),
-	})
+	switch {
+	case cmd.SlotID != uuid.Nil:
+		if in.slotMap[cmd.SlotID] == nil {
+			return val, fmt.Errorf("%w: slot %s does not belong to the semester", ErrInvalidEdit, cmd.SlotID)
+		}
+		val.SlotID = cmd.SlotID
+	case cmd.SlotAt != nil:
+		found := false
+		for _, sl := range in.slots {
+			if sl.DayOfWeek == cmd.SlotAt.DayOfWeek && sl.StartPeriod == cmd.SlotAt.StartPeriod && sl.EndPeriod == cmd.SlotAt.EndPeriod {
+				val.SlotID, found = sl.ID, true
+				break
+			}
+		}
+		if !found {
+			return val, fmt.Errorf("%w: no slot on day %d, periods %d-%d", ErrInvalidEdit,
+				cmd.SlotAt.DayOfWeek, cmd.SlotAt.StartPeriod, cmd.SlotAt.EndPeriod)
+		}
+	}
+	return val, nil
+}
 
-	return updated, nil
+// slotClashes lists the entries holding one of the edited entry's teachers,
+// lead or assistant, or its room in its very slot.
+func slotClashes(edited *entity.ScheduleEntry, entries []*entity.ScheduleEntry) []EntryViolation {
+	var clashes []EntryViolation
+	for _, e := range entries {
+		if e.ID == edited.ID || e.TimeSlotID != edited.TimeSlotID {
+			continue
+		}
+		if sharesTeacher(edited, e) {
+			clashes = append(clashes, EntryViolation{Constraint: valueobject.ConstraintTeacherConflict, EntryID: edited.ID, OtherEntryID: e.ID})
+		}
+		if e.RoomID == edited.RoomID {
+			clashes = append(clashes, EntryViolation{Constraint: valueobject.ConstraintRoomConflict, EntryID: edited.ID, OtherEntryID: e.ID})
+		}
+	}
+	return clashes
+}
+
+// sharesTeacher reports whether two entries have a teacher in common, in
+// either role.
+func sharesTeacher(a, b *entity.ScheduleEntry) bool {
+	for _, ta := range a.Teachers() {
+		for _, tb := range b.Teachers() {
+			if ta.TeacherID == tb.TeacherID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// entryViolations maps solver keys back to the entries they were built from.
+func entryViolations(violations []service.Violation, keyed map[string]keyedEntry) []EntryViolation {
+	out := make([]EntryViolation, 0, len(violations))
+	for _, v := range violations {
+		ev := EntryViolation{Constraint: v.Constraint, TeacherID: v.TeacherID}
+		if ke, ok := keyed[v.Key]; ok {
+			ev.EntryID = ke.entry.ID
+		}
+		if ke, ok := keyed[v.OtherKey]; ok {
+			ev.OtherEntryID = ke.entry.ID
+		}
+		out = append(out, ev)
+	}
+	return out
 }
@@ -0,0 +1,123 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+)
+
+// PinnedAssignment pre-places one weekly session of a subject's section
+// before generation. Nil fields are left to the solver.
+type PinnedAssignment struct {
+	SubjectID uuid.UUID
+	Section   int // 1-based section; 0 is the first
+	Session   int // 0-based weekly session index
+	TeacherID *uuid.UUID
+	RoomID    *uuid.UUID
+	SlotID    *uuid.UUID
+}
+
+// validatePins rejects pins for subjects the semester does not offer and
+// duplicate pins for the same session.
+func validatePins(pins []PinnedAssignment, semester *entity.Semester) error {
+	offered := idSet(semester.OfferedSubjectIDs)
+	seen := make(map[string]bool, len(pins))
+	for _, p := range pins {
+		if !offered[p.SubjectID] {
+			return fmt.Errorf("%w: subject %s is not offered in the semester", ErrInvalidPin, p.SubjectID)
+		}
+		if p.Session < 0 || p.Section < 0 {
+			return fmt.Errorf("%w: negative session or section for subject %s", ErrInvalidPin, p.SubjectID)
+		}
+		key := p.key()
+		if seen[key] {
+			return fmt.Errorf("%w: subject %s section %d session %d pinned twice", ErrInvalidPin, p.SubjectID, max(p.Section, 1), p.Session)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// key is the solver variable key of the pinned session.
+func (p PinnedAssignment) key() string {
+	return service.ScheduleVariable{SubjectID: p.SubjectID, Section: p.Section, Session: p.Session}.Key()
+}
+
+// preplacements merges the locked entries of a previous schedule with the
+// explicit pins; an explicit pin wins over a lock on the same session. Locks
+// whose subject session, teacher, room or slot no longer exists are dropped
+// and counted rather than failing the run.
+func preplacements(
+	ctx context.Context,
+	scheduleRepo repository.ScheduleRepository,
+	cmd GenerateScheduleCommand,
+	variables []service.ScheduleVariable,
+	in *solverInputs,
+) (map[string]service.PartialAssignment, int, error) {
+	known := make(map[string]bool, len(variables))
+	for _, v := range variables {
+		known[v.Key()] = true
+	}
+	preplaced := map[string]service.PartialAssignment{}
+	dropped := 0
+
+	if cmd.LockedFromScheduleID != uuid.Nil {
+		entries, err := scheduleRepo.ListEntries(ctx, cmd.LockedFromScheduleID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("list locked entries: %w", err)
+		}
+		valid := validAssignments(in)
+		for key, ke := range keyEntries(entries) {
+			if !ke.entry.IsManualOverride {
+				continue
+			}
+			e := ke.entry
+			if !known[key] || !valid(service.Assignment{TeacherID: e.TeacherID, RoomID: e.RoomID, SlotID: e.TimeSlotID}) {
+				dropped++
+				continue
+			}
+			preplaced[key] = service.PartialAssignment{TeacherID: &e.TeacherID, RoomID: &e.RoomID, SlotID: &e.TimeSlotID}
+		}
+	}
+
+	for _, p := range cmd.Pins {
+		key := p.key()
+		if !known[key] {
+			return nil, 0, fmt.Errorf("%w: subject %s has no section %d session %d", ErrInvalidPin, p.SubjectID, max(p.Section, 1), p.Session)
+		}
+		preplaced[key] = service.PartialAssignment{TeacherID: p.TeacherID, RoomID: p.RoomID, SlotID: p.SlotID}
+	}
+	return preplaced, dropped, nil
+}
+
+// lockedSlot is a section of a subject in one time slot.
+type lockedSlot struct {
+	subjectID uuid.UUID
+	section   int
+	slotID    uuid.UUID
+}
+
+// lockedSlots returns the slots the pre-placed sessions ended up in, so their
+// entries can be stored as locked for the next regeneration.
+func lockedSlots(
+	variables []service.ScheduleVariable,
+	preplaced map[string]service.PartialAssignment,
+	assignment map[string]service.Assignment,
+) map[lockedSlot]bool {
+	locked := make(map[lockedSlot]bool, len(preplaced))
+	for _, v := range variables {
+		key := v.Key()
+		if _, ok := preplaced[key]; !ok {
+			continue
+		}
+		if a, ok := assignment[key]; ok {
+			locked[lockedSlot{v.SubjectID, max(v.Section, 1), a.SlotID}] = true
+		}
+	}
+	return locked
+}
@@ -2,37 +2,113 @@ package command
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
-	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
 )
 
-// PublishScheduleCommand requests transitioning a draft schedule to published.
+// PublishScheduleCommand requests making a schedule the semester's published one.
 type PublishScheduleCommand struct {
 	ScheduleID uuid.UUID
 }
 
-// PublishScheduleHandler executes the PublishSchedule use case.
+// PublishScheduleResult is the published schedule and the version recorded for it.
+type PublishScheduleResult struct {
+	Schedule *entity.Schedule
+	Version  *entity.ScheduleVersion
+	Archived []uuid.UUID // previously published schedules that were archived
+}
+
+// PublishScheduleHandler executes the PublishSchedule use case. A semester has
+// at most one published schedule, so publishing archives the previous one.
 type PublishScheduleHandler struct {
-	repo repository.ScheduleRepository
+	scheduleRepo repository.ScheduleRepository
+	versionRepo  repository.ScheduleVersionRepository
+	semesterRepo repository.SemesterRepository
+	publisher    EventPublisher
 }
 
-func NewPublishScheduleHandler(repo repository.ScheduleRepository) *PublishScheduleHandler {
-	return &PublishScheduleHandler{repo: repo}
+func NewPublishScheduleHandler(
+	scheduleRepo repository.ScheduleRepository,
+	versionRepo repository.ScheduleVersionRepository,
+	semesterRepo repository.SemesterRepository,
+	publisher EventPublisher,
+) *PublishScheduleHandler {
+	return &PublishScheduleHandler{scheduleRepo: scheduleRepo, versionRepo: versionRepo, semesterRepo: semesterRepo, publisher: publisher}
 }
 
-func (h *PublishScheduleHandler) Handle(ctx context.Context, cmd PublishScheduleCommand) error {
-	schedule, err := h.repo.GetByID(ctx, cmd.ScheduleID)
+// Handle returns an error wrapping entity.ErrStatusTransition or
+// entity.ErrHardViolations when the schedule cannot be published.
+func (h *PublishScheduleHandler) Handle(ctx context.Context, cmd PublishScheduleCommand) (*PublishScheduleResult, error) {
+	// Check up front for a clear error; the repository checks again under lock
+	schedule, err := h.scheduleRepo.GetByID(ctx, cmd.ScheduleID)
 	if err != nil {
-		return fmt.Errorf("get schedule: %w", err)
+		return nil, fmt.Errorf("get schedule: %w", err)
 	}
 	if err := schedule.Publish(); err != nil {
-		return fmt.Errorf("publish schedule: %w", err)
+		return nil, fmt.Errorf("publish schedule: %w", err)
+	}
+
+	version, archived, err := h.versionRepo.Publish(ctx, cmd.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	return h.announce(ctx, schedule, version, archived), nil
+}
+
+// announce records and publishes the event of a committed publication. The
+// schedule is reloaded for its new status, falling back to the given copy.
+func (h *PublishScheduleHandler) announce(ctx context.Context, schedule *entity.Schedule, version *entity.ScheduleVersion, archived []uuid.UUID) *PublishScheduleResult {
+	if refreshed, err := h.scheduleRepo.GetByID(ctx, version.ScheduleID); err == nil {
+		schedule = refreshed
+	}
+
+	archivedIDs := make([]string, len(archived))
+	for i, id := range archived {
+		archivedIDs[i] = id.String()
+	}
+	data := map[string]any{
+		"schedule_id":  schedule.ID.String(),
+		"semester_id":  schedule.SemesterID.String(),
+		"version":      version.Version,
+		"archived_ids": archivedIDs,
+	}
+	if workload := h.workload(ctx, schedule); workload != nil {
+		data["workload"] = workload
+	}
+	payload, _ := json.Marshal(data)
+	_ = h.scheduleRepo.AppendEvent(ctx, schedule.ID, "Schedule", "SchedulePublished", payload)
+	_ = h.publisher.Publish(ctx, "timetable.schedule.published", data)
+
+	return &PublishScheduleResult{Schedule: schedule, Version: version, Archived: archived}
+}
+
+// workload lists the published hours per teacher, subject and role for analytics,
+// or nil when the semester or entries cannot be loaded; publishing does not
+// fail over it.
+func (h *PublishScheduleHandler) workload(ctx context.Context, schedule *entity.Schedule) []map[string]any {
+	semester, err := h.semesterRepo.GetByID(ctx, schedule.SemesterID)
+	if err != nil {
+		return nil
+	}
+	entries, err := h.scheduleRepo.ListEntries(ctx, schedule.ID)
+	if err != nil {
+		return nil
 	}
-	if _, err := h.repo.UpdateStatus(ctx, cmd.ScheduleID, valueobject.ScheduleStatusPublished); err != nil {
-		return fmt.Errorf("persist published status: %w", err)
+	loads := service.TeachingLoads(semester, entries)
+	out := make([]map[string]any, len(loads))
+	for i, l := range loads {
+		out[i] = map[string]any{
+			"teacher_id":     l.TeacherID.String(),
+			"subject_id":     l.SubjectID.String(),
+			"role":           string(l.Role),
+			"hours_per_week": l.HoursPerWeek,
+			"total_hours":    l.TotalHours,
+		}
 	}
-	return nil
+	return out
 }
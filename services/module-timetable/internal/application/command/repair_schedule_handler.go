@@ -0,0 +1,325 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	infragrpc "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/grpc"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// RepairScheduleCommand re-solves only the entries of an existing schedule
+// that a change has invalidated. Entries whose teacher is no longer active in
+// HR, whose room is no longer available, or whose slot no longer belongs to
+// the semester are invalidated even when not listed explicitly.
+type RepairScheduleCommand struct {
+	ScheduleID        uuid.UUID
+	RemovedTeacherIDs []uuid.UUID // teachers who can no longer teach
+	ClosedRoomIDs     []uuid.UUID // rooms that can no longer be used
+	RemovedSlotIDs    []uuid.UUID // slots that are about to be deleted
+	TimeoutSeconds    int         // solver wall-clock timeout (default 10)
+}
+
+// RepairScheduleResult summarises what a repair changed.
+type RepairScheduleResult struct {
+	Schedule   *entity.Schedule
+	Entries    []*entity.ScheduleEntry
+	Affected   int // entries invalidated by the change
+	Reassigned int // affected entries given a new teacher, room or slot
+	Moved      int // reassigned entries whose room or slot changed
+	Unresolved int // affected entries the solver could not place; left unchanged
+}
+
+// RepairScheduleHandler repairs an unpublished schedule in place, under the
+// solver options it was generated with: manual overrides and unaffected
+// entries are pinned and the solver prefers values that keep each affected
+// class where it was. A manual override whose teacher, room or slot is gone
+// cannot stand, so it is re-solved like any other affected entry.
+type RepairScheduleHandler struct {
+	semesterRepo  repository.SemesterRepository
+	scheduleRepo  repository.ScheduleRepository
+	jobRepo       repository.GenerationJobRepository
+	roomRepo      repository.RoomRepository
+	campusRepo    repository.CampusRepository
+	hrClient      *infragrpc.HRClient
+	subjectClient *infragrpc.SubjectClient
+	studentClient *infragrpc.StudentClient
+	publisher     EventPublisher
+}
+
+func NewRepairScheduleHandler(
+	semesterRepo repository.SemesterRepository,
+	scheduleRepo repository.ScheduleRepository,
+	jobRepo repository.GenerationJobRepository,
+	roomRepo repository.RoomRepository,
+	campusRepo repository.CampusRepository,
+	hrClient *infragrpc.HRClient,
+	subjectClient *infragrpc.SubjectClient,
+	studentClient *infragrpc.StudentClient,
+	publisher EventPublisher,
+) *RepairScheduleHandler {
+	return &RepairScheduleHandler{
+		semesterRepo:  semesterRepo,
+		scheduleRepo:  scheduleRepo,
+		jobRepo:       jobRepo,
+		roomRepo:      roomRepo,
+		campusRepo:    campusRepo,
+		hrClient:      hrClient,
+		subjectClient: subjectClient,
+		studentClient: studentClient,
+		publisher:     publisher,
+	}
+}
+
+// Handle runs the repair synchronously. It returns an error wrapping
+// entity.ErrStatusTransition for a schedule that is not a draft, and one
+// wrapping service.ErrNoFeasibleSolution when the affected entries cannot be
+// placed around the pinned ones.
+func (h *RepairScheduleHandler) Handle(ctx context.Context, cmd RepairScheduleCommand) (*RepairScheduleResult, error) {
+	schedule, err := h.scheduleRepo.GetByID(ctx, cmd.ScheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("get schedule: %w", err)
+	}
+	// A published schedule must be unpublished first, never changed live
+	if schedule.Status != valueobject.ScheduleStatusDraft && schedule.Status != valueobject.ScheduleStatusCompleted {
+		return nil, fmt.Errorf("%w: only draft or completed schedules can be repaired, current: %s",
+			entity.ErrStatusTransition, schedule.Status)
+	}
+	semester, err := h.semesterRepo.GetByID(ctx, schedule.SemesterID)
+	if err != nil {
+		return nil, fmt.Errorf("get semester: %w", err)
+	}
+	entries, err := h.scheduleRepo.ListEntries(ctx, schedule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list entries: %w", err)
+	}
+
+	timeout := cmd.TimeoutSeconds
+	if timeout <= 0 {
+		timeout = 10
+	}
+	solveCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	loader := &solverInputLoader{
+		semesterRepo:  h.semesterRepo,
+		roomRepo:      h.roomRepo,
+		campusRepo:    h.campusRepo,
+		hrClient:      h.hrClient,
+		subjectClient: h.subjectClient,
+		studentClient: h.studentClient,
+	}
+	in, err := loader.load(solveCtx, semester, generationOptions(ctx, h.jobRepo, schedule.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Drop removed teachers, closed rooms and removed slots from the inputs
+	in.teachers = withoutTeachers(in.teachers, cmd.RemovedTeacherIDs)
+	in.rooms = withoutRooms(in.rooms, cmd.ClosedRoomIDs)
+	in.slots = withoutSlots(in.slots, cmd.RemovedSlotIDs)
+
+	// Split entries into pinned and affected, keyed like solver variables;
+	// an entry with a removed assistant is re-staffed as a whole
+	valid := validAssignments(in)
+	active := make(map[uuid.UUID]bool, len(in.teachers))
+	for _, t := range in.teachers {
+		active[t.ID] = true
+	}
+	keyed := keyEntries(entries)
+	pinned := map[string]service.Assignment{}
+	pinnedAssistants := map[string][]uuid.UUID{}
+	anchors := map[string]service.Assignment{}
+	var variables []service.ScheduleVariable
+	for key, ke := range keyed {
+		a := service.Assignment{TeacherID: ke.entry.TeacherID, RoomID: ke.entry.RoomID, SlotID: ke.entry.TimeSlotID}
+		staffed := true
+		var assistants []uuid.UUID
+		for _, at := range ke.entry.Assistants {
+			staffed = staffed && active[at.TeacherID]
+			assistants = append(assistants, at.TeacherID)
+		}
+		if valid(a) && staffed {
+			pinned[key] = a
+			if len(assistants) > 0 {
+				pinnedAssistants[key] = assistants
+			}
+			continue
+		}
+		anchors[key] = a
+		variables = append(variables, service.ScheduleVariable{
+			SubjectID:   ke.entry.SubjectID,
+			SubjectCode: ke.entry.SubjectCode,
+			Session:     ke.session,
+			Section:     ke.entry.SectionNumber(),
+			Assistants:  in.assistantsNeeded(ke.entry.SubjectID, ke.entry.SectionNumber()),
+		})
+	}
+	sort.Slice(variables, func(i, j int) bool { return variables[i].Key() < variables[j].Key() })
+
+	result := &RepairScheduleResult{Schedule: schedule, Entries: entries, Affected: len(variables)}
+	if len(variables) == 0 {
+		return result, nil
+	}
+
+	solver := service.NewCSPSolverFromCandidates(variables, buildCandidates(in.teachers, in.rooms, in.slots), in.slotMap, in.checker)
+	solver.SetPinned(pinned)
+	solver.SetPinnedAssistants(pinnedAssistants)
+	solver.SetAnchors(anchors)
+	solved, err := solver.Solve(solveCtx)
+	if err != nil {
+		return nil, fmt.Errorf("repair schedule: %w", err)
+	}
+
+	// Persist the reassigned entries; unplaced ones are left as they were
+	for _, v := range variables {
+		key := v.Key()
+		val, ok := solved.Assignment[key]
+		if !ok {
+			result.Unresolved++
+			continue
+		}
+		entry := keyed[key].entry
+		if val.RoomID != entry.RoomID || val.SlotID != entry.TimeSlotID {
+			result.Moved++
+		}
+		entry.TeacherID = val.TeacherID
+		entry.RoomID = val.RoomID
+		entry.TimeSlotID = val.SlotID
+		entry.TeacherName = in.teacherNames[val.TeacherID]
+		entry.Assistants = in.entryAssistants(solved.Assistants[key])
+		entry.IsManualOverride = false
+		if _, err := h.scheduleRepo.UpdateEntry(ctx, entry); err != nil {
+			return nil, fmt.Errorf("update entry %s: %w", entry.ID, err)
+		}
+		result.Reassigned++
+	}
+
+	_, _ = h.scheduleRepo.UpdateResult(ctx, schedule.ID, solved.Score, solved.HardViolations, solved.SoftPenalty)
+	_, _ = h.scheduleRepo.UpdateCohortClashes(ctx, schedule.ID, solved.CohortClashes)
+	if refreshed, err := h.scheduleRepo.GetByID(ctx, schedule.ID); err == nil {
+		result.Schedule = refreshed
+	}
+	if refreshed, err := h.scheduleRepo.ListEntries(ctx, schedule.ID); err == nil {
+		result.Entries = refreshed
+	}
+
+	data := map[string]any{
+		"schedule_id": schedule.ID.String(),
+		"affected":    result.Affected,
+		"reassigned":  result.Reassigned,
+		"moved":       result.Moved,
+		"unresolved":  result.Unresolved,
+	}
+	payload, _ := json.Marshal(data)
+	_ = h.scheduleRepo.AppendEvent(ctx, schedule.ID, "Schedule", "ScheduleRepaired", payload)
+	_ = h.publisher.Publish(ctx, "timetable.schedule.repaired", data)
+
+	return result, nil
+}
+
+// keyedEntry is a schedule entry with its session index within the section.
+type keyedEntry struct {
+	entry   *entity.ScheduleEntry
+	session int
+}
+
+// keyEntries keys entries by solver variable key, numbering the sessions of
+// each section of a subject in weekly order.
+func keyEntries(entries []*entity.ScheduleEntry) map[string]keyedEntry {
+	type unit struct {
+		subjectID uuid.UUID
+		section   int
+	}
+	byUnit := map[unit][]*entity.ScheduleEntry{}
+	for _, e := range entries {
+		u := unit{e.SubjectID, e.SectionNumber()}
+		byUnit[u] = append(byUnit[u], e)
+	}
+	keyed := make(map[string]keyedEntry, len(entries))
+	for _, list := range byUnit {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].DayOfWeek != list[j].DayOfWeek {
+				return list[i].DayOfWeek < list[j].DayOfWeek
+			}
+			if list[i].StartPeriod != list[j].StartPeriod {
+				return list[i].StartPeriod < list[j].StartPeriod
+			}
+			return list[i].ID.String() < list[j].ID.String()
+		})
+		for i, e := range list {
+			v := service.ScheduleVariable{SubjectID: e.SubjectID, Section: e.SectionNumber(), Session: i}
+			keyed[v.Key()] = keyedEntry{entry: e, session: i}
+		}
+	}
+	return keyed
+}
+
+// validAssignments reports whether an existing assignment still uses an
+// active teacher, an available room and a slot of the semester.
+func validAssignments(in *solverInputs) func(service.Assignment) bool {
+	teachers := make(map[uuid.UUID]bool, len(in.teachers))
+	for _, t := range in.teachers {
+		teachers[t.ID] = true
+	}
+	rooms := make(map[uuid.UUID]bool, len(in.rooms))
+	for _, r := range in.rooms {
+		rooms[r.ID] = true
+	}
+	slots := make(map[uuid.UUID]bool, len(in.slots))
+	for _, sl := range in.slots {
+		slots[sl.ID] = true
+	}
+	return func(a service.Assignment) bool {
+		return teachers[a.TeacherID] && rooms[a.RoomID] && slots[a.SlotID]
+	}
+}
+
+func withoutTeachers(teachers []service.TeacherInfo, removed []uuid.UUID) []service.TeacherInfo {
+	drop := idSet(removed)
+	kept := teachers[:0:0]
+	for _, t := range teachers {
+		if !drop[t.ID] {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+func withoutRooms(rooms []*entity.Room, closed []uuid.UUID) []*entity.Room {
+	drop := idSet(closed)
+	kept := rooms[:0:0]
+	for _, r := range rooms {
+		if !drop[r.ID] {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+func withoutSlots(slots []*entity.TimeSlot, removed []uuid.UUID) []*entity.TimeSlot {
+	drop := idSet(removed)
+	kept := slots[:0:0]
+	for _, sl := range slots {
+		if !drop[sl.ID] {
+			kept = append(kept, sl)
+		}
+	}
+	return kept
+}
+
+func idSet(ids []uuid.UUID) map[uuid.UUID]bool {
+	set := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
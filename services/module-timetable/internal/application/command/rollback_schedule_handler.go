@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// RollbackScheduleCommand requests republishing a previous version.
+type RollbackScheduleCommand struct {
+	SemesterID uuid.UUID
+	Version    int
+}
+
+// RollbackScheduleHandler restores a published version. History is never
+// rewritten: the version's entries are copied into a new schedule, which is
+// published as the semester's next version in the same transaction.
+type RollbackScheduleHandler struct {
+	versionRepo repository.ScheduleVersionRepository
+	publish     *PublishScheduleHandler
+}
+
+func NewRollbackScheduleHandler(
+	versionRepo repository.ScheduleVersionRepository,
+	publish *PublishScheduleHandler,
+) *RollbackScheduleHandler {
+	return &RollbackScheduleHandler{versionRepo: versionRepo, publish: publish}
+}
+
+// Handle returns an error wrapping repository.ErrVersionNotFound when the
+// semester has no such version.
+func (h *RollbackScheduleHandler) Handle(ctx context.Context, cmd RollbackScheduleCommand) (*PublishScheduleResult, error) {
+	version, archived, err := h.versionRepo.Restore(ctx, cmd.SemesterID, cmd.Version)
+	if err != nil {
+		return nil, err
+	}
+	restored := &entity.Schedule{
+		ID:         version.ScheduleID,
+		SemesterID: version.SemesterID,
+		Name:       fmt.Sprintf("Rollback to v%d", cmd.Version),
+		Status:     valueobject.ScheduleStatusPublished,
+	}
+	return h.publish.announce(ctx, restored, version, archived), nil
+}
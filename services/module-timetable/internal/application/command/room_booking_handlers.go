@@ -0,0 +1,246 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+var (
+	// ErrInvalidRoomBooking is returned when a booking request is malformed
+	// or names an inactive room.
+	ErrInvalidRoomBooking = errors.New("invalid room booking")
+	// ErrRoomUnavailable is returned when a class of a published schedule or
+	// another booking holds the room at the requested time.
+	ErrRoomUnavailable = errors.New("room is not available")
+	// ErrBookingNotOwned is returned when cancelling someone else's booking
+	// without override rights.
+	ErrBookingNotOwned = errors.New("room booking belongs to another user")
+)
+
+// OccupancyLoader loads what holds rooms over a date range; see
+// query.RoomOccupancyLoader.
+type OccupancyLoader interface {
+	Load(ctx context.Context, from, to time.Time) (*service.RoomOccupancy, error)
+}
+
+// CreateRoomBookingCommand requests a room for periods StartPeriod..EndPeriod
+// on every day from StartDate to EndDate.
+type CreateRoomBookingCommand struct {
+	RoomID      uuid.UUID
+	Title       string
+	Purpose     string
+	RequestedBy uuid.UUID
+	StartDate   time.Time
+	EndDate     time.Time // defaults to StartDate
+	StartPeriod int
+	EndPeriod   int
+}
+
+// CreateRoomBookingHandler books a room when nothing holds it at the
+// requested times. Bookings of restricted rooms start out pending and
+// already hold the room until they are reviewed.
+type CreateRoomBookingHandler struct {
+	roomRepo    repository.RoomRepository
+	bookingRepo repository.RoomBookingRepository
+	occupancy   OccupancyLoader
+	publisher   EventPublisher
+}
+
+func NewCreateRoomBookingHandler(
+	roomRepo repository.RoomRepository,
+	bookingRepo repository.RoomBookingRepository,
+	occupancy OccupancyLoader,
+	publisher EventPublisher,
+) *CreateRoomBookingHandler {
+	return &CreateRoomBookingHandler{roomRepo: roomRepo, bookingRepo: bookingRepo, occupancy: occupancy, publisher: publisher}
+}
+
+func (h *CreateRoomBookingHandler) Handle(ctx context.Context, cmd CreateRoomBookingCommand) (*entity.RoomBooking, error) {
+	room, err := h.roomRepo.GetByID(ctx, cmd.RoomID)
+	if err != nil {
+		return nil, fmt.Errorf("get room: %w", err)
+	}
+	if !room.IsActive {
+		return nil, fmt.Errorf("%w: room %s is inactive", ErrInvalidRoomBooking, room.Name)
+	}
+	b := &entity.RoomBooking{
+		RoomID:      room.ID,
+		RoomName:    room.Name,
+		Title:       cmd.Title,
+		Purpose:     cmd.Purpose,
+		RequestedBy: cmd.RequestedBy,
+		StartDate:   cmd.StartDate,
+		EndDate:     cmd.EndDate,
+		StartPeriod: cmd.StartPeriod,
+		EndPeriod:   cmd.EndPeriod,
+		Status:      valueobject.BookingStatusApproved,
+	}
+	if b.EndDate.IsZero() {
+		b.EndDate = b.StartDate
+	}
+	if room.RequiresApproval {
+		b.Status = valueobject.BookingStatusPending
+	}
+	if err := b.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRoomBooking, err)
+	}
+	if err := checkRoomFree(ctx, h.occupancy, b); err != nil {
+		return nil, err
+	}
+
+	created, err := h.bookingRepo.Create(ctx, b)
+	if errors.Is(err, repository.ErrRoomBookingOverlap) {
+		// Lost a race with a concurrent request for the same room
+		return nil, fmt.Errorf("%w: %v", ErrRoomUnavailable, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_ = h.publisher.Publish(ctx, "timetable.room_booking.created", roomBookingEvent(created))
+	return created, nil
+}
+
+// ReviewRoomBookingCommand approves or rejects a pending booking.
+type ReviewRoomBookingCommand struct {
+	ID         uuid.UUID
+	ReviewerID uuid.UUID
+	Approve    bool
+	Note       string
+}
+
+// ReviewRoomBookingHandler decides pending bookings of restricted rooms.
+// Approval checks the room again, since a schedule published after the
+// request may have claimed it.
+type ReviewRoomBookingHandler struct {
+	bookingRepo repository.RoomBookingRepository
+	occupancy   OccupancyLoader
+	publisher   EventPublisher
+}
+
+func NewReviewRoomBookingHandler(
+	bookingRepo repository.RoomBookingRepository,
+	occupancy OccupancyLoader,
+	publisher EventPublisher,
+) *ReviewRoomBookingHandler {
+	return &ReviewRoomBookingHandler{bookingRepo: bookingRepo, occupancy: occupancy, publisher: publisher}
+}
+
+// Handle returns an error wrapping entity.ErrBookingTransition when the
+// booking is no longer pending.
+func (h *ReviewRoomBookingHandler) Handle(ctx context.Context, cmd ReviewRoomBookingCommand) (*entity.RoomBooking, error) {
+	b, err := h.bookingRepo.GetByID(ctx, cmd.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get room booking: %w", err)
+	}
+	if cmd.Approve {
+		err = b.Approve(cmd.ReviewerID, cmd.Note, time.Now())
+	} else {
+		err = b.Reject(cmd.ReviewerID, cmd.Note, time.Now())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Approve {
+		if err := checkRoomFree(ctx, h.occupancy, b); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := h.bookingRepo.UpdateStatus(ctx, b, valueobject.BookingStatusPending)
+	if errors.Is(err, repository.ErrRoomBookingNotFound) {
+		return nil, fmt.Errorf("%w: booking was changed meanwhile", entity.ErrBookingTransition)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_ = h.publisher.Publish(ctx, "timetable.room_booking.reviewed", roomBookingEvent(updated))
+	return updated, nil
+}
+
+// CancelRoomBookingCommand withdraws a booking. Only its requester may
+// cancel it unless Override is set (administrators).
+type CancelRoomBookingCommand struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	Override bool
+}
+
+// CancelRoomBookingHandler frees the room of a pending or approved booking.
+type CancelRoomBookingHandler struct {
+	bookingRepo repository.RoomBookingRepository
+	publisher   EventPublisher
+}
+
+func NewCancelRoomBookingHandler(bookingRepo repository.RoomBookingRepository, publisher EventPublisher) *CancelRoomBookingHandler {
+	return &CancelRoomBookingHandler{bookingRepo: bookingRepo, publisher: publisher}
+}
+
+func (h *CancelRoomBookingHandler) Handle(ctx context.Context, cmd CancelRoomBookingCommand) (*entity.RoomBooking, error) {
+	b, err := h.bookingRepo.GetByID(ctx, cmd.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get room booking: %w", err)
+	}
+	if b.RequestedBy != cmd.UserID && !cmd.Override {
+		return nil, ErrBookingNotOwned
+	}
+	from := b.Status
+	if err := b.Cancel(); err != nil {
+		return nil, err
+	}
+	updated, err := h.bookingRepo.UpdateStatus(ctx, b, from)
+	if errors.Is(err, repository.ErrRoomBookingNotFound) {
+		return nil, fmt.Errorf("%w: booking was changed meanwhile", entity.ErrBookingTransition)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_ = h.publisher.Publish(ctx, "timetable.room_booking.cancelled", roomBookingEvent(updated))
+	return updated, nil
+}
+
+// checkRoomFree returns ErrRoomUnavailable, listing the first clashes, when
+// anything but b itself holds b's room at b's times.
+func checkRoomFree(ctx context.Context, loader OccupancyLoader, b *entity.RoomBooking) error {
+	occupancy, err := loader.Load(ctx, b.StartDate, b.EndDate)
+	if err != nil {
+		return fmt.Errorf("load room occupancy: %w", err)
+	}
+	conflicts := occupancy.Conflicts(b.RoomID, b.Days(), b.StartPeriod, b.EndPeriod, b.ID)
+	if len(conflicts) == 0 {
+		return nil
+	}
+	const shown = 5
+	parts := make([]string, 0, shown+1)
+	for i, c := range conflicts {
+		if i == shown {
+			parts = append(parts, fmt.Sprintf("and %d more", len(conflicts)-shown))
+			break
+		}
+		parts = append(parts, c.String())
+	}
+	return fmt.Errorf("%w: %s", ErrRoomUnavailable, strings.Join(parts, "; "))
+}
+
+func roomBookingEvent(b *entity.RoomBooking) map[string]any {
+	return map[string]any{
+		"booking_id":   b.ID.String(),
+		"room_id":      b.RoomID.String(),
+		"room_name":    b.RoomName,
+		"title":        b.Title,
+		"requested_by": b.RequestedBy.String(),
+		"start_date":   b.StartDate.Format(time.DateOnly),
+		"end_date":     b.EndDate.Format(time.DateOnly),
+		"start_period": b.StartPeriod,
+		"end_period":   b.EndPeriod,
+		"status":       b.Status.String(),
+	}
+}
@@ -0,0 +1,157 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// UpdateRoomCommand changes a room. Nil fields keep their value; a non-nil
+// empty Features clears the features and a uuid.Nil BuildingID detaches the
+// room from its building.
+type UpdateRoomCommand struct {
+	ID               uuid.UUID
+	Name             *string
+	Capacity         *int
+	Type             *string
+	Features         []string
+	RequiresApproval *bool
+	BuildingID       *uuid.UUID
+}
+
+// UpdateRoomHandler executes the UpdateRoom use case.
+type UpdateRoomHandler struct {
+	repo       repository.RoomRepository
+	campusRepo repository.CampusRepository
+	publisher  EventPublisher
+}
+
+func NewUpdateRoomHandler(repo repository.RoomRepository, campusRepo repository.CampusRepository, publisher EventPublisher) *UpdateRoomHandler {
+	return &UpdateRoomHandler{repo: repo, campusRepo: campusRepo, publisher: publisher}
+}
+
+func (h *UpdateRoomHandler) Handle(ctx context.Context, cmd UpdateRoomCommand) (*entity.Room, error) {
+	room, err := h.repo.GetByID(ctx, cmd.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get room: %w", err)
+	}
+	if cmd.Name != nil {
+		room.Name = *cmd.Name
+	}
+	if cmd.Capacity != nil {
+		room.Capacity = *cmd.Capacity
+	}
+	if cmd.Type != nil {
+		room.Type = *cmd.Type
+	}
+	if cmd.Features != nil {
+		room.Features = cmd.Features
+	}
+	if cmd.RequiresApproval != nil {
+		room.RequiresApproval = *cmd.RequiresApproval
+	}
+	if cmd.BuildingID != nil {
+		room.BuildingID = *cmd.BuildingID
+		if err := checkBuilding(ctx, h.campusRepo, room.BuildingID); err != nil {
+			return nil, err
+		}
+	}
+	if err := room.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRoom, err)
+	}
+	updated, err := h.repo.Update(ctx, room)
+	if err != nil {
+		return nil, err
+	}
+	_ = h.publisher.Publish(ctx, "timetable.room.updated", roomEventData(updated))
+	return updated, nil
+}
+
+// SetRoomActiveCommand deactivates or reactivates a room.
+type SetRoomActiveCommand struct {
+	ID     uuid.UUID
+	Active bool
+}
+
+// SetRoomActiveHandler deactivates and reactivates rooms. A deactivated room
+// keeps its place in existing schedules but is no longer offered to the
+// solver, to bookings or in room lists.
+type SetRoomActiveHandler struct {
+	repo      repository.RoomRepository
+	publisher EventPublisher
+}
+
+func NewSetRoomActiveHandler(repo repository.RoomRepository, publisher EventPublisher) *SetRoomActiveHandler {
+	return &SetRoomActiveHandler{repo: repo, publisher: publisher}
+}
+
+func (h *SetRoomActiveHandler) Handle(ctx context.Context, cmd SetRoomActiveCommand) (*entity.Room, error) {
+	room, err := h.repo.GetByID(ctx, cmd.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get room: %w", err)
+	}
+	if room.IsActive == cmd.Active {
+		return room, nil
+	}
+	room.IsActive = cmd.Active
+	updated, err := h.repo.Update(ctx, room)
+	if err != nil {
+		return nil, err
+	}
+	subject := "timetable.room.deactivated"
+	if cmd.Active {
+		subject = "timetable.room.activated"
+	}
+	_ = h.publisher.Publish(ctx, subject, roomEventData(updated))
+	return updated, nil
+}
+
+// DeleteRoomCommand requests removing a room for good.
+type DeleteRoomCommand struct {
+	ID uuid.UUID
+}
+
+// DeleteRoomHandler deletes rooms nothing refers to. Rooms used by a
+// published schedule, or still referenced by drafts, archived schedules or
+// bookings, can only be deactivated.
+type DeleteRoomHandler struct {
+	repo      repository.RoomRepository
+	publisher EventPublisher
+}
+
+func NewDeleteRoomHandler(repo repository.RoomRepository, publisher EventPublisher) *DeleteRoomHandler {
+	return &DeleteRoomHandler{repo: repo, publisher: publisher}
+}
+
+// Handle returns an error wrapping repository.ErrRoomInUse when the room is
+// still referenced.
+func (h *DeleteRoomHandler) Handle(ctx context.Context, cmd DeleteRoomCommand) error {
+	room, err := h.repo.GetByID(ctx, cmd.ID)
+	if err != nil {
+		return fmt.Errorf("get room: %w", err)
+	}
+	n, err := h.repo.CountPublishedEntries(ctx, room.ID)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return fmt.Errorf("%w: %d sessions of published schedules are held in room %s; deactivate it instead",
+			repository.ErrRoomInUse, n, room.Name)
+	}
+	if err := h.repo.Delete(ctx, room.ID); err != nil {
+		return err
+	}
+	_ = h.publisher.Publish(ctx, "timetable.room.deleted", roomEventData(room))
+	return nil
+}
+
+func roomEventData(r *entity.Room) map[string]any {
+	return map[string]any{
+		"room_id":   r.ID.String(),
+		"name":      r.Name,
+		"is_active": r.IsActive,
+	}
+}
@@ -0,0 +1,252 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	infragrpc "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/grpc"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// solverOptions are the per-run switches shared by generation and repair.
+type solverOptions struct {
+	BindSessionTeacher bool
+	SoftCohortClash    bool
+}
+
+// solverInputs is everything the CSP solver needs for one semester, fetched
+// up front so that no IO happens during search.
+type solverInputs struct {
+	subjects []infragrpc.SubjectInfo
+	teachers []service.TeacherInfo
+	rooms    []*entity.Room
+	slots    []*entity.TimeSlot
+	slotMap  map[uuid.UUID]*entity.TimeSlot
+	sections map[uuid.UUID][]entity.CourseSection
+	checker  *service.ConstraintChecker
+
+	// raw teacher availability and approved enrollments, for modes that
+	// configure their own checker (see exam scheduling)
+	availability map[uuid.UUID][]*entity.TimeSlot
+	enrolled     map[uuid.UUID][]uuid.UUID
+
+	// lookups for denormalised entry fields
+	subjectNames map[uuid.UUID]string
+	subjectCodes map[uuid.UUID]string
+	subjectDepts map[uuid.UUID]uuid.UUID
+	teacherNames map[uuid.UUID]string
+}
+
+// solverInputLoader fetches solver inputs from the repositories and the HR,
+// Subject and Student modules.
+type solverInputLoader struct {
+	semesterRepo  repository.SemesterRepository
+	roomRepo      repository.RoomRepository
+	campusRepo    repository.CampusRepository // nil-safe — campus travel is ignored if nil
+	hrClient      *infragrpc.HRClient
+	subjectClient *infragrpc.SubjectClient
+	studentClient *infragrpc.StudentClient // nil-safe — cohort clashes are ignored if nil
+}
+
+// load pre-fetches subjects, rooms, slots, teachers, availability and
+// enrollments for the semester and builds a configured constraint checker.
+func (l *solverInputLoader) load(ctx context.Context, semester *entity.Semester, opts solverOptions) (*solverInputs, error) {
+	// Fetch offered subjects
+	subjects, err := l.subjectClient.ListSubjectsByIDs(ctx, semester.OfferedSubjectIDs)
+	if err != nil {
+		return nil, fmt.Errorf("fetch subjects: %w", err)
+	}
+
+	// Fetch available rooms — use semester-specific rooms if configured, else all active rooms
+	var rooms []*entity.Room
+	if len(semester.RoomIDs) > 0 {
+		for _, rid := range semester.RoomIDs {
+			r, err := l.roomRepo.GetByID(ctx, rid)
+			if err != nil || !r.IsActive {
+				continue // skip missing/inactive rooms
+			}
+			rooms = append(rooms, r)
+		}
+	} else {
+		rooms, err = l.roomRepo.List(ctx, 200, 0)
+		if err != nil {
+			return nil, fmt.Errorf("fetch rooms: %w", err)
+		}
+	}
+
+	// Fetch enrollment caps so rooms seat every offering's students
+	enrollment, err := l.subjectClient.ListEnrollmentCaps(ctx, semester.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch enrollment caps: %w", err)
+	}
+
+	// Fetch time slots for the semester
+	slots, err := l.semesterRepo.ListTimeSlots(ctx, semester.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch slots: %w", err)
+	}
+
+	// Fetch teachers from HR module
+	teachers, err := l.hrClient.ListTeachers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch teachers: %w", err)
+	}
+
+	// Fetch availability for each teacher
+	teacherAvailability := make(map[uuid.UUID][]*entity.TimeSlot, len(teachers))
+	teacherPreferred := make(map[uuid.UUID][]*entity.TimeSlot)
+	teacherDisliked := make(map[uuid.UUID][]*entity.TimeSlot)
+	for _, t := range teachers {
+		avail, err := l.hrClient.GetTeacherAvailability(ctx, t.ID)
+		if err == nil {
+			teacherAvailability[t.ID], teacherPreferred[t.ID], teacherDisliked[t.ID] = service.SplitAvailability(avail)
+		}
+	}
+
+	// Fetch approved enrollments so subjects sharing students are kept apart
+	var (
+		enrolled      map[uuid.UUID][]uuid.UUID
+		cohortOverlap service.CohortOverlap
+	)
+	if l.studentClient != nil {
+		enrolled, err = l.studentClient.ListApprovedEnrollments(ctx, semester.ID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch enrollments: %w", err)
+		}
+		cohortOverlap = service.BuildCohortOverlap(enrolled)
+	}
+
+	// Build CSP inputs + name lookup maps for denormalised fields
+	in := &solverInputs{
+		subjects:     subjects,
+		teachers:     teachers,
+		rooms:        rooms,
+		slots:        slots,
+		slotMap:      make(map[uuid.UUID]*entity.TimeSlot, len(slots)),
+		sections:     semester.Sections,
+		subjectNames: make(map[uuid.UUID]string, len(subjects)),
+		subjectCodes: make(map[uuid.UUID]string, len(subjects)),
+		subjectDepts: make(map[uuid.UUID]uuid.UUID, len(subjects)),
+		teacherNames: make(map[uuid.UUID]string, len(teachers)),
+		availability: teacherAvailability,
+		enrolled:     enrolled,
+	}
+	for _, sl := range slots {
+		in.slotMap[sl.ID] = sl
+	}
+
+	teacherSpecs := make(map[uuid.UUID]map[string]bool, len(teachers))
+	teacherMaxHours := make(map[uuid.UUID]int, len(teachers))
+	for _, t := range teachers {
+		specSet := make(map[string]bool, len(t.Specializations))
+		for _, s := range t.Specializations {
+			specSet[s] = true
+		}
+		teacherSpecs[t.ID] = specSet
+		teacherMaxHours[t.ID] = t.MaxHoursPerWeek
+		in.teacherNames[t.ID] = t.FullName
+	}
+
+	subjectSpecs := make(map[uuid.UUID][]string, len(subjects))
+	roomReqs := make(map[uuid.UUID]service.RoomRequirement, len(subjects))
+	for _, s := range subjects {
+		subjectSpecs[s.ID] = s.RequiredSpecializations
+		in.subjectNames[s.ID] = s.Name
+		in.subjectCodes[s.ID] = s.Code
+		in.subjectDepts[s.ID] = s.DepartmentID
+		roomReqs[s.ID] = service.RoomRequirement{
+			MinCapacity: s.MinRoomCapacity,
+			RoomType:    s.RequiredRoomType,
+			Features:    s.RequiredRoomFeatures,
+			Enrollment:  enrollment[s.ID],
+		}
+	}
+
+	checker := service.NewConstraintChecker(teacherAvailability, teacherSpecs, subjectSpecs, teacherMaxHours)
+	checker.SetSessionTeacherBinding(opts.BindSessionTeacher)
+	checker.SetCohortOverlap(cohortOverlap, !opts.SoftCohortClash)
+	checker.SetSections(semester.Sections)
+	checker.SetRoomRequirements(rooms, roomReqs)
+	softConstraints, err := service.BuildSoftConstraints(semester.SoftConstraints)
+	if err != nil {
+		return nil, fmt.Errorf("soft constraints: %w", err)
+	}
+	checker.SetSoftConstraints(softConstraints)
+	checker.SetTeacherPreferences(teacherPreferred, teacherDisliked)
+	if err := l.setRoomLocations(ctx, checker, rooms); err != nil {
+		return nil, err
+	}
+	in.checker = checker
+
+	return in, nil
+}
+
+// setRoomLocations registers the building and campus of each located room
+// and the travel times between campuses for the travel soft constraints.
+func (l *solverInputLoader) setRoomLocations(ctx context.Context, checker *service.ConstraintChecker, rooms []*entity.Room) error {
+	buildings := make(map[uuid.UUID]string)
+	campuses := make(map[uuid.UUID]uuid.UUID)
+	for _, r := range rooms {
+		if r.BuildingCode != "" {
+			buildings[r.ID] = r.BuildingCode
+		}
+		if r.CampusID != uuid.Nil {
+			campuses[r.ID] = r.CampusID
+		}
+	}
+	checker.SetRoomBuildings(buildings)
+	if l.campusRepo == nil || len(campuses) == 0 {
+		return nil
+	}
+	travel, err := l.campusRepo.TravelMatrix(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch campus travel times: %w", err)
+	}
+	checker.SetCampusTravel(campuses, travel)
+	return nil
+}
+
+// variables builds one solver variable per weekly session of every section
+// of every subject.
+func (in *solverInputs) variables() []service.ScheduleVariable {
+	variables := make([]service.ScheduleVariable, 0, len(in.subjects))
+	for _, s := range in.subjects {
+		variables = append(variables, service.ScheduleVariable{
+			SubjectID:               s.ID,
+			SubjectCode:             s.Code,
+			WeeklyHours:             s.WeeklyHours,
+			RequiredSpecializations: s.RequiredSpecializations,
+		})
+	}
+	variables = service.ExpandSections(variables, in.sections)
+	return service.ExpandSessions(variables, service.SessionPeriods(in.slots))
+}
+
+// assistantsNeeded is how many assistants each session of a subject's
+// section needs; 0 for subjects without sections.
+func (in *solverInputs) assistantsNeeded(subjectID uuid.UUID, section int) int {
+	for _, sec := range in.sections[subjectID] {
+		if sec.Number == section {
+			return sec.Assistants
+		}
+	}
+	return 0
+}
+
+// entryAssistants names the assistants the solver staffed for a session.
+func (in *solverInputs) entryAssistants(ids []uuid.UUID) []entity.EntryTeacher {
+	var assistants []entity.EntryTeacher
+	for _, id := range ids {
+		assistants = append(assistants, entity.EntryTeacher{
+			TeacherID:   id,
+			TeacherName: in.teacherNames[id],
+			Role:        valueobject.TeacherRoleAssistant,
+		})
+	}
+	return assistants
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// UnpublishScheduleCommand requests withdrawing a published schedule.
+type UnpublishScheduleCommand struct {
+	ScheduleID uuid.UUID
+}
+
+// UnpublishScheduleHandler returns a published schedule to draft. Its
+// published versions stay in the history.
+type UnpublishScheduleHandler struct {
+	repo      repository.ScheduleRepository
+	publisher EventPublisher
+}
+
+func NewUnpublishScheduleHandler(repo repository.ScheduleRepository, publisher EventPublisher) *UnpublishScheduleHandler {
+	return &UnpublishScheduleHandler{repo: repo, publisher: publisher}
+}
+
+func (h *UnpublishScheduleHandler) Handle(ctx context.Context, cmd UnpublishScheduleCommand) (*entity.Schedule, error) {
+	schedule, err := h.repo.GetByID(ctx, cmd.ScheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("get schedule: %w", err)
+	}
+	if err := schedule.Unpublish(); err != nil {
+		return nil, fmt.Errorf("unpublish schedule: %w", err)
+	}
+	return changeScheduleStatus(ctx, h.repo, h.publisher, schedule, "ScheduleUnpublished", "timetable.schedule.unpublished")
+}
+
+// changeScheduleStatus persists a lifecycle transition already applied to the
+// schedule and records it in the event store.
+func changeScheduleStatus(
+	ctx context.Context,
+	repo repository.ScheduleRepository,
+	publisher EventPublisher,
+	schedule *entity.Schedule,
+	eventType, subject string,
+) (*entity.Schedule, error) {
+	updated, err := repo.UpdateStatus(ctx, schedule.ID, schedule.Status)
+	if err != nil {
+		return nil, fmt.Errorf("persist %s status: %w", schedule.Status, err)
+	}
+	data := map[string]any{
+		"schedule_id": schedule.ID.String(),
+		"semester_id": schedule.SemesterID.String(),
+		"status":      schedule.Status.String(),
+	}
+	payload, _ := json.Marshal(data)
+	_ = repo.AppendEvent(ctx, schedule.ID, "Schedule", eventType, payload)
+	_ = publisher.Publish(ctx, subject, data)
+	return updated, nil
+}
@@ -0,0 +1,67 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+)
+
+// ScheduleRef names one side of a diff: a schedule, or when ScheduleID is
+// unset, a published version of the query's semester.
+type ScheduleRef struct {
+	ScheduleID uuid.UUID
+	Version    int
+}
+
+// DiffSchedulesQuery compares Target against Base.
+type DiffSchedulesQuery struct {
+	SemesterID uuid.UUID // required only for version refs
+	Base       ScheduleRef
+	Target     ScheduleRef
+}
+
+// DiffSchedulesHandler executes the DiffSchedules read use case.
+type DiffSchedulesHandler struct {
+	scheduleRepo repository.ScheduleRepository
+	versionRepo  repository.ScheduleVersionRepository
+}
+
+func NewDiffSchedulesHandler(scheduleRepo repository.ScheduleRepository, versionRepo repository.ScheduleVersionRepository) *DiffSchedulesHandler {
+	return &DiffSchedulesHandler{scheduleRepo: scheduleRepo, versionRepo: versionRepo}
+}
+
+func (h *DiffSchedulesHandler) Handle(ctx context.Context, q DiffSchedulesQuery) (*service.ScheduleDiff, error) {
+	base, err := h.entries(ctx, q.SemesterID, q.Base)
+	if err != nil {
+		return nil, err
+	}
+	target, err := h.entries(ctx, q.SemesterID, q.Target)
+	if err != nil {
+		return nil, err
+	}
+	diff := service.DiffEntries(base, target)
+	return &diff, nil
+}
+
+func (h *DiffSchedulesHandler) entries(ctx context.Context, semesterID uuid.UUID, ref ScheduleRef) ([]*entity.ScheduleEntry, error) {
+	if ref.ScheduleID != uuid.Nil {
+		entries, err := h.scheduleRepo.ListEntries(ctx, ref.ScheduleID)
+		if err != nil {
+			return nil, fmt.Errorf("list entries for schedule %s: %w", ref.ScheduleID, err)
+		}
+		return entries, nil
+	}
+	version, err := h.versionRepo.Get(ctx, semesterID, ref.Version)
+	if err != nil {
+		return nil, fmt.Errorf("get version %d of semester %s: %w", ref.Version, semesterID, err)
+	}
+	entries := make([]*entity.ScheduleEntry, len(version.Entries))
+	for i, e := range version.Entries {
+		entries[i] = e.ScheduleEntry()
+	}
+	return entries, nil
+}
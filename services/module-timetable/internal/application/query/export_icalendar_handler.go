@@ -0,0 +1,168 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	infragrpc "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/grpc"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// ErrNoPublishedSchedule is returned when a semester's calendar is requested
+// but none of its schedules is published.
+var ErrNoPublishedSchedule = errors.New("semester has no published schedule")
+
+// ExportICalendarQuery requests a schedule as an iCalendar file. The schedule
+// is ScheduleID, or the published schedule of SemesterID when that is unset,
+// so that subscriptions follow republishing. At most one of the filters
+// narrows the entries to one teacher, room, student or department.
+type ExportICalendarQuery struct {
+	ScheduleID   uuid.UUID
+	SemesterID   uuid.UUID
+	TeacherID    uuid.UUID
+	RoomID       uuid.UUID
+	StudentID    uuid.UUID // entries of subjects with an approved enrollment
+	DepartmentID uuid.UUID
+}
+
+// ExportICalendarResult is a rendered .ics file.
+type ExportICalendarResult struct {
+	Calendar []byte
+	Filename string
+}
+
+// ExportICalendarHandler executes the ExportICalendar read use case.
+type ExportICalendarHandler struct {
+	semesterRepo  repository.SemesterRepository
+	scheduleRepo  repository.ScheduleRepository
+	studentClient *infragrpc.StudentClient
+}
+
+func NewExportICalendarHandler(
+	semesterRepo repository.SemesterRepository,
+	scheduleRepo repository.ScheduleRepository,
+	studentClient *infragrpc.StudentClient,
+) *ExportICalendarHandler {
+	return &ExportICalendarHandler{semesterRepo: semesterRepo, scheduleRepo: scheduleRepo, studentClient: studentClient}
+}
+
+func (h *ExportICalendarHandler) Handle(ctx context.Context, q ExportICalendarQuery) (*ExportICalendarResult, error) {
+	schedule, err := h.schedule(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	semester, err := h.semesterRepo.GetByID(ctx, schedule.SemesterID)
+	if err != nil {
+		return nil, fmt.Errorf("get semester: %w", err)
+	}
+	entries, err := h.scheduleRepo.ListEntries(ctx, schedule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list entries for schedule %s: %w", schedule.ID, err)
+	}
+
+	keep, scope, err := h.filter(ctx, q, semester.ID)
+	if err != nil {
+		return nil, err
+	}
+	var selected []*entity.ScheduleEntry
+	for _, e := range entries {
+		if keep(e) {
+			selected = append(selected, e)
+		}
+	}
+	if scope == "" {
+		scope = "all"
+	} else if len(selected) > 0 {
+		// Name the file after the teacher or room when the entries tell us
+		switch {
+		case q.TeacherID != uuid.Nil && selected[0].TeacherName != "":
+			scope = selected[0].TeacherName
+		case q.RoomID != uuid.Nil && selected[0].RoomName != "":
+			scope = selected[0].RoomName
+		}
+	}
+
+	stamp := schedule.CreatedAt
+	if schedule.GeneratedAt != nil {
+		stamp = *schedule.GeneratedAt
+	}
+	cal := service.ICalendar{
+		Name:     fmt.Sprintf("%s (%s)", semester.Name, scope),
+		Semester: semester,
+		Entries:  selected,
+		Stamp:    stamp,
+	}
+	return &ExportICalendarResult{
+		Calendar: cal.Render(),
+		Filename: icsFilename(semester.Name + "-" + scope),
+	}, nil
+}
+
+func (h *ExportICalendarHandler) schedule(ctx context.Context, q ExportICalendarQuery) (*entity.Schedule, error) {
+	if q.ScheduleID != uuid.Nil {
+		schedule, err := h.scheduleRepo.GetByID(ctx, q.ScheduleID)
+		if err != nil {
+			return nil, fmt.Errorf("get schedule %s: %w", q.ScheduleID, err)
+		}
+		return schedule, nil
+	}
+	schedules, err := h.scheduleRepo.ListBySemester(ctx, q.SemesterID)
+	if err != nil {
+		return nil, fmt.Errorf("list schedules of semester %s: %w", q.SemesterID, err)
+	}
+	for _, s := range schedules {
+		if s.Status == valueobject.ScheduleStatusPublished {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNoPublishedSchedule, q.SemesterID)
+}
+
+// filter returns the entry predicate for the query and a label for its scope.
+func (h *ExportICalendarHandler) filter(ctx context.Context, q ExportICalendarQuery, semesterID uuid.UUID) (func(*entity.ScheduleEntry) bool, string, error) {
+	switch {
+	case q.TeacherID != uuid.Nil:
+		return func(e *entity.ScheduleEntry) bool { return e.TeacherID == q.TeacherID }, "teacher", nil
+	case q.RoomID != uuid.Nil:
+		return func(e *entity.ScheduleEntry) bool { return e.RoomID == q.RoomID }, "room", nil
+	case q.DepartmentID != uuid.Nil:
+		return func(e *entity.ScheduleEntry) bool { return e.DepartmentID == q.DepartmentID }, "department", nil
+	case q.StudentID != uuid.Nil:
+		if h.studentClient == nil {
+			return nil, "", fmt.Errorf("student calendars need the student service")
+		}
+		enrolled, err := h.studentClient.ListApprovedEnrollments(ctx, semesterID)
+		if err != nil {
+			return nil, "", err
+		}
+		subjects := map[uuid.UUID]bool{}
+		for subjectID, students := range enrolled {
+			for _, id := range students {
+				if id == q.StudentID {
+					subjects[subjectID] = true
+				}
+			}
+		}
+		return func(e *entity.ScheduleEntry) bool { return subjects[e.SubjectID] }, "student", nil
+	}
+	return func(*entity.ScheduleEntry) bool { return true }, "", nil
+}
+
+// icsFilename turns a label into a safe "<label>.ics" download name.
+func icsFilename(label string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		}
+		return '-'
+	}, label)
+	return strings.ToLower(name) + ".ics"
+}
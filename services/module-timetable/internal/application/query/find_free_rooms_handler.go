@@ -0,0 +1,81 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// maxSearchDays bounds the date range of an availability search.
+const maxSearchDays = 180
+
+// ErrInvalidAvailabilityQuery is returned when a free-room search is malformed.
+var ErrInvalidAvailabilityQuery = errors.New("invalid availability query")
+
+// FindFreeRoomsQuery asks for the rooms free during periods
+// StartPeriod..EndPeriod (inclusive) on every day from StartDate to EndDate,
+// or only on the DayOfWeek days (0=Monday) of that range when set.
+type FindFreeRoomsQuery struct {
+	StartDate   time.Time
+	EndDate     time.Time // defaults to StartDate
+	DayOfWeek   *int
+	StartPeriod int
+	EndPeriod   int
+	MinCapacity int
+	RoomType    string // empty = any type
+}
+
+// FindFreeRoomsHandler searches active rooms that neither a published
+// schedule nor a pending or approved booking holds at the requested times.
+type FindFreeRoomsHandler struct {
+	roomRepo repository.RoomRepository
+	loader   *RoomOccupancyLoader
+}
+
+func NewFindFreeRoomsHandler(roomRepo repository.RoomRepository, loader *RoomOccupancyLoader) *FindFreeRoomsHandler {
+	return &FindFreeRoomsHandler{roomRepo: roomRepo, loader: loader}
+}
+
+func (h *FindFreeRoomsHandler) Handle(ctx context.Context, q FindFreeRoomsQuery) ([]*entity.Room, error) {
+	if q.EndDate.IsZero() {
+		q.EndDate = q.StartDate
+	}
+	if q.StartDate.IsZero() || q.EndDate.Before(q.StartDate) {
+		return nil, fmt.Errorf("%w: start_date is required and must not be after end_date", ErrInvalidAvailabilityQuery)
+	}
+	if q.StartPeriod < 1 || q.EndPeriod < q.StartPeriod {
+		return nil, fmt.Errorf("%w: periods must satisfy 1 <= start_period <= end_period", ErrInvalidAvailabilityQuery)
+	}
+	if q.EndDate.Sub(q.StartDate) >= maxSearchDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: a search may span at most %d days", ErrInvalidAvailabilityQuery, maxSearchDays)
+	}
+	var days []time.Time
+	for _, d := range entity.DaysBetween(q.StartDate, q.EndDate) {
+		if q.DayOfWeek == nil || entity.DayIndex(d) == *q.DayOfWeek {
+			days = append(days, d)
+		}
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("%w: no such weekday between the dates", ErrInvalidAvailabilityQuery)
+	}
+
+	rooms, err := h.roomRepo.List(ctx, 500, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list rooms: %w", err)
+	}
+	candidates := make([]*entity.Room, 0, len(rooms))
+	for _, r := range rooms {
+		if r.Capacity >= q.MinCapacity && (q.RoomType == "" || r.Type == q.RoomType) {
+			candidates = append(candidates, r)
+		}
+	}
+	occupancy, err := h.loader.Load(ctx, days[0], days[len(days)-1])
+	if err != nil {
+		return nil, err
+	}
+	return occupancy.FreeRooms(candidates, days, q.StartPeriod, q.EndPeriod), nil
+}
@@ -0,0 +1,32 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// GetExamScheduleQuery requests one exam schedule with its entries.
+type GetExamScheduleQuery struct {
+	ID uuid.UUID
+}
+
+// GetExamScheduleHandler executes the GetExamSchedule read use case.
+type GetExamScheduleHandler struct {
+	repo repository.ExamScheduleRepository
+}
+
+func NewGetExamScheduleHandler(repo repository.ExamScheduleRepository) *GetExamScheduleHandler {
+	return &GetExamScheduleHandler{repo: repo}
+}
+
+func (h *GetExamScheduleHandler) Handle(ctx context.Context, q GetExamScheduleQuery) (*entity.ExamSchedule, error) {
+	exam, err := h.repo.GetByID(ctx, q.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get exam schedule %s: %w", q.ID, err)
+	}
+	return exam, nil
+}
@@ -11,20 +11,25 @@ import (
 )
 
 // GenerationStatus summarises the current state of an async generation job.
+// For failed runs, Schedule.FailureReason and Schedule.Diagnosis explain why.
 type GenerationStatus struct {
 	Schedule    *entity.Schedule
 	IsComplete  bool
 	IsPartial   bool
 	IsFailed    bool
+	// Job is the generation job behind the schedule; nil for schedules
+	// generated before jobs were persisted.
+	Job *entity.GenerationJob
 }
 
 // GetGenerationStatusHandler polls the schedule record to determine job state.
 type GetGenerationStatusHandler struct {
-	repo repository.ScheduleRepository
+	repo    repository.ScheduleRepository
+	jobRepo repository.GenerationJobRepository // nil-safe — job state is omitted if nil
 }
 
-func NewGetGenerationStatusHandler(repo repository.ScheduleRepository) *GetGenerationStatusHandler {
-	return &GetGenerationStatusHandler{repo: repo}
+func NewGetGenerationStatusHandler(repo repository.ScheduleRepository, jobRepo repository.GenerationJobRepository) *GetGenerationStatusHandler {
+	return &GetGenerationStatusHandler{repo: repo, jobRepo: jobRepo}
 }
 
 func (h *GetGenerationStatusHandler) Handle(ctx context.Context, scheduleID uuid.UUID) (*GenerationStatus, error) {
@@ -34,14 +39,20 @@ func (h *GetGenerationStatusHandler) Handle(ctx context.Context, scheduleID uuid
 	}
 
 	// score == -1 is the sentinel written by markFailed
-	isFailed := schedule.Score < 0
+	isFailed := schedule.Score < 0 || schedule.Status == valueobject.ScheduleStatusFailed
 	isComplete := schedule.GeneratedAt != nil && !isFailed
 	isPartial := isComplete && schedule.Status == valueobject.ScheduleStatusDraft && schedule.Score < 100
 
-	return &GenerationStatus{
+	st := &GenerationStatus{
 		Schedule:   schedule,
 		IsComplete: isComplete,
 		IsPartial:  isPartial,
 		IsFailed:   isFailed,
-	}, nil
+	}
+	if h.jobRepo != nil {
+		if job, err := h.jobRepo.GetBySchedule(ctx, scheduleID); err == nil {
+			st.Job = job
+		}
+	}
+	return st, nil
 }
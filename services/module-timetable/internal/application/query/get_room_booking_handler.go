@@ -0,0 +1,32 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// GetRoomBookingQuery requests one room booking.
+type GetRoomBookingQuery struct {
+	ID uuid.UUID
+}
+
+// GetRoomBookingHandler executes the GetRoomBooking read use case.
+type GetRoomBookingHandler struct {
+	repo repository.RoomBookingRepository
+}
+
+func NewGetRoomBookingHandler(repo repository.RoomBookingRepository) *GetRoomBookingHandler {
+	return &GetRoomBookingHandler{repo: repo}
+}
+
+func (h *GetRoomBookingHandler) Handle(ctx context.Context, q GetRoomBookingQuery) (*entity.RoomBooking, error) {
+	booking, err := h.repo.GetByID(ctx, q.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get room booking %s: %w", q.ID, err)
+	}
+	return booking, nil
+}
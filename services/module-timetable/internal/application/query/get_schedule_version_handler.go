@@ -0,0 +1,33 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// GetScheduleVersionQuery requests one published version with its entries.
+type GetScheduleVersionQuery struct {
+	SemesterID uuid.UUID
+	Version    int
+}
+
+// GetScheduleVersionHandler executes the GetScheduleVersion read use case.
+type GetScheduleVersionHandler struct {
+	repo repository.ScheduleVersionRepository
+}
+
+func NewGetScheduleVersionHandler(repo repository.ScheduleVersionRepository) *GetScheduleVersionHandler {
+	return &GetScheduleVersionHandler{repo: repo}
+}
+
+func (h *GetScheduleVersionHandler) Handle(ctx context.Context, q GetScheduleVersionQuery) (*entity.ScheduleVersion, error) {
+	version, err := h.repo.Get(ctx, q.SemesterID, q.Version)
+	if err != nil {
+		return nil, fmt.Errorf("get version %d of semester %s: %w", q.Version, q.SemesterID, err)
+	}
+	return version, nil
+}
@@ -0,0 +1,32 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// ListExamSchedulesQuery requests a semester's exam schedules.
+type ListExamSchedulesQuery struct {
+	SemesterID uuid.UUID
+}
+
+// ListExamSchedulesHandler lists exam schedules, newest first, without entries.
+type ListExamSchedulesHandler struct {
+	repo repository.ExamScheduleRepository
+}
+
+func NewListExamSchedulesHandler(repo repository.ExamScheduleRepository) *ListExamSchedulesHandler {
+	return &ListExamSchedulesHandler{repo: repo}
+}
+
+func (h *ListExamSchedulesHandler) Handle(ctx context.Context, q ListExamSchedulesQuery) ([]*entity.ExamSchedule, error) {
+	exams, err := h.repo.ListBySemester(ctx, q.SemesterID)
+	if err != nil {
+		return nil, fmt.Errorf("list exam schedules for semester %s: %w", q.SemesterID, err)
+	}
+	return exams, nil
+}
@@ -0,0 +1,27 @@
+package query
+
+import (
+	"context"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// ListRoomBookingsQuery filters bookings by room, requester, status and
+// date range; zero fields do not filter.
+type ListRoomBookingsQuery struct {
+	Filter repository.RoomBookingFilter
+}
+
+// ListRoomBookingsHandler executes the ListRoomBookings read use case.
+type ListRoomBookingsHandler struct {
+	repo repository.RoomBookingRepository
+}
+
+func NewListRoomBookingsHandler(repo repository.RoomBookingRepository) *ListRoomBookingsHandler {
+	return &ListRoomBookingsHandler{repo: repo}
+}
+
+func (h *ListRoomBookingsHandler) Handle(ctx context.Context, q ListRoomBookingsQuery) ([]*entity.RoomBooking, error) {
+	return h.repo.List(ctx, q.Filter)
+}
@@ -0,0 +1,32 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+)
+
+// ListScheduleVersionsQuery requests a semester's published versions.
+type ListScheduleVersionsQuery struct {
+	SemesterID uuid.UUID
+}
+
+// ListScheduleVersionsHandler lists version history, newest first, without entries.
+type ListScheduleVersionsHandler struct {
+	repo repository.ScheduleVersionRepository
+}
+
+func NewListScheduleVersionsHandler(repo repository.ScheduleVersionRepository) *ListScheduleVersionsHandler {
+	return &ListScheduleVersionsHandler{repo: repo}
+}
+
+func (h *ListScheduleVersionsHandler) Handle(ctx context.Context, q ListScheduleVersionsQuery) ([]*entity.ScheduleVersion, error) {
+	versions, err := h.repo.ListBySemester(ctx, q.SemesterID)
+	if err != nil {
+		return nil, fmt.Errorf("list versions for semester %s: %w", q.SemesterID, err)
+	}
+	return versions, nil
+}
@@ -0,0 +1,68 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// maxSemesters bounds how many semesters are scanned for published schedules.
+const maxSemesters = 500
+
+// RoomOccupancyLoader gathers what holds rooms over a date range: the
+// published schedules of the semesters overlapping it and the pending or
+// approved bookings within it.
+type RoomOccupancyLoader struct {
+	semesterRepo repository.SemesterRepository
+	scheduleRepo repository.ScheduleRepository
+	bookingRepo  repository.RoomBookingRepository
+}
+
+func NewRoomOccupancyLoader(
+	semesterRepo repository.SemesterRepository,
+	scheduleRepo repository.ScheduleRepository,
+	bookingRepo repository.RoomBookingRepository,
+) *RoomOccupancyLoader {
+	return &RoomOccupancyLoader{semesterRepo: semesterRepo, scheduleRepo: scheduleRepo, bookingRepo: bookingRepo}
+}
+
+func (l *RoomOccupancyLoader) Load(ctx context.Context, from, to time.Time) (*service.RoomOccupancy, error) {
+	semesters, err := l.semesterRepo.List(ctx, maxSemesters, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list semesters: %w", err)
+	}
+	var timetables []service.PublishedTimetable
+	for _, sem := range semesters {
+		if sem.EndDate.Before(from) || sem.StartDate.After(to) {
+			continue
+		}
+		schedules, err := l.scheduleRepo.ListBySemester(ctx, sem.ID)
+		if err != nil {
+			return nil, fmt.Errorf("list schedules of semester %s: %w", sem.ID, err)
+		}
+		for _, s := range schedules {
+			if s.Status != valueobject.ScheduleStatusPublished {
+				continue
+			}
+			entries, err := l.scheduleRepo.ListEntries(ctx, s.ID)
+			if err != nil {
+				return nil, fmt.Errorf("list entries of schedule %s: %w", s.ID, err)
+			}
+			timetables = append(timetables, service.PublishedTimetable{Semester: sem, Entries: entries})
+		}
+	}
+
+	bookings, err := l.bookingRepo.List(ctx, repository.RoomBookingFilter{
+		Statuses: []valueobject.BookingStatus{valueobject.BookingStatusPending, valueobject.BookingStatusApproved},
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.NewRoomOccupancy(timetables, bookings), nil
+}
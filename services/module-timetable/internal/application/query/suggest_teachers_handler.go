@@ -2,66 +2,169 @@ package query
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 	infragrpc "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/grpc"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
 )
 
+// ErrInvalidSuggestionQuery is returned when a suggestion's subject or slot
+// cannot be resolved.
+var ErrInvalidSuggestionQuery = errors.New("invalid suggestion query")
+
 // SuggestTeachersQuery requests a ranked list of teachers for a subject/slot.
+// Without a schedule only specialization and availability are weighed; with
+// ScheduleID, teachers busy at the time or out of weekly hours in that
+// schedule are ruled out. EntryID then takes the subject and slot from the
+// entry being reassigned, and TimeSlotID names one of the semester's slots in
+// place of the periods.
 type SuggestTeachersQuery struct {
 	SubjectID   uuid.UUID
 	DayOfWeek   int
 	StartPeriod int
 	EndPeriod   int
+	ScheduleID  uuid.UUID
+	EntryID     uuid.UUID
+	TimeSlotID  uuid.UUID
 }
 
 // SuggestTeachersHandler fetches teachers from HR and ranks them via TeacherRanker.
 type SuggestTeachersHandler struct {
-	hrClient *infragrpc.HRClient
-	ranker   *service.TeacherRanker
+	hrClient      *infragrpc.HRClient
+	subjectClient *infragrpc.SubjectClient
+	semesterRepo  repository.SemesterRepository
+	scheduleRepo  repository.ScheduleRepository
 }
 
-func NewSuggestTeachersHandler(hrClient *infragrpc.HRClient, ranker *service.TeacherRanker) *SuggestTeachersHandler {
-	return &SuggestTeachersHandler{hrClient: hrClient, ranker: ranker}
+func NewSuggestTeachersHandler(
+	hrClient *infragrpc.HRClient,
+	subjectClient *infragrpc.SubjectClient,
+	semesterRepo repository.SemesterRepository,
+	scheduleRepo repository.ScheduleRepository,
+) *SuggestTeachersHandler {
+	return &SuggestTeachersHandler{
+		hrClient:      hrClient,
+		subjectClient: subjectClient,
+		semesterRepo:  semesterRepo,
+		scheduleRepo:  scheduleRepo,
+	}
 }
 
 func (h *SuggestTeachersHandler) Handle(ctx context.Context, q SuggestTeachersQuery) ([]service.TeacherRank, error) {
+	subjectID, slot, others, err := h.target(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
 	teachers, err := h.hrClient.ListTeachers(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("fetch teachers: %w", err)
 	}
-
-	// Filter to teachers available for the requested slot
-	slot := &entity.TimeSlot{
-		DayOfWeek:   q.DayOfWeek,
-		StartPeriod: q.StartPeriod,
-		EndPeriod:   q.EndPeriod,
+	subjects, err := h.subjectClient.ListSubjectsByIDs(ctx, []uuid.UUID{subjectID})
+	if err != nil {
+		return nil, fmt.Errorf("fetch subject: %w", err)
 	}
 
-	var available []service.TeacherInfo
+	// Configure a checker with what ranking needs: availability,
+	// specializations and weekly caps
+	availability := make(map[uuid.UUID][]*entity.TimeSlot, len(teachers))
+	specs := make(map[uuid.UUID]map[string]bool, len(teachers))
+	maxHours := make(map[uuid.UUID]int, len(teachers))
 	for _, t := range teachers {
-		avail, err := h.hrClient.GetTeacherAvailability(ctx, t.ID)
-		if err != nil {
-			continue
+		if avail, err := h.hrClient.GetTeacherAvailability(ctx, t.ID); err == nil && len(avail) > 0 {
+			availability[t.ID], _, _ = service.SplitAvailability(avail)
 		}
-		for _, a := range avail {
-			if a.DayOfWeek == slot.DayOfWeek &&
-				a.StartPeriod <= slot.StartPeriod &&
-				a.EndPeriod >= slot.EndPeriod {
-				available = append(available, t)
-				break
-			}
+		specs[t.ID] = make(map[string]bool, len(t.Specializations))
+		for _, s := range t.Specializations {
+			specs[t.ID][s] = true
 		}
+		maxHours[t.ID] = t.MaxHoursPerWeek
 	}
+	subjectSpecs := make(map[uuid.UUID][]string, len(subjects))
+	for _, s := range subjects {
+		subjectSpecs[s.ID] = s.RequiredSpecializations
+	}
+	ranker := service.NewTeacherRanker(service.NewConstraintChecker(availability, specs, subjectSpecs, maxHours))
+
+	if slot == nil {
+		return ranker.RankForSubject(subjectID, teachers, nil), nil
+	}
+	return ranker.RankForSlot(subjectID, slot, teachers, others), nil
+}
 
-	if len(available) == 0 {
-		// Fall back to all teachers if availability data is sparse
-		available = teachers
+// target resolves the subject and slot to rank for and, with a schedule,
+// the schedule's other sessions. The slot is nil when the query names none.
+func (h *SuggestTeachersHandler) target(ctx context.Context, q SuggestTeachersQuery) (uuid.UUID, *entity.TimeSlot, []*entity.ScheduleEntry, error) {
+	var slot *entity.TimeSlot
+	if q.EndPeriod > 0 {
+		slot = &entity.TimeSlot{DayOfWeek: q.DayOfWeek, StartPeriod: q.StartPeriod, EndPeriod: q.EndPeriod}
+	}
+	if q.ScheduleID == uuid.Nil {
+		if q.EntryID != uuid.Nil || q.TimeSlotID != uuid.Nil {
+			return uuid.Nil, nil, nil, fmt.Errorf("%w: entry or time slot given without a schedule", ErrInvalidSuggestionQuery)
+		}
+		if q.SubjectID == uuid.Nil {
+			return uuid.Nil, nil, nil, fmt.Errorf("%w: subject is required", ErrInvalidSuggestionQuery)
+		}
+		return q.SubjectID, slot, nil, nil
 	}
 
-	ranked := h.ranker.RankForSubject(q.SubjectID, available, nil)
-	return ranked, nil
+	entries, err := h.scheduleRepo.ListEntries(ctx, q.ScheduleID)
+	if err != nil {
+		return uuid.Nil, nil, nil, fmt.Errorf("list entries: %w", err)
+	}
+	subjectID := q.SubjectID
+	others := make([]*entity.ScheduleEntry, 0, len(entries))
+	if q.EntryID != uuid.Nil {
+		var edited *entity.ScheduleEntry
+		for _, e := range entries {
+			if e.ID == q.EntryID {
+				edited = e
+				continue
+			}
+			others = append(others, e)
+		}
+		if edited == nil {
+			return uuid.Nil, nil, nil, fmt.Errorf("%w: entry %s does not belong to schedule %s", ErrInvalidSuggestionQuery, q.EntryID, q.ScheduleID)
+		}
+		subjectID = edited.SubjectID
+		slot = &entity.TimeSlot{DayOfWeek: edited.DayOfWeek, StartPeriod: edited.StartPeriod, EndPeriod: edited.EndPeriod}
+		// The entry's lead is the one being replaced; its assistants stay
+		// and cannot lead it too
+		if len(edited.Assistants) > 0 {
+			kept := *edited
+			kept.TeacherID = uuid.Nil
+			others = append(others, &kept)
+		}
+	} else {
+		others = entries
+	}
+	if subjectID == uuid.Nil {
+		return uuid.Nil, nil, nil, fmt.Errorf("%w: subject is required", ErrInvalidSuggestionQuery)
+	}
+
+	if q.TimeSlotID != uuid.Nil {
+		schedule, err := h.scheduleRepo.GetByID(ctx, q.ScheduleID)
+		if err != nil {
+			return uuid.Nil, nil, nil, fmt.Errorf("get schedule: %w", err)
+		}
+		slots, err := h.semesterRepo.ListTimeSlots(ctx, schedule.SemesterID)
+		if err != nil {
+			return uuid.Nil, nil, nil, fmt.Errorf("list time slots: %w", err)
+		}
+		slot = nil
+		for _, sl := range slots {
+			if sl.ID == q.TimeSlotID {
+				slot = sl
+			}
+		}
+		if slot == nil {
+			return uuid.Nil, nil, nil, fmt.Errorf("%w: time slot %s is not in the schedule's semester", ErrInvalidSuggestionQuery, q.TimeSlotID)
+		}
+	}
+	return subjectID, slot, others, nil
 }
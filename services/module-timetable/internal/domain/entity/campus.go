@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Campus is a site of the university. Moving between campuses takes long
+// enough that back-to-back classes on different campuses are penalised.
+type Campus struct {
+	ID   uuid.UUID
+	Code string
+	Name string
+}
+
+func (c *Campus) Validate() error {
+	if c.Code == "" {
+		return fmt.Errorf("campus code is required")
+	}
+	if c.Name == "" {
+		return fmt.Errorf("campus name is required")
+	}
+	return nil
+}
+
+// Building is a building on a campus; rooms belong to a building.
+type Building struct {
+	ID       uuid.UUID
+	CampusID uuid.UUID
+	Code     string
+	Name     string
+}
+
+func (b *Building) Validate() error {
+	if b.CampusID == uuid.Nil {
+		return fmt.Errorf("building campus is required")
+	}
+	if b.Code == "" {
+		return fmt.Errorf("building code is required")
+	}
+	if b.Name == "" {
+		return fmt.Errorf("building name is required")
+	}
+	return nil
+}
+
+// CampusPair is an unordered pair of campuses, normalised so that A sorts
+// before B.
+type CampusPair struct {
+	A, B uuid.UUID
+}
+
+func NewCampusPair(a, b uuid.UUID) CampusPair {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return CampusPair{A: a, B: b}
+}
+
+// TravelMatrix holds the travel minutes between campuses. It is symmetric:
+// the time from a to b is the time from b to a.
+type TravelMatrix map[CampusPair]int
+
+// Minutes returns the travel time between two campuses; zero for the same
+// campus and false when the pair has no entry.
+func (m TravelMatrix) Minutes(a, b uuid.UUID) (int, bool) {
+	if a == b {
+		return 0, true
+	}
+	minutes, ok := m[NewCampusPair(a, b)]
+	return minutes, ok
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxSectionAssistants bounds the assistants one section may ask for.
+const maxSectionAssistants = 4
+
+// CourseSection is one parallel section of an offered subject. Each section
+// meets for the subject's full weekly hours in its own room and slots.
+type CourseSection struct {
+	Number int `json:"number"` // 1-based, unique within the subject
+	// Capacity is the section's head count; when set, rooms must seat it in
+	// place of the offering's enrollment (0 = use the offering's). The
+	// subject's own minimum room capacity still applies.
+	Capacity int `json:"capacity,omitempty"`
+	// StudentGroup names the cohort that attends the section, e.g. "CS-K20-1".
+	// Sections of the same group never overlap; "" = open to all enrolled.
+	StudentGroup string `json:"student_group,omitempty"`
+	// Assistants is how many assistant teachers every session needs besides
+	// its lead, e.g. a lab TA.
+	Assistants int `json:"assistants,omitempty"`
+}
+
+// ValidateSections checks a subject's sections: at least one, numbers
+// positive and unique, counts not negative and a bounded number of assistants.
+// Student groups are trimmed in place.
+func ValidateSections(sections []CourseSection) error {
+	if len(sections) == 0 {
+		return fmt.Errorf("at least one section is required")
+	}
+	seen := make(map[int]bool, len(sections))
+	for i := range sections {
+		sec := &sections[i]
+		if sec.Number < 1 {
+			return fmt.Errorf("section number must be positive, got %d", sec.Number)
+		}
+		if seen[sec.Number] {
+			return fmt.Errorf("section %d listed twice", sec.Number)
+		}
+		seen[sec.Number] = true
+		if sec.Capacity < 0 {
+			return fmt.Errorf("section %d: capacity must not be negative", sec.Number)
+		}
+		if sec.Assistants < 0 || sec.Assistants > maxSectionAssistants {
+			return fmt.Errorf("section %d: assistants must be between 0 and %d", sec.Number, maxSectionAssistants)
+		}
+		sec.StudentGroup = strings.TrimSpace(sec.StudentGroup)
+	}
+	return nil
+}
+
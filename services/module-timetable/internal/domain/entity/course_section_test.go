@@ -0,0 +1,31 @@
+package entity
+
+import "testing"
+
+func TestValidateSections(t *testing.T) {
+	tests := []struct {
+		name     string
+		sections []CourseSection
+		wantErr  bool
+	}{
+		{name: "single", sections: []CourseSection{{Number: 1}}},
+		{name: "split", sections: []CourseSection{{Number: 1, Capacity: 40}, {Number: 2, StudentGroup: "K21-B", Assistants: 2}}},
+		{name: "none", sections: nil, wantErr: true},
+		{name: "zero number", sections: []CourseSection{{Number: 0}}, wantErr: true},
+		{name: "duplicate", sections: []CourseSection{{Number: 1}, {Number: 1}}, wantErr: true},
+		{name: "negative capacity", sections: []CourseSection{{Number: 1, Capacity: -1}}, wantErr: true},
+		{name: "too many assistants", sections: []CourseSection{{Number: 1, Assistants: 5}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSections(tt.sections); (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSections() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	sections := []CourseSection{{Number: 1, StudentGroup: "  K21-A "}}
+	if err := ValidateSections(sections); err != nil || sections[0].StudentGroup != "K21-A" {
+		t.Fatalf("expected the student group trimmed, got %q (%v)", sections[0].StudentGroup, err)
+	}
+}
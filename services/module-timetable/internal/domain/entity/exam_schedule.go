@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExamSession is one exam sitting offered on every exam day, spanning
+// periods StartPeriod..EndPeriod of the semester's period table.
+type ExamSession struct {
+	StartPeriod int `json:"start_period"`
+	EndPeriod   int `json:"end_period"`
+}
+
+// DefaultExamSessions is a morning and an afternoon sitting of three periods.
+var DefaultExamSessions = []ExamSession{{StartPeriod: 1, EndPeriod: 3}, {StartPeriod: 4, EndPeriod: 6}}
+
+// maxExamPeriodDays bounds the exam window so the solver's domains stay small.
+const maxExamPeriodDays = 42
+
+// ExamSettings are the rules of one exam period. They are stored as JSON on
+// the exam schedule, hence the tags.
+type ExamSettings struct {
+	Sessions []ExamSession `json:"sessions"`
+	// MaxPerDay is the most exams one student may sit on a day.
+	MaxPerDay int `json:"max_per_day"`
+	// SeatSpacing is the number of empty seats left between candidates, so a
+	// room seats Capacity / (SeatSpacing+1) students.
+	SeatSpacing int `json:"seat_spacing"`
+	// MinGapDays is the spacing aimed for between exams sharing students.
+	MinGapDays int `json:"min_gap_days"`
+	// MaxInvigilations caps the exams one teacher invigilates; 0 = uncapped.
+	MaxInvigilations int `json:"max_invigilations"`
+}
+
+// WithDefaults fills unset settings: the default sessions, two exams a day
+// and a two-day gap.
+func (s ExamSettings) WithDefaults() ExamSettings {
+	if len(s.Sessions) == 0 {
+		s.Sessions = DefaultExamSessions
+	}
+	if s.MaxPerDay == 0 {
+		s.MaxPerDay = 2
+	}
+	if s.MinGapDays == 0 {
+		s.MinGapDays = 2
+	}
+	return s
+}
+
+// Validate checks the settings against the semester's period table: every
+// session must be known periods, and sessions may not overlap.
+func (s ExamSettings) Validate(semester *Semester) error {
+	if s.MaxPerDay < 1 {
+		return fmt.Errorf("max_per_day must be >= 1")
+	}
+	if s.SeatSpacing < 0 || s.MinGapDays < 0 || s.MaxInvigilations < 0 {
+		return fmt.Errorf("seat_spacing, min_gap_days and max_invigilations must not be negative")
+	}
+	for i, session := range s.Sessions {
+		if session.EndPeriod < session.StartPeriod {
+			return fmt.Errorf("exam session %d ends before it starts", i+1)
+		}
+		if _, _, ok := semester.SlotTimes(session.StartPeriod, session.EndPeriod); !ok {
+			return fmt.Errorf("exam session %d uses periods outside the semester's period table", i+1)
+		}
+		for _, other := range s.Sessions[:i] {
+			if session.StartPeriod <= other.EndPeriod && other.StartPeriod <= session.EndPeriod {
+				return fmt.Errorf("exam session %d overlaps another session", i+1)
+			}
+		}
+	}
+	return nil
+}
+
+// ExamSchedule is a generated final-exam timetable for a semester.
+type ExamSchedule struct {
+	ID          uuid.UUID
+	SemesterID  uuid.UUID
+	Name        string
+	StartDate   time.Time
+	EndDate     time.Time
+	Settings    ExamSettings
+	Score       float64
+	SoftPenalty float64
+	Entries     []ExamEntry // empty when exam schedules are listed
+	// Unscheduled holds the subjects the solver could not place in time.
+	Unscheduled []uuid.UUID
+	CreatedAt   time.Time
+}
+
+// ValidateWindow checks that the exam period is a sensible date range.
+func (e *ExamSchedule) ValidateWindow() error {
+	start, end := calendarDay(e.StartDate), calendarDay(e.EndDate)
+	if end.Before(start) {
+		return fmt.Errorf("exam end_date must not be before start_date")
+	}
+	if days := int(end.Sub(start).Hours()/24) + 1; days > maxExamPeriodDays {
+		return fmt.Errorf("exam period may span at most %d days, got %d", maxExamPeriodDays, days)
+	}
+	return nil
+}
+
+// ExamEntry is one placed exam. Entries are stored as JSON on the exam
+// schedule, hence the tags.
+type ExamEntry struct {
+	SubjectID       uuid.UUID `json:"subject_id"`
+	SubjectCode     string    `json:"subject_code"`
+	SubjectName     string    `json:"subject_name"`
+	Date            time.Time `json:"date"`
+	StartPeriod     int       `json:"start_period"`
+	EndPeriod       int       `json:"end_period"`
+	RoomID          uuid.UUID `json:"room_id"`
+	RoomName        string    `json:"room_name"`
+	InvigilatorID   uuid.UUID `json:"invigilator_id"`
+	InvigilatorName string    `json:"invigilator_name"`
+	StudentCount    int       `json:"student_count"`
+}
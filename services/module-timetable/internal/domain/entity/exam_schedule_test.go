@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExamSettingsValidate(t *testing.T) {
+	semester := &Semester{}
+	if err := (ExamSettings{}).WithDefaults().Validate(semester); err != nil {
+		t.Fatalf("default settings should be valid: %v", err)
+	}
+	for name, s := range map[string]ExamSettings{
+		"overlapping sessions": {Sessions: []ExamSession{{1, 3}, {3, 5}}},
+		"unknown period":       {Sessions: []ExamSession{{7, 9}}},
+		"negative spacing":     {SeatSpacing: -1},
+	} {
+		if err := s.WithDefaults().Validate(semester); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestExamScheduleValidateWindow(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	if err := (&ExamSchedule{StartDate: start, EndDate: start}).ValidateWindow(); err != nil {
+		t.Fatalf("a one-day exam period should be valid: %v", err)
+	}
+	if err := (&ExamSchedule{StartDate: start, EndDate: start.AddDate(0, 0, -1)}).ValidateWindow(); err == nil {
+		t.Fatal("an end before the start should be rejected")
+	}
+	if err := (&ExamSchedule{StartDate: start, EndDate: start.AddDate(0, 0, maxExamPeriodDays)}).ValidateWindow(); err == nil {
+		t.Fatal("an exam period longer than the maximum should be rejected")
+	}
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// GenerationDiagnosis explains why schedule generation proved infeasible.
+// It is stored as JSON alongside the failed schedule, hence the tags.
+type GenerationDiagnosis struct {
+	EmptyDomains     []EmptyDomainDiagnosis `json:"empty_domains"`
+	ConflictSubjects []DiagnosisRef         `json:"conflict_subjects"`
+	ConflictTeachers []DiagnosisRef         `json:"conflict_teachers"`
+}
+
+// EmptyDomainDiagnosis describes a subject session left without any candidate
+// (teacher, room, slot) and how many candidates each hard constraint removed.
+type EmptyDomainDiagnosis struct {
+	SubjectID   uuid.UUID                          `json:"subject_id"`
+	SubjectCode string                             `json:"subject_code"`
+	SubjectName string                             `json:"subject_name"`
+	Session     int                                `json:"session"`
+	Candidates  int                                `json:"candidates"`
+	Cause       valueobject.ConstraintType         `json:"cause"`
+	Eliminated  map[valueobject.ConstraintType]int `json:"eliminated"`
+}
+
+// DiagnosisRef names a subject or teacher involved in a conflict.
+type DiagnosisRef struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// GenerationJob is a persisted schedule-generation run. A worker claims it
+// with a time-limited lease; a job whose lease expires is claimed again until
+// MaxAttempts is reached, so runs survive a restart of the instance.
+type GenerationJob struct {
+	ID              uuid.UUID
+	ScheduleID      uuid.UUID
+	SemesterID      uuid.UUID
+	Status          valueobject.JobStatus
+	Params          []byte // JSON-encoded generation command
+	Attempts        int
+	MaxAttempts     int
+	LeaseOwner      string
+	LeaseExpiresAt  *time.Time
+	CancelRequested bool
+	ErrorMessage    string
+	CreatedAt       time.Time
+	StartedAt       *time.Time
+	FinishedAt      *time.Time
+}
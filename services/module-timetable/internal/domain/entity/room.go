@@ -14,6 +14,14 @@ type Room struct {
 	Type     string
 	Features []string
 	IsActive bool
+	// RequiresApproval marks a restricted room: ad-hoc bookings of it stay
+	// pending until approved.
+	RequiresApproval bool
+	// BuildingID places the room; uuid.Nil = unknown location. BuildingCode
+	// and CampusID are resolved from the building when the room is loaded.
+	BuildingID   uuid.UUID
+	BuildingCode string
+	CampusID     uuid.UUID
 }
 
 func (r *Room) Validate() error {
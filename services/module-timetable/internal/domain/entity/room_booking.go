@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// maxBookingDays bounds the date range of one booking.
+const maxBookingDays = 180
+
+// ErrBookingTransition is returned when a booking cannot be approved,
+// rejected or cancelled from its current status.
+var ErrBookingTransition = errors.New("invalid room booking status transition")
+
+// RoomBooking reserves a room outside the generated timetable, e.g. for a
+// thesis defence or a meeting. It holds periods StartPeriod..EndPeriod
+// (inclusive) on every day from StartDate to EndDate.
+type RoomBooking struct {
+	ID          uuid.UUID
+	RoomID      uuid.UUID
+	RoomName    string
+	Title       string
+	Purpose     string
+	RequestedBy uuid.UUID
+	StartDate   time.Time
+	EndDate     time.Time
+	StartPeriod int
+	EndPeriod   int
+	Status      valueobject.BookingStatus
+	ReviewedBy  uuid.UUID // uuid.Nil until approved or rejected
+	ReviewNote  string
+	ReviewedAt  time.Time // zero until approved or rejected
+	CreatedAt   time.Time
+}
+
+func (b *RoomBooking) Validate() error {
+	if b.Title == "" {
+		return fmt.Errorf("booking title is required")
+	}
+	if b.RoomID == uuid.Nil {
+		return fmt.Errorf("room is required")
+	}
+	if b.StartPeriod < 1 || b.EndPeriod < b.StartPeriod {
+		return fmt.Errorf("periods must satisfy 1 <= start_period <= end_period")
+	}
+	if b.StartDate.IsZero() || b.EndDate.IsZero() {
+		return fmt.Errorf("start_date and end_date are required")
+	}
+	start, end := calendarDay(b.StartDate), calendarDay(b.EndDate)
+	if end.Before(start) {
+		return fmt.Errorf("end_date is before start_date")
+	}
+	if int(end.Sub(start).Hours()/24)+1 > maxBookingDays {
+		return fmt.Errorf("a booking may span at most %d days", maxBookingDays)
+	}
+	return nil
+}
+
+// Days returns the booked days in order.
+func (b *RoomBooking) Days() []time.Time {
+	return DaysBetween(b.StartDate, b.EndDate)
+}
+
+// Holds reports whether the booking occupies its room on day during any of
+// periods start..end (inclusive).
+func (b *RoomBooking) Holds(day time.Time, start, end int) bool {
+	d := calendarDay(day)
+	if d.Before(calendarDay(b.StartDate)) || d.After(calendarDay(b.EndDate)) {
+		return false
+	}
+	return b.Status.IsActive() && PeriodsOverlap(b.StartPeriod, b.EndPeriod, start, end)
+}
+
+// Approve confirms a pending booking.
+func (b *RoomBooking) Approve(reviewer uuid.UUID, note string, at time.Time) error {
+	if b.Status != valueobject.BookingStatusPending {
+		return fmt.Errorf("%w: only pending bookings can be approved, current: %s", ErrBookingTransition, b.Status)
+	}
+	b.Status = valueobject.BookingStatusApproved
+	b.ReviewedBy, b.ReviewNote, b.ReviewedAt = reviewer, note, at
+	return nil
+}
+
+// Reject turns down a pending booking and frees the room.
+func (b *RoomBooking) Reject(reviewer uuid.UUID, note string, at time.Time) error {
+	if b.Status != valueobject.BookingStatusPending {
+		return fmt.Errorf("%w: only pending bookings can be rejected, current: %s", ErrBookingTransition, b.Status)
+	}
+	b.Status = valueobject.BookingStatusRejected
+	b.ReviewedBy, b.ReviewNote, b.ReviewedAt = reviewer, note, at
+	return nil
+}
+
+// Cancel withdraws a pending or approved booking.
+func (b *RoomBooking) Cancel() error {
+	if !b.Status.IsActive() {
+		return fmt.Errorf("%w: cannot cancel a %s booking", ErrBookingTransition, b.Status)
+	}
+	b.Status = valueobject.BookingStatusCancelled
+	return nil
+}
+
+// DaysBetween returns the calendar days from start to end, inclusive.
+func DaysBetween(start, end time.Time) []time.Time {
+	var days []time.Time
+	for d := calendarDay(start); !d.After(calendarDay(end)); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+	return days
+}
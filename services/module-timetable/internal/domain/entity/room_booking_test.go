@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestRoomBooking_Validate(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 11, d, 0, 0, 0, 0, time.UTC) }
+	valid := func() RoomBooking {
+		return RoomBooking{RoomID: uuid.New(), Title: "Thesis defence", StartDate: day(4), EndDate: day(4), StartPeriod: 3, EndPeriod: 5}
+	}
+	tests := []struct {
+		name    string
+		modify  func(b *RoomBooking)
+		wantErr bool
+	}{
+		{"valid single day", func(*RoomBooking) {}, false},
+		{"single period", func(b *RoomBooking) { b.EndPeriod = 3 }, false},
+		{"missing title", func(b *RoomBooking) { b.Title = "" }, true},
+		{"missing room", func(b *RoomBooking) { b.RoomID = uuid.Nil }, true},
+		{"periods reversed", func(b *RoomBooking) { b.StartPeriod, b.EndPeriod = 5, 3 }, true},
+		{"period zero", func(b *RoomBooking) { b.StartPeriod = 0 }, true},
+		{"ends before it starts", func(b *RoomBooking) { b.EndDate = day(3) }, true},
+		{"too long", func(b *RoomBooking) { b.EndDate = b.StartDate.AddDate(1, 0, 0) }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := valid()
+			tt.modify(&b)
+			if err := b.Validate(); (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRoomBooking_Holds(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 11, d, 0, 0, 0, 0, time.UTC) }
+	b := RoomBooking{StartDate: day(4), EndDate: day(6), StartPeriod: 3, EndPeriod: 5, Status: valueobject.BookingStatusApproved}
+
+	if !b.Holds(day(5), 5, 6) {
+		t.Fatal("periods 5-6 share period 5 with the booking")
+	}
+	if b.Holds(day(5), 1, 2) {
+		t.Fatal("periods 1-2 are free")
+	}
+	if b.Holds(day(7), 3, 5) {
+		t.Fatal("the booking ends on the 6th")
+	}
+	b.Status = valueobject.BookingStatusRejected
+	if b.Holds(day(5), 3, 5) {
+		t.Fatal("a rejected booking holds nothing")
+	}
+}
+
+func TestRoomBooking_Transitions(t *testing.T) {
+	reviewer := uuid.New()
+	b := RoomBooking{Status: valueobject.BookingStatusPending}
+	if err := b.Approve(reviewer, "ok", time.Now()); err != nil {
+		t.Fatalf("approve pending: %v", err)
+	}
+	if b.ReviewedBy != reviewer {
+		t.Fatal("reviewer not recorded")
+	}
+	if err := b.Reject(reviewer, "", time.Now()); !errors.Is(err, ErrBookingTransition) {
+		t.Fatalf("reject approved: want ErrBookingTransition, got %v", err)
+	}
+	if err := b.Cancel(); err != nil {
+		t.Fatalf("cancel approved: %v", err)
+	}
+	if err := b.Cancel(); !errors.Is(err, ErrBookingTransition) {
+		t.Fatalf("cancel twice: want ErrBookingTransition, got %v", err)
+	}
+}
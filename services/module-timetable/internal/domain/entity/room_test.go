@@ -2,6 +2,8 @@ package entity
 
 import (
 	"testing"
+
+	"github.com/google/uuid"
 )
 
 func TestRoom_Validate(t *testing.T) {
@@ -52,3 +54,21 @@ func TestRoom_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestTravelMatrix_Minutes(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	m := TravelMatrix{NewCampusPair(b, a): 25}
+
+	if got, ok := m.Minutes(a, b); !ok || got != 25 {
+		t.Fatalf("Minutes(a, b) = %d, %v, want 25, true", got, ok)
+	}
+	if got, ok := m.Minutes(b, a); !ok || got != 25 {
+		t.Fatalf("matrix must be symmetric, got %d, %v", got, ok)
+	}
+	if got, ok := m.Minutes(c, c); !ok || got != 0 {
+		t.Fatalf("same campus = %d, %v, want 0, true", got, ok)
+	}
+	if _, ok := m.Minutes(a, c); ok {
+		t.Fatal("pair without an entry must be unknown")
+	}
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -17,11 +18,25 @@ type Schedule struct {
 	Score          float64
 	HardViolations int
 	SoftPenalty    float64
+	CohortClashes  int // students double-booked; 0 unless the cohort rule was relaxed
 	GeneratedAt    *time.Time
 	CreatedAt      time.Time
 	Entries        []*ScheduleEntry
+
+	// Set only when generation failed; Diagnosis is nil unless the solver
+	// proved the problem infeasible.
+	FailureReason string
+	Diagnosis     *GenerationDiagnosis
 }
 
+// ErrStatusTransition is returned when a lifecycle change is not allowed
+// from the schedule's current status.
+var ErrStatusTransition = errors.New("invalid schedule status transition")
+
+// ErrHardViolations is returned when publishing a schedule that still breaks
+// hard constraints, e.g. after a manual edit introduced a conflict.
+var ErrHardViolations = errors.New("schedule has hard violations")
+
 func (s *Schedule) Validate() error {
 	if s.Name == "" {
 		return fmt.Errorf("schedule name is required")
@@ -32,14 +47,34 @@ func (s *Schedule) Validate() error {
 	return nil
 }
 
-// Publish transitions the schedule from Draft to Published.
+// Publish transitions a generated or draft schedule to Published.
 func (s *Schedule) Publish() error {
-	if s.Status != valueobject.ScheduleStatusDraft {
-		return fmt.Errorf("only draft schedules can be published, current: %s", s.Status)
+	if s.Status != valueobject.ScheduleStatusDraft && s.Status != valueobject.ScheduleStatusCompleted {
+		return fmt.Errorf("%w: only draft or completed schedules can be published, current: %s", ErrStatusTransition, s.Status)
 	}
 	if s.HardViolations > 0 {
-		return fmt.Errorf("cannot publish schedule with %d hard violations", s.HardViolations)
+		return fmt.Errorf("cannot publish schedule: %w (%d)", ErrHardViolations, s.HardViolations)
 	}
 	s.Status = valueobject.ScheduleStatusPublished
 	return nil
 }
+
+// Unpublish returns a published schedule to Draft, leaving its semester
+// without a published timetable.
+func (s *Schedule) Unpublish() error {
+	if s.Status != valueobject.ScheduleStatusPublished {
+		return fmt.Errorf("%w: only published schedules can be unpublished, current: %s", ErrStatusTransition, s.Status)
+	}
+	s.Status = valueobject.ScheduleStatusDraft
+	return nil
+}
+
+// Archive retires a schedule that is not being generated.
+func (s *Schedule) Archive() error {
+	switch s.Status {
+	case valueobject.ScheduleStatusGenerating, valueobject.ScheduleStatusArchived:
+		return fmt.Errorf("%w: cannot archive a %s schedule", ErrStatusTransition, s.Status)
+	}
+	s.Status = valueobject.ScheduleStatusArchived
+	return nil
+}
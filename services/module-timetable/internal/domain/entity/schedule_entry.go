@@ -3,14 +3,17 @@ package entity
 import (
 	"time"
 
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 	"github.com/google/uuid"
 )
 
 // ScheduleEntry maps a subject to a teacher, room, and time slot within a schedule.
+// TeacherID is the session's lead; Assistants teach alongside them.
 type ScheduleEntry struct {
 	ID               uuid.UUID
 	ScheduleID       uuid.UUID
 	SubjectID        uuid.UUID
+	Section          int // 1-based section of the subject
 	TeacherID        uuid.UUID
 	RoomID           uuid.UUID
 	TimeSlotID       uuid.UUID
@@ -22,6 +25,7 @@ type ScheduleEntry struct {
 	SubjectCode  string
 	TeacherName  string
 	DepartmentID uuid.UUID
+	Assistants   []EntryTeacher
 
 	// Display-only fields populated via JOIN on read (not persisted directly).
 	DayOfWeek   int
@@ -29,3 +33,27 @@ type ScheduleEntry struct {
 	EndPeriod   int
 	RoomName    string
 }
+
+// EntryTeacher is a teacher with their role in one session.
+type EntryTeacher struct {
+	TeacherID   uuid.UUID               `json:"teacher_id"`
+	TeacherName string                  `json:"teacher_name"`
+	Role        valueobject.TeacherRole `json:"role"`
+}
+
+// Teachers lists everyone teaching the session, the lead first.
+func (e *ScheduleEntry) Teachers() []EntryTeacher {
+	teachers := make([]EntryTeacher, 0, 1+len(e.Assistants))
+	teachers = append(teachers, EntryTeacher{TeacherID: e.TeacherID, TeacherName: e.TeacherName, Role: valueobject.TeacherRoleLead})
+	return append(teachers, e.Assistants...)
+}
+
+// Slot returns the entry's time slot as read with the entry.
+func (e *ScheduleEntry) Slot() *TimeSlot {
+	return &TimeSlot{ID: e.TimeSlotID, DayOfWeek: e.DayOfWeek, StartPeriod: e.StartPeriod, EndPeriod: e.EndPeriod}
+}
+
+// SectionNumber is Section, treating entries stored before sections as 1.
+func (e *ScheduleEntry) SectionNumber() int {
+	return max(e.Section, 1)
+}
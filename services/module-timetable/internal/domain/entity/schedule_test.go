@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
@@ -93,3 +94,26 @@ func TestSchedule_Publish_Archived(t *testing.T) {
 		t.Fatal("expected error for archived status")
 	}
 }
+
+func TestSchedule_Publish_Completed(t *testing.T) {
+	s := &Schedule{ID: uuid.New(), Name: "S1", Status: valueobject.ScheduleStatusCompleted}
+	if err := s.Publish(); err != nil {
+		t.Fatalf("generated schedules should be publishable, got %v", err)
+	}
+}
+
+func TestSchedule_UnpublishAndArchive(t *testing.T) {
+	s := &Schedule{ID: uuid.New(), Name: "S1", Status: valueobject.ScheduleStatusPublished}
+	if err := s.Unpublish(); err != nil || s.Status != valueobject.ScheduleStatusDraft {
+		t.Fatalf("expected Draft after unpublish, got %s (%v)", s.Status, err)
+	}
+	if err := s.Unpublish(); !errors.Is(err, ErrStatusTransition) {
+		t.Fatalf("expected a transition error unpublishing a draft, got %v", err)
+	}
+	if err := s.Archive(); err != nil || s.Status != valueobject.ScheduleStatusArchived {
+		t.Fatalf("expected Archived, got %s (%v)", s.Status, err)
+	}
+	if err := s.Archive(); !errors.Is(err, ErrStatusTransition) {
+		t.Fatalf("expected a transition error archiving twice, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleVersion is an immutable snapshot of a schedule taken when it was
+// published. Versions are numbered per semester starting at 1.
+type ScheduleVersion struct {
+	ID             uuid.UUID
+	SemesterID     uuid.UUID
+	ScheduleID     uuid.UUID
+	Version        int
+	Score          float64
+	HardViolations int
+	SoftPenalty    float64
+	PublishedAt    time.Time
+	Entries        []VersionEntry // empty when versions are listed
+}
+
+// VersionEntry is one placed session as it stood when the version was published.
+type VersionEntry struct {
+	SubjectID        uuid.UUID `json:"subject_id"`
+	SubjectName      string    `json:"subject_name"`
+	SubjectCode      string    `json:"subject_code"`
+	DepartmentID     uuid.UUID `json:"department_id"`
+	TeacherID        uuid.UUID `json:"teacher_id"`
+	TeacherName      string    `json:"teacher_name"`
+	RoomID           uuid.UUID `json:"room_id"`
+	RoomName         string    `json:"room_name"`
+	TimeSlotID       uuid.UUID `json:"time_slot_id"`
+	DayOfWeek        int       `json:"day_of_week"`
+	StartPeriod      int       `json:"start_period"`
+	EndPeriod        int       `json:"end_period"`
+	IsManualOverride bool      `json:"is_manual_override"`
+
+	// Absent from versions published before sections.
+	Section    int            `json:"section,omitempty"`
+	Assistants []EntryTeacher `json:"assistants,omitempty"`
+}
+
+// VersionEntryOf snapshots a schedule entry.
+func VersionEntryOf(e *ScheduleEntry) VersionEntry {
+	return VersionEntry{
+		SubjectID:        e.SubjectID,
+		SubjectName:      e.SubjectName,
+		SubjectCode:      e.SubjectCode,
+		Section:          e.Section,
+		DepartmentID:     e.DepartmentID,
+		TeacherID:        e.TeacherID,
+		TeacherName:      e.TeacherName,
+		RoomID:           e.RoomID,
+		RoomName:         e.RoomName,
+		TimeSlotID:       e.TimeSlotID,
+		DayOfWeek:        e.DayOfWeek,
+		StartPeriod:      e.StartPeriod,
+		EndPeriod:        e.EndPeriod,
+		IsManualOverride: e.IsManualOverride,
+		Assistants:       e.Assistants,
+	}
+}
+
+// ScheduleEntry rebuilds an entry from the snapshot, without ID or schedule.
+func (v VersionEntry) ScheduleEntry() *ScheduleEntry {
+	return &ScheduleEntry{
+		SubjectID:        v.SubjectID,
+		SubjectName:      v.SubjectName,
+		SubjectCode:      v.SubjectCode,
+		Section:          v.Section,
+		DepartmentID:     v.DepartmentID,
+		TeacherID:        v.TeacherID,
+		TeacherName:      v.TeacherName,
+		RoomID:           v.RoomID,
+		RoomName:         v.RoomName,
+		TimeSlotID:       v.TimeSlotID,
+		DayOfWeek:        v.DayOfWeek,
+		StartPeriod:      v.StartPeriod,
+		EndPeriod:        v.EndPeriod,
+		IsManualOverride: v.IsManualOverride,
+		Assistants:       v.Assistants,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 )
 
 // Semester is the aggregate root for a teaching semester.
@@ -17,7 +18,16 @@ type Semester struct {
 	EndDate           time.Time
 	OfferedSubjectIDs []uuid.UUID
 	RoomIDs           []uuid.UUID
-	CreatedAt         time.Time
+	// SoftConstraints overrides the solver's default soft constraints; empty = defaults.
+	SoftConstraints []SoftConstraintSetting
+	// Periods maps period numbers to clock times; empty = DefaultPeriods.
+	Periods  []PeriodDefinition
+	Holidays []Holiday
+	// WeekPatterns holds the offered subjects that do not meet every week.
+	WeekPatterns map[uuid.UUID]valueobject.WeekPattern
+	// Sections holds the offered subjects split into parallel sections.
+	Sections  map[uuid.UUID][]CourseSection
+	CreatedAt time.Time
 }
 
 func (s *Semester) Validate() error {
@@ -0,0 +1,275 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// PeriodDefinition gives the wall-clock times ("HH:MM") of one teaching
+// period. It is stored as JSON on the semester, hence the tags.
+type PeriodDefinition struct {
+	Period int    `json:"period"`
+	Start  string `json:"start"`
+	End    string `json:"end"`
+}
+
+// DefaultPeriods is the standard university period table, used when a
+// semester defines none (the frontend shows the same times).
+var DefaultPeriods = []PeriodDefinition{
+	{1, "08:00", "09:30"},
+	{2, "09:45", "11:15"},
+	{3, "11:30", "13:00"},
+	{4, "13:15", "14:45"},
+	{5, "15:00", "16:30"},
+	{6, "16:45", "18:15"},
+	{7, "18:30", "20:00"},
+	{8, "20:15", "21:45"},
+}
+
+// Times returns the period's start and end as offsets from midnight.
+func (p PeriodDefinition) Times() (start, end time.Duration, err error) {
+	if start, err = ParseClock(p.Start); err != nil {
+		return 0, 0, err
+	}
+	if end, err = ParseClock(p.End); err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// ParseClock parses an "HH:MM" time of day into an offset from midnight.
+func ParseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid clock time %q, want HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func formatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
+
+// ValidatePeriods checks that periods are numbered 1..n in order and that
+// each one ends after it starts and before the next one begins.
+func ValidatePeriods(periods []PeriodDefinition) error {
+	var prevEnd time.Duration
+	for i, p := range periods {
+		if p.Period != i+1 {
+			return fmt.Errorf("periods must be numbered 1..%d in order, got %d at position %d", len(periods), p.Period, i+1)
+		}
+		start, end, err := p.Times()
+		if err != nil {
+			return fmt.Errorf("period %d: %w", p.Period, err)
+		}
+		if end <= start {
+			return fmt.Errorf("period %d must end after it starts", p.Period)
+		}
+		if i > 0 && start < prevEnd {
+			return fmt.Errorf("period %d starts before period %d ends", p.Period, p.Period-1)
+		}
+		prevEnd = end
+	}
+	return nil
+}
+
+// GeneratePeriods builds count periods of periodMinutes each, starting at
+// firstStart and separated by breakMinutes. longBreaks overrides the break
+// after a given period, e.g. {3: 60} for a lunch break after period 3.
+func GeneratePeriods(firstStart string, count, periodMinutes, breakMinutes int, longBreaks map[int]int) ([]PeriodDefinition, error) {
+	at, err := ParseClock(firstStart)
+	if err != nil {
+		return nil, err
+	}
+	if count < 1 || periodMinutes < 1 || breakMinutes < 0 {
+		return nil, fmt.Errorf("count and period length must be positive and the break not negative")
+	}
+	periods := make([]PeriodDefinition, 0, count)
+	for n := 1; n <= count; n++ {
+		end := at + time.Duration(periodMinutes)*time.Minute
+		if end > 24*time.Hour {
+			return nil, fmt.Errorf("period %d would end after midnight", n)
+		}
+		periods = append(periods, PeriodDefinition{Period: n, Start: formatClock(at), End: formatClock(end)})
+		gap := breakMinutes
+		if long, ok := longBreaks[n]; ok {
+			gap = long
+		}
+		at = end + time.Duration(gap)*time.Minute
+	}
+	return periods, nil
+}
+
+// Holiday is a non-teaching day or an inclusive range of days. It is stored
+// as JSON on the semester, hence the tags.
+type Holiday struct {
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+// Covers reports whether day falls within the holiday.
+func (h Holiday) Covers(day time.Time) bool {
+	d := calendarDay(day)
+	return !d.Before(calendarDay(h.StartDate)) && !d.After(calendarDay(h.EndDate))
+}
+
+// calendarDay truncates t to its date at midnight UTC, the form dates are
+// compared in.
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// DayIndex returns the day of the week of t as used by time slots, 0=Monday
+// … 6=Sunday.
+func DayIndex(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+// ValidateHolidays checks that every holiday ends no earlier than it starts
+// and lies within the semester.
+func (s *Semester) ValidateHolidays(holidays []Holiday) error {
+	first, last := calendarDay(s.StartDate), calendarDay(s.EndDate)
+	for _, h := range holidays {
+		start, end := calendarDay(h.StartDate), calendarDay(h.EndDate)
+		if end.Before(start) {
+			return fmt.Errorf("holiday %q ends before it starts", h.Name)
+		}
+		if start.Before(first) || end.After(last) {
+			return fmt.Errorf("holiday %q is outside the semester", h.Name)
+		}
+	}
+	return nil
+}
+
+// PeriodTable returns the semester's period definitions, or DefaultPeriods
+// when it defines none.
+func (s *Semester) PeriodTable() []PeriodDefinition {
+	if len(s.Periods) > 0 {
+		return s.Periods
+	}
+	return DefaultPeriods
+}
+
+// SlotTimes returns the wall-clock span of a slot, from the start of
+// startPeriod to the end of endPeriod; ok is false when either period is
+// not in the table.
+func (s *Semester) SlotTimes(startPeriod, endPeriod int) (from, to time.Duration, ok bool) {
+	var haveFrom, haveTo bool
+	for _, p := range s.PeriodTable() {
+		start, end, err := p.Times()
+		if err != nil {
+			continue
+		}
+		if p.Period == startPeriod {
+			from, haveFrom = start, true
+		}
+		if p.Period == endPeriod {
+			to, haveTo = end, true
+		}
+	}
+	return from, to, haveFrom && haveTo && to > from
+}
+
+// TeachingTime returns the time taught in a slot: the summed length of
+// periods startPeriod..endPeriod, without the breaks between them.
+func (s *Semester) TeachingTime(startPeriod, endPeriod int) time.Duration {
+	var total time.Duration
+	for _, p := range s.PeriodTable() {
+		if p.Period < startPeriod || p.Period > endPeriod {
+			continue
+		}
+		if start, end, err := p.Times(); err == nil {
+			total += end - start
+		}
+	}
+	return total
+}
+
+// WeekPatternOf returns the week pattern of an offered subject, weekly
+// unless one was set.
+func (s *Semester) WeekPatternOf(subjectID uuid.UUID) valueobject.WeekPattern {
+	if p, ok := s.WeekPatterns[subjectID]; ok {
+		return p
+	}
+	return valueobject.WeekPatternWeekly
+}
+
+// firstMonday is the Monday of the week containing the start date, the
+// start of teaching week 1.
+func (s *Semester) firstMonday() time.Time {
+	start := calendarDay(s.StartDate)
+	return start.AddDate(0, 0, -DayIndex(start))
+}
+
+// Weeks returns the number of calendar weeks the semester touches.
+func (s *Semester) Weeks() int {
+	return s.WeekOf(s.EndDate)
+}
+
+// WeekOf returns the teaching week of day, counting from 1.
+func (s *Semester) WeekOf(day time.Time) int {
+	return int(calendarDay(day).Sub(s.firstMonday()).Hours()/24)/7 + 1
+}
+
+// IsHoliday reports whether day is a non-teaching day.
+func (s *Semester) IsHoliday(day time.Time) bool {
+	for _, h := range s.Holidays {
+		if h.Covers(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// InPattern reports whether a course with the pattern meets in week.
+func (s *Semester) InPattern(week int, pattern valueobject.WeekPattern) bool {
+	half := (s.Weeks() + 1) / 2
+	switch pattern {
+	case valueobject.WeekPatternOdd:
+		return week%2 == 1
+	case valueobject.WeekPatternEven:
+		return week%2 == 0
+	case valueobject.WeekPatternFirstHalf:
+		return week <= half
+	case valueobject.WeekPatternSecondHalf:
+		return week > half
+	}
+	return true
+}
+
+// ClassDates returns the dates within the semester on which a course with
+// the pattern meets on dayOfWeek (0=Monday). Dates that fall on a holiday
+// are returned separately as cancelled.
+func (s *Semester) ClassDates(dayOfWeek int, pattern valueobject.WeekPattern) (teaching, cancelled []time.Time) {
+	if dayOfWeek < 0 || dayOfWeek > 6 {
+		return nil, nil
+	}
+	first, last := calendarDay(s.StartDate), calendarDay(s.EndDate)
+	for week := 1; week <= s.Weeks(); week++ {
+		day := s.firstMonday().AddDate(0, 0, (week-1)*7+dayOfWeek)
+		if day.Before(first) || day.After(last) || !s.InPattern(week, pattern) {
+			continue
+		}
+		if s.IsHoliday(day) {
+			cancelled = append(cancelled, day)
+		} else {
+			teaching = append(teaching, day)
+		}
+	}
+	return teaching, cancelled
+}
+
+// TeachesOn reports whether a course with the pattern that meets on
+// dayOfWeek (0=Monday) is taught on day: the day is that weekday within the
+// semester, in one of the pattern's weeks and not a holiday.
+func (s *Semester) TeachesOn(day time.Time, dayOfWeek int, pattern valueobject.WeekPattern) bool {
+	d := calendarDay(day)
+	if DayIndex(d) != dayOfWeek || d.Before(calendarDay(s.StartDate)) || d.After(calendarDay(s.EndDate)) {
+		return false
+	}
+	return s.InPattern(s.WeekOf(d), pattern) && !s.IsHoliday(d)
+}
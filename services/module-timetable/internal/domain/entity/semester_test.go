@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 )
 
 func TestSemester_Validate(t *testing.T) {
@@ -166,3 +167,118 @@ func TestSemester_RemoveOfferedSubject(t *testing.T) {
 		t.Fatalf("expected 1 after no-op remove, got %d", len(s.OfferedSubjectIDs))
 	}
 }
+
+func TestGeneratePeriods(t *testing.T) {
+	periods, err := GeneratePeriods("07:00", 4, 50, 10, map[int]int{2: 30})
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	want := []PeriodDefinition{
+		{1, "07:00", "07:50"},
+		{2, "08:00", "08:50"},
+		{3, "09:20", "10:10"}, // long break after period 2
+		{4, "10:20", "11:10"},
+	}
+	if len(periods) != len(want) {
+		t.Fatalf("expected %d periods, got %d", len(want), len(periods))
+	}
+	for i := range want {
+		if periods[i] != want[i] {
+			t.Fatalf("period %d: expected %+v, got %+v", i+1, want[i], periods[i])
+		}
+	}
+	if err := ValidatePeriods(periods); err != nil {
+		t.Fatalf("generated periods should be valid: %v", err)
+	}
+
+	if _, err := GeneratePeriods("22:00", 2, 90, 15, nil); err == nil {
+		t.Fatal("expected error for periods running past midnight")
+	}
+}
+
+func TestValidatePeriods(t *testing.T) {
+	tests := []struct {
+		name    string
+		periods []PeriodDefinition
+	}{
+		{"gap in numbering", []PeriodDefinition{{1, "08:00", "09:00"}, {3, "09:10", "10:00"}}},
+		{"ends before start", []PeriodDefinition{{1, "09:00", "08:00"}}},
+		{"overlap", []PeriodDefinition{{1, "08:00", "09:00"}, {2, "08:30", "09:30"}}},
+		{"bad clock", []PeriodDefinition{{1, "8am", "09:00"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidatePeriods(tt.periods); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+	if err := ValidatePeriods(DefaultPeriods); err != nil {
+		t.Fatalf("default periods should be valid: %v", err)
+	}
+}
+
+func TestSemester_SlotTimes(t *testing.T) {
+	s := &Semester{}
+	from, to, ok := s.SlotTimes(1, 2)
+	if !ok || from != 8*time.Hour || to != 11*time.Hour+15*time.Minute {
+		t.Fatalf("default slot 1-2: got %v-%v ok=%v", from, to, ok)
+	}
+	if got := s.TeachingTime(1, 2); got != 3*time.Hour {
+		t.Fatalf("expected 3h taught without the break, got %v", got)
+	}
+	if _, _, ok := s.SlotTimes(1, 9); ok {
+		t.Fatal("expected unknown period to be reported")
+	}
+
+	s.Periods = []PeriodDefinition{{1, "07:00", "07:50"}, {2, "08:00", "08:50"}}
+	if from, to, _ := s.SlotTimes(1, 2); from != 7*time.Hour || to != 8*time.Hour+50*time.Minute {
+		t.Fatalf("custom slot 1-2: got %v-%v", from, to)
+	}
+}
+
+func TestSemester_ClassDates(t *testing.T) {
+	date := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC) }
+	s := &Semester{
+		// Wednesday 2025-09-03 to Friday 2025-10-03: five calendar weeks
+		StartDate: date(9, 3),
+		EndDate:   date(10, 3),
+		Holidays:  []Holiday{{Name: "Founders' day", StartDate: date(9, 15), EndDate: date(9, 15)}},
+	}
+	if s.Weeks() != 5 {
+		t.Fatalf("expected 5 weeks, got %d", s.Weeks())
+	}
+
+	tests := []struct {
+		pattern   valueobject.WeekPattern
+		teaching  []time.Time
+		cancelled []time.Time
+	}{
+		// The Monday of week 1 is before the start date
+		{valueobject.WeekPatternWeekly, []time.Time{date(9, 8), date(9, 22), date(9, 29)}, []time.Time{date(9, 15)}},
+		{valueobject.WeekPatternOdd, []time.Time{date(9, 29)}, []time.Time{date(9, 15)}},
+		{valueobject.WeekPatternEven, []time.Time{date(9, 8), date(9, 22)}, nil},
+		{valueobject.WeekPatternFirstHalf, []time.Time{date(9, 8)}, []time.Time{date(9, 15)}},
+		{valueobject.WeekPatternSecondHalf, []time.Time{date(9, 22), date(9, 29)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.pattern), func(t *testing.T) {
+			teaching, cancelled := s.ClassDates(0, tt.pattern)
+			if !sameDates(teaching, tt.teaching) || !sameDates(cancelled, tt.cancelled) {
+				t.Fatalf("got teaching %v cancelled %v, want %v and %v", teaching, cancelled, tt.teaching, tt.cancelled)
+			}
+		})
+	}
+}
+
+func sameDates(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
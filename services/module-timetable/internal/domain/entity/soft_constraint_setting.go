@@ -0,0 +1,12 @@
+package entity
+
+import "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+
+// SoftConstraintSetting enables one soft constraint for a semester with its
+// weight and optional integer params (e.g. after_period for late_period).
+// It is stored as JSON on the semester, hence the tags.
+type SoftConstraintSetting struct {
+	Type   valueobject.ConstraintType `json:"type"`
+	Weight float64                    `json:"weight"`
+	Params map[string]int             `json:"params,omitempty"`
+}
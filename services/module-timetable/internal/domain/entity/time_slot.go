@@ -7,7 +7,11 @@ import (
 )
 
 // TimeSlot represents a recurring teaching period within a semester.
-// Periods are integer indices (e.g. 1 = 07:00-07:50, 2 = 08:00-08:50 …).
+// Periods are integer indices; their clock times come from the semester's
+// period table (see Semester.PeriodTable). Both ends are taught: a slot
+// covers periods StartPeriod..EndPeriod inclusive, so the preset slot 1-2 is
+// two periods long and the next slot may start at period 3. Overlap checks,
+// period counts, clock times and room bookings all follow this convention.
 type TimeSlot struct {
 	ID          uuid.UUID
 	SemesterID  uuid.UUID
@@ -34,5 +38,11 @@ func (ts *TimeSlot) OverlapsWith(other *TimeSlot) bool {
 	if ts.DayOfWeek != other.DayOfWeek {
 		return false
 	}
-	return ts.StartPeriod < other.EndPeriod && other.StartPeriod < ts.EndPeriod
+	return PeriodsOverlap(ts.StartPeriod, ts.EndPeriod, other.StartPeriod, other.EndPeriod)
+}
+
+// PeriodsOverlap reports whether the inclusive period ranges aStart..aEnd
+// and bStart..bEnd share a period.
+func PeriodsOverlap(aStart, aEnd, bStart, bEnd int) bool {
+	return aStart <= bEnd && bStart <= aEnd
 }
@@ -59,9 +59,15 @@ func TestTimeSlotOverlapsWith(t *testing.T) {
 			want: true,
 		},
 		{
-			name: "same day adjacent",
+			name: "same day sharing the end period",
 			a:    &TimeSlot{DayOfWeek: 1, StartPeriod: 1, EndPeriod: 3},
 			b:    &TimeSlot{DayOfWeek: 1, StartPeriod: 3, EndPeriod: 5},
+			want: true,
+		},
+		{
+			name: "same day adjacent",
+			a:    &TimeSlot{DayOfWeek: 1, StartPeriod: 1, EndPeriod: 2},
+			b:    &TimeSlot{DayOfWeek: 1, StartPeriod: 3, EndPeriod: 4},
 			want: false,
 		},
 		{
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+)
+
+// ErrCampusNotFound is returned when no campus has the ID.
+var ErrCampusNotFound = errors.New("campus not found")
+
+// ErrBuildingNotFound is returned when no building has the ID.
+var ErrBuildingNotFound = errors.New("building not found")
+
+// ErrLocationCodeTaken is returned when another campus or building already
+// has the code.
+var ErrLocationCodeTaken = errors.New("code already in use")
+
+// CampusRepository stores campuses, their buildings and the travel times
+// between campuses.
+type CampusRepository interface {
+	CreateCampus(ctx context.Context, c *entity.Campus) (*entity.Campus, error)
+	GetCampusByID(ctx context.Context, id uuid.UUID) (*entity.Campus, error)
+	// ListCampuses returns every campus ordered by code.
+	ListCampuses(ctx context.Context) ([]*entity.Campus, error)
+	CreateBuilding(ctx context.Context, b *entity.Building) (*entity.Building, error)
+	GetBuildingByID(ctx context.Context, id uuid.UUID) (*entity.Building, error)
+	// ListBuildings returns the buildings of a campus, or of every campus
+	// for uuid.Nil, ordered by code.
+	ListBuildings(ctx context.Context, campusID uuid.UUID) ([]*entity.Building, error)
+	// SetTravelTime stores the travel minutes between two distinct campuses.
+	SetTravelTime(ctx context.Context, a, b uuid.UUID, minutes int) error
+	TravelMatrix(ctx context.Context) (entity.TravelMatrix, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+)
+
+// ErrExamScheduleNotFound is returned when no exam schedule has the ID.
+var ErrExamScheduleNotFound = errors.New("exam schedule not found")
+
+// ExamScheduleRepository stores generated exam timetables.
+type ExamScheduleRepository interface {
+	Create(ctx context.Context, e *entity.ExamSchedule) (*entity.ExamSchedule, error)
+	// GetByID returns one exam schedule with its entries.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.ExamSchedule, error)
+	// ListBySemester returns a semester's exam schedules, newest first, without entries.
+	ListBySemester(ctx context.Context, semesterID uuid.UUID) ([]*entity.ExamSchedule, error)
+}
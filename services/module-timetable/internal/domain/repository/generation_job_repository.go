@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// ErrLeaseLost is returned when a worker no longer holds a job's lease.
+var ErrLeaseLost = errors.New("generation job lease lost")
+
+// ErrNoActiveJob is returned when a schedule has no queued or running job.
+var ErrNoActiveJob = errors.New("no active generation job")
+
+// GenerationJobRepository persists generation jobs and their leases.
+type GenerationJobRepository interface {
+	Create(ctx context.Context, job *entity.GenerationJob) (*entity.GenerationJob, error)
+	GetBySchedule(ctx context.Context, scheduleID uuid.UUID) (*entity.GenerationJob, error)
+	// Claim leases the next runnable job to owner; returns nil when none is runnable.
+	Claim(ctx context.Context, owner string, lease time.Duration) (*entity.GenerationJob, error)
+	// RenewLease extends owner's lease and reports whether a cancel was requested.
+	// Returns ErrLeaseLost when owner no longer holds the lease.
+	RenewLease(ctx context.Context, id uuid.UUID, owner string, lease time.Duration) (bool, error)
+	// Finish moves a running job owned by owner to a terminal status.
+	Finish(ctx context.Context, id uuid.UUID, owner string, status valueobject.JobStatus, errMsg string) error
+	// RequestCancel flags the schedule's active job; a queued job is cancelled
+	// at once. Returns ErrNoActiveJob when nothing is queued or running.
+	RequestCancel(ctx context.Context, scheduleID uuid.UUID) (*entity.GenerationJob, error)
+	// Release puts owner's running jobs back in the queue. With refund the
+	// interrupted attempt does not count against MaxAttempts.
+	Release(ctx context.Context, owner string, refund bool) (int, error)
+	// Reap ends expired jobs that cannot be claimed again and returns them.
+	Reap(ctx context.Context) ([]*entity.GenerationJob, error)
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// ErrRoomBookingNotFound is returned when no booking has the ID.
+var ErrRoomBookingNotFound = errors.New("room booking not found")
+
+// ErrRoomBookingOverlap is returned when storing a booking would overlap
+// another pending or approved booking of the room.
+var ErrRoomBookingOverlap = errors.New("room booking overlaps another booking")
+
+// RoomBookingFilter selects bookings; zero fields do not filter. From and To
+// select bookings whose date range overlaps them.
+type RoomBookingFilter struct {
+	RoomID      uuid.UUID
+	RequestedBy uuid.UUID
+	Statuses    []valueobject.BookingStatus
+	From        time.Time
+	To          time.Time
+}
+
+// RoomBookingRepository stores ad-hoc room bookings.
+type RoomBookingRepository interface {
+	Create(ctx context.Context, b *entity.RoomBooking) (*entity.RoomBooking, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.RoomBooking, error)
+	// List returns matching bookings ordered by start date and period.
+	List(ctx context.Context, filter RoomBookingFilter) ([]*entity.RoomBooking, error)
+	// UpdateStatus stores b's status and review fields, provided the stored
+	// booking still has status from; ErrRoomBookingNotFound otherwise.
+	UpdateStatus(ctx context.Context, b *entity.RoomBooking, from valueobject.BookingStatus) (*entity.RoomBooking, error)
+}
@@ -2,15 +2,37 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
 )
 
+// ErrRoomNotFound is returned when no room has the ID.
+var ErrRoomNotFound = errors.New("room not found")
+
+// ErrRoomNameTaken is returned when another room already has the name.
+var ErrRoomNameTaken = errors.New("room name already in use")
+
+// ErrRoomInUse is returned when a room cannot be deleted because schedules,
+// exams or bookings still refer to it.
+var ErrRoomInUse = errors.New("room is still in use")
+
 // RoomRepository defines persistence operations for Room entities.
 type RoomRepository interface {
 	Create(ctx context.Context, r *entity.Room) (*entity.Room, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Room, error)
+	// List returns active rooms ordered by name.
 	List(ctx context.Context, limit, offset int32) ([]*entity.Room, error)
+	// ListAll returns active and deactivated rooms ordered by name.
+	ListAll(ctx context.Context, limit, offset int32) ([]*entity.Room, error)
 	Count(ctx context.Context) (int64, error)
+	// Update stores the room's name, capacity, type, features, approval flag,
+	// building and active flag.
+	Update(ctx context.Context, r *entity.Room) (*entity.Room, error)
+	// Delete removes the room; ErrRoomInUse while anything refers to it.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// CountPublishedEntries counts the entries of published schedules placed
+	// in the room.
+	CountPublishedEntries(ctx context.Context, id uuid.UUID) (int64, error)
 }
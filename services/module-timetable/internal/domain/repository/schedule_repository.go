@@ -3,12 +3,17 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 )
 
+// ErrSlotTaken is returned when an entry would give a teacher or room a
+// second session in the same time slot of a schedule, which cannot be stored.
+var ErrSlotTaken = errors.New("teacher or room already booked in this slot")
+
 // ScheduleRepository defines persistence operations for Schedule aggregates.
 type ScheduleRepository interface {
 	Create(ctx context.Context, s *entity.Schedule) (*entity.Schedule, error)
@@ -20,6 +25,10 @@ type ScheduleRepository interface {
 	CountSchedules(ctx context.Context, semesterID uuid.UUID) (int64, error)
 	UpdateResult(ctx context.Context, id uuid.UUID, score float64, hardViolations int, softPenalty float64) (*entity.Schedule, error)
 	UpdateStatus(ctx context.Context, id uuid.UUID, status valueobject.ScheduleStatus) (*entity.Schedule, error)
+	// UpdateFailure stores the failure reason and optional infeasibility diagnosis.
+	UpdateFailure(ctx context.Context, id uuid.UUID, reason string, diagnosis *entity.GenerationDiagnosis) (*entity.Schedule, error)
+	// UpdateCohortClashes stores how many students the schedule double-books.
+	UpdateCohortClashes(ctx context.Context, id uuid.UUID, clashes int) (*entity.Schedule, error)
 
 	CreateEntry(ctx context.Context, e *entity.ScheduleEntry) (*entity.ScheduleEntry, error)
 	GetEntry(ctx context.Context, id uuid.UUID) (*entity.ScheduleEntry, error)
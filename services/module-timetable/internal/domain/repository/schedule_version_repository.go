@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+)
+
+// ErrVersionNotFound is returned when a semester has no version with that number.
+var ErrVersionNotFound = errors.New("schedule version not found")
+
+// ScheduleVersionRepository publishes schedules and keeps their immutable
+// version history.
+type ScheduleVersionRepository interface {
+	// Publish atomically checks that the schedule may be published, archives
+	// the semester's currently published schedule, marks this one published
+	// and records its entries as the semester's next version. It returns the
+	// new version and the IDs of the schedules it archived.
+	Publish(ctx context.Context, scheduleID uuid.UUID) (*entity.ScheduleVersion, []uuid.UUID, error)
+	// Restore copies a version's entries into a new "Rollback to vN" schedule
+	// and publishes it like Publish, all in one transaction, so that nothing
+	// is left behind when publishing fails. The returned version refers to the
+	// new schedule; ErrVersionNotFound is returned when there is no such version.
+	Restore(ctx context.Context, semesterID uuid.UUID, version int) (*entity.ScheduleVersion, []uuid.UUID, error)
+	// ListBySemester returns a semester's versions, newest first, without entries.
+	ListBySemester(ctx context.Context, semesterID uuid.UUID) ([]*entity.ScheduleVersion, error)
+	// Get returns one version with its entries.
+	Get(ctx context.Context, semesterID uuid.UUID, version int) (*entity.ScheduleVersion, error)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 )
 
 // SemesterRepository defines persistence operations for Semester aggregates.
@@ -16,6 +17,14 @@ type SemesterRepository interface {
 	AddOfferedSubject(ctx context.Context, semesterID, subjectID uuid.UUID) (*entity.Semester, error)
 	RemoveOfferedSubject(ctx context.Context, semesterID, subjectID uuid.UUID) (*entity.Semester, error)
 	SetRoomIDs(ctx context.Context, semesterID uuid.UUID, roomIDs []uuid.UUID) (*entity.Semester, error)
+	SetSoftConstraints(ctx context.Context, semesterID uuid.UUID, settings []entity.SoftConstraintSetting) (*entity.Semester, error)
+	SetPeriods(ctx context.Context, semesterID uuid.UUID, periods []entity.PeriodDefinition) (*entity.Semester, error)
+	SetHolidays(ctx context.Context, semesterID uuid.UUID, holidays []entity.Holiday) (*entity.Semester, error)
+	// SetWeekPattern sets one offered subject's week pattern; weekly clears it.
+	SetWeekPattern(ctx context.Context, semesterID, subjectID uuid.UUID, pattern valueobject.WeekPattern) (*entity.Semester, error)
+	// SetSections splits one offered subject into parallel sections; a single
+	// plain section 1 clears it.
+	SetSections(ctx context.Context, semesterID, subjectID uuid.UUID, sections []entity.CourseSection) (*entity.Semester, error)
 	CreateTimeSlot(ctx context.Context, ts *entity.TimeSlot) (*entity.TimeSlot, error)
 	ListTimeSlots(ctx context.Context, semesterID uuid.UUID) ([]*entity.TimeSlot, error)
 	DeleteTimeSlot(ctx context.Context, slotID uuid.UUID) error
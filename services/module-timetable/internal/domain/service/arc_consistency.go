@@ -1,84 +1,188 @@
 package service
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
 
-// arc represents a directed constraint between two CSP variables.
-type arc struct {
-	from string // subject ID string
-	to   string // subject ID string
-}
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
 
-// ac3Propagate runs the AC-3 arc-consistency algorithm to prune domains before
-// the backtracking search begins. Returns false when a domain becomes empty
-// (problem is infeasible).
+// ac3Propagate runs the AC-3 arc-consistency algorithm over enumerated
+// domains and narrows them in place. Returns false when a domain becomes
+// empty (problem is infeasible).
 func ac3Propagate(
 	variables []ScheduleVariable,
 	domains map[string][]Assignment,
 	checker *ConstraintChecker,
-	slots map[uuid.UUID]*ScheduleVariable,
+	slots map[uuid.UUID]*entity.TimeSlot,
 ) bool {
-	// Build initial queue: all arcs between every pair of variables
-	queue := buildAllArcs(variables)
+	p, live := indexDomains(variables, domains, slots, checker)
+	p.link(live)
+	d := newDomainState(p, live)
+	ok := d.ac3(nil)
+	for i, key := range p.keys {
+		domains[key] = p.values(i, d.live[i])
+	}
+	return ok
+}
 
+// ac3 makes every edge of the conflict graph arc-consistent, recording each
+// pruned value in log (which may be nil) so that a domain wipeout can be
+// explained afterwards. The queue holds variables whose domain shrank; each
+// is taken out once and its neighbours are revised against it. Returns false
+// when a domain becomes empty.
+func (d *domainState) ac3(log *pruneLog) bool {
+	n := len(d.p.vars)
+	queue := make([]int, 0, n)
+	inQueue := make([]bool, n)
+	for j := range n {
+		queue = append(queue, j)
+		inQueue[j] = true
+	}
 	for len(queue) > 0 {
-		a := queue[0]
+		j := queue[0]
 		queue = queue[1:]
-
-		if removeInconsistentValues(a.from, a.to, domains, checker) {
-			if len(domains[a.from]) == 0 {
+		inQueue[j] = false
+		for _, e := range d.p.edges[j] {
+			i := e.to
+			e.to = j // the same edge seen from i
+			if !d.revise(i, j, e, log) {
+				continue
+			}
+			if d.size[i] == 0 {
 				return false // domain wipeout → infeasible
 			}
-			// Re-add arcs pointing to a.from so they are re-checked
-			for _, v := range variables {
-				key := v.SubjectID.String()
-				if key != a.to && key != a.from {
-					queue = append(queue, arc{from: key, to: a.from})
-				}
+			if !inQueue[i] {
+				queue = append(queue, i)
+				inQueue[i] = true
 			}
 		}
 	}
 	return true
 }
 
-// removeInconsistentValues removes values from domain[xi] that have no support
-// in domain[xj]. Returns true when at least one value was removed.
-func removeInconsistentValues(
-	xi, xj string,
-	domains map[string][]Assignment,
-	checker *ConstraintChecker,
-) bool {
+// revise removes the values of i that no value of j supports; e is the edge
+// from i to j. Returns true when it removed any.
+//
+// A value of i in slot s can only clash with values of j in slots that
+// overlap s (or share its day, for the exam limit), so while j has a live
+// value elsewhere the value is supported and is skipped without a scan. The
+// exception is a pair of sessions that may break a tight weekly cap on their
+// own, which is checked exactly.
+func (d *domainState) revise(i, j int, e edge, log *pruneLog) bool {
+	if e.sameSubject {
+		return d.reviseSessions(i, j, e, log)
+	}
+	p := d.p
+	sp := p.spaces[i]
+	region := p.slots.overlap
+	if e.examDay {
+		region = p.slots.sameDay
+	}
 	removed := false
-	newDomain := domains[xi][:0:0] // zero-length slice, same backing capacity
+	for si, s := range sp.slots {
+		if d.slotCount[i][si] == 0 {
+			continue
+		}
+		covered := d.slotsUsed[j].subsetOf(region[s])
+		if !covered && !p.anyTight {
+			continue
+		}
+		for ti, t := range sp.teachers {
+			if d.teacherCount[i][ti] == 0 {
+				continue
+			}
+			if !covered && !(p.tight[t] && d.hasTeacher(j, t)) {
+				continue
+			}
+			for ri := range sp.rooms {
+				if v := sp.at(ti, ri, si); d.live[i].has(v) && !d.supported(i, v, j, e) {
+					d.prune(i, v, j, e, log)
+					removed = true
+				}
+			}
+		}
+	}
+	return removed
+}
 
-	for _, valI := range domains[xi] {
-		hasSupport := false
-		for _, valJ := range domains[xj] {
-			if !checker.Conflicts(xi, valI, xj, valJ, nil) {
-				hasSupport = true
-				break
+// reviseSessions is revise for two sessions of one subject, which only
+// clash by sharing a day, by having different teachers under session
+// binding, or together breaking a tight cap. Support is read off the days
+// each of j's teachers still has.
+func (d *domainState) reviseSessions(i, j int, e edge, log *pruneLog) bool {
+	p := d.p
+	spI, spJ := p.spaces[i], p.spaces[j]
+	const unknownDay = 1 << 63 // a value in an unknown slot clashes on no day
+	days := make([]uint64, len(spJ.teachers))
+	var allDays uint64
+	for v := d.live[j].next(0); v >= 0; v = d.live[j].next(v + 1) {
+		tj, _, sj := spJ.decode(v)
+		bit := uint64(unknownDay)
+		if s := spJ.slots[sj]; p.slots.known[s] {
+			bit = 1 << p.slots.day[s]
+		}
+		days[tj] |= bit
+		allDays |= bit
+	}
+	bind := p.checker.bindSessionTeacher
+	removed := false
+	for ti, t := range spI.teachers {
+		if d.teacherCount[i][ti] == 0 {
+			continue
+		}
+		exact := p.tight[t] && d.hasTeacher(j, t)
+		mask := allDays
+		if bind {
+			mask = 0
+			if tj := spJ.teacherPos[t]; tj >= 0 {
+				mask = days[tj]
 			}
 		}
-		if hasSupport {
-			newDomain = append(newDomain, valI)
-		} else {
-			removed = true
+		for si, s := range spI.slots {
+			if d.slotCount[i][si] == 0 {
+				continue
+			}
+			ok := mask != 0
+			if p.slots.known[s] {
+				ok = mask&^(1<<p.slots.day[s]) != 0
+			}
+			if ok && !exact {
+				continue
+			}
+			for ri := range spI.rooms {
+				v := spI.at(ti, ri, si)
+				if !d.live[i].has(v) || (ok && d.supported(i, v, j, e)) {
+					continue
+				}
+				d.prune(i, v, j, e, log)
+				removed = true
+			}
 		}
 	}
-	domains[xi] = newDomain
 	return removed
 }
 
-func buildAllArcs(variables []ScheduleVariable) []arc {
-	arcs := make([]arc, 0, len(variables)*(len(variables)-1))
-	for i := range variables {
-		for j := range variables {
-			if i != j {
-				arcs = append(arcs, arc{
-					from: variables[i].SubjectID.String(),
-					to:   variables[j].SubjectID.String(),
-				})
-			}
+// supported reports whether some live value of j is compatible with value v of i.
+func (d *domainState) supported(i, v, j int, e edge) bool {
+	for b := d.live[j].next(0); b >= 0; b = d.live[j].next(b + 1) {
+		if d.p.valueConflict(i, v, e, b) == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// prune removes value v of i for lack of support in j and logs the
+// constraint it breaks against j's first value; every value of j clashes
+// with it, so that one is representative.
+func (d *domainState) prune(i, v, j int, e edge, log *pruneLog) {
+	cause := valueobject.ConstraintTeacherConflict
+	if b := d.live[j].next(0); b >= 0 {
+		if c := d.p.valueConflict(i, v, e, b); c != "" {
+			cause = c
 		}
 	}
-	return arcs
+	d.remove(i, v)
+	log.recordConflict(d.p.keys[i], d.p.keys[j], cause)
 }
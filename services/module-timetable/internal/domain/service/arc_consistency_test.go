@@ -11,9 +11,20 @@ func TestAC3PropagateEmptyDomainWipeout(t *testing.T) {
 		mustUUID(1).String(): {conflictAssign},
 		mustUUID(2).String(): {conflictAssign},
 	}
-	result := ac3Propagate([]ScheduleVariable{v1, v2}, domains, openChecker(), nil)
-	if !result {
-		t.Fatal("AC3 with nil slots should not wipe domains")
+	if ac3Propagate([]ScheduleVariable{v1, v2}, domains, openChecker(), slotsMap(makeSlot(1, 0, 1, 3))) {
+		t.Fatal("two sessions with the same teacher, room and slot should wipe a domain")
+	}
+}
+
+func TestAC3PropagateUnknownSlotsDoNotConflict(t *testing.T) {
+	v1, v2 := makeVar(1), makeVar(2)
+	conflictAssign := makeAssign(10, 20, 1)
+	domains := map[string][]Assignment{
+		mustUUID(1).String(): {conflictAssign},
+		mustUUID(2).String(): {conflictAssign},
+	}
+	if !ac3Propagate([]ScheduleVariable{v1, v2}, domains, openChecker(), nil) {
+		t.Fatal("AC3 without slot data should not wipe domains")
 	}
 }
 
@@ -27,3 +38,37 @@ func TestAC3PropagateValidDomainsReturnsTrue(t *testing.T) {
 		t.Fatal("expected true for non-conflicting domains")
 	}
 }
+
+func TestAC3PropagatePrunesOverlappingSlot(t *testing.T) {
+	// v1 is fixed to teacher 10 in slot 1; slot 2 overlaps it, slot 3 does not.
+	v1, v2 := makeVar(1), makeVar(2)
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 0, 2, 4), makeSlot(3, 0, 5, 7))
+	domains := map[string][]Assignment{
+		v1.Key(): {makeAssign(10, 20, 1)},
+		v2.Key(): {makeAssign(10, 21, 2), makeAssign(10, 21, 3)},
+	}
+	if !ac3Propagate([]ScheduleVariable{v1, v2}, domains, openChecker(), slots) {
+		t.Fatal("v2 can still use slot 3")
+	}
+	if got := domains[v2.Key()]; len(got) != 1 || got[0] != makeAssign(10, 21, 3) {
+		t.Fatalf("expected only the non-overlapping value left, got %v", got)
+	}
+}
+
+func TestConflictGraphLinksOnlySharedResources(t *testing.T) {
+	v1, v2, v3 := makeVar(1), makeVar(2), makeVar(3)
+	slots := slotsMap(makeSlot(1, 0, 1, 3))
+	domains := map[string][]Assignment{
+		v1.Key(): {makeAssign(10, 20, 1)},
+		v2.Key(): {makeAssign(10, 21, 1)}, // shares teacher 10 with v1
+		v3.Key(): {makeAssign(11, 22, 1)}, // shares nothing
+	}
+	p, live := indexDomains([]ScheduleVariable{v1, v2, v3}, domains, slots, openChecker())
+	p.link(live)
+	if len(p.edges[0]) != 1 || p.edges[0][0].to != 1 {
+		t.Fatalf("v1 should only be linked to v2, got %+v", p.edges[0])
+	}
+	if len(p.edges[2]) != 0 {
+		t.Fatalf("v3 shares nothing and should have no edges, got %+v", p.edges[2])
+	}
+}
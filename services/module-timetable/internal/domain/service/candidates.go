@@ -0,0 +1,172 @@
+package service
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// Candidates lists the teachers, rooms and time slots the sessions of a
+// problem may be given. Each session's domain is their product, less the
+// values its hard constraints rule out.
+type Candidates struct {
+	TeacherIDs []uuid.UUID
+	RoomIDs    []uuid.UUID
+	SlotIDs    []uuid.UUID
+}
+
+// NewCSPSolverFromCandidates is NewCSPSolver with every domain given as the
+// product of the candidates, which the solver narrows per subject without
+// ever listing teachers × rooms × slots.
+func NewCSPSolverFromCandidates(
+	variables []ScheduleVariable,
+	candidates Candidates,
+	slots map[uuid.UUID]*entity.TimeSlot,
+	checker *ConstraintChecker,
+) *CSPSolver {
+	csp := NewCSPSolver(variables, nil, slots, checker)
+	csp.candidates = &candidates
+	return csp
+}
+
+// indexCandidates numbers a problem whose domains are the product of the
+// candidates. A section's space leaves out the teachers without the
+// subject's specialization and the rooms that do not fit the section; their
+// values are logged in bulk, counted as node consistency would count them one
+// by one. Sessions of one section share the space unless pre-placed, in
+// which case the lists are first narrowed to the pre-placement. It returns
+// each variable's candidate count for diagnosis and false when a
+// pre-placement matches nothing.
+func indexCandidates(
+	variables []ScheduleVariable,
+	c Candidates,
+	preplaced map[string]PartialAssignment,
+	slots map[uuid.UUID]*entity.TimeSlot,
+	checker *ConstraintChecker,
+	log *pruneLog,
+) (*problemIndex, []bitset, []int, bool) {
+	p := newProblemIndex(variables, slots, checker)
+	var teachers, rooms, slotIdx []int32
+	for _, id := range uniqueIDs(c.TeacherIDs) {
+		teachers = append(teachers, p.addTeacher(id))
+	}
+	for _, id := range uniqueIDs(c.RoomIDs) {
+		rooms = append(rooms, p.addRoom(id))
+	}
+	for _, id := range uniqueIDs(c.SlotIDs) {
+		slotIdx = append(slotIdx, p.slots.add(id))
+	}
+	total := len(teachers) * len(rooms) * len(slotIdx)
+
+	type subjectSpace struct {
+		space  *valueSpace
+		counts map[valueobject.ConstraintType]int
+	}
+	bySection := map[sectionRef]subjectSpace{}
+	candidates := make([]int, len(variables))
+	ok := true
+	for i, v := range variables {
+		key := p.keys[i]
+		pa, pre := preplaced[key]
+		var ss subjectSpace
+		switch {
+		case pre:
+			ts := filterIndexes(teachers, p.teacherIDs, pa.TeacherID)
+			rs := filterIndexes(rooms, p.roomIDs, pa.RoomID)
+			sl := filterIndexes(slotIdx, p.slots.ids, pa.SlotID)
+			candidates[i] = len(ts) * len(rs) * len(sl)
+			if candidates[i] == 0 {
+				log.recordN(key, valueobject.ConstraintPinned, total)
+				candidates[i] = total
+				p.spaces[i] = &valueSpace{}
+				ok = false
+				continue
+			}
+			ss.space, ss.counts = p.subjectSpace(v, ts, rs, sl)
+		default:
+			candidates[i] = total
+			var found bool
+			if ss, found = bySection[v.sectionRef()]; !found {
+				ss.space, ss.counts = p.subjectSpace(v, teachers, rooms, slotIdx)
+				bySection[v.sectionRef()] = ss
+			}
+		}
+		for cause, n := range ss.counts {
+			log.recordN(key, cause, n)
+		}
+		p.spaces[i] = ss.space
+	}
+	p.finish()
+	live := make([]bitset, len(variables))
+	for i, sp := range p.spaces {
+		live[i] = fullBitset(sp.size())
+	}
+	return p, live, candidates, ok
+}
+
+// subjectSpace keeps the teachers qualified for the subject and the rooms
+// fitting the section. A dropped room's values with a teacher unavailable in the slot
+// count as unavailability, which node consistency checks first.
+func (p *problemIndex) subjectSpace(v ScheduleVariable, teachers, rooms, slots []int32) (*valueSpace, map[valueobject.ConstraintType]int) {
+	cc := p.checker
+	counts := map[valueobject.ConstraintType]int{}
+	sp := &valueSpace{slots: slots}
+	for _, t := range teachers {
+		if !cc.teacherHasSpecialization(p.teacherIDs[t], v.SubjectID) {
+			counts[valueobject.ConstraintSpecializationMissing] += len(rooms) * len(slots)
+			continue
+		}
+		sp.teachers = append(sp.teachers, t)
+	}
+	unavailable := make([]int, len(sp.teachers))
+	for k, t := range sp.teachers {
+		for _, s := range slots {
+			if slot := p.slotMap[p.slots.ids[s]]; slot != nil && !cc.teacherAvailableAt(p.teacherIDs[t], slot) {
+				unavailable[k]++
+			}
+		}
+	}
+	for _, r := range rooms {
+		if c := cc.roomViolation(v, p.roomIDs[r]); c != "" {
+			for k := range sp.teachers {
+				counts[valueobject.ConstraintTeacherUnavailable] += unavailable[k]
+				counts[c] += len(slots) - unavailable[k]
+			}
+			continue
+		}
+		sp.rooms = append(sp.rooms, r)
+	}
+	for c, n := range counts {
+		if n == 0 {
+			delete(counts, c)
+		}
+	}
+	return sp, counts
+}
+
+// filterIndexes keeps the members whose ID is want; all of them when want is nil.
+func filterIndexes(members []int32, ids []uuid.UUID, want *uuid.UUID) []int32 {
+	if want == nil {
+		return members
+	}
+	var kept []int32
+	for _, m := range members {
+		if ids[m] == *want {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	out := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// CohortOverlap counts the students two subjects have in common, keyed by
+// subject ID string (as returned by subjectKeyOf). Subjects without shared
+// students are absent.
+type CohortOverlap map[string]map[string]int
+
+// BuildCohortOverlap derives the pairwise overlap from approved enrollments
+// (subject_id -> enrolled student IDs).
+func BuildCohortOverlap(enrolled map[uuid.UUID][]uuid.UUID) CohortOverlap {
+	byStudent := map[uuid.UUID]map[string]bool{}
+	for subjectID, students := range enrolled {
+		for _, st := range students {
+			if byStudent[st] == nil {
+				byStudent[st] = map[string]bool{}
+			}
+			byStudent[st][subjectID.String()] = true
+		}
+	}
+
+	overlap := CohortOverlap{}
+	for _, subjects := range byStudent {
+		for a := range subjects {
+			for b := range subjects {
+				if a == b {
+					continue
+				}
+				if overlap[a] == nil {
+					overlap[a] = map[string]int{}
+				}
+				overlap[a][b]++
+			}
+		}
+	}
+	return overlap
+}
+
+// Shared returns how many students take both subjects.
+func (o CohortOverlap) Shared(subjectA, subjectB string) int {
+	return o[subjectA][subjectB]
+}
+
+// Clashes sums, over every pair of overlapping sessions of different
+// subjects, the students who would have to be in two places at once.
+func (o CohortOverlap) Clashes(assignment map[string]Assignment, slots map[uuid.UUID]*entity.TimeSlot) int {
+	if len(o) == 0 {
+		return 0
+	}
+	keys := make([]string, 0, len(assignment))
+	for key := range assignment {
+		keys = append(keys, key)
+	}
+	clashes := 0
+	for i, ki := range keys {
+		si := slots[assignment[ki].SlotID]
+		if si == nil {
+			continue
+		}
+		for _, kj := range keys[i+1:] {
+			shared := o.Shared(subjectKeyOf(ki), subjectKeyOf(kj))
+			if shared == 0 {
+				continue
+			}
+			if sj := slots[assignment[kj].SlotID]; sj != nil && slotsOverlap(si, sj) {
+				clashes += shared
+			}
+		}
+	}
+	return clashes
+}
+
+// cohortClashConstraint is the soft form of the cohort clash rule, used when
+// a run relaxes it; under the hard rule it always scores 0.
+type cohortClashConstraint struct{}
+
+func (cohortClashConstraint) Type() valueobject.ConstraintType {
+	return valueobject.ConstraintCohortClash
+}
+
+func (cohortClashConstraint) Penalty(in *SoftInput) float64 {
+	return float64(in.CohortOverlap.Clashes(in.Assignment, in.Slots))
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// twoSubjectCohort enrolls students 100-101 in subjects 1 and 2, and student
+// 102 in subject 2 and 3.
+func twoSubjectCohort() CohortOverlap {
+	return BuildCohortOverlap(map[uuid.UUID][]uuid.UUID{
+		mustUUID(1): {mustUUID(100), mustUUID(101)},
+		mustUUID(2): {mustUUID(100), mustUUID(101), mustUUID(102)},
+		mustUUID(3): {mustUUID(102)},
+	})
+}
+
+func TestBuildCohortOverlap(t *testing.T) {
+	o := twoSubjectCohort()
+	s1, s2, s3 := mustUUID(1).String(), mustUUID(2).String(), mustUUID(3).String()
+	if got := o.Shared(s1, s2); got != 2 {
+		t.Fatalf("subjects 1/2 share 2 students, got %d", got)
+	}
+	if got := o.Shared(s2, s1); got != 2 {
+		t.Fatalf("overlap must be symmetric, got %d", got)
+	}
+	if got := o.Shared(s2, s3); got != 1 {
+		t.Fatalf("subjects 2/3 share 1 student, got %d", got)
+	}
+	if got := o.Shared(s1, s3); got != 0 {
+		t.Fatalf("subjects 1/3 share nobody, got %d", got)
+	}
+}
+
+func TestCohortClashIsHardByDefault(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 0, 2, 4), makeSlot(3, 1, 1, 3))
+	cc := openChecker()
+	cc.SetCohortOverlap(twoSubjectCohort(), true)
+
+	current := map[string]Assignment{mustUUID(1).String(): makeAssign(10, 20, 1)}
+	if cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(2)}, makeAssign(11, 21, 2), current, slots) {
+		t.Fatal("overlapping subjects sharing students must be rejected")
+	}
+	if !cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(2)}, makeAssign(11, 21, 3), current, slots) {
+		t.Fatal("non-overlapping slot should be accepted")
+	}
+	if !cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(3)}, makeAssign(11, 21, 2), current, slots) {
+		t.Fatal("subjects without shared students may overlap")
+	}
+
+	reason := cc.ConflictReason(
+		mustUUID(1).String(), makeAssign(10, 20, 1),
+		mustUUID(2).String(), makeAssign(11, 21, 2), slots,
+	)
+	if reason != valueobject.ConstraintCohortClash {
+		t.Fatalf("expected cohort_clash, got %q", reason)
+	}
+}
+
+func TestCohortClashSoftModeReportsClashes(t *testing.T) {
+	vars := []ScheduleVariable{makeVar(1), makeVar(2)}
+	slots := slotsMap(makeSlot(1, 0, 1, 3))
+	domains := map[string][]Assignment{
+		mustUUID(1).String(): {makeAssign(10, 20, 1)},
+		mustUUID(2).String(): {makeAssign(11, 21, 1)},
+	}
+
+	hard := openChecker()
+	hard.SetCohortOverlap(twoSubjectCohort(), true)
+	if result, err := NewCSPSolver(vars, domains, slots, hard).Solve(context.Background()); err == nil && !result.IsPartial {
+		t.Fatal("hard cohort rule should prevent a complete schedule")
+	}
+
+	soft := openChecker()
+	soft.SetCohortOverlap(twoSubjectCohort(), false)
+	result, err := NewCSPSolver(vars, domains, slots, soft).Solve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.CohortClashes != 2 {
+		t.Fatalf("expected 2 student clashes, got %d", result.CohortClashes)
+	}
+	if result.SoftPenalty < 20 {
+		t.Fatalf("clashes should cost 2 × 10 penalty, got %v", result.SoftPenalty)
+	}
+}
@@ -0,0 +1,390 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// slotTable numbers the time slots and precomputes which of them overlap or
+// fall on the same day, so pruning and search compare slots by index.
+type slotTable struct {
+	ids         []uuid.UUID
+	index       map[uuid.UUID]int32
+	known       []bool // false for slots missing from the solver's slot map
+	day         []int
+	periods     []int
+	overlap     []bitset // overlap[s]: known slots overlapping s, s included
+	sameDay     []bitset // sameDay[s]: known slots on the day of s, s included
+	overlapList [][]int32
+	sameDayList [][]int32
+	maxPeriods  int
+}
+
+func (t *slotTable) add(id uuid.UUID) int32 {
+	if i, ok := t.index[id]; ok {
+		return i
+	}
+	i := int32(len(t.ids))
+	t.index[id] = i
+	t.ids = append(t.ids, id)
+	return i
+}
+
+// finish fills the tables once every slot has been added. Unknown slots
+// overlap nothing, like a nil slot in ConflictReason.
+func (t *slotTable) finish(slots map[uuid.UUID]*entity.TimeSlot) {
+	n := len(t.ids)
+	t.known = make([]bool, n)
+	t.day = make([]int, n)
+	t.periods = make([]int, n)
+	for i, id := range t.ids {
+		if s := slots[id]; s != nil {
+			t.known[i] = true
+			t.day[i] = s.DayOfWeek
+			t.periods[i] = slotPeriods(s)
+			t.maxPeriods = max(t.maxPeriods, t.periods[i])
+		}
+	}
+	t.overlap = make([]bitset, n)
+	t.sameDay = make([]bitset, n)
+	t.overlapList = make([][]int32, n)
+	t.sameDayList = make([][]int32, n)
+	for i := range n {
+		t.overlap[i], t.sameDay[i] = newBitset(n), newBitset(n)
+		if !t.known[i] {
+			continue
+		}
+		for j := range n {
+			if !t.known[j] || t.day[j] != t.day[i] {
+				continue
+			}
+			t.sameDay[i].set(j)
+			t.sameDayList[i] = append(t.sameDayList[i], int32(j))
+			if slotsOverlap(slots[t.ids[i]], slots[t.ids[j]]) {
+				t.overlap[i].set(j)
+				t.overlapList[i] = append(t.overlapList[i], int32(j))
+			}
+		}
+	}
+}
+
+// valueSpace is the domain of a variable before pruning: the product of its
+// candidate teachers, rooms and slots. Values are numbered teacher-major, so
+// value (ti, ri, si) is (ti*len(rooms)+ri)*len(slots)+si; which of them are
+// still possible is tracked in a bitset.
+type valueSpace struct {
+	teachers, rooms, slots       []int32 // global indexes, in domain order
+	teacherPos, roomPos, slotPos []int32 // global index -> position, -1 when absent
+}
+
+func (sp *valueSpace) size() int { return len(sp.teachers) * len(sp.rooms) * len(sp.slots) }
+
+func (sp *valueSpace) at(ti, ri, si int) int {
+	return (ti*len(sp.rooms)+ri)*len(sp.slots) + si
+}
+
+func (sp *valueSpace) decode(v int) (ti, ri, si int) {
+	ns, nr := len(sp.slots), len(sp.rooms)
+	si = v % ns
+	v /= ns
+	return v / nr, v % nr, si
+}
+
+// edge links two variables that can rule out each other's values: they share
+// a teacher or room, or one of the flags holds.
+type edge struct {
+	to          int
+	sameSubject bool // sessions of one subject
+	cohort      bool // subjects sharing students under the hard cohort rule
+	examDay     bool // subjects sharing students with one exam a day
+}
+
+// problemIndex numbers the teachers, rooms and slots of a problem and holds
+// the conflict graph, so that pruning and search never go through maps.
+type problemIndex struct {
+	vars    []ScheduleVariable
+	keys    []string
+	checker *ConstraintChecker
+	slotMap map[uuid.UUID]*entity.TimeSlot
+
+	teacherIDs []uuid.UUID
+	roomIDs    []uuid.UUID
+	teacherIdx map[uuid.UUID]int32
+	roomIdx    map[uuid.UUID]int32
+	slots      slotTable
+
+	caps     []int  // weekly period cap per teacher, 0 when uncapped
+	tight    []bool // cap small enough that two sessions may exceed it
+	anyTight bool
+	baseLoad []int // periods per teacher taken by pinned assignments
+
+	spaces []*valueSpace
+	edges  [][]edge // set by link once unary pruning is done
+}
+
+func newProblemIndex(variables []ScheduleVariable, slots map[uuid.UUID]*entity.TimeSlot, checker *ConstraintChecker) *problemIndex {
+	p := &problemIndex{
+		vars:       variables,
+		keys:       make([]string, len(variables)),
+		checker:    checker,
+		slotMap:    slots,
+		teacherIdx: map[uuid.UUID]int32{},
+		roomIdx:    map[uuid.UUID]int32{},
+		slots:      slotTable{index: map[uuid.UUID]int32{}},
+		spaces:     make([]*valueSpace, len(variables)),
+	}
+	for i, v := range variables {
+		p.keys[i] = v.Key()
+	}
+	return p
+}
+
+func (p *problemIndex) addTeacher(id uuid.UUID) int32 {
+	if i, ok := p.teacherIdx[id]; ok {
+		return i
+	}
+	i := int32(len(p.teacherIDs))
+	p.teacherIdx[id] = i
+	p.teacherIDs = append(p.teacherIDs, id)
+	return i
+}
+
+func (p *problemIndex) addRoom(id uuid.UUID) int32 {
+	if i, ok := p.roomIdx[id]; ok {
+		return i
+	}
+	i := int32(len(p.roomIDs))
+	p.roomIdx[id] = i
+	p.roomIDs = append(p.roomIDs, id)
+	return i
+}
+
+// finish sizes the per-teacher tables and the position maps of every space
+// once all teachers, rooms and slots are numbered.
+func (p *problemIndex) finish() {
+	p.slots.finish(p.slotMap)
+	p.caps = make([]int, len(p.teacherIDs))
+	p.tight = make([]bool, len(p.teacherIDs))
+	p.baseLoad = make([]int, len(p.teacherIDs))
+	for t, id := range p.teacherIDs {
+		if c := p.checker.teacherMaxHours[id]; c > 0 {
+			p.caps[t] = c
+			p.tight[t] = c < 2*p.slots.maxPeriods
+			p.anyTight = p.anyTight || p.tight[t]
+		}
+	}
+	done := map[*valueSpace]bool{}
+	for _, sp := range p.spaces {
+		if done[sp] {
+			continue
+		}
+		done[sp] = true
+		sp.teacherPos = positions(sp.teachers, len(p.teacherIDs))
+		sp.roomPos = positions(sp.rooms, len(p.roomIDs))
+		sp.slotPos = positions(sp.slots, len(p.slots.ids))
+	}
+}
+
+func positions(members []int32, n int) []int32 {
+	pos := make([]int32, n)
+	for i := range pos {
+		pos[i] = -1
+	}
+	for i, m := range members {
+		pos[m] = int32(i)
+	}
+	return pos
+}
+
+// indexDomains numbers enumerated domains. Each variable's space is the
+// product of the teachers, rooms and slots its values mention, in order of
+// first appearance; only the listed values start live.
+func indexDomains(
+	variables []ScheduleVariable,
+	domains map[string][]Assignment,
+	slots map[uuid.UUID]*entity.TimeSlot,
+	checker *ConstraintChecker,
+) (*problemIndex, []bitset) {
+	p := newProblemIndex(variables, slots, checker)
+	triples := make([][][3]int32, len(variables))
+	for i, key := range p.keys {
+		sp := &valueSpace{}
+		seenT, seenR, seenS := map[int32]bool{}, map[int32]bool{}, map[int32]bool{}
+		for _, a := range domains[key] {
+			t, r, s := p.addTeacher(a.TeacherID), p.addRoom(a.RoomID), p.slots.add(a.SlotID)
+			if !seenT[t] {
+				seenT[t] = true
+				sp.teachers = append(sp.teachers, t)
+			}
+			if !seenR[r] {
+				seenR[r] = true
+				sp.rooms = append(sp.rooms, r)
+			}
+			if !seenS[s] {
+				seenS[s] = true
+				sp.slots = append(sp.slots, s)
+			}
+			triples[i] = append(triples[i], [3]int32{t, r, s})
+		}
+		p.spaces[i] = sp
+	}
+	p.finish()
+	live := make([]bitset, len(variables))
+	for i, sp := range p.spaces {
+		live[i] = newBitset(sp.size())
+		for _, tr := range triples[i] {
+			live[i].set(sp.at(int(sp.teacherPos[tr[0]]), int(sp.roomPos[tr[1]]), int(sp.slotPos[tr[2]])))
+		}
+	}
+	return p, live
+}
+
+// assignment turns value v of variable i back into IDs.
+func (p *problemIndex) assignment(i, v int) Assignment {
+	sp := p.spaces[i]
+	ti, ri, si := sp.decode(v)
+	return Assignment{
+		TeacherID: p.teacherIDs[sp.teachers[ti]],
+		RoomID:    p.roomIDs[sp.rooms[ri]],
+		SlotID:    p.slots.ids[sp.slots[si]],
+	}
+}
+
+// values lists the live values of variable i as assignments.
+func (p *problemIndex) values(i int, live bitset) []Assignment {
+	var out []Assignment
+	for v := live.next(0); v >= 0; v = live.next(v + 1) {
+		out = append(out, p.assignment(i, v))
+	}
+	return out
+}
+
+// link builds the conflict graph: an edge between every two variables that
+// can still use a common teacher or room, are sessions of one subject, or
+// share students under the hard cohort rule or the one-exam-a-day limit.
+// Variables that share nothing never constrain each other and get no edge.
+func (p *problemIndex) link(live []bitset) {
+	n := len(p.vars)
+	byTeacher := make([][]int, len(p.teacherIDs))
+	byRoom := make([][]int, len(p.roomIDs))
+	bySubject := map[string][]int{}
+	for i := range n {
+		sp := p.spaces[i]
+		usedT := make([]bool, len(sp.teachers))
+		usedR := make([]bool, len(sp.rooms))
+		for v := live[i].next(0); v >= 0; v = live[i].next(v + 1) {
+			ti, ri, _ := sp.decode(v)
+			usedT[ti], usedR[ri] = true, true
+		}
+		for ti, used := range usedT {
+			if used {
+				byTeacher[sp.teachers[ti]] = append(byTeacher[sp.teachers[ti]], i)
+			}
+		}
+		for ri, used := range usedR {
+			if used {
+				byRoom[sp.rooms[ri]] = append(byRoom[sp.rooms[ri]], i)
+			}
+		}
+		subject := subjectKeyOf(p.keys[i])
+		bySubject[subject] = append(bySubject[subject], i)
+	}
+
+	cc := p.checker
+	p.edges = make([][]edge, n)
+	stamp := make([]int, n)
+	for i := range stamp {
+		stamp[i] = -1
+	}
+	var found []int
+	add := func(js []int, i int) {
+		for _, j := range js {
+			if j != i && stamp[j] != i {
+				stamp[j] = i
+				found = append(found, j)
+			}
+		}
+	}
+	for i := range n {
+		found = found[:0]
+		sp := p.spaces[i]
+		for _, t := range sp.teachers {
+			add(byTeacher[t], i)
+		}
+		for _, r := range sp.rooms {
+			add(byRoom[r], i)
+		}
+		subject := subjectKeyOf(p.keys[i])
+		add(bySubject[subject], i)
+		if cc.cohortHard {
+			for other := range cc.cohortOverlap[subject] {
+				add(bySubject[other], i)
+			}
+		}
+		if cc.maxPerDay == 1 {
+			for _, st := range cc.subjectStudents[subject] {
+				for other := range cc.studentSubjects[st] {
+					add(bySubject[other], i)
+				}
+			}
+		}
+		sort.Ints(found)
+		edges := make([]edge, 0, len(found))
+		for _, j := range found {
+			other := subjectKeyOf(p.keys[j])
+			edges = append(edges, edge{
+				to:          j,
+				sameSubject: other == subject,
+				cohort:      cc.cohortClash(subject, other),
+				examDay:     cc.maxPerDay == 1 && other != subject && cc.sharesStudents(subject, other),
+			})
+		}
+		p.edges[i] = edges
+	}
+}
+
+// conflict is ConflictReason for two values given as global indexes of
+// variables joined by e.
+func (p *problemIndex) conflict(e edge, t1, r1, s1, t2, r2, s2 int32) valueobject.ConstraintType {
+	if e.sameSubject && p.checker.bindSessionTeacher && t1 != t2 {
+		return valueobject.ConstraintSessionTeacherSplit
+	}
+	st := &p.slots
+	if !st.known[s1] || !st.known[s2] {
+		return ""
+	}
+	if e.sameSubject && st.day[s1] == st.day[s2] {
+		return valueobject.ConstraintSessionSameDay
+	}
+	if t1 == t2 && p.caps[t1] > 0 && st.periods[s1]+st.periods[s2] > p.caps[t1] {
+		return valueobject.ConstraintTeacherOverload
+	}
+	if e.examDay && st.day[s1] == st.day[s2] {
+		return valueobject.ConstraintExamDailyLimit
+	}
+	if !st.overlap[s1].has(int(s2)) {
+		return ""
+	}
+	if t1 == t2 {
+		return valueobject.ConstraintTeacherConflict
+	}
+	if r1 == r2 {
+		return valueobject.ConstraintRoomConflict
+	}
+	if e.cohort {
+		return valueobject.ConstraintCohortClash
+	}
+	return ""
+}
+
+// valueConflict is conflict for value a of variable i and value b of e.to.
+func (p *problemIndex) valueConflict(i int, a int, e edge, b int) valueobject.ConstraintType {
+	spI, spJ := p.spaces[i], p.spaces[e.to]
+	ti, ri, si := spI.decode(a)
+	tj, rj, sj := spJ.decode(b)
+	return p.conflict(e, spI.teachers[ti], spI.rooms[ri], spI.slots[si], spJ.teachers[tj], spJ.rooms[rj], spJ.slots[sj])
+}
@@ -1,8 +1,11 @@
 package service
 
 import (
+	"sort"
+
 	"github.com/google/uuid"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 )
 
 // ConstraintChecker evaluates hard and soft scheduling constraints.
@@ -14,8 +17,35 @@ type ConstraintChecker struct {
 	teacherSpecs map[uuid.UUID]map[string]bool
 	// subject_id -> required specialization strings
 	subjectSpecs map[uuid.UUID][]string
-	// teacher_id -> max weekly hours
+	// teacher_id -> max weekly hours (in periods); missing or <= 0 means uncapped
 	teacherMaxHours map[uuid.UUID]int
+	// when true, every session of a subject must be taught by the same teacher
+	bindSessionTeacher bool
+	// room_id -> room, used to check subject room requirements
+	rooms map[uuid.UUID]*entity.Room
+	// subject_id -> capacity/type/feature requirements for its room
+	roomRequirements map[uuid.UUID]RoomRequirement
+	// section -> head count replacing the subject's minimum capacity
+	sectionCapacity map[sectionRef]int
+	// weighted soft constraints; DefaultSoftConstraints until configured
+	softConstraints []WeightedSoftConstraint
+	// teacher_id -> preferred / disliked teaching windows, scored by preference_break
+	teacherPreferences map[uuid.UUID][]*entity.TimeSlot
+	teacherDislikes    map[uuid.UUID][]*entity.TimeSlot
+	// room_id -> building code, scored by building_travel
+	roomBuildings map[uuid.UUID]string
+	// room_id -> campus and travel minutes between campuses, scored by
+	// campus_travel
+	roomCampuses map[uuid.UUID]uuid.UUID
+	campusTravel entity.TravelMatrix
+	// shared enrolled students between subjects; overlaps are rejected when
+	// cohortHard is set and scored by cohort_clash otherwise
+	cohortOverlap CohortOverlap
+	cohortHard    bool
+	// per-student cap on subjects in one day (exam periods); 0 = no cap
+	maxPerDay       int
+	subjectStudents map[string][]uuid.UUID
+	studentSubjects map[uuid.UUID]map[string]bool
 }
 
 func NewConstraintChecker(
@@ -29,13 +59,62 @@ func NewConstraintChecker(
 		teacherSpecs:        teacherSpecs,
 		subjectSpecs:        subjectSpecs,
 		teacherMaxHours:     teacherMaxHours,
+		softConstraints:     defaultWeightedSoftConstraints(),
 	}
 }
 
-// IsConsistent checks all hard constraints for a proposed assignment.
+// SetSessionTeacherBinding toggles the requirement that all weekly sessions of
+// a subject are taught by the same teacher.
+func (cc *ConstraintChecker) SetSessionTeacherBinding(enabled bool) {
+	cc.bindSessionTeacher = enabled
+}
+
+// SetRoomRequirements registers the candidate rooms and each subject's room
+// requirements so that unsuitable rooms are rejected as a hard constraint.
+func (cc *ConstraintChecker) SetRoomRequirements(rooms []*entity.Room, requirements map[uuid.UUID]RoomRequirement) {
+	cc.rooms = make(map[uuid.UUID]*entity.Room, len(rooms))
+	for _, r := range rooms {
+		cc.rooms[r.ID] = r
+	}
+	cc.roomRequirements = requirements
+}
+
+// SetSoftConstraints replaces the weighted soft constraints used to score
+// complete assignments. An empty slice disables soft scoring entirely.
+func (cc *ConstraintChecker) SetSoftConstraints(constraints []WeightedSoftConstraint) {
+	cc.softConstraints = constraints
+}
+
+// SetTeacherPreferences registers each teacher's preferred and disliked
+// teaching windows from HR availability.
+func (cc *ConstraintChecker) SetTeacherPreferences(preferred, disliked map[uuid.UUID][]*entity.TimeSlot) {
+	cc.teacherPreferences = preferred
+	cc.teacherDislikes = disliked
+}
+
+// SetRoomBuildings registers the building of each room for travel scoring.
+func (cc *ConstraintChecker) SetRoomBuildings(buildings map[uuid.UUID]string) {
+	cc.roomBuildings = buildings
+}
+
+// SetCampusTravel registers the campus of each room and the travel times
+// between campuses for travel scoring.
+func (cc *ConstraintChecker) SetCampusTravel(roomCampuses map[uuid.UUID]uuid.UUID, travel entity.TravelMatrix) {
+	cc.roomCampuses = roomCampuses
+	cc.campusTravel = travel
+}
+
+// SetCohortOverlap registers which subjects share enrolled students. When hard
+// is true such subjects may never overlap; otherwise clashes only cost penalty.
+func (cc *ConstraintChecker) SetCohortOverlap(overlap CohortOverlap, hard bool) {
+	cc.cohortOverlap = overlap
+	cc.cohortHard = hard
+}
+
+// IsConsistent checks all hard constraints for a proposed assignment of v.
 // Returns false if any hard constraint is violated.
 func (cc *ConstraintChecker) IsConsistent(
-	subjectID uuid.UUID,
+	v ScheduleVariable,
 	val Assignment,
 	current map[string]Assignment,
 	slots map[uuid.UUID]*entity.TimeSlot,
@@ -45,11 +124,26 @@ func (cc *ConstraintChecker) IsConsistent(
 		return false
 	}
 
-	for _, existing := range current {
+	subjectKey := v.unitKey()
+	teacherLoad := 0
+	for key, existing := range current {
 		existingSlot := slots[existing.SlotID]
 		if existingSlot == nil {
 			continue
 		}
+		if existing.TeacherID == val.TeacherID {
+			teacherLoad += slotPeriods(existingSlot)
+		}
+		if subjectKeyOf(key) == subjectKey {
+			// Hard constraint 5: sessions of one subject fall on distinct days
+			if existingSlot.DayOfWeek == slot.DayOfWeek {
+				return false
+			}
+			// Hard constraint 6: sessions share a teacher when binding is enabled
+			if cc.bindSessionTeacher && existing.TeacherID != val.TeacherID {
+				return false
+			}
+		}
 		// Hard constraint 1: teacher not double-booked in overlapping period
 		if existing.TeacherID == val.TeacherID && slotsOverlap(slot, existingSlot) {
 			return false
@@ -58,54 +152,204 @@ func (cc *ConstraintChecker) IsConsistent(
 		if existing.RoomID == val.RoomID && slotsOverlap(slot, existingSlot) {
 			return false
 		}
+		// Hard constraint 9: subjects sharing students do not overlap
+		if cc.cohortClash(subjectKey, subjectKeyOf(key)) && slotsOverlap(slot, existingSlot) {
+			return false
+		}
+	}
+
+	// Hard constraints 3, 4 and 7: specialization, availability and room fit
+	if cc.UnaryViolation(v, val, slots) != "" {
+		return false
 	}
 
-	// Hard constraint 3: teacher has required specialization
-	if !cc.teacherHasSpecialization(val.TeacherID, subjectID) {
+	// Hard constraint 8: teacher stays within their weekly period cap
+	if cc.ExceedsMaxHours(val.TeacherID, teacherLoad+slotPeriods(slot)) {
 		return false
 	}
 
-	// Hard constraint 4: teacher available at this time slot
-	if !cc.teacherAvailableAt(val.TeacherID, slot) {
+	// Hard constraint 10: no student has more than maxPerDay subjects a day
+	if cc.exceedsDailyLimit(subjectKey, slot, current, slots) {
 		return false
 	}
 
 	return true
 }
 
-// Conflicts checks whether two assignments for different subjects conflict (used by AC-3).
+// UnaryViolation returns the hard constraint that rules out a value on its own,
+// without looking at any other assignment, or "" when the value is acceptable.
+// Checks: teacher specialization, teacher availability, room fit, and a single
+// slot that is already longer than the teacher's weekly cap.
+func (cc *ConstraintChecker) UnaryViolation(
+	v ScheduleVariable,
+	val Assignment,
+	slots map[uuid.UUID]*entity.TimeSlot,
+) valueobject.ConstraintType {
+	if !cc.teacherHasSpecialization(val.TeacherID, v.SubjectID) {
+		return valueobject.ConstraintSpecializationMissing
+	}
+	slot := slots[val.SlotID]
+	if slot != nil && !cc.teacherAvailableAt(val.TeacherID, slot) {
+		return valueobject.ConstraintTeacherUnavailable
+	}
+	if c := cc.roomViolation(v, val.RoomID); c != "" {
+		return c
+	}
+	if slot != nil && cc.ExceedsMaxHours(val.TeacherID, slotPeriods(slot)) {
+		return valueobject.ConstraintTeacherOverload
+	}
+	return ""
+}
+
+// Conflicts checks whether two assignments for different variables conflict (used by AC-3).
+// xi and xj are variable keys; sessions of the same subject are additionally
+// kept on distinct days and, when binding is enabled, with the same teacher.
 func (cc *ConstraintChecker) Conflicts(
 	xi string, valI Assignment,
 	xj string, valJ Assignment,
 	slots map[uuid.UUID]*entity.TimeSlot,
 ) bool {
+	return cc.ConflictReason(xi, valI, xj, valJ, slots) != ""
+}
+
+// ConflictReason is Conflicts, but names the hard constraint the pair breaks
+// ("" when the two values are compatible).
+func (cc *ConstraintChecker) ConflictReason(
+	xi string, valI Assignment,
+	xj string, valJ Assignment,
+	slots map[uuid.UUID]*entity.TimeSlot,
+) valueobject.ConstraintType {
+	sameSubject := subjectKeyOf(xi) == subjectKeyOf(xj)
+	if sameSubject && cc.bindSessionTeacher && valI.TeacherID != valJ.TeacherID {
+		return valueobject.ConstraintSessionTeacherSplit
+	}
 	slotI := slots[valI.SlotID]
 	slotJ := slots[valJ.SlotID]
 	if slotI == nil || slotJ == nil {
-		return false
+		return ""
+	}
+	if sameSubject && slotI.DayOfWeek == slotJ.DayOfWeek {
+		return valueobject.ConstraintSessionSameDay
+	}
+	// Same teacher whose two sessions alone already exceed the weekly cap
+	if valI.TeacherID == valJ.TeacherID &&
+		cc.ExceedsMaxHours(valI.TeacherID, slotPeriods(slotI)+slotPeriods(slotJ)) {
+		return valueobject.ConstraintTeacherOverload
+	}
+	// With one subject a day, any two sharing a student must be on different days
+	if cc.maxPerDay == 1 && !sameSubject && slotI.DayOfWeek == slotJ.DayOfWeek &&
+		cc.sharesStudents(subjectKeyOf(xi), subjectKeyOf(xj)) {
+		return valueobject.ConstraintExamDailyLimit
 	}
 	if !slotsOverlap(slotI, slotJ) {
-		return false
+		return ""
 	}
 	// Same teacher at same overlapping time = conflict
 	if valI.TeacherID == valJ.TeacherID {
-		return true
+		return valueobject.ConstraintTeacherConflict
 	}
 	// Same room at same overlapping time = conflict
 	if valI.RoomID == valJ.RoomID {
-		return true
+		return valueobject.ConstraintRoomConflict
 	}
-	return false
+	// Subjects sharing students at overlapping times = conflict
+	if cc.cohortClash(subjectKeyOf(xi), subjectKeyOf(xj)) {
+		return valueobject.ConstraintCohortClash
+	}
+	return ""
+}
+
+// ExceedsMaxHours reports whether a weekly load of the given number of periods
+// breaks the teacher's MaxHoursPerWeek cap. Teachers without a cap never exceed it.
+func (cc *ConstraintChecker) ExceedsMaxHours(teacherID uuid.UUID, periods int) bool {
+	max, ok := cc.teacherMaxHours[teacherID]
+	if !ok || max <= 0 {
+		return false
+	}
+	return periods > max
+}
+
+// TeacherLoad sums the periods assigned to a teacher in the given assignment.
+func (cc *ConstraintChecker) TeacherLoad(
+	teacherID uuid.UUID,
+	assignment map[string]Assignment,
+	slots map[uuid.UUID]*entity.TimeSlot,
+) int {
+	load := 0
+	for _, a := range assignment {
+		if a.TeacherID != teacherID {
+			continue
+		}
+		if slot := slots[a.SlotID]; slot != nil {
+			load += slotPeriods(slot)
+		}
+	}
+	return load
+}
+
+// TeacherOverload describes a teacher whose assigned periods exceed their cap.
+type TeacherOverload struct {
+	TeacherID       uuid.UUID
+	AssignedPeriods int
+	MaxPeriods      int
+}
+
+// TeacherOverloads returns every teacher over their weekly cap, ordered by teacher ID.
+func (cc *ConstraintChecker) TeacherOverloads(
+	assignment map[string]Assignment,
+	slots map[uuid.UUID]*entity.TimeSlot,
+) []TeacherOverload {
+	loads := map[uuid.UUID]int{}
+	for _, a := range assignment {
+		if slot := slots[a.SlotID]; slot != nil {
+			loads[a.TeacherID] += slotPeriods(slot)
+		}
+	}
+	var overloads []TeacherOverload
+	for teacherID, load := range loads {
+		if cc.ExceedsMaxHours(teacherID, load) {
+			overloads = append(overloads, TeacherOverload{
+				TeacherID:       teacherID,
+				AssignedPeriods: load,
+				MaxPeriods:      cc.teacherMaxHours[teacherID],
+			})
+		}
+	}
+	sort.Slice(overloads, func(i, j int) bool {
+		return overloads[i].TeacherID.String() < overloads[j].TeacherID.String()
+	})
+	return overloads
 }
 
-// EvaluateSoftConstraints computes a total penalty score for a complete assignment.
+// CohortClashes counts students double-booked by overlapping sessions of the
+// subjects they are enrolled in (always 0 when the hard rule holds).
+func (cc *ConstraintChecker) CohortClashes(
+	assignment map[string]Assignment,
+	slots map[uuid.UUID]*entity.TimeSlot,
+) int {
+	return cc.cohortOverlap.Clashes(assignment, slots)
+}
+
+// EvaluateSoftConstraints computes the weighted penalty score for a complete
+// assignment using the configured soft constraints (defaults when unset).
 func (cc *ConstraintChecker) EvaluateSoftConstraints(
 	assignment map[string]Assignment,
 	slots map[uuid.UUID]*entity.TimeSlot,
 ) float64 {
+	in := &SoftInput{
+		Assignment:         assignment,
+		Slots:              slots,
+		TeacherPreferences: cc.teacherPreferences,
+		TeacherDislikes:    cc.teacherDislikes,
+		RoomBuildings:      cc.roomBuildings,
+		RoomCampuses:       cc.roomCampuses,
+		CampusTravel:       cc.campusTravel,
+		CohortOverlap:      cc.cohortOverlap,
+	}
 	penalty := 0.0
-	penalty += cc.teacherGapPenalty(assignment, slots) * 2.0
-	penalty += cc.loadImbalancePenalty(assignment) * 1.5
+	for _, wc := range cc.softConstraints {
+		penalty += wc.Constraint.Penalty(in) * wc.Weight
+	}
 	return penalty
 }
 
@@ -125,6 +369,29 @@ func (cc *ConstraintChecker) teacherHasSpecialization(teacherID, subjectID uuid.
 	return false
 }
 
+// cohortClash reports whether two distinct subjects may not overlap because
+// they share students and the cohort rule is hard.
+func (cc *ConstraintChecker) cohortClash(subjectA, subjectB string) bool {
+	return cc.cohortHard && subjectA != subjectB && cc.cohortOverlap.Shared(subjectA, subjectB) > 0
+}
+
+func (cc *ConstraintChecker) roomViolation(v ScheduleVariable, roomID uuid.UUID) valueobject.ConstraintType {
+	req, ok := cc.roomRequirements[v.SubjectID]
+	if capacity, sized := cc.sectionCapacity[v.sectionRef()]; sized {
+		// the section's head count stands in for the whole offering's; the
+		// subject's own minimum, room type and features still apply
+		req.Enrollment, ok = capacity, true
+	}
+	if !ok {
+		return "" // no requirement → any room is fine
+	}
+	room := cc.rooms[roomID]
+	if room == nil {
+		return "" // unknown room → nothing to check against
+	}
+	return req.Violation(room)
+}
+
 func (cc *ConstraintChecker) teacherAvailableAt(teacherID uuid.UUID, slot *entity.TimeSlot) bool {
 	available, ok := cc.teacherAvailability[teacherID]
 	if !ok || len(available) == 0 {
@@ -140,77 +407,15 @@ func (cc *ConstraintChecker) teacherAvailableAt(teacherID uuid.UUID, slot *entit
 	return false
 }
 
-// teacherGapPenalty penalises teachers who have large idle gaps between classes.
-func (cc *ConstraintChecker) teacherGapPenalty(
-	assignment map[string]Assignment,
-	slots map[uuid.UUID]*entity.TimeSlot,
-) float64 {
-	// group assigned slots per teacher per day
-	type dayKey struct {
-		teacherID uuid.UUID
-		day       int
-	}
-	byDay := map[dayKey][]int{}
-	for _, a := range assignment {
-		slot := slots[a.SlotID]
-		if slot == nil {
-			continue
-		}
-		k := dayKey{a.TeacherID, slot.DayOfWeek}
-		byDay[k] = append(byDay[k], slot.StartPeriod)
-	}
-	penalty := 0.0
-	for _, periods := range byDay {
-		if len(periods) < 2 {
-			continue
-		}
-		min, max := periods[0], periods[0]
-		for _, p := range periods[1:] {
-			if p < min {
-				min = p
-			}
-			if p > max {
-				max = p
-			}
-		}
-		span := max - min
-		assigned := len(periods)
-		gap := span - assigned
-		if gap > 2 {
-			penalty += float64(gap - 2)
-		}
-	}
-	return penalty
-}
-
-// loadImbalancePenalty penalises uneven distribution of teaching hours.
-func (cc *ConstraintChecker) loadImbalancePenalty(assignment map[string]Assignment) float64 {
-	hours := map[uuid.UUID]int{}
-	for _, a := range assignment {
-		hours[a.TeacherID]++
-	}
-	if len(hours) == 0 {
-		return 0
+// slotPeriods returns the length of a slot in teaching periods (at least 1),
+// counting both ends as the teaching-load report does.
+func slotPeriods(slot *entity.TimeSlot) int {
+	if n := slot.EndPeriod - slot.StartPeriod + 1; n > 0 {
+		return n
 	}
-	total := 0
-	for _, h := range hours {
-		total += h
-	}
-	avg := float64(total) / float64(len(hours))
-	penalty := 0.0
-	for _, h := range hours {
-		diff := float64(h) - avg
-		if diff < 0 {
-			diff = -diff
-		}
-		penalty += diff
-	}
-	return penalty
+	return 1
 }
 
 func slotsOverlap(a, b *entity.TimeSlot) bool {
-	if a.DayOfWeek != b.DayOfWeek {
-		return false
-	}
-	return a.StartPeriod < b.EndPeriod && b.StartPeriod < a.EndPeriod
+	return a.OverlapsWith(b)
 }
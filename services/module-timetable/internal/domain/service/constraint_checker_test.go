@@ -2,6 +2,7 @@ package service
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -22,13 +23,13 @@ func TestSlotsOverlap(t *testing.T) {
 			want: true,
 		},
 		{
-			name: "same day adjacent",
+			name: "same day sharing the end period",
 			a:    makeSlot(1, 0, 1, 3),
 			b:    makeSlot(2, 0, 3, 5),
-			want: false,
+			want: true,
 		},
 		{
-			name: "same day no overlap",
+			name: "same day adjacent",
 			a:    makeSlot(1, 0, 1, 2),
 			b:    makeSlot(2, 0, 3, 4),
 			want: false,
@@ -56,6 +57,20 @@ func TestSlotsOverlap(t *testing.T) {
 	}
 }
 
+func TestSlotPeriodsMatchTeachingLoad(t *testing.T) {
+	// The standard preset slots over the default 90-minute periods
+	semester := &entity.Semester{}
+	for i, slot := range []*entity.TimeSlot{makeSlot(1, 0, 1, 2), makeSlot(2, 0, 3, 4), makeSlot(3, 0, 5, 6)} {
+		if got := slotPeriods(slot); got != 2 {
+			t.Fatalf("preset %d: slotPeriods=%d want 2", i, got)
+		}
+		taught := semester.TeachingTime(slot.StartPeriod, slot.EndPeriod)
+		if want := time.Duration(slotPeriods(slot)) * 90 * time.Minute; taught != want {
+			t.Fatalf("preset %d: teaching load counts %v, the cap counts %v", i, taught, want)
+		}
+	}
+}
+
 func TestConstraintCheckerIsConsistent(t *testing.T) {
 	teacherID := mustUUID(10)
 	roomID := mustUUID(20)
@@ -65,7 +80,7 @@ func TestConstraintCheckerIsConsistent(t *testing.T) {
 	t.Run("nil slot returns false", func(t *testing.T) {
 		cc := openChecker()
 		val := makeAssign(10, 20, 99)
-		if cc.IsConsistent(mustUUID(1), val, nil, slotsMap(slot)) {
+		if cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(1)}, val, nil, slotsMap(slot)) {
 			t.Fatal("expected false for missing slot")
 		}
 	})
@@ -75,7 +90,7 @@ func TestConstraintCheckerIsConsistent(t *testing.T) {
 		existing := map[string]Assignment{"s1": {TeacherID: teacherID, RoomID: mustUUID(21), SlotID: slotID}}
 		val := Assignment{TeacherID: teacherID, RoomID: roomID, SlotID: slotID}
 		slots := slotsMap(slot)
-		if cc.IsConsistent(mustUUID(2), val, existing, slots) {
+		if cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(2)}, val, existing, slots) {
 			t.Fatal("expected false: teacher double-booked")
 		}
 	})
@@ -84,7 +99,7 @@ func TestConstraintCheckerIsConsistent(t *testing.T) {
 		cc := openChecker()
 		existing := map[string]Assignment{"s1": {TeacherID: mustUUID(11), RoomID: roomID, SlotID: slotID}}
 		val := Assignment{TeacherID: teacherID, RoomID: roomID, SlotID: slotID}
-		if cc.IsConsistent(mustUUID(2), val, existing, slotsMap(slot)) {
+		if cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(2)}, val, existing, slotsMap(slot)) {
 			t.Fatal("expected false: room double-booked")
 		}
 	})
@@ -98,7 +113,7 @@ func TestConstraintCheckerIsConsistent(t *testing.T) {
 			nil,
 		)
 		val := Assignment{TeacherID: teacherID, RoomID: roomID, SlotID: slotID}
-		if cc.IsConsistent(subjID, val, nil, slotsMap(slot)) {
+		if cc.IsConsistent(ScheduleVariable{SubjectID: subjID}, val, nil, slotsMap(slot)) {
 			t.Fatal("expected false: specialization mismatch")
 		}
 	})
@@ -109,7 +124,7 @@ func TestConstraintCheckerIsConsistent(t *testing.T) {
 			nil, nil, nil,
 		)
 		val := Assignment{TeacherID: teacherID, RoomID: roomID, SlotID: slotID}
-		if cc.IsConsistent(mustUUID(1), val, nil, slotsMap(slot)) {
+		if cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(1)}, val, nil, slotsMap(slot)) {
 			t.Fatal("expected false: wrong day")
 		}
 	})
@@ -120,15 +135,31 @@ func TestConstraintCheckerIsConsistent(t *testing.T) {
 			nil, nil, nil,
 		)
 		val := Assignment{TeacherID: teacherID, RoomID: roomID, SlotID: slotID}
-		if !cc.IsConsistent(mustUUID(1), val, nil, slotsMap(slot)) {
+		if !cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(1)}, val, nil, slotsMap(slot)) {
 			t.Fatal("expected true: no availability data = assume available")
 		}
 	})
 
+	t.Run("room below required capacity", func(t *testing.T) {
+		subjID := mustUUID(1)
+		cc := openChecker()
+		cc.SetRoomRequirements(
+			[]*entity.Room{{ID: roomID, Capacity: 20, Type: "lab"}},
+			map[uuid.UUID]RoomRequirement{subjID: {MinCapacity: 200}},
+		)
+		val := Assignment{TeacherID: teacherID, RoomID: roomID, SlotID: slotID}
+		if cc.IsConsistent(ScheduleVariable{SubjectID: subjID}, val, nil, slotsMap(slot)) {
+			t.Fatal("expected false: room too small")
+		}
+		if !cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(2)}, val, nil, slotsMap(slot)) {
+			t.Fatal("expected true: subject without room requirements")
+		}
+	})
+
 	t.Run("all constraints satisfied", func(t *testing.T) {
 		cc := openChecker()
 		val := Assignment{TeacherID: teacherID, RoomID: roomID, SlotID: slotID}
-		if !cc.IsConsistent(mustUUID(1), val, nil, slotsMap(slot)) {
+		if !cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(1)}, val, nil, slotsMap(slot)) {
 			t.Fatal("expected true: all constraints satisfied")
 		}
 	})
@@ -182,3 +213,73 @@ func TestConstraintCheckerSoftConstraints(t *testing.T) {
 		}
 	})
 }
+
+func TestConstraintCheckerSessionConstraints(t *testing.T) {
+	subjID := mustUUID(1)
+	monday1 := makeSlot(1, 0, 1, 2)
+	monday2 := makeSlot(2, 0, 3, 4)
+	tuesday := makeSlot(3, 1, 1, 2)
+	slots := slotsMap(monday1, monday2, tuesday)
+	existing := map[string]Assignment{sessionKey(subjID, 0): makeAssign(10, 20, 1)}
+
+	t.Run("second session same day rejected", func(t *testing.T) {
+		if openChecker().IsConsistent(ScheduleVariable{SubjectID: subjID}, makeAssign(10, 20, 2), existing, slots) {
+			t.Fatal("expected false: sessions on the same day")
+		}
+	})
+
+	t.Run("second session other day accepted", func(t *testing.T) {
+		if !openChecker().IsConsistent(ScheduleVariable{SubjectID: subjID}, makeAssign(11, 20, 3), existing, slots) {
+			t.Fatal("expected true: sessions on distinct days")
+		}
+	})
+
+	t.Run("binding rejects different teacher", func(t *testing.T) {
+		cc := openChecker()
+		cc.SetSessionTeacherBinding(true)
+		if cc.IsConsistent(ScheduleVariable{SubjectID: subjID}, makeAssign(11, 20, 3), existing, slots) {
+			t.Fatal("expected false: session taught by another teacher")
+		}
+	})
+
+	t.Run("conflicts flags same-day sessions", func(t *testing.T) {
+		if !openChecker().Conflicts(sessionKey(subjID, 0), makeAssign(10, 20, 1), sessionKey(subjID, 1), makeAssign(11, 21, 2), slots) {
+			t.Fatal("expected conflict for sessions on the same day")
+		}
+	})
+}
+
+func TestConstraintCheckerTeacherMaxHours(t *testing.T) {
+	teacherID := mustUUID(10)
+	monday := makeSlot(1, 0, 1, 2)  // preset slot 1-2: 2 periods
+	tuesday := makeSlot(2, 1, 1, 3) // 3 periods
+	slots := slotsMap(monday, tuesday)
+	cc := NewConstraintChecker(nil, nil, nil, map[uuid.UUID]int{teacherID: 4})
+	existing := map[string]Assignment{"s1": makeAssign(10, 20, 1)}
+
+	t.Run("load counted in periods", func(t *testing.T) {
+		if got := cc.TeacherLoad(teacherID, existing, slots); got != 2 {
+			t.Fatalf("expected load 2, got %d", got)
+		}
+	})
+
+	t.Run("assignment over cap rejected", func(t *testing.T) {
+		if cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(2)}, makeAssign(10, 21, 2), existing, slots) {
+			t.Fatal("expected false: 2+3 periods exceeds cap of 4")
+		}
+	})
+
+	t.Run("uncapped teacher accepted", func(t *testing.T) {
+		if !cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(2)}, makeAssign(11, 21, 2), existing, slots) {
+			t.Fatal("expected true: teacher 11 has no cap")
+		}
+	})
+
+	t.Run("overloads reported per teacher", func(t *testing.T) {
+		over := map[string]Assignment{"s1": makeAssign(10, 20, 1), "s2": makeAssign(10, 21, 2)}
+		got := cc.TeacherOverloads(over, slots)
+		if len(got) != 1 || got[0].TeacherID != teacherID || got[0].AssignedPeriods != 5 || got[0].MaxPeriods != 4 {
+			t.Fatalf("unexpected overloads: %+v", got)
+		}
+	})
+}
@@ -3,22 +3,64 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strings"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	"github.com/google/uuid"
 )
 
-// ErrNoFeasibleSolution is returned when AC-3 wipes a domain before search.
+// ErrNoFeasibleSolution is returned when pruning wipes a domain before search
+// (wrapped in an *InfeasibleError carrying the diagnosis) or when search
+// exhausts every value without assigning anything.
 var ErrNoFeasibleSolution = errors.New("no feasible schedule solution found")
 
-// ScheduleVariable represents a subject that must be assigned to a
-// (teacher, room, time-slot) tuple.
+// ScheduleVariable represents one weekly session of a subject that must be
+// assigned to a (teacher, room, time-slot) tuple. Subjects with several weekly
+// sessions are expanded into one variable per session (see ExpandSessions),
+// and split subjects into one per section first (see ExpandSections).
 type ScheduleVariable struct {
 	SubjectID               uuid.UUID
 	SubjectCode             string
 	WeeklyHours             int
 	RequiredSpecializations []string
+	Session                 int // 0-based session index within the week
+	Section                 int // 1-based section of the subject; 0 is section 1
+	Assistants              int // assistant teachers each session needs besides its lead
+}
+
+// Key returns the domain/assignment map key for this variable.
+// The first session keeps the bare unit key (see unitKey) so single-session
+// subjects are keyed exactly as before; later sessions append "#<index>".
+func (v ScheduleVariable) Key() string {
+	if v.Section <= 1 {
+		return sessionKey(v.SubjectID, v.Session)
+	}
+	if v.Session == 0 {
+		return v.unitKey()
+	}
+	return fmt.Sprintf("%s#%d", v.unitKey(), v.Session)
+}
+
+func sessionKey(subjectID uuid.UUID, session int) string {
+	if session == 0 {
+		return subjectID.String()
+	}
+	return fmt.Sprintf("%s#%d", subjectID, session)
+}
+
+// subjectKeyOf strips the session suffix from a variable key. The result
+// names one section of a subject (see unitKey); it is the subject ID for
+// subjects that are not split.
+func subjectKeyOf(key string) string {
+	if i := strings.IndexByte(key, '#'); i >= 0 {
+		return key[:i]
+	}
+	return key
 }
 
 // Assignment is a concrete (teacher, room, slot) tuple for one subject.
@@ -36,19 +78,73 @@ type SolverResult struct {
 	SoftPenalty    float64
 	IsPartial      bool // true when solver timed out before full assignment
 	Duration       time.Duration
+	// TeacherOverloads lists teachers assigned more periods than MaxHoursPerWeek.
+	TeacherOverloads []TeacherOverload
+	// InitialPenalty is the soft penalty of the first complete assignment,
+	// before local search; equal to SoftPenalty when local search did not run.
+	InitialPenalty float64
+	// PenaltyTrajectory samples the local-search phase (nil when it did not run).
+	PenaltyTrajectory []LocalSearchProgress
+	// CohortClashes counts students booked into overlapping sessions; only
+	// non-zero when the cohort rule was relaxed to a soft constraint.
+	CohortClashes int
+	// Assignment is the final assignment by variable key, pinned values included.
+	Assignment map[string]Assignment
+	// Strategy is the strategy of the run that produced the result, and
+	// Member its index in the portfolio (0 for Solve).
+	Strategy SolverStrategy
+	Member   int
+	// Nodes counts the values backtracking search assigned, a measure of
+	// search effort independent of machine speed.
+	Nodes int
+	// Assistants maps variable keys to the assistant teachers staffed for
+	// the session (see staffAssistants), and Unstaffed counts the assistant
+	// places left empty; those are also counted as hard violations.
+	Assistants map[string][]uuid.UUID
+	Unstaffed  int
+}
+
+// SolverStrategy varies how a solver explores the search space. Two runs
+// with the same strategy on the same input make the same choices, so a
+// schedule can be reproduced from its seed unless the deadline cut it short.
+type SolverStrategy struct {
+	Seed uint64 // seeds tie-breaking and local-search moves
+	// ShuffleTies orders values the LCV heuristic scores equally at random
+	// instead of by their position in the domain.
+	ShuffleTies bool
 }
 
 // CSPSolver performs backtracking search with AC-3 pre-processing,
-// MRV variable selection, and LCV value ordering.
+// MRV variable selection, and LCV value ordering. Domains are bitsets over
+// each variable's teacher × room × slot space, and only variables that share
+// a resource are linked in the conflict graph (see problemIndex).
 type CSPSolver struct {
-	variables  []ScheduleVariable
-	domains    map[string][]Assignment       // subject_id_str -> valid assignments
-	slots      map[uuid.UUID]*entity.TimeSlot // slot_id -> TimeSlot (for overlap checks)
-	checker    *ConstraintChecker
-	bestSoFar  map[string]Assignment // best partial assignment seen during search
+	variables   []ScheduleVariable
+	domains     map[string][]Assignment        // enumerated domains; nil when built from candidates
+	candidates  *Candidates                    // product domains (see NewCSPSolverFromCandidates)
+	slots       map[uuid.UUID]*entity.TimeSlot // slot_id -> TimeSlot (for overlap checks)
+	checker     *ConstraintChecker
+	index       *problemIndex                // numbering and conflict graph, set by prune
+	state       *domainState                 // live domains during search, set by prune
+	bestSoFar   map[string]Assignment        // best partial assignment seen during search
+	localSearch *LocalSearchOptions          // nil disables the post-solve improvement phase
+	pinned      map[string]Assignment        // fixed assignments kept as-is (see SetPinned)
+	staffed     map[string][]uuid.UUID       // assistants of pinned assignments (see SetPinnedAssistants)
+	assisted    map[string]ScheduleVariable  // variables needing assistants while search checks staffable
+	preplaced   map[string]PartialAssignment // domain restrictions (see SetPreplaced)
+	anchors     map[string]Assignment        // preferred values to stay close to (see SetAnchors)
+	strategy    SolverStrategy
+	rng         *rand.Rand       // tie-breaking; nil unless strategy.ShuffleTies
+	progress    *ProgressOptions // nil disables search progress reports
+	nodes       int              // values assigned by backtrack, see SolverResult.Nodes
+	backtracks  int              // values taken back by backtrack
+	started     time.Time        // start of the solve, for progress reports
+	lastReport  time.Time
+	throttle    *progressThrottle // shared by portfolio members; nil for Solve
 }
 
-// NewCSPSolver constructs a solver ready to call Solve.
+// NewCSPSolver constructs a solver ready to call Solve. The domains are read,
+// never modified.
 func NewCSPSolver(
 	variables []ScheduleVariable,
 	domains map[string][]Assignment,
@@ -57,45 +153,151 @@ func NewCSPSolver(
 ) *CSPSolver {
 	return &CSPSolver{
 		variables: variables,
-		domains:   cloneDomains(domains),
+		domains:   domains,
 		slots:     slots,
 		checker:   checker,
 		bestSoFar: map[string]Assignment{},
 	}
 }
 
-// Solve runs AC-3 pre-processing then backtracking search.
+// SetLocalSearch enables simulated annealing over the soft constraints once a
+// complete assignment is found; it runs for whatever is left of the Solve
+// context. Pass nil to disable it.
+func (csp *CSPSolver) SetLocalSearch(opts *LocalSearchOptions) {
+	csp.localSearch = opts
+}
+
+// SetStrategy sets the seed and value ordering of the search.
+func (csp *CSPSolver) SetStrategy(strategy SolverStrategy) {
+	csp.strategy = strategy
+	csp.rng = nil
+	if strategy.ShuffleTies {
+		csp.rng = rand.New(rand.NewPCG(strategy.Seed, strategy.Seed^0x6a09e667f3bcc909))
+	}
+}
+
+// Solve runs node consistency and AC-3 pre-processing then backtracking search.
 // It respects ctx cancellation and returns the best partial result on timeout.
+// When pruning proves the problem infeasible the error is an *InfeasibleError.
 func (csp *CSPSolver) Solve(ctx context.Context) (*SolverResult, error) {
 	start := time.Now()
+	searchDomains, err := csp.prune()
+	if err != nil {
+		return nil, err
+	}
+	return csp.search(ctx, start, searchDomains)
+}
 
-	// AC-3: prune infeasible values before search begins
-	if !ac3Propagate(csp.variables, csp.domains, csp.checker, nil) {
-		return nil, ErrNoFeasibleSolution
+// prune runs the pre-processing that every search shares: pre-placement,
+// node consistency, pinned consistency and AC-3. It leaves the pruned domains
+// in csp.state and returns a copy of them for local search.
+func (csp *CSPSolver) prune() (*liveDomains, error) {
+	// Node consistency + AC-3: prune infeasible values before search begins
+	log := newPruneLog()
+	var live []bitset
+	var candidates []int
+	ok := true
+	if csp.candidates != nil {
+		csp.index, live, candidates, ok = indexCandidates(csp.variables, *csp.candidates, csp.preplaced, csp.slots, csp.checker, log)
+	} else {
+		csp.index, live = indexDomains(csp.variables, csp.domains, csp.slots, csp.checker)
+		candidates = domainSizes(live)
+		if ok = csp.index.enforcePreplacement(live, csp.preplaced, log); ok {
+			candidates = domainSizes(live)
+		}
+	}
+	p := csp.index
+	if !ok || !p.enforceNodeConsistency(live, log) || !p.enforcePinnedConsistency(live, csp.pinned, log) {
+		return nil, &InfeasibleError{Report: csp.diagnose(candidates, live, live, log)}
+	}
+	consistent := make([]bitset, len(live))
+	for i, b := range live {
+		consistent[i] = b.clone()
+	}
+	p.link(live)
+	state := newDomainState(p, live)
+	if !state.ac3(log) {
+		return nil, &InfeasibleError{Report: csp.diagnose(candidates, consistent, state.live, log)}
 	}
+	csp.state = state
+	return state.snapshot(), nil
+}
 
-	assignment := make(map[string]Assignment, len(csp.variables))
+func domainSizes(live []bitset) []int {
+	sizes := make([]int, len(live))
+	for i, b := range live {
+		sizes[i] = b.count()
+	}
+	return sizes
+}
+
+// search runs backtracking over the pruned domains, then local search when
+// enabled. start is when the whole solve began, for SolverResult.Duration.
+func (csp *CSPSolver) search(ctx context.Context, start time.Time, searchDomains *liveDomains) (*SolverResult, error) {
+	assignment := make(map[string]Assignment, len(csp.variables)+len(csp.pinned))
+	for k, v := range csp.pinned {
+		assignment[k] = v
+	}
+	csp.started, csp.lastReport = start, start
+	csp.assisted = map[string]ScheduleVariable{}
+	for _, v := range csp.variables {
+		if v.Assistants > 0 {
+			csp.assisted[v.Key()] = v
+		}
+	}
 	final := csp.backtrack(ctx, assignment)
+	if final == nil && len(csp.assisted) > 0 && ctx.Err() == nil {
+		// No placement of the leads leaves enough assistants: place them
+		// anyway and report the places staffAssistants cannot fill
+		csp.assisted = nil
+		final = csp.backtrack(ctx, assignment)
+	}
+	if final != nil {
+		csp.reportProgress(final, true)
+	} else {
+		csp.reportProgress(csp.bestSoFar, true)
+	}
 
-	isPartial := false
 	if final == nil {
 		// Timeout or dead-end — return best partial if available
 		if len(csp.bestSoFar) == 0 {
 			return nil, ErrNoFeasibleSolution
 		}
 		final = csp.bestSoFar
-		isPartial = true
+	}
+	isPartial := len(final) < csp.size()
+
+	initialPenalty := csp.checker.EvaluateSoftConstraints(final, csp.slots)
+	var trajectory []LocalSearchProgress
+	if csp.localSearch != nil && !isPartial {
+		opts := *csp.localSearch
+		if opts.Seed == 0 {
+			opts.Seed = csp.strategy.Seed
+		}
+		final, trajectory = newLocalSearch(csp, searchDomains, opts).run(ctx, final)
 	}
 
 	penalty := csp.checker.EvaluateSoftConstraints(final, csp.slots)
-	entries := assignmentToEntries(final)
+	assistants, unstaffed := csp.staffAssistants(final)
+	overloads := csp.checker.TeacherOverloads(final, csp.slots)
+	entries := assignmentToEntries(final, assistants)
 
 	return &SolverResult{
-		Entries:     entries,
-		Score:       100.0 - penalty,
-		SoftPenalty: penalty,
-		IsPartial:   isPartial,
-		Duration:    time.Since(start),
+		Entries:           entries,
+		Score:             100.0 - penalty,
+		HardViolations:    len(overloads) + unstaffed,
+		SoftPenalty:       penalty,
+		IsPartial:         isPartial,
+		Duration:          time.Since(start),
+		TeacherOverloads:  overloads,
+		InitialPenalty:    initialPenalty,
+		PenaltyTrajectory: trajectory,
+		CohortClashes:     csp.checker.CohortClashes(final, csp.slots),
+		Assignment:        final,
+		Strategy:          csp.strategy,
+		Nodes:             csp.nodes,
+		Assistants:        assistants,
+		Unstaffed:         unstaffed,
 	}, nil
 }
 
@@ -114,67 +316,61 @@ func (csp *CSPSolver) backtrack(ctx context.Context, assignment map[string]Assig
 	if len(assignment) > len(csp.bestSoFar) {
 		csp.bestSoFar = copyAssignment(assignment)
 	}
+	csp.reportProgress(assignment, false)
 
 	// Base case: all variables assigned
-	if len(assignment) == len(csp.variables) {
+	if len(assignment) == csp.size() {
 		return assignment
 	}
 
 	// MRV: pick variable with smallest remaining domain
-	variable := selectMRV(csp.variables, csp.domains, assignment)
-	if variable == nil {
+	d := csp.state
+	i := d.selectMRV()
+	if i < 0 {
 		return nil
 	}
+	variable, key := csp.index.vars[i], csp.index.keys[i]
+	var anchor *Assignment
+	if a, ok := csp.anchors[key]; ok {
+		anchor = &a
+	}
 
 	// LCV: try values ordered by least constraining first
-	for _, value := range orderLCV(variable, csp.variables, csp.domains, assignment, csp.checker, nil) {
-		if !csp.checker.IsConsistent(variable.SubjectID, value, assignment, csp.slots) {
+	for _, v := range d.orderLCV(i, csp.rng, anchor) {
+		value := csp.index.assignment(i, v)
+		if !csp.checker.IsConsistent(variable, value, assignment, csp.slots) {
 			continue
 		}
 
-		assignment[variable.SubjectID.String()] = value
+		assignment[key] = value
+		if !csp.staffable(csp.slots[value.SlotID], assignment) {
+			delete(assignment, key)
+			continue
+		}
+		csp.nodes++
 
-		// Forward checking: save domains, propagate, recurse, restore
-		savedDomains := cloneDomains(csp.domains)
-		if csp.propagateAfterAssignment(variable.SubjectID, value) {
+		// Forward checking: propagate, recurse, then undo back to the mark
+		mark := d.mark()
+		d.assign(i, v)
+		if d.forwardCheck(i, v) {
 			result := csp.backtrack(ctx, assignment)
 			if result != nil {
 				return result
 			}
 		}
 
-		csp.domains = savedDomains
-		delete(assignment, variable.SubjectID.String())
+		d.undo(mark)
+		delete(assignment, key)
+		csp.backtracks++
 	}
 
 	return nil
 }
 
-// propagateAfterAssignment removes values from remaining domains that now
-// conflict with the newly assigned (teacher, room, slot) tuple.
-// Returns false if any domain becomes empty (forward-checking failure).
-func (csp *CSPSolver) propagateAfterAssignment(subjectID uuid.UUID, val Assignment) bool {
-	assigned := subjectID.String()
-	for _, v := range csp.variables {
-		key := v.SubjectID.String()
-		if key == assigned {
-			continue
-		}
-		if _, done := csp.domains[key]; !done {
-			continue
-		}
-		newDomain := csp.domains[key][:0:0]
-		for _, candidate := range csp.domains[key] {
-			if !csp.checker.Conflicts(assigned, val, key, candidate, csp.slots) {
-				newDomain = append(newDomain, candidate)
-			}
-		}
-		if len(newDomain) == 0 {
-			return false
-		}
-		csp.domains[key] = newDomain
-	}
-	return true
+// size is the number of entries in a complete assignment: every variable plus
+// the pinned assignments.
+func (csp *CSPSolver) size() int {
+	return len(csp.variables) + len(csp.pinned)
 }
 
 // --- helpers ---
@@ -187,26 +383,30 @@ func copyAssignment(src map[string]Assignment) map[string]Assignment {
 	return dst
 }
 
-func cloneDomains(src map[string][]Assignment) map[string][]Assignment {
-	dst := make(map[string][]Assignment, len(src))
-	for k, vals := range src {
-		cp := make([]Assignment, len(vals))
-		copy(cp, vals)
-		dst[k] = cp
+// assignmentToEntries lists the entries in variable-key order so that equal
+// assignments always give equal entry lists. Entries carry their section and
+// assistants; names are left for the caller to fill in.
+func assignmentToEntries(assignment map[string]Assignment, assistants map[string][]uuid.UUID) []*entity.ScheduleEntry {
+	keys := make([]string, 0, len(assignment))
+	for key := range assignment {
+		keys = append(keys, key)
 	}
-	return dst
-}
-
-func assignmentToEntries(assignment map[string]Assignment) []*entity.ScheduleEntry {
+	sort.Strings(keys)
 	entries := make([]*entity.ScheduleEntry, 0, len(assignment))
-	for subjectIDStr, a := range assignment {
-		subjectID, _ := uuid.Parse(subjectIDStr)
-		entries = append(entries, &entity.ScheduleEntry{
-			SubjectID: subjectID,
-			TeacherID: a.TeacherID,
-			RoomID:    a.RoomID,
+	for _, key := range keys {
+		a := assignment[key]
+		subjectID, section, _ := parseKey(key)
+		entry := &entity.ScheduleEntry{
+			SubjectID:  subjectID,
+			Section:    section,
+			TeacherID:  a.TeacherID,
+			RoomID:     a.RoomID,
 			TimeSlotID: a.SlotID,
-		})
+		}
+		for _, id := range assistants[key] {
+			entry.Assistants = append(entry.Assistants, entity.EntryTeacher{TeacherID: id, Role: valueobject.TeacherRoleAssistant})
+		}
+		entries = append(entries, entry)
 	}
 	return entries
 }
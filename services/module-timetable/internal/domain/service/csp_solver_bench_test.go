@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+)
+
+// generatedSemester is a synthetic semester for the solver benchmarks: six
+// teaching days of two- and three-period slots (some overlapping), 25
+// specializations with two per teacher, lab and lecture rooms of three sizes,
+// every third subject meeting twice a week, and cohorts of eight subjects
+// sharing their students under the hard cohort rule.
+type generatedSemester struct {
+	vars       []ScheduleVariable
+	candidates Candidates
+	slots      map[uuid.UUID]*entity.TimeSlot
+	checker    *ConstraintChecker
+}
+
+func benchID(kind byte, n int) uuid.UUID {
+	var b [16]byte
+	b[0] = kind
+	b[14], b[15] = byte(n>>8), byte(n)
+	return uuid.UUID(b)
+}
+
+func newGeneratedSemester(subjects int) generatedSemester {
+	const specializations = 25
+	g := generatedSemester{slots: map[uuid.UUID]*entity.TimeSlot{}}
+	for day := 1; day <= 6; day++ {
+		for _, p := range [][2]int{{1, 2}, {3, 4}, {6, 7}, {8, 9}, {1, 3}, {6, 8}} {
+			s := &entity.TimeSlot{ID: benchID(3, len(g.slots)), DayOfWeek: day, StartPeriod: p[0], EndPeriod: p[1]}
+			g.slots[s.ID] = s
+			g.candidates.SlotIDs = append(g.candidates.SlotIDs, s.ID)
+		}
+	}
+
+	teacherSpecs := map[uuid.UUID]map[string]bool{}
+	maxHours := map[uuid.UUID]int{}
+	for t := range subjects / 4 {
+		id := benchID(1, t)
+		teacherSpecs[id] = map[string]bool{
+			fmt.Sprint("spec-", t%specializations):     true,
+			fmt.Sprint("spec-", (t+7)%specializations): true,
+		}
+		maxHours[id] = 18
+		g.candidates.TeacherIDs = append(g.candidates.TeacherIDs, id)
+	}
+
+	var rooms []*entity.Room
+	for r := range subjects / 10 {
+		room := &entity.Room{ID: benchID(2, r), Capacity: 40 + (r%3)*40, Type: "lecture"}
+		if r%4 == 0 {
+			room.Type = "lab"
+		}
+		rooms = append(rooms, room)
+		g.candidates.RoomIDs = append(g.candidates.RoomIDs, room.ID)
+	}
+
+	subjectSpecs := map[uuid.UUID][]string{}
+	requirements := map[uuid.UUID]RoomRequirement{}
+	enrolled := map[uuid.UUID][]uuid.UUID{}
+	var vars []ScheduleVariable
+	for s := range subjects {
+		v := ScheduleVariable{SubjectID: benchID(4, s), SubjectCode: fmt.Sprint("S", s), WeeklyHours: 2}
+		if s%3 == 0 {
+			v.WeeklyHours = 4
+		}
+		vars = append(vars, v)
+		subjectSpecs[v.SubjectID] = []string{fmt.Sprint("spec-", s%specializations)}
+		req := RoomRequirement{MinCapacity: 30 + (s%4)*25, RoomType: "lecture"}
+		if s%5 == 0 {
+			req.RoomType = "lab"
+		}
+		requirements[v.SubjectID] = req
+		for st := range 30 {
+			enrolled[v.SubjectID] = append(enrolled[v.SubjectID], benchID(5, (s/8)*30+st))
+		}
+	}
+	g.vars = ExpandSessions(vars, 2)
+
+	g.checker = NewConstraintChecker(nil, teacherSpecs, subjectSpecs, maxHours)
+	g.checker.SetRoomRequirements(rooms, requirements)
+	g.checker.SetCohortOverlap(BuildCohortOverlap(enrolled), true)
+	return g
+}
+
+func (g generatedSemester) solver() *CSPSolver {
+	return NewCSPSolverFromCandidates(g.vars, g.candidates, g.slots, g.checker)
+}
+
+func TestSolveGeneratedSemester(t *testing.T) {
+	g := newGeneratedSemester(120)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	result, err := g.solver().Solve(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.IsPartial || len(result.Entries) != len(g.vars) {
+		t.Fatalf("expected all %d sessions placed, got %d (partial=%v)", len(g.vars), len(result.Entries), result.IsPartial)
+	}
+	if result.Nodes < len(g.vars) {
+		t.Fatalf("expected at least one search node per session, got %d", result.Nodes)
+	}
+	for _, v := range g.vars {
+		rest := copyAssignment(result.Assignment)
+		val := rest[v.Key()]
+		delete(rest, v.Key())
+		if !g.checker.IsConsistent(v, val, rest, g.slots) {
+			t.Fatalf("%s breaks a hard constraint: %+v", v.Key(), val)
+		}
+	}
+}
+
+// BenchmarkSolveGeneratedSemester times pruning plus backtracking (no local
+// search) on generated semesters of 250 to 750 subjects.
+func BenchmarkSolveGeneratedSemester(b *testing.B) {
+	for _, subjects := range []int{250, 500, 750} {
+		g := newGeneratedSemester(subjects)
+		b.Run(fmt.Sprintf("subjects=%d", subjects), func(b *testing.B) {
+			for b.Loop() {
+				ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+				result, err := g.solver().Solve(ctx)
+				cancel()
+				if err != nil {
+					b.Fatal(err)
+				}
+				if result.IsPartial {
+					b.Fatalf("only %d of %d sessions placed", len(result.Entries), len(g.vars))
+				}
+			}
+			b.ReportMetric(float64(len(g.vars)), "sessions")
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/google/uuid"
+
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
 )
 
@@ -97,3 +99,94 @@ func TestCSPSolverConflictingAssignmentsReturnPartialOrError(t *testing.T) {
 		t.Fatal("expected partial result or error for conflicting assignments")
 	}
 }
+
+func TestCSPSolverMultiSessionDistinctDays(t *testing.T) {
+	v := makeVar(1)
+	v.WeeklyHours = 2
+	vars := ExpandSessions([]ScheduleVariable{v}, 1)
+	slots := []*entity.TimeSlot{
+		makeSlot(1, 0, 1, 2),
+		makeSlot(2, 0, 3, 4),
+		makeSlot(3, 1, 1, 2),
+	}
+	values := []Assignment{makeAssign(10, 20, 1), makeAssign(10, 20, 2), makeAssign(10, 20, 3)}
+	domains := map[string][]Assignment{}
+	for _, sv := range vars {
+		domains[sv.Key()] = values
+	}
+	solver := NewCSPSolver(vars, domains, slotsMap(slots...), openChecker())
+	result, err := solver.Solve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.IsPartial || len(result.Entries) != 2 {
+		t.Fatalf("expected 2 complete entries, got %d (partial=%v)", len(result.Entries), result.IsPartial)
+	}
+	days := map[int]bool{}
+	for _, e := range result.Entries {
+		if e.SubjectID != mustUUID(1) {
+			t.Fatalf("unexpected subject %s", e.SubjectID)
+		}
+		days[slotsMap(slots...)[e.TimeSlotID].DayOfWeek] = true
+	}
+	if len(days) != 2 {
+		t.Fatal("expected sessions on distinct days")
+	}
+}
+
+func TestCSPSolverSessionTeacherBinding(t *testing.T) {
+	v := makeVar(1)
+	v.WeeklyHours = 2
+	vars := ExpandSessions([]ScheduleVariable{v}, 1)
+	slots := []*entity.TimeSlot{makeSlot(1, 0, 1, 2), makeSlot(2, 1, 1, 2)}
+	// Teacher 10 only fits Monday, teacher 11 both days.
+	domains := map[string][]Assignment{
+		vars[0].Key(): {makeAssign(10, 20, 1), makeAssign(11, 20, 1)},
+		vars[1].Key(): {makeAssign(11, 20, 2)},
+	}
+	checker := openChecker()
+	checker.SetSessionTeacherBinding(true)
+	solver := NewCSPSolver(vars, domains, slotsMap(slots...), checker)
+	result, err := solver.Solve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.IsPartial {
+		t.Fatal("expected complete solution")
+	}
+	for _, e := range result.Entries {
+		if e.TeacherID != mustUUID(11) {
+			t.Fatalf("expected bound teacher 11, got %s", e.TeacherID)
+		}
+	}
+}
+
+func TestCSPSolverTeacherMaxHoursCap(t *testing.T) {
+	vars := []ScheduleVariable{makeVar(1), makeVar(2)}
+	// Two-period slots on different days; teacher 10 is capped at 2 periods.
+	slots := []*entity.TimeSlot{makeSlot(1, 0, 1, 2), makeSlot(2, 1, 1, 2)}
+	values := []Assignment{makeAssign(10, 20, 1), makeAssign(10, 20, 2), makeAssign(11, 20, 2)}
+	domains := map[string][]Assignment{
+		vars[0].Key(): values,
+		vars[1].Key(): values,
+	}
+	checker := NewConstraintChecker(nil, nil, nil, map[uuid.UUID]int{mustUUID(10): 2})
+	solver := NewCSPSolver(vars, domains, slotsMap(slots...), checker)
+	result, err := solver.Solve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.IsPartial || len(result.Entries) != 2 {
+		t.Fatalf("expected 2 complete entries, got %d (partial=%v)", len(result.Entries), result.IsPartial)
+	}
+	load := map[uuid.UUID]int{}
+	for _, e := range result.Entries {
+		load[e.TeacherID]++
+	}
+	if load[mustUUID(10)] != 1 || load[mustUUID(11)] != 1 {
+		t.Fatalf("expected one subject per teacher, got %v", load)
+	}
+	if len(result.TeacherOverloads) != 0 || result.HardViolations != 0 {
+		t.Fatalf("expected no overloads, got %+v", result.TeacherOverloads)
+	}
+}
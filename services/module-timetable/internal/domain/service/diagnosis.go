@@ -0,0 +1,297 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// maxConflictSetToMinimise bounds the deletion-filtering pass; each step reruns AC-3.
+const maxConflictSetToMinimise = 25
+
+// InfeasibilityReport explains why the solver proved that no schedule exists.
+type InfeasibilityReport struct {
+	// EmptyDomains lists every session left without a single candidate.
+	EmptyDomains []EmptyDomain
+	// ConflictSubjects is a minimal set of subjects that cannot be scheduled together.
+	ConflictSubjects []uuid.UUID
+	// ConflictTeachers are the teachers still eligible for ConflictSubjects.
+	ConflictTeachers []uuid.UUID
+}
+
+// EmptyDomain describes one session variable whose domain was wiped out.
+type EmptyDomain struct {
+	SubjectID   uuid.UUID
+	SubjectCode string
+	Session     int
+	Candidates  int // (teacher, room, slot) candidates before pruning
+	Eliminated  map[valueobject.ConstraintType]int
+}
+
+// Cause returns the constraint that eliminated the most candidates.
+func (e EmptyDomain) Cause() valueobject.ConstraintType {
+	var cause valueobject.ConstraintType
+	best := 0
+	for c, n := range e.Eliminated {
+		if n > best || (n == best && c < cause) {
+			cause, best = c, n
+		}
+	}
+	return cause
+}
+
+// InfeasibleError is returned by Solve when pruning proves the problem has no
+// solution. It unwraps to ErrNoFeasibleSolution.
+type InfeasibleError struct {
+	Report *InfeasibilityReport
+}
+
+func (e *InfeasibleError) Error() string {
+	if e.Report == nil || len(e.Report.EmptyDomains) == 0 {
+		return ErrNoFeasibleSolution.Error()
+	}
+	parts := make([]string, 0, len(e.Report.EmptyDomains))
+	for _, d := range e.Report.EmptyDomains {
+		parts = append(parts, fmt.Sprintf("%s (%s)", d.SubjectCode, d.Cause()))
+	}
+	return fmt.Sprintf("%s: no candidates left for %s", ErrNoFeasibleSolution, strings.Join(parts, ", "))
+}
+
+func (e *InfeasibleError) Unwrap() error { return ErrNoFeasibleSolution }
+
+// pruneLog records why values were removed from each variable's domain during
+// node consistency and AC-3. A nil *pruneLog ignores all records.
+type pruneLog struct {
+	eliminated map[string]map[valueobject.ConstraintType]int
+	prunedBy   map[string]map[string]bool // variable key -> keys whose domains pruned it
+}
+
+func newPruneLog() *pruneLog {
+	return &pruneLog{
+		eliminated: map[string]map[valueobject.ConstraintType]int{},
+		prunedBy:   map[string]map[string]bool{},
+	}
+}
+
+func (l *pruneLog) record(key string, cause valueobject.ConstraintType) {
+	l.recordN(key, cause, 1)
+}
+
+// recordN records n values of key removed for the same cause.
+func (l *pruneLog) recordN(key string, cause valueobject.ConstraintType, n int) {
+	if l == nil || n == 0 {
+		return
+	}
+	if l.eliminated[key] == nil {
+		l.eliminated[key] = map[valueobject.ConstraintType]int{}
+	}
+	l.eliminated[key][cause] += n
+}
+
+func (l *pruneLog) recordConflict(key, by string, cause valueobject.ConstraintType) {
+	if l == nil {
+		return
+	}
+	l.record(key, cause)
+	if l.prunedBy[key] == nil {
+		l.prunedBy[key] = map[string]bool{}
+	}
+	l.prunedBy[key][by] = true
+}
+
+// enforceNodeConsistency drops every value that breaks a unary hard constraint
+// (specialization, availability, room fit, single-slot cap), checked in that
+// order as UnaryViolation does. Returns false if any domain becomes empty.
+func (p *problemIndex) enforceNodeConsistency(live []bitset, log *pruneLog) bool {
+	cc := p.checker
+	st := &p.slots
+	ns := len(st.ids)
+	// 0 = not checked yet, 1 = available, 2 = unavailable
+	availability := make([]int8, len(p.teacherIDs)*ns)
+	unavailable := func(t, s int32) bool {
+		k := int(t)*ns + int(s)
+		if availability[k] == 0 {
+			availability[k] = 1
+			if slot := p.slotMap[st.ids[s]]; slot != nil && !cc.teacherAvailableAt(p.teacherIDs[t], slot) {
+				availability[k] = 2
+			}
+		}
+		return availability[k] == 2
+	}
+	type unary struct {
+		qualified []bool
+		room      []valueobject.ConstraintType
+	}
+	bySpace := map[*valueSpace]unary{}
+
+	ok := true
+	for i, sp := range p.spaces {
+		v := p.vars[i]
+		u, found := bySpace[sp]
+		if !found {
+			u = unary{qualified: make([]bool, len(sp.teachers)), room: make([]valueobject.ConstraintType, len(sp.rooms))}
+			for ti, t := range sp.teachers {
+				u.qualified[ti] = cc.teacherHasSpecialization(p.teacherIDs[t], v.SubjectID)
+			}
+			for ri, r := range sp.rooms {
+				u.room[ri] = cc.roomViolation(v, p.roomIDs[r])
+			}
+			bySpace[sp] = u
+		}
+		eliminated := map[valueobject.ConstraintType]int{}
+		kept := 0
+		for v := live[i].next(0); v >= 0; v = live[i].next(v + 1) {
+			ti, ri, si := sp.decode(v)
+			t, s := sp.teachers[ti], sp.slots[si]
+			var cause valueobject.ConstraintType
+			switch {
+			case !u.qualified[ti]:
+				cause = valueobject.ConstraintSpecializationMissing
+			case unavailable(t, s):
+				cause = valueobject.ConstraintTeacherUnavailable
+			case u.room[ri] != "":
+				cause = u.room[ri]
+			case st.known[s] && p.caps[t] > 0 && st.periods[s] > p.caps[t]:
+				cause = valueobject.ConstraintTeacherOverload
+			default:
+				kept++
+				continue
+			}
+			live[i].clear(v)
+			eliminated[cause]++
+		}
+		for cause, n := range eliminated {
+			log.recordN(p.keys[i], cause, n)
+		}
+		if kept == 0 {
+			ok = false
+		}
+	}
+	return ok
+}
+
+// diagnose builds an InfeasibilityReport after pruning wiped at least one domain.
+// candidates holds each domain's size before any pruning; consistent holds the
+// domains after node consistency, before AC-3, and live the domains pruning
+// left.
+func (csp *CSPSolver) diagnose(candidates []int, consistent, live []bitset, log *pruneLog) *InfeasibilityReport {
+	p := csp.index
+	report := &InfeasibilityReport{}
+	byKey := make(map[string]int, len(p.vars))
+	var wiped []string
+	for i, v := range p.vars {
+		key := p.keys[i]
+		byKey[key] = i
+		if live[i].next(0) >= 0 {
+			continue
+		}
+		wiped = append(wiped, key)
+		eliminated := make(map[valueobject.ConstraintType]int, len(log.eliminated[key]))
+		for c, n := range log.eliminated[key] {
+			eliminated[c] = n
+		}
+		report.EmptyDomains = append(report.EmptyDomains, EmptyDomain{
+			SubjectID:   v.SubjectID,
+			SubjectCode: v.SubjectCode,
+			Session:     v.Session,
+			Candidates:  candidates[i],
+			Eliminated:  eliminated,
+		})
+	}
+
+	conflict := csp.minimiseConflictSet(conflictClosure(wiped, log), byKey, consistent)
+
+	seenSubject := map[uuid.UUID]bool{}
+	seenTeacher := map[uuid.UUID]bool{}
+	for _, key := range conflict {
+		i := byKey[key]
+		v := p.vars[i]
+		if !seenSubject[v.SubjectID] {
+			seenSubject[v.SubjectID] = true
+			report.ConflictSubjects = append(report.ConflictSubjects, v.SubjectID)
+		}
+		sp := p.spaces[i]
+		for val := consistent[i].next(0); val >= 0; val = consistent[i].next(val + 1) {
+			ti, _, _ := sp.decode(val)
+			if id := p.teacherIDs[sp.teachers[ti]]; !seenTeacher[id] {
+				seenTeacher[id] = true
+				report.ConflictTeachers = append(report.ConflictTeachers, id)
+			}
+		}
+	}
+	sortUUIDs(report.ConflictSubjects)
+	sortUUIDs(report.ConflictTeachers)
+	return report
+}
+
+// conflictClosure collects the wiped variables plus every variable that
+// (transitively) pruned them during AC-3, sorted for determinism.
+func conflictClosure(wiped []string, log *pruneLog) []string {
+	seen := map[string]bool{}
+	queue := append([]string(nil), wiped...)
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		for by := range log.prunedBy[key] {
+			queue = append(queue, by)
+		}
+	}
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// minimiseConflictSet drops variables from keys one at a time while AC-3 on
+// the node-consistent domains still proves the remainder infeasible, leaving
+// a set where every member is needed to reproduce the conflict.
+func (csp *CSPSolver) minimiseConflictSet(keys []string, byKey map[string]int, consistent []bitset) []string {
+	if len(keys) <= 1 || len(keys) > maxConflictSetToMinimise {
+		return keys
+	}
+	values := make(map[string][]Assignment, len(keys))
+	for _, k := range keys {
+		values[k] = csp.index.values(byKey[k], consistent[byKey[k]])
+	}
+	infeasible := func(subset []string) bool {
+		vars := make([]ScheduleVariable, 0, len(subset))
+		domains := make(map[string][]Assignment, len(subset))
+		for _, k := range subset {
+			if len(values[k]) == 0 {
+				return true // already empty after node consistency
+			}
+			vars = append(vars, csp.index.vars[byKey[k]])
+			domains[k] = values[k]
+		}
+		return !ac3Propagate(vars, domains, csp.checker, csp.slots)
+	}
+	if !infeasible(keys) {
+		return keys // conflict only shows up with the full problem; keep it whole
+	}
+	kept := append([]string(nil), keys...)
+	for i := 0; i < len(kept); {
+		trial := make([]string, 0, len(kept)-1)
+		trial = append(trial, kept[:i]...)
+		trial = append(trial, kept[i+1:]...)
+		if len(trial) > 0 && infeasible(trial) {
+			kept = trial
+			continue
+		}
+		i++
+	}
+	return kept
+}
+
+func sortUUIDs(ids []uuid.UUID) {
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+}
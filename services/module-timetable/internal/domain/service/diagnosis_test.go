@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestSolveReportsSpecializationWipeout(t *testing.T) {
+	v1 := makeVar(1, "math")
+	v2 := makeVar(2)
+	slot := makeSlot(1, 0, 1, 3)
+	checker := NewConstraintChecker(nil,
+		map[uuid.UUID]map[string]bool{mustUUID(10): {"physics": true}},
+		map[uuid.UUID][]string{v1.SubjectID: {"math"}},
+		nil,
+	)
+	domains := map[string][]Assignment{
+		v1.Key(): {makeAssign(10, 20, 1)},
+		v2.Key(): {makeAssign(10, 21, 1)},
+	}
+	solver := NewCSPSolver([]ScheduleVariable{v1, v2}, domains, slotsMap(slot), checker)
+	_, err := solver.Solve(context.Background())
+
+	var infeasible *InfeasibleError
+	if !errors.As(err, &infeasible) || !errors.Is(err, ErrNoFeasibleSolution) {
+		t.Fatalf("expected InfeasibleError wrapping ErrNoFeasibleSolution, got %v", err)
+	}
+	report := infeasible.Report
+	if len(report.EmptyDomains) != 1 || report.EmptyDomains[0].SubjectID != v1.SubjectID {
+		t.Fatalf("expected only S1 to be wiped, got %+v", report.EmptyDomains)
+	}
+	d := report.EmptyDomains[0]
+	if d.Candidates != 1 || d.Cause() != valueobject.ConstraintSpecializationMissing {
+		t.Fatalf("unexpected diagnosis %+v cause=%s", d, d.Cause())
+	}
+	if len(report.ConflictSubjects) != 1 || report.ConflictSubjects[0] != v1.SubjectID {
+		t.Fatalf("expected conflict set {S1}, got %v", report.ConflictSubjects)
+	}
+}
+
+func TestSolveReportsMinimalConflictSetFromAC3(t *testing.T) {
+	// Two sessions of S1 must share a teacher but have disjoint teacher pools;
+	// S2 is unrelated and must not appear in the conflict set.
+	v := makeVar(1)
+	v.WeeklyHours = 2
+	vars := append(ExpandSessions([]ScheduleVariable{v}, 1), makeVar(2))
+	slots := []*entity.TimeSlot{makeSlot(1, 0, 1, 2), makeSlot(2, 1, 1, 2)}
+	domains := map[string][]Assignment{
+		vars[0].Key(): {makeAssign(10, 20, 1)},
+		vars[1].Key(): {makeAssign(11, 20, 2)},
+		vars[2].Key(): {makeAssign(12, 21, 1)},
+	}
+	checker := openChecker()
+	checker.SetSessionTeacherBinding(true)
+	solver := NewCSPSolver(vars, domains, slotsMap(slots...), checker)
+	_, err := solver.Solve(context.Background())
+
+	var infeasible *InfeasibleError
+	if !errors.As(err, &infeasible) {
+		t.Fatalf("expected InfeasibleError, got %v", err)
+	}
+	report := infeasible.Report
+	if len(report.EmptyDomains) == 0 ||
+		report.EmptyDomains[0].Cause() != valueobject.ConstraintSessionTeacherSplit {
+		t.Fatalf("expected session teacher split wipeout, got %+v", report.EmptyDomains)
+	}
+	if len(report.ConflictSubjects) != 1 || report.ConflictSubjects[0] != v.SubjectID {
+		t.Fatalf("expected conflict set {S1}, got %v", report.ConflictSubjects)
+	}
+	if len(report.ConflictTeachers) != 2 {
+		t.Fatalf("expected teachers 10 and 11 in conflict, got %v", report.ConflictTeachers)
+	}
+}
+
+func TestEmptyDomainCausePicksLargestCount(t *testing.T) {
+	d := EmptyDomain{Eliminated: map[valueobject.ConstraintType]int{
+		valueobject.ConstraintTeacherUnavailable: 3,
+		valueobject.ConstraintRoomCapacity:       5,
+	}}
+	if got := d.Cause(); got != valueobject.ConstraintRoomCapacity {
+		t.Fatalf("Cause()=%s want room_capacity", got)
+	}
+}
@@ -0,0 +1,318 @@
+package service
+
+import "math/rand/v2"
+
+// domainState is the mutable side of a search: the live values of every
+// variable plus occupancy counts kept in step with them, so that forward
+// checking and LCV read counters instead of rescanning domains. Every change
+// goes on a trail and is taken back by undo.
+type domainState struct {
+	p            *problemIndex
+	live         []bitset
+	size         []int
+	slotCount    [][]int32 // per variable and slot position: live values in that slot
+	slotsUsed    []bitset  // per variable: global slots with a live value
+	teacherCount [][]int32 // per variable and teacher position: live values
+	// live values of unassigned variables per (teacher, slot) and (room, slot)
+	ts, rs   []int32
+	load     []int // assigned periods per teacher, pinned included
+	assigned []bool
+	trail    []trailEntry
+}
+
+// trailEntry is a removed value, or an assignment when val is negative
+// (^value).
+type trailEntry struct{ v, val int32 }
+
+func newDomainState(p *problemIndex, live []bitset) *domainState {
+	n, ns := len(p.vars), len(p.slots.ids)
+	d := &domainState{
+		p:            p,
+		live:         live,
+		size:         make([]int, n),
+		slotCount:    make([][]int32, n),
+		slotsUsed:    make([]bitset, n),
+		teacherCount: make([][]int32, n),
+		ts:           make([]int32, len(p.teacherIDs)*ns),
+		rs:           make([]int32, len(p.roomIDs)*ns),
+		load:         append([]int(nil), p.baseLoad...),
+		assigned:     make([]bool, n),
+	}
+	for i, sp := range p.spaces {
+		d.slotCount[i] = make([]int32, len(sp.slots))
+		d.slotsUsed[i] = newBitset(ns)
+		d.teacherCount[i] = make([]int32, len(sp.teachers))
+		for v := live[i].next(0); v >= 0; v = live[i].next(v + 1) {
+			ti, ri, si := sp.decode(v)
+			d.size[i]++
+			d.slotCount[i][si]++
+			d.slotsUsed[i].set(int(sp.slots[si]))
+			d.teacherCount[i][ti]++
+			d.count(sp, ti, ri, si, 1)
+		}
+	}
+	return d
+}
+
+// count adds delta to the occupancy of one value.
+func (d *domainState) count(sp *valueSpace, ti, ri, si int, delta int32) {
+	ns := len(d.p.slots.ids)
+	s := int(sp.slots[si])
+	d.ts[int(sp.teachers[ti])*ns+s] += delta
+	d.rs[int(sp.rooms[ri])*ns+s] += delta
+}
+
+// remove takes value v out of variable j's domain.
+func (d *domainState) remove(j, v int) {
+	sp := d.p.spaces[j]
+	ti, ri, si := sp.decode(v)
+	d.live[j].clear(v)
+	d.size[j]--
+	if d.slotCount[j][si]--; d.slotCount[j][si] == 0 {
+		d.slotsUsed[j].clear(int(sp.slots[si]))
+	}
+	d.teacherCount[j][ti]--
+	if !d.assigned[j] {
+		d.count(sp, ti, ri, si, -1)
+	}
+	d.trail = append(d.trail, trailEntry{v: int32(j), val: int32(v)})
+}
+
+func (d *domainState) restore(j, v int) {
+	sp := d.p.spaces[j]
+	ti, ri, si := sp.decode(v)
+	d.live[j].set(v)
+	d.size[j]++
+	if d.slotCount[j][si]++; d.slotCount[j][si] == 1 {
+		d.slotsUsed[j].set(int(sp.slots[si]))
+	}
+	d.teacherCount[j][ti]++
+	if !d.assigned[j] {
+		d.count(sp, ti, ri, si, 1)
+	}
+}
+
+// assign marks variable i as set to value v: its values stop counting as
+// occupancy and the teacher's load grows by the slot's periods.
+func (d *domainState) assign(i, v int) {
+	d.setAssigned(i, true)
+	sp := d.p.spaces[i]
+	ti, _, si := sp.decode(v)
+	d.load[sp.teachers[ti]] += d.p.slots.periods[sp.slots[si]]
+	d.trail = append(d.trail, trailEntry{v: int32(i), val: ^int32(v)})
+}
+
+func (d *domainState) unassign(i, v int) {
+	sp := d.p.spaces[i]
+	ti, _, si := sp.decode(v)
+	d.load[sp.teachers[ti]] -= d.p.slots.periods[sp.slots[si]]
+	d.setAssigned(i, false)
+}
+
+// setAssigned flips variable i and moves its live values out of or back
+// into the occupancy counts.
+func (d *domainState) setAssigned(i int, assigned bool) {
+	delta := int32(1)
+	if assigned {
+		delta = -1
+	}
+	d.assigned[i] = assigned
+	sp := d.p.spaces[i]
+	for v := d.live[i].next(0); v >= 0; v = d.live[i].next(v + 1) {
+		ti, ri, si := sp.decode(v)
+		d.count(sp, ti, ri, si, delta)
+	}
+}
+
+func (d *domainState) mark() int { return len(d.trail) }
+
+// undo takes back every change made since mark.
+func (d *domainState) undo(mark int) {
+	for k := len(d.trail) - 1; k >= mark; k-- {
+		e := d.trail[k]
+		if e.val < 0 {
+			d.unassign(int(e.v), int(^e.val))
+		} else {
+			d.restore(int(e.v), int(e.val))
+		}
+	}
+	d.trail = d.trail[:mark]
+}
+
+// clone copies the state for another search; the index is shared.
+func (d *domainState) clone() *domainState {
+	c := &domainState{
+		p:            d.p,
+		live:         make([]bitset, len(d.live)),
+		size:         append([]int(nil), d.size...),
+		slotCount:    make([][]int32, len(d.slotCount)),
+		slotsUsed:    make([]bitset, len(d.slotsUsed)),
+		teacherCount: make([][]int32, len(d.teacherCount)),
+		ts:           append([]int32(nil), d.ts...),
+		rs:           append([]int32(nil), d.rs...),
+		load:         append([]int(nil), d.load...),
+		assigned:     append([]bool(nil), d.assigned...),
+	}
+	for i := range d.live {
+		c.live[i] = d.live[i].clone()
+		c.slotCount[i] = append([]int32(nil), d.slotCount[i]...)
+		c.slotsUsed[i] = d.slotsUsed[i].clone()
+		c.teacherCount[i] = append([]int32(nil), d.teacherCount[i]...)
+	}
+	return c
+}
+
+// hasTeacher reports whether variable j still has a value taught by global
+// teacher t.
+func (d *domainState) hasTeacher(j int, t int32) bool {
+	tj := d.p.spaces[j].teacherPos[t]
+	return tj >= 0 && d.teacherCount[j][tj] > 0
+}
+
+// removeWhere drops the live values of j at slot position sj, limited to
+// teacher position tj and room position rj when those are >= 0.
+func (d *domainState) removeWhere(j, tj, rj, sj int) {
+	sp := d.p.spaces[j]
+	if d.slotCount[j][sj] == 0 {
+		return
+	}
+	tFrom, tTo := 0, len(sp.teachers)
+	if tj >= 0 {
+		tFrom, tTo = tj, tj+1
+	}
+	rFrom, rTo := 0, len(sp.rooms)
+	if rj >= 0 {
+		rFrom, rTo = rj, rj+1
+	}
+	for t := tFrom; t < tTo; t++ {
+		if d.teacherCount[j][t] == 0 {
+			continue
+		}
+		for r := rFrom; r < rTo; r++ {
+			if v := sp.at(t, r, sj); d.live[j].has(v) {
+				d.remove(j, v)
+			}
+		}
+	}
+}
+
+// removeTeacher drops every live value of j taught by teacher position tj.
+func (d *domainState) removeTeacher(j, tj int) {
+	sp := d.p.spaces[j]
+	block := len(sp.rooms) * len(sp.slots)
+	for v := tj * block; d.teacherCount[j][tj] > 0 && v < (tj+1)*block; v++ {
+		if d.live[j].has(v) {
+			d.remove(j, v)
+		}
+	}
+}
+
+// forwardCheck removes from the unassigned neighbours of i every value that
+// clashes with i's new value v, and values that no longer fit under the
+// teacher's weekly cap. Returns false when a domain empties.
+func (d *domainState) forwardCheck(i, v int) bool {
+	p := d.p
+	sp := p.spaces[i]
+	ti, ri, si := sp.decode(v)
+	t, r, s := sp.teachers[ti], sp.rooms[ri], sp.slots[si]
+	st := &p.slots
+	for _, e := range p.edges[i] {
+		j := e.to
+		if d.assigned[j] {
+			continue
+		}
+		spJ := p.spaces[j]
+		switch {
+		case e.sameSubject:
+			for _, s2 := range st.sameDayList[s] {
+				if sj := spJ.slotPos[s2]; sj >= 0 {
+					d.removeWhere(j, -1, -1, int(sj))
+				}
+			}
+			if p.checker.bindSessionTeacher {
+				for tj, other := range spJ.teachers {
+					if other != t && d.teacherCount[j][tj] > 0 {
+						d.removeTeacher(j, tj)
+					}
+				}
+			}
+		case e.cohort || e.examDay:
+			region := st.overlapList[s]
+			if e.examDay {
+				region = st.sameDayList[s]
+			}
+			for _, s2 := range region {
+				if sj := spJ.slotPos[s2]; sj >= 0 {
+					d.removeWhere(j, -1, -1, int(sj))
+				}
+			}
+		default:
+			tj, rj := spJ.teacherPos[t], spJ.roomPos[r]
+			for _, s2 := range st.overlapList[s] {
+				sj := spJ.slotPos[s2]
+				if sj < 0 {
+					continue
+				}
+				if tj >= 0 {
+					d.removeWhere(j, int(tj), -1, int(sj))
+				}
+				if rj >= 0 {
+					d.removeWhere(j, -1, int(rj), int(sj))
+				}
+			}
+		}
+		if d.size[j] == 0 {
+			return false
+		}
+	}
+
+	// Values of the same teacher that would push them over the weekly cap
+	if p.caps[t] == 0 {
+		return true
+	}
+	remaining := p.caps[t] - d.load[t]
+	if remaining >= st.maxPeriods {
+		return true
+	}
+	for _, e := range p.edges[i] {
+		j := e.to
+		if d.assigned[j] || !d.hasTeacher(j, t) {
+			continue
+		}
+		spJ := p.spaces[j]
+		tj := int(spJ.teacherPos[t])
+		for sj, s2 := range spJ.slots {
+			if st.known[s2] && st.periods[s2] > remaining {
+				d.removeWhere(j, tj, -1, sj)
+			}
+		}
+		if d.size[j] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// liveDomains is a read-only copy of the domains after pruning, before
+// search, from which local search draws its moves.
+type liveDomains struct {
+	p    *problemIndex
+	live []bitset
+	size []int
+}
+
+func (d *domainState) snapshot() *liveDomains {
+	l := &liveDomains{p: d.p, live: make([]bitset, len(d.live)), size: append([]int(nil), d.size...)}
+	for i, b := range d.live {
+		l.live[i] = b.clone()
+	}
+	return l
+}
+
+// random draws a value of variable i uniformly; false when the domain is empty.
+func (l *liveDomains) random(i int, rng *rand.Rand) (Assignment, bool) {
+	if l.size[i] == 0 {
+		return Assignment{}, false
+	}
+	return l.p.assignment(i, l.live[i].nth(rng.IntN(l.size[i]))), true
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// Exam periods reuse the teaching solver: each exam is a ScheduleVariable, the
+// teacher of an Assignment is its invigilator, and exam slots are ordinary
+// TimeSlots whose DayOfWeek holds the day's offset from the first exam day.
+// Slots on different dates therefore never overlap, and two slots share a
+// day exactly when they fall on the same date.
+
+// ExamSlots builds one slot per session on every exam day from start to end,
+// skipping Sundays and the semester's holidays. dates maps each slot's
+// DayOfWeek back to its calendar date.
+func ExamSlots(semester *entity.Semester, start, end time.Time, sessions []entity.ExamSession) (slots []*entity.TimeSlot, dates map[int]time.Time) {
+	dates = map[int]time.Time{}
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	for day := 0; !first.AddDate(0, 0, day).After(end); day++ {
+		date := first.AddDate(0, 0, day)
+		if date.Weekday() == time.Sunday || semester.IsHoliday(date) {
+			continue
+		}
+		dates[day] = date
+		for _, s := range sessions {
+			slots = append(slots, &entity.TimeSlot{
+				ID:          uuid.New(),
+				SemesterID:  semester.ID,
+				DayOfWeek:   day,
+				StartPeriod: s.StartPeriod,
+				EndPeriod:   s.EndPeriod,
+			})
+		}
+	}
+	return slots, dates
+}
+
+// ExamAvailability translates weekly availability windows (DayOfWeek 0=Monday)
+// onto the exam days they fall on. Teachers without windows stay absent, and
+// so remain available at any time.
+func ExamAvailability(weekly map[uuid.UUID][]*entity.TimeSlot, dates map[int]time.Time) map[uuid.UUID][]*entity.TimeSlot {
+	days := make([]int, 0, len(dates))
+	for day := range dates {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+
+	out := make(map[uuid.UUID][]*entity.TimeSlot, len(weekly))
+	for teacherID, windows := range weekly {
+		var translated []*entity.TimeSlot
+		for _, w := range windows {
+			for _, day := range days {
+				if entity.DayIndex(dates[day]) != w.DayOfWeek {
+					continue
+				}
+				translated = append(translated, &entity.TimeSlot{
+					DayOfWeek:   day,
+					StartPeriod: w.StartPeriod,
+					EndPeriod:   w.EndPeriod,
+				})
+			}
+		}
+		if len(windows) > 0 {
+			// An empty list would read as "no availability data"; a teacher
+			// whose windows miss every exam day gets one no slot can match
+			if len(translated) == 0 {
+				translated = []*entity.TimeSlot{{DayOfWeek: -1}}
+			}
+			out[teacherID] = translated
+		}
+	}
+	return out
+}
+
+// ExamRooms returns copies of the rooms seating only every (spacing+1)-th
+// seat, the capacity left once candidates are spaced apart.
+func ExamRooms(rooms []*entity.Room, spacing int) []*entity.Room {
+	out := make([]*entity.Room, len(rooms))
+	for i, r := range rooms {
+		cp := *r
+		cp.Capacity = r.Capacity / (spacing + 1)
+		out[i] = &cp
+	}
+	return out
+}
+
+// SetStudentDailyLimit caps how many of the registered subjects one student
+// may have on a single day (enrolled: subject_id -> student IDs). A limit of
+// 0 disables the rule. Used for exam periods, where a subject is one sitting.
+func (cc *ConstraintChecker) SetStudentDailyLimit(enrolled map[uuid.UUID][]uuid.UUID, maxPerDay int) {
+	cc.maxPerDay = maxPerDay
+	cc.subjectStudents = make(map[string][]uuid.UUID, len(enrolled))
+	cc.studentSubjects = map[uuid.UUID]map[string]bool{}
+	for subjectID, students := range enrolled {
+		key := subjectID.String()
+		cc.subjectStudents[key] = students
+		for _, st := range students {
+			if cc.studentSubjects[st] == nil {
+				cc.studentSubjects[st] = map[string]bool{}
+			}
+			cc.studentSubjects[st][key] = true
+		}
+	}
+}
+
+// exceedsDailyLimit reports whether placing subjectKey in slot would give one
+// of its students more than maxPerDay subjects that day.
+func (cc *ConstraintChecker) exceedsDailyLimit(
+	subjectKey string,
+	slot *entity.TimeSlot,
+	current map[string]Assignment,
+	slots map[uuid.UUID]*entity.TimeSlot,
+) bool {
+	students := cc.subjectStudents[subjectKey]
+	if cc.maxPerDay <= 0 || len(students) == 0 {
+		return false
+	}
+	sameDay := map[string]bool{}
+	for key, a := range current {
+		if s := slots[a.SlotID]; s != nil && s.DayOfWeek == slot.DayOfWeek && subjectKeyOf(key) != subjectKey {
+			sameDay[subjectKeyOf(key)] = true
+		}
+	}
+	if len(sameDay) < cc.maxPerDay {
+		return false
+	}
+	for _, st := range students {
+		count := 1
+		for key := range sameDay {
+			if cc.studentSubjects[st][key] {
+				count++
+			}
+		}
+		if count > cc.maxPerDay {
+			return true
+		}
+	}
+	return false
+}
+
+// sharesStudents reports whether any student takes both subjects.
+func (cc *ConstraintChecker) sharesStudents(subjectA, subjectB string) bool {
+	for _, st := range cc.subjectStudents[subjectA] {
+		if cc.studentSubjects[st][subjectB] {
+			return true
+		}
+	}
+	return false
+}
+
+// examSpreadConstraint penalises exams sharing students that are fewer than
+// minGapDays apart: each shared student costs one point per missing day.
+type examSpreadConstraint struct{ minGapDays int }
+
+func (examSpreadConstraint) Type() valueobject.ConstraintType {
+	return valueobject.ConstraintExamSpread
+}
+
+func (c examSpreadConstraint) Penalty(in *SoftInput) float64 {
+	if len(in.CohortOverlap) == 0 {
+		return 0
+	}
+	keys := make([]string, 0, len(in.Assignment))
+	for key := range in.Assignment {
+		keys = append(keys, key)
+	}
+	penalty := 0.0
+	for i, ki := range keys {
+		si := in.Slots[in.Assignment[ki].SlotID]
+		if si == nil {
+			continue
+		}
+		for _, kj := range keys[i+1:] {
+			shared := in.CohortOverlap.Shared(subjectKeyOf(ki), subjectKeyOf(kj))
+			sj := in.Slots[in.Assignment[kj].SlotID]
+			if shared == 0 || sj == nil {
+				continue
+			}
+			gap := si.DayOfWeek - sj.DayOfWeek
+			if gap < 0 {
+				gap = -gap
+			}
+			if gap < c.minGapDays {
+				penalty += float64(shared * (c.minGapDays - gap))
+			}
+		}
+	}
+	return penalty
+}
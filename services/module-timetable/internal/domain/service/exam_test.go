@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestExamSlotsSkipSundaysAndHolidays(t *testing.T) {
+	date := func(d int) time.Time { return time.Date(2025, 12, d, 0, 0, 0, 0, time.UTC) }
+	semester := &entity.Semester{Holidays: []entity.Holiday{{Name: "Break", StartDate: date(16), EndDate: date(16)}}}
+
+	// Friday 12-12 to Tuesday 12-16: Sunday 12-14 and the holiday are skipped
+	slots, dates := ExamSlots(semester, date(12), date(16), entity.DefaultExamSessions)
+	if len(dates) != 3 || len(slots) != 6 {
+		t.Fatalf("expected 3 exam days and 6 slots, got %d days and %d slots", len(dates), len(slots))
+	}
+	for day, want := range map[int]time.Time{0: date(12), 1: date(13), 3: date(15)} {
+		if !dates[day].Equal(want) {
+			t.Fatalf("day %d should be %s, got %s", day, want, dates[day])
+		}
+	}
+}
+
+func TestExamAvailabilityMapsWeekdaysToExamDays(t *testing.T) {
+	// Day 0 is a Friday, day 3 the following Monday
+	dates := map[int]time.Time{
+		0: time.Date(2025, 12, 12, 0, 0, 0, 0, time.UTC),
+		3: time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC),
+	}
+	weekly := map[uuid.UUID][]*entity.TimeSlot{
+		mustUUID(10): {makeSlot(0, 0, 1, 6)}, // Mondays
+		mustUUID(11): {makeSlot(0, 2, 1, 6)}, // Wednesdays only
+	}
+	got := ExamAvailability(weekly, dates)
+
+	cc := NewConstraintChecker(got, nil, nil, nil)
+	friday, monday := makeSlot(1, 0, 1, 3), makeSlot(2, 3, 1, 3)
+	if cc.teacherAvailableAt(mustUUID(10), friday) || !cc.teacherAvailableAt(mustUUID(10), monday) {
+		t.Fatal("a Monday window should cover exam day 3 only")
+	}
+	if cc.teacherAvailableAt(mustUUID(11), friday) || cc.teacherAvailableAt(mustUUID(11), monday) {
+		t.Fatal("a teacher available on no exam day must not invigilate")
+	}
+	if !cc.teacherAvailableAt(mustUUID(12), friday) {
+		t.Fatal("teachers without availability data stay available")
+	}
+}
+
+func TestStudentDailyLimit(t *testing.T) {
+	// Two sessions on day 0, one on day 1
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 0, 4, 6), makeSlot(3, 1, 1, 3))
+	enrolled := map[uuid.UUID][]uuid.UUID{
+		mustUUID(1): {mustUUID(100)},
+		mustUUID(2): {mustUUID(100)},
+		mustUUID(3): {mustUUID(101)},
+	}
+	cc := openChecker()
+	cc.SetStudentDailyLimit(enrolled, 1)
+
+	current := map[string]Assignment{mustUUID(1).String(): makeAssign(10, 20, 1)}
+	if cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(2)}, makeAssign(11, 21, 2), current, slots) {
+		t.Fatal("a student may not sit two exams on one day")
+	}
+	if !cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(2)}, makeAssign(11, 21, 3), current, slots) {
+		t.Fatal("the next day should be accepted")
+	}
+	if !cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(3)}, makeAssign(11, 21, 2), current, slots) {
+		t.Fatal("subjects without shared students may share a day")
+	}
+	reason := cc.ConflictReason(
+		mustUUID(1).String(), makeAssign(10, 20, 1),
+		mustUUID(2).String(), makeAssign(11, 21, 2), slots,
+	)
+	if reason != valueobject.ConstraintExamDailyLimit {
+		t.Fatalf("expected exam_daily_limit, got %q", reason)
+	}
+
+	cc.SetStudentDailyLimit(enrolled, 2)
+	if !cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(2)}, makeAssign(11, 21, 2), current, slots) {
+		t.Fatal("two exams a day should be allowed under a limit of 2")
+	}
+}
+
+func TestExamSpreadPenalty(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 1, 1, 3), makeSlot(3, 4, 1, 3))
+	in := &SoftInput{
+		Slots:         slots,
+		CohortOverlap: twoSubjectCohort(),
+		Assignment: map[string]Assignment{
+			mustUUID(1).String(): makeAssign(10, 20, 1),
+			mustUUID(2).String(): makeAssign(11, 20, 2),
+			mustUUID(3).String(): makeAssign(12, 20, 3),
+		},
+	}
+	// Subjects 1/2 share 2 students one day apart: 2 × (3-1); 2/3 are 3 days apart
+	if got := (examSpreadConstraint{minGapDays: 3}).Penalty(in); got != 4 {
+		t.Fatalf("expected penalty 4, got %v", got)
+	}
+}
+
+func TestExamScheduleSolve(t *testing.T) {
+	enrolled := map[uuid.UUID][]uuid.UUID{
+		mustUUID(1): {mustUUID(100), mustUUID(101)},
+		mustUUID(2): {mustUUID(100), mustUUID(101), mustUUID(102)},
+		mustUUID(3): {mustUUID(102)},
+	}
+	// Two exam days of two sessions; one spaced room seats 2, the other 3
+	slots := []*entity.TimeSlot{makeSlot(1, 0, 1, 3), makeSlot(2, 0, 4, 6), makeSlot(3, 2, 1, 3), makeSlot(4, 2, 4, 6)}
+	rooms := ExamRooms([]*entity.Room{{ID: mustUUID(20), Capacity: 4}, {ID: mustUUID(21), Capacity: 7}}, 1)
+	if rooms[0].Capacity != 2 || rooms[1].Capacity != 3 {
+		t.Fatalf("seat spacing should halve capacity, got %d and %d", rooms[0].Capacity, rooms[1].Capacity)
+	}
+
+	// Each teacher may invigilate two three-period sittings
+	cc := NewConstraintChecker(nil, nil, nil, map[uuid.UUID]int{mustUUID(10): 6, mustUUID(11): 6})
+	cc.SetCohortOverlap(BuildCohortOverlap(enrolled), true)
+	cc.SetStudentDailyLimit(enrolled, 1)
+	cc.SetRoomRequirements(rooms, map[uuid.UUID]RoomRequirement{
+		mustUUID(1): {MinCapacity: 2},
+		mustUUID(2): {MinCapacity: 3},
+		mustUUID(3): {MinCapacity: 1},
+	})
+	spread, err := BuildSoftConstraints([]entity.SoftConstraintSetting{{Type: valueobject.ConstraintExamSpread, Weight: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc.SetSoftConstraints(spread)
+
+	variables := []ScheduleVariable{makeVar(1), makeVar(2), makeVar(3)}
+	domains := map[string][]Assignment{}
+	for _, v := range variables {
+		for _, teacher := range []int{10, 11} {
+			for _, room := range []int{20, 21} {
+				for slot := 1; slot <= 4; slot++ {
+					domains[v.Key()] = append(domains[v.Key()], makeAssign(teacher, room, slot))
+				}
+			}
+		}
+	}
+	slotMap := slotsMap(slots...)
+	result, err := NewCSPSolver(variables, domains, slotMap, cc).Solve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.IsPartial {
+		t.Fatal("expected every exam to be placed")
+	}
+
+	a := result.Assignment
+	day := func(id int) int { return slotMap[a[mustUUID(id).String()].SlotID].DayOfWeek }
+	if day(1) == day(2) || day(2) == day(3) {
+		t.Fatalf("exams sharing students must be on different days: %v", a)
+	}
+	if a[mustUUID(2).String()].RoomID != mustUUID(21) {
+		t.Fatal("the three-student exam only fits the larger room")
+	}
+}
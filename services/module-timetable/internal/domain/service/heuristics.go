@@ -1,90 +1,122 @@
 package service
 
-import "github.com/google/uuid"
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+)
 
-// selectMRV picks the unassigned variable (subject) with the Minimum Remaining Values
-// in its domain — fewest valid (teacher, room, slot) combinations left.
-// This reduces backtracking by tackling the most constrained subject first.
-func selectMRV(
-	variables []ScheduleVariable,
-	domains map[string][]Assignment,
-	assignment map[string]Assignment,
-) *ScheduleVariable {
+// selectMRV picks the unassigned variable (session) with the Minimum Remaining
+// Values in its domain — fewest valid (teacher, room, slot) combinations left,
+// the earliest one on ties. This reduces backtracking by tackling the most
+// constrained session first. Returns -1 when every variable is assigned.
+func (d *domainState) selectMRV() int {
 	best := -1
-	bestSize := -1
-	for i, v := range variables {
-		key := v.SubjectID.String()
-		if _, assigned := assignment[key]; assigned {
-			continue
-		}
-		size := len(domains[key])
-		if bestSize < 0 || size < bestSize {
-			bestSize = size
+	for i, size := range d.size {
+		if !d.assigned[i] && (best < 0 || size < d.size[best]) {
 			best = i
 		}
 	}
-	if best < 0 {
-		return nil
-	}
-	return &variables[best]
+	return best
 }
 
-// orderLCV sorts the domain values of a variable by Least Constraining Value —
-// values that rule out the fewest options for neighbouring unassigned variables
-// are tried first, keeping the search space as open as possible.
-func orderLCV(
-	variable *ScheduleVariable,
-	variables []ScheduleVariable,
-	domains map[string][]Assignment,
-	assignment map[string]Assignment,
-	checker *ConstraintChecker,
-	slots map[uuid.UUID]*ScheduleVariable, // unused but kept for interface symmetry
-) []Assignment {
-	values := domains[variable.SubjectID.String()]
+// orderLCV sorts the live values of variable i by Least Constraining Value —
+// values that rule out the fewest options for unassigned variables are tried
+// first, keeping the search space as open as possible. A value in slot s
+// rules out every value with its teacher or room in a slot overlapping s,
+// read from the occupancy counts, plus the values of cohort, exam and
+// sibling neighbours in clashing slots. Values closer to anchor (when
+// non-nil) come first regardless of score. Values with equal scores keep
+// their domain order, or a random order drawn from rng when it is non-nil.
+func (d *domainState) orderLCV(i int, rng *rand.Rand, anchor *Assignment) []int {
+	sp := d.p.spaces[i]
+	values := make([]int, 0, d.size[i])
+	for v := d.live[i].next(0); v >= 0; v = d.live[i].next(v + 1) {
+		values = append(values, v)
+	}
 	if len(values) <= 1 {
 		return values
 	}
+	if rng != nil {
+		rng.Shuffle(len(values), func(a, b int) { values[a], values[b] = values[b], values[a] })
+	}
 
-	type scored struct {
-		val   Assignment
-		score int // number of values eliminated from neighbours
+	teacherScore, roomScore, slotScore := d.lcvScores(i)
+	ns := len(sp.slots)
+	score := func(v int) int32 {
+		ti, ri, si := sp.decode(v)
+		return teacherScore[ti*ns+si] + roomScore[ri*ns+si] + slotScore[si]
+	}
+	distance := func(int) int { return 0 }
+	if anchor != nil {
+		distance = func(v int) int { return anchorDistance(d.p.assignment(i, v), *anchor) }
 	}
 
-	ranked := make([]scored, len(values))
-	for i, val := range values {
-		eliminated := 0
-		for _, neighbour := range variables {
-			nKey := neighbour.SubjectID.String()
-			if _, done := assignment[nKey]; done {
-				continue
-			}
-			if nKey == variable.SubjectID.String() {
-				continue
-			}
-			for _, nVal := range domains[nKey] {
-				// Count how many neighbour values conflict with this choice
-				if checker.Conflicts(
-					variable.SubjectID.String(), val,
-					nKey, nVal,
-					nil, // slots map not needed for teacher/room conflict check
-				) {
-					eliminated++
-				}
+	type ranked struct {
+		v, rank int
+		dist    int
+		score   int32
+	}
+	order := make([]ranked, len(values))
+	for k, v := range values {
+		order[k] = ranked{v: v, rank: k, dist: distance(v), score: score(v)}
+	}
+	slices.SortFunc(order, func(a, b ranked) int {
+		return cmp.Or(cmp.Compare(a.dist, b.dist), cmp.Compare(a.score, b.score), cmp.Compare(a.rank, b.rank))
+	})
+	for k, r := range order {
+		values[k] = r.v
+	}
+	return values
+}
+
+// lcvScores counts, per (teacher, slot), (room, slot) and slot position of
+// variable i, the values of other unassigned variables a value there would
+// rule out. i's own values are taken out of the counts while scoring.
+func (d *domainState) lcvScores(i int) (teacherScore, roomScore, slotScore []int32) {
+	p := d.p
+	sp := p.spaces[i]
+	st := &p.slots
+	ns, gs := len(sp.slots), len(st.ids)
+	d.setAssigned(i, true)
+	defer d.setAssigned(i, false)
+
+	teacherScore = make([]int32, len(sp.teachers)*ns)
+	for ti, t := range sp.teachers {
+		if d.teacherCount[i][ti] == 0 {
+			continue
+		}
+		for si, s := range sp.slots {
+			for _, s2 := range st.overlapList[s] {
+				teacherScore[ti*ns+si] += d.ts[int(t)*gs+int(s2)]
 			}
 		}
-		ranked[i] = scored{val, eliminated}
 	}
-
-	// Sort ascending: values that eliminate fewer neighbours come first
-	for i := 1; i < len(ranked); i++ {
-		for j := i; j > 0 && ranked[j].score < ranked[j-1].score; j-- {
-			ranked[j], ranked[j-1] = ranked[j-1], ranked[j]
+	roomScore = make([]int32, len(sp.rooms)*ns)
+	for ri, r := range sp.rooms {
+		for si, s := range sp.slots {
+			for _, s2 := range st.overlapList[s] {
+				roomScore[ri*ns+si] += d.rs[int(r)*gs+int(s2)]
+			}
 		}
 	}
-
-	result := make([]Assignment, len(ranked))
-	for i, s := range ranked {
-		result[i] = s.val
+	slotScore = make([]int32, ns)
+	for _, e := range p.edges[i] {
+		if d.assigned[e.to] || !(e.sameSubject || e.cohort || e.examDay) {
+			continue
+		}
+		spJ := p.spaces[e.to]
+		for si, s := range sp.slots {
+			region := st.overlapList[s]
+			if e.sameSubject || e.examDay {
+				region = st.sameDayList[s]
+			}
+			for _, s2 := range region {
+				if sj := spJ.slotPos[s2]; sj >= 0 {
+					slotScore[si] += d.slotCount[e.to][sj]
+				}
+			}
+		}
 	}
-	return result
+	return teacherScore, roomScore, slotScore
 }
@@ -1,6 +1,20 @@
 package service
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+)
+
+// newTestState indexes enumerated domains the way prune does, without the
+// unary passes.
+func newTestState(vars []ScheduleVariable, domains map[string][]Assignment, slots map[uuid.UUID]*entity.TimeSlot) *domainState {
+	p, live := indexDomains(vars, domains, slots, openChecker())
+	p.link(live)
+	return newDomainState(p, live)
+}
 
 func TestSelectMRVPicksSmallestDomain(t *testing.T) {
 	v1 := makeVar(1)
@@ -11,12 +25,9 @@ func TestSelectMRVPicksSmallestDomain(t *testing.T) {
 		mustUUID(2).String(): {makeAssign(3, 3, 3)},
 		mustUUID(3).String(): {makeAssign(4, 4, 4), makeAssign(5, 5, 5), makeAssign(6, 6, 6)},
 	}
-	selected := selectMRV([]ScheduleVariable{v1, v2, v3}, domains, map[string]Assignment{})
-	if selected == nil {
-		t.Fatal("expected selection")
-	}
-	if selected.SubjectID != mustUUID(2) {
-		t.Fatalf("MRV should pick v2 (domain size 1), got %v", selected.SubjectID)
+	d := newTestState([]ScheduleVariable{v1, v2, v3}, domains, nil)
+	if got := d.selectMRV(); got != 1 {
+		t.Fatalf("MRV should pick v2 (domain size 1), got variable %d", got)
 	}
 }
 
@@ -27,30 +38,28 @@ func TestSelectMRVSkipsAssigned(t *testing.T) {
 		mustUUID(1).String(): {makeAssign(1, 1, 1)},
 		mustUUID(2).String(): {makeAssign(2, 2, 2), makeAssign(3, 3, 3)},
 	}
-	assignment := map[string]Assignment{mustUUID(1).String(): makeAssign(1, 1, 1)}
-	selected := selectMRV([]ScheduleVariable{v1, v2}, domains, assignment)
-	if selected == nil {
-		t.Fatal("expected selection")
-	}
-	if selected.SubjectID != mustUUID(2) {
-		t.Fatal("should skip assigned v1, select v2")
+	d := newTestState([]ScheduleVariable{v1, v2}, domains, nil)
+	d.assign(0, 0)
+	if got := d.selectMRV(); got != 1 {
+		t.Fatalf("should skip assigned v1, select v2; got variable %d", got)
 	}
 }
 
-func TestSelectMRVAllAssignedReturnsNil(t *testing.T) {
+func TestSelectMRVAllAssignedReturnsNone(t *testing.T) {
 	v1 := makeVar(1)
 	domains := map[string][]Assignment{mustUUID(1).String(): {makeAssign(1, 1, 1)}}
-	assignment := map[string]Assignment{mustUUID(1).String(): makeAssign(1, 1, 1)}
-	if selectMRV([]ScheduleVariable{v1}, domains, assignment) != nil {
-		t.Fatal("expected nil when all variables assigned")
+	d := newTestState([]ScheduleVariable{v1}, domains, nil)
+	d.assign(0, 0)
+	if got := d.selectMRV(); got != -1 {
+		t.Fatalf("expected -1 when all variables assigned, got %d", got)
 	}
 }
 
 func TestOrderLCVSingleValueReturnsSame(t *testing.T) {
 	v := makeVar(1)
 	domains := map[string][]Assignment{mustUUID(1).String(): {makeAssign(1, 1, 1)}}
-	result := orderLCV(&v, []ScheduleVariable{v}, domains, nil, openChecker(), nil)
-	if len(result) != 1 {
+	d := newTestState([]ScheduleVariable{v}, domains, nil)
+	if result := d.orderLCV(0, nil, nil); len(result) != 1 {
 		t.Fatalf("expected 1 value, got %d", len(result))
 	}
 }
@@ -63,11 +72,50 @@ func TestOrderLCVMultiValueStableWhenScoresEqual(t *testing.T) {
 			makeAssign(2, 2, 2),
 		},
 	}
-	result := orderLCV(&v, []ScheduleVariable{v}, domains, nil, openChecker(), nil)
+	d := newTestState([]ScheduleVariable{v}, domains, nil)
+	result := d.orderLCV(0, nil, nil)
 	if len(result) != 2 {
 		t.Fatalf("expected 2 values, got %d", len(result))
 	}
-	if result[0] != domains[mustUUID(1).String()][0] || result[1] != domains[mustUUID(1).String()][1] {
+	got := []Assignment{d.p.assignment(0, result[0]), d.p.assignment(0, result[1])}
+	if got[0] != domains[mustUUID(1).String()][0] || got[1] != domains[mustUUID(1).String()][1] {
 		t.Fatal("expected order to remain stable when scores are equal")
 	}
 }
+
+func TestOrderLCVPrefersSlotsNeighboursDoNotNeed(t *testing.T) {
+	// v2 can only have teacher 10 in slot 1 or 2, so v1 should leave those to it.
+	v1, v2 := makeVar(1), makeVar(2)
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 0, 2, 4), makeSlot(3, 1, 1, 3))
+	domains := map[string][]Assignment{
+		v1.Key(): {makeAssign(10, 20, 1), makeAssign(10, 20, 2), makeAssign(10, 20, 3)},
+		v2.Key(): {makeAssign(10, 21, 1), makeAssign(10, 21, 2)},
+	}
+	d := newTestState([]ScheduleVariable{v1, v2}, domains, slots)
+	result := d.orderLCV(0, nil, nil)
+	if first := d.p.assignment(0, result[0]); first.SlotID != mustUUID(3) {
+		t.Fatalf("LCV should try slot 3 first, got %v", first.SlotID)
+	}
+}
+
+func TestForwardCheckUndo(t *testing.T) {
+	v1, v2 := makeVar(1), makeVar(2)
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 0, 2, 4), makeSlot(3, 1, 1, 3))
+	domains := map[string][]Assignment{
+		v1.Key(): {makeAssign(10, 20, 1)},
+		v2.Key(): {makeAssign(10, 21, 2), makeAssign(11, 20, 1), makeAssign(10, 21, 3)},
+	}
+	d := newTestState([]ScheduleVariable{v1, v2}, domains, slots)
+	mark := d.mark()
+	d.assign(0, d.live[0].next(0))
+	if !d.forwardCheck(0, d.live[0].next(0)) {
+		t.Fatal("v2 keeps slot 3, forward checking should succeed")
+	}
+	if d.size[1] != 1 || d.p.assignment(1, d.live[1].next(0)) != makeAssign(10, 21, 3) {
+		t.Fatalf("expected only (10, 21, 3) left for v2, got %v", d.p.values(1, d.live[1]))
+	}
+	d.undo(mark)
+	if d.size[1] != 3 || d.assigned[0] {
+		t.Fatalf("undo should restore v2's three values, size=%d", d.size[1])
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+)
+
+// ICalendar describes a timetable to render as an RFC 5545 calendar.
+type ICalendar struct {
+	Name     string // calendar display name (X-WR-CALNAME)
+	Semester *entity.Semester
+	Entries  []*entity.ScheduleEntry
+	Stamp    time.Time // DTSTAMP of every event, e.g. when the schedule was generated
+}
+
+// Render writes the calendar with one recurring VEVENT per entry, following
+// the semester's calendar: clock times come from its period table, the
+// subject's week pattern picks the weeks (odd/even weeks recur every two
+// weeks) and classes on holidays are excluded with EXDATE. Times are
+// floating (no TZID), so clients show them in the subscriber's own time
+// zone, which is the campus zone in practice. A slot spans from the start of
+// StartPeriod to the end of EndPeriod. Entries whose periods are not in the
+// table, or that never meet, are skipped.
+func (c ICalendar) Render() []byte {
+	entries := append([]*entity.ScheduleEntry(nil), c.Entries...)
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].DayOfWeek != entries[j].DayOfWeek {
+			return entries[i].DayOfWeek < entries[j].DayOfWeek
+		}
+		if entries[i].StartPeriod != entries[j].StartPeriod {
+			return entries[i].StartPeriod < entries[j].StartPeriod
+		}
+		return entries[i].ID.String() < entries[j].ID.String()
+	})
+
+	var b strings.Builder
+	w := func(line string) { writeICalLine(&b, line) }
+	w("BEGIN:VCALENDAR")
+	w("VERSION:2.0")
+	w("PRODID:-//Myrmex//Timetable//EN")
+	w("CALSCALE:GREGORIAN")
+	w("METHOD:PUBLISH")
+	if c.Name != "" {
+		w("X-WR-CALNAME:" + escapeICalText(c.Name))
+	}
+
+	stamp := c.Stamp.UTC().Format("20060102T150405Z")
+	for _, e := range entries {
+		from, to, ok := c.Semester.SlotTimes(e.StartPeriod, e.EndPeriod)
+		if !ok {
+			continue
+		}
+		pattern := c.Semester.WeekPatternOf(e.SubjectID)
+		teaching, cancelled := c.Semester.ClassDates(e.DayOfWeek, pattern)
+		if len(teaching) == 0 {
+			continue
+		}
+		dates := append(append([]time.Time(nil), teaching...), cancelled...)
+		sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+		first, last := dates[0], dates[len(dates)-1]
+
+		rule := "RRULE:FREQ=WEEKLY"
+		if pattern.Biweekly() {
+			rule += ";INTERVAL=2"
+		}
+		rule += ";UNTIL=" + last.Add(24*time.Hour-time.Second).Format("20060102T150405")
+
+		w("BEGIN:VEVENT")
+		w("UID:" + e.ID.String() + "@myrmex")
+		w("DTSTAMP:" + stamp)
+		w("DTSTART:" + first.Add(from).Format("20060102T150405"))
+		w("DTEND:" + first.Add(to).Format("20060102T150405"))
+		w(rule)
+		if len(cancelled) > 0 {
+			excluded := make([]string, len(cancelled))
+			for i, day := range cancelled {
+				excluded[i] = day.Add(from).Format("20060102T150405")
+			}
+			w("EXDATE:" + strings.Join(excluded, ","))
+		}
+		w("SUMMARY:" + escapeICalText(eventSummary(e)))
+		if e.RoomName != "" {
+			w("LOCATION:" + escapeICalText(e.RoomName))
+		}
+		if e.TeacherName != "" {
+			w("DESCRIPTION:" + escapeICalText(fmt.Sprintf("Teacher: %s", e.TeacherName)))
+		}
+		w("END:VEVENT")
+	}
+	w("END:VCALENDAR")
+	return []byte(b.String())
+}
+
+func eventSummary(e *entity.ScheduleEntry) string {
+	switch {
+	case e.SubjectCode != "" && e.SubjectName != "":
+		return e.SubjectCode + " " + e.SubjectName
+	case e.SubjectName != "":
+		return e.SubjectName
+	case e.SubjectCode != "":
+		return e.SubjectCode
+	}
+	return e.SubjectID.String()
+}
+
+// escapeICalText escapes a TEXT value (RFC 5545 §3.3.11).
+func escapeICalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// writeICalLine writes a content line terminated by CRLF, folding it so that
+// no line exceeds 75 octets (RFC 5545 §3.1) without splitting a UTF-8 rune.
+func writeICalLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74 // continuation lines start with a space
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestICalendarRenderWeeklyEvents(t *testing.T) {
+	semester := &entity.Semester{
+		// Wednesday 2025-09-03 to Friday 2025-12-19
+		StartDate: time.Date(2025, 9, 3, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 12, 19, 0, 0, 0, 0, time.UTC),
+	}
+	monday := makeEntry(1, 10, 20, 30, 0, 1)
+	monday.EndPeriod = 2
+	monday.SubjectCode, monday.SubjectName, monday.RoomName = "CS101", "Intro, Part 1", "A-101"
+	friday := makeEntry(2, 10, 20, 31, 4, 3)
+	friday.EndPeriod = 3
+
+	out := string(ICalendar{
+		Name:     "Fall",
+		Semester: semester,
+		Entries:  []*entity.ScheduleEntry{friday, monday},
+		Stamp:    time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC),
+	}.Render())
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"DTSTAMP:20250801T120000Z\r\n",
+		// Monday classes start on the first Monday after a Wednesday start
+		"DTSTART:20250908T080000\r\nDTEND:20250908T111500\r\n",
+		"DTSTART:20250905T113000\r\nDTEND:20250905T130000\r\n",
+		"RRULE:FREQ=WEEKLY;UNTIL=20251219T235959\r\n",
+		`SUMMARY:CS101 Intro\, Part 1` + "\r\n",
+		"LOCATION:A-101\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("calendar missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "20250908T080000") > strings.Index(out, "20250905T113000") {
+		t.Fatalf("events should be ordered by weekday")
+	}
+}
+
+func TestICalendarRenderFollowsSemesterCalendar(t *testing.T) {
+	date := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC) }
+	semester := &entity.Semester{
+		// Wednesday 2025-09-03 to Friday 2025-10-03, weeks 1-5 from Monday 09-01
+		StartDate:    date(9, 3),
+		EndDate:      date(10, 3),
+		Periods:      []entity.PeriodDefinition{{Period: 1, Start: "07:00", End: "07:50"}, {Period: 2, Start: "08:00", End: "08:50"}},
+		Holidays:     []entity.Holiday{{Name: "Holiday", StartDate: date(9, 15), EndDate: date(9, 15)}},
+		WeekPatterns: map[uuid.UUID]valueobject.WeekPattern{mustUUID(1): valueobject.WeekPatternOdd},
+	}
+	odd := makeEntry(1, 10, 20, 30, 0, 1)
+	odd.EndPeriod = 2
+	weekly := makeEntry(2, 10, 20, 31, 0, 1)
+	weekly.EndPeriod = 2
+	late := makeEntry(3, 10, 20, 32, 0, 2)
+	late.EndPeriod = 3 // period 3 is not in the semester's table
+
+	out := string(ICalendar{Semester: semester, Entries: []*entity.ScheduleEntry{odd, weekly, late}}.Render())
+
+	for _, want := range []string{
+		// Odd weeks start in week 3 since week 1's Monday precedes the start
+		"DTSTART:20250915T070000\r\nDTEND:20250915T085000\r\nRRULE:FREQ=WEEKLY;INTERVAL=2;UNTIL=20250929T235959\r\nEXDATE:20250915T070000\r\n",
+		"DTSTART:20250908T070000\r\nDTEND:20250908T085000\r\nRRULE:FREQ=WEEKLY;UNTIL=20250929T235959\r\nEXDATE:20250915T070000\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("calendar missing %q:\n%s", want, out)
+		}
+	}
+	if n := strings.Count(out, "BEGIN:VEVENT"); n != 2 {
+		t.Fatalf("expected the entry outside the period table to be skipped, got %d events", n)
+	}
+}
+
+func TestWriteICalLineFoldsLongLines(t *testing.T) {
+	var b strings.Builder
+	writeICalLine(&b, "SUMMARY:"+strings.Repeat("é", 60))
+	for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Fatalf("line longer than 75 octets: %d", len(line))
+		}
+	}
+	if unfolded := strings.ReplaceAll(b.String(), "\r\n ", ""); unfolded != "SUMMARY:"+strings.Repeat("é", 60)+"\r\n" {
+		t.Fatalf("unfolding should restore the line, got %q", unfolded)
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Simulated-annealing defaults for the post-solve improvement phase.
+const (
+	defaultLocalSearchStall       = 20000
+	defaultLocalSearchReportEvery = time.Second
+	localSearchInitialTemperature = 2.0
+	localSearchCooling            = 0.9995
+	localSearchMinTemperature     = 0.01
+)
+
+// LocalSearchOptions configures the simulated-annealing phase that runs after
+// backtracking finds a complete assignment. Zero values pick the defaults.
+type LocalSearchOptions struct {
+	Seed        uint64        // seed for move selection; same seed + input = same result
+	MaxStall    int           // stop after this many iterations without a new best
+	ReportEvery time.Duration // minimum interval between OnProgress calls
+	OnProgress  func(LocalSearchProgress)
+}
+
+// LocalSearchProgress is one sample of the penalty trajectory.
+type LocalSearchProgress struct {
+	Iteration   int
+	Elapsed     time.Duration
+	Penalty     float64 // penalty of the current (possibly worse) assignment
+	BestPenalty float64
+	Temperature float64
+	Member      int // portfolio member that sampled it (0 for Solve)
+}
+
+// localSearch improves soft-constraint penalty with move and swap neighbourhoods
+// while every accepted state still satisfies all hard constraints.
+type localSearch struct {
+	csp     *CSPSolver
+	domains *liveDomains // domains after pre-processing, before search
+	opts    LocalSearchOptions
+	rng     *rand.Rand
+}
+
+func newLocalSearch(csp *CSPSolver, domains *liveDomains, opts LocalSearchOptions) *localSearch {
+	if opts.MaxStall <= 0 {
+		opts.MaxStall = defaultLocalSearchStall
+	}
+	if opts.ReportEvery <= 0 {
+		opts.ReportEvery = defaultLocalSearchReportEvery
+	}
+	return &localSearch{
+		csp:     csp,
+		domains: domains,
+		opts:    opts,
+		rng:     rand.New(rand.NewPCG(opts.Seed, opts.Seed^0x9e3779b97f4a7c15)),
+	}
+}
+
+// run anneals from start until ctx is done, the penalty reaches zero, or the
+// search stalls. It returns the best assignment seen and the sampled trajectory.
+func (ls *localSearch) run(ctx context.Context, start map[string]Assignment) (map[string]Assignment, []LocalSearchProgress) {
+	began := time.Now()
+	current := copyAssignment(start)
+	penalty := ls.csp.checker.EvaluateSoftConstraints(current, ls.csp.slots)
+	best, bestPenalty := copyAssignment(current), penalty
+	temperature := localSearchInitialTemperature
+
+	trajectory := []LocalSearchProgress{{Penalty: penalty, BestPenalty: bestPenalty, Temperature: temperature}}
+	lastReport := began
+	report := func(iter int, final bool) {
+		p := LocalSearchProgress{
+			Iteration:   iter,
+			Elapsed:     time.Since(began),
+			Penalty:     penalty,
+			BestPenalty: bestPenalty,
+			Temperature: temperature,
+		}
+		trajectory = append(trajectory, p)
+		if ls.opts.OnProgress != nil {
+			ls.csp.throttle.send(true, ls.opts.ReportEvery, final, func() { ls.opts.OnProgress(p) })
+		}
+	}
+
+	stall := 0
+	iter := 0
+	for ; bestPenalty > 0 && stall < ls.opts.MaxStall && len(ls.csp.variables) > 0; iter++ {
+		if ctx.Err() != nil {
+			break
+		}
+		undo, ok := ls.neighbour(current)
+		if ok {
+			next := ls.csp.checker.EvaluateSoftConstraints(current, ls.csp.slots)
+			delta := next - penalty
+			if delta <= 0 || ls.rng.Float64() < math.Exp(-delta/temperature) {
+				penalty = next
+			} else {
+				undo()
+			}
+		}
+		if penalty < bestPenalty {
+			best, bestPenalty = copyAssignment(current), penalty
+			stall = 0
+		} else {
+			stall++
+		}
+		temperature = math.Max(temperature*localSearchCooling, localSearchMinTemperature)
+		if time.Since(lastReport) >= ls.opts.ReportEvery {
+			lastReport = time.Now()
+			report(iter, false)
+		}
+	}
+	report(iter, true)
+	return best, trajectory
+}
+
+// neighbour applies a random move or swap to current in place. It returns an
+// undo func and true when the new state keeps every hard constraint; otherwise
+// current is left untouched.
+func (ls *localSearch) neighbour(current map[string]Assignment) (func(), bool) {
+	vars := ls.csp.variables
+	i := ls.rng.IntN(len(vars))
+	if len(vars) > 1 && ls.rng.IntN(2) == 0 {
+		b := vars[ls.rng.IntN(len(vars))]
+		if b.Key() != vars[i].Key() {
+			return ls.swapSlots(current, vars[i], b)
+		}
+	}
+	return ls.move(current, i)
+}
+
+// move reassigns the i-th variable to a random value from its domain.
+func (ls *localSearch) move(current map[string]Assignment, i int) (func(), bool) {
+	v := ls.csp.variables[i]
+	val, ok := ls.domains.random(i, ls.rng)
+	if !ok {
+		return nil, false
+	}
+	old := current[v.Key()]
+	if val == old {
+		return nil, false
+	}
+	delete(current, v.Key())
+	if !ls.csp.checker.IsConsistent(v, val, current, ls.csp.slots) {
+		current[v.Key()] = old
+		return nil, false
+	}
+	current[v.Key()] = val
+	if !ls.csp.staffable(ls.csp.slots[val.SlotID], current) {
+		current[v.Key()] = old
+		return nil, false
+	}
+	return func() { current[v.Key()] = old }, true
+}
+
+// swapSlots exchanges the time slots of two variables, keeping their teachers and rooms.
+func (ls *localSearch) swapSlots(current map[string]Assignment, a, b ScheduleVariable) (func(), bool) {
+	oldA, oldB := current[a.Key()], current[b.Key()]
+	if oldA.SlotID == oldB.SlotID {
+		return nil, false
+	}
+	newA := Assignment{TeacherID: oldA.TeacherID, RoomID: oldA.RoomID, SlotID: oldB.SlotID}
+	newB := Assignment{TeacherID: oldB.TeacherID, RoomID: oldB.RoomID, SlotID: oldA.SlotID}
+	restore := func() {
+		current[a.Key()] = oldA
+		current[b.Key()] = oldB
+	}
+
+	delete(current, a.Key())
+	delete(current, b.Key())
+	if !ls.csp.checker.IsConsistent(a, newA, current, ls.csp.slots) {
+		restore()
+		return nil, false
+	}
+	current[a.Key()] = newA
+	if !ls.csp.checker.IsConsistent(b, newB, current, ls.csp.slots) {
+		restore()
+		return nil, false
+	}
+	current[b.Key()] = newB
+	if !ls.csp.staffable(ls.csp.slots[newA.SlotID], current) || !ls.csp.staffable(ls.csp.slots[newB.SlotID], current) {
+		restore()
+		return nil, false
+	}
+	return restore, true
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLocalSearchRemovesTeacherGap(t *testing.T) {
+	v1, v2 := makeVar(1), makeVar(2)
+	slots := slotsMap(makeSlot(1, 0, 1, 2), makeSlot(2, 0, 3, 4), makeSlot(3, 0, 8, 9))
+	domains := map[string][]Assignment{
+		v1.Key(): {makeAssign(10, 20, 1)},
+		v2.Key(): {makeAssign(10, 21, 3), makeAssign(10, 21, 2)},
+	}
+	solver := NewCSPSolver([]ScheduleVariable{v1, v2}, domains, slots, openChecker())
+	start := map[string]Assignment{
+		v1.Key(): makeAssign(10, 20, 1),
+		v2.Key(): makeAssign(10, 21, 3), // periods 1 and 8 → large gap
+	}
+	if p := solver.checker.EvaluateSoftConstraints(start, slots); p <= 0 {
+		t.Fatalf("fixture should start with a gap penalty, got %v", p)
+	}
+
+	pruned, err := solver.prune()
+	if err != nil {
+		t.Fatalf("prune: %v", err)
+	}
+	var reports int
+	ls := newLocalSearch(solver, pruned, LocalSearchOptions{
+		Seed:       7,
+		MaxStall:   500,
+		OnProgress: func(LocalSearchProgress) { reports++ },
+	})
+	best, trajectory := ls.run(context.Background(), start)
+
+	if p := solver.checker.EvaluateSoftConstraints(best, slots); p != 0 {
+		t.Fatalf("expected gap removed, penalty=%v assignment=%v", p, best)
+	}
+	if len(trajectory) < 2 || trajectory[0].Penalty <= trajectory[len(trajectory)-1].BestPenalty {
+		t.Fatalf("trajectory should record the improvement: %+v", trajectory)
+	}
+	if reports == 0 {
+		t.Fatal("expected at least the final progress report")
+	}
+}
+
+func TestLocalSearchKeepsHardConstraints(t *testing.T) {
+	// Slots 1 and 2 overlap, so the sessions may never occupy both of them.
+	v1, v2 := makeVar(1), makeVar(2)
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 0, 2, 4), makeSlot(3, 0, 8, 9))
+	domains := map[string][]Assignment{
+		v1.Key(): {makeAssign(10, 20, 1)},
+		v2.Key(): {makeAssign(10, 21, 3), makeAssign(10, 21, 2)},
+	}
+	solver := NewCSPSolver([]ScheduleVariable{v1, v2}, domains, slots, openChecker())
+	start := map[string]Assignment{
+		v1.Key(): makeAssign(10, 20, 1),
+		v2.Key(): makeAssign(10, 21, 3),
+	}
+	pruned, err := solver.prune()
+	if err != nil {
+		t.Fatalf("prune: %v", err)
+	}
+	best, _ := newLocalSearch(solver, pruned, LocalSearchOptions{MaxStall: 200}).run(context.Background(), start)
+	a1, a2 := best[v1.Key()], best[v2.Key()]
+	if slotsOverlap(slots[a1.SlotID], slots[a2.SlotID]) {
+		t.Fatalf("local search double-booked teacher 10: %v", best)
+	}
+}
+
+func TestCSPSolverLocalSearchNeverWorsensPenalty(t *testing.T) {
+	v1, v2 := makeVar(1), makeVar(2)
+	slots := slotsMap(makeSlot(1, 0, 1, 2), makeSlot(2, 0, 3, 4), makeSlot(3, 0, 8, 9))
+	domains := map[string][]Assignment{
+		v1.Key(): {makeAssign(10, 20, 1)},
+		v2.Key(): {makeAssign(10, 21, 3), makeAssign(10, 21, 2)},
+	}
+	solver := NewCSPSolver([]ScheduleVariable{v1, v2}, domains, slots, openChecker())
+	solver.SetLocalSearch(&LocalSearchOptions{MaxStall: 500})
+	result, err := solver.Solve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.SoftPenalty > result.InitialPenalty {
+		t.Fatalf("penalty worsened: %v > %v", result.SoftPenalty, result.InitialPenalty)
+	}
+	if len(result.PenaltyTrajectory) == 0 {
+		t.Fatal("expected a penalty trajectory")
+	}
+}
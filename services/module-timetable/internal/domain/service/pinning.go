@@ -0,0 +1,208 @@
+package service
+
+import (
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// SetPinned fixes assignments that search must keep unchanged. Keys are
+// variable keys and must not belong to any of the solver's variables; free
+// variables are checked against the pinned ones, and the pinned assignments
+// are part of the result.
+func (csp *CSPSolver) SetPinned(pinned map[string]Assignment) {
+	csp.pinned = pinned
+}
+
+// PartialAssignment pre-places a variable before search. Nil fields are left
+// to the solver; a fully specified one fixes the variable to a single value.
+type PartialAssignment struct {
+	TeacherID *uuid.UUID
+	RoomID    *uuid.UUID
+	SlotID    *uuid.UUID
+}
+
+// Matches reports whether a satisfies every field the partial assignment sets.
+func (p PartialAssignment) Matches(a Assignment) bool {
+	return (p.TeacherID == nil || *p.TeacherID == a.TeacherID) &&
+		(p.RoomID == nil || *p.RoomID == a.RoomID) &&
+		(p.SlotID == nil || *p.SlotID == a.SlotID)
+}
+
+// SetPreplaced restricts the domains of the keyed variables to the values
+// matching their partial assignment. Unlike SetPinned the variables stay part
+// of the search, so hard constraints still apply to them and diagnosis names
+// the constraint that makes a pre-placement impossible.
+func (csp *CSPSolver) SetPreplaced(preplaced map[string]PartialAssignment) {
+	csp.preplaced = preplaced
+}
+
+// SetAnchors makes search try the values closest to each variable's anchor
+// first (same slot, then same room, then same teacher), so that re-solving an
+// existing schedule moves as few classes as possible.
+func (csp *CSPSolver) SetAnchors(anchors map[string]Assignment) {
+	csp.anchors = anchors
+}
+
+// pinnedSlot is where a pinned assignment puts its teacher, as sets of the
+// table slots it rules out.
+type pinnedSlot struct {
+	teacherID uuid.UUID
+	overlap   bitset // slots overlapping the pinned slot
+	sameDay   bitset // slots on its day
+}
+
+// enforcePinnedConsistency drops every value that cannot coexist with the
+// pinned assignments, the check IsConsistent would make against them, and
+// adds their periods to the teachers' base load. Returns false if any domain
+// becomes empty.
+func (p *problemIndex) enforcePinnedConsistency(live []bitset, pinned map[string]Assignment, log *pruneLog) bool {
+	if len(pinned) == 0 {
+		return true
+	}
+	cc := p.checker
+	st := &p.slots
+	ns := len(st.ids)
+	teacherBusy := make([]bitset, len(p.teacherIDs))
+	roomBusy := make([]bitset, len(p.roomIDs))
+	for t := range teacherBusy {
+		teacherBusy[t] = newBitset(ns)
+	}
+	for r := range roomBusy {
+		roomBusy[r] = newBitset(ns)
+	}
+	bySubject := map[string][]pinnedSlot{}
+	for key, a := range pinned {
+		slot := p.slotMap[a.SlotID]
+		if slot == nil {
+			continue // IsConsistent skips assignments in unknown slots
+		}
+		ps := pinnedSlot{teacherID: a.TeacherID, overlap: newBitset(ns), sameDay: newBitset(ns)}
+		for s, id := range st.ids {
+			if other := p.slotMap[id]; other != nil && other.DayOfWeek == slot.DayOfWeek {
+				ps.sameDay.set(s)
+				if slotsOverlap(slot, other) {
+					ps.overlap.set(s)
+				}
+			}
+		}
+		if t, ok := p.teacherIdx[a.TeacherID]; ok {
+			p.baseLoad[t] += slotPeriods(slot)
+			teacherBusy[t].union(ps.overlap)
+		}
+		if r, ok := p.roomIdx[a.RoomID]; ok {
+			roomBusy[r].union(ps.overlap)
+		}
+		subject := subjectKeyOf(key)
+		bySubject[subject] = append(bySubject[subject], ps)
+	}
+
+	ok := true
+	for i, sp := range p.spaces {
+		subject := subjectKeyOf(p.keys[i])
+		siblingDays, cohortBusy := newBitset(ns), newBitset(ns)
+		siblingTeachers := map[uuid.UUID]bool{}
+		for _, ps := range bySubject[subject] {
+			siblingDays.union(ps.sameDay)
+			siblingTeachers[ps.teacherID] = true
+		}
+		for other, pins := range bySubject {
+			if cc.cohortClash(subject, other) {
+				for _, ps := range pins {
+					cohortBusy.union(ps.overlap)
+				}
+			}
+		}
+		overDailyLimit := map[int]bool{}
+
+		eliminated := map[valueobject.ConstraintType]int{}
+		kept := 0
+		for v := live[i].next(0); v >= 0; v = live[i].next(v + 1) {
+			ti, ri, si := sp.decode(v)
+			t, r, s := sp.teachers[ti], sp.rooms[ri], int(sp.slots[si])
+			var cause valueobject.ConstraintType
+			switch {
+			case !st.known[s]:
+				cause = valueobject.ConstraintTeacherOverload // no pairwise reason applies
+			case cc.bindSessionTeacher && len(siblingTeachers) > 0 && (len(siblingTeachers) > 1 || !siblingTeachers[p.teacherIDs[t]]):
+				cause = valueobject.ConstraintSessionTeacherSplit
+			case siblingDays.has(s):
+				cause = valueobject.ConstraintSessionSameDay
+			case cc.maxPerDay > 0 && p.exceedsDailyLimit(subject, s, overDailyLimit, pinned):
+				cause = valueobject.ConstraintExamDailyLimit
+			case teacherBusy[t].has(s):
+				cause = valueobject.ConstraintTeacherConflict
+			case roomBusy[r].has(s):
+				cause = valueobject.ConstraintRoomConflict
+			case cohortBusy.has(s):
+				cause = valueobject.ConstraintCohortClash
+			case p.caps[t] > 0 && p.baseLoad[t]+st.periods[s] > p.caps[t]:
+				cause = valueobject.ConstraintTeacherOverload
+			default:
+				kept++
+				continue
+			}
+			live[i].clear(v)
+			eliminated[cause]++
+		}
+		for cause, n := range eliminated {
+			log.recordN(p.keys[i], cause, n)
+		}
+		if kept == 0 {
+			ok = false
+		}
+	}
+	return ok
+}
+
+// exceedsDailyLimit is the checker's daily limit for subject in table slot s
+// against the pinned assignments, cached by day in seen.
+func (p *problemIndex) exceedsDailyLimit(subject string, s int, seen map[int]bool, pinned map[string]Assignment) bool {
+	day := p.slots.day[s]
+	over, found := seen[day]
+	if !found {
+		over = p.checker.exceedsDailyLimit(subject, p.slotMap[p.slots.ids[s]], pinned, p.slotMap)
+		seen[day] = over
+	}
+	return over
+}
+
+// enforcePreplacement drops every value that does not match its variable's
+// pre-placement. Values are dropped silently so that diagnosis reports why the
+// matching ones failed, unless nothing matches at all; then the wipe-out is
+// recorded as ConstraintPinned. Returns false if any domain becomes empty.
+func (p *problemIndex) enforcePreplacement(live []bitset, preplaced map[string]PartialAssignment, log *pruneLog) bool {
+	ok := true
+	for i, key := range p.keys {
+		pa, found := preplaced[key]
+		if !found {
+			continue
+		}
+		total := live[i].count()
+		for v := live[i].next(0); v >= 0; v = live[i].next(v + 1) {
+			if !pa.Matches(p.assignment(i, v)) {
+				live[i].clear(v)
+			}
+		}
+		if live[i].next(0) < 0 {
+			log.recordN(key, valueobject.ConstraintPinned, total)
+			ok = false
+		}
+	}
+	return ok
+}
+
+// anchorDistance weighs a changed slot above a changed room above a changed
+// teacher: students notice a new time more than a new room or lecturer.
+func anchorDistance(val, anchor Assignment) int {
+	d := 0
+	if val.SlotID != anchor.SlotID {
+		d += 4
+	}
+	if val.RoomID != anchor.RoomID {
+		d += 2
+	}
+	if val.TeacherID != anchor.TeacherID {
+		d++
+	}
+	return d
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestCSPSolverKeepsPinnedAssignments(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 1, 1, 3))
+	free := makeVar(1)
+	pinnedKey := mustUUID(2).String()
+	// Teacher 10 is pinned to slot 1, so subject 1 must move to slot 2.
+	domains := map[string][]Assignment{free.Key(): {makeAssign(10, 20, 1), makeAssign(10, 20, 2)}}
+	solver := NewCSPSolver([]ScheduleVariable{free}, domains, slots, openChecker())
+	solver.SetPinned(map[string]Assignment{pinnedKey: makeAssign(10, 21, 1)})
+
+	result, err := solver.Solve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.IsPartial || len(result.Entries) != 2 {
+		t.Fatalf("expected pinned + free entries, got %d partial=%v", len(result.Entries), result.IsPartial)
+	}
+	if got := result.Assignment[free.Key()]; got != makeAssign(10, 20, 2) {
+		t.Fatalf("free subject should avoid the pinned slot, got %+v", got)
+	}
+	if got := result.Assignment[pinnedKey]; got != makeAssign(10, 21, 1) {
+		t.Fatalf("pinned assignment changed: %+v", got)
+	}
+}
+
+func TestCSPSolverPinnedConflictIsInfeasible(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 0, 1, 3))
+	free := makeVar(1)
+	domains := map[string][]Assignment{free.Key(): {makeAssign(10, 20, 1)}}
+	solver := NewCSPSolver([]ScheduleVariable{free}, domains, slots, openChecker())
+	solver.SetPinned(map[string]Assignment{mustUUID(2).String(): makeAssign(10, 21, 1)})
+
+	_, err := solver.Solve(context.Background())
+	var infeasible *InfeasibleError
+	if !errors.As(err, &infeasible) {
+		t.Fatalf("expected InfeasibleError, got %v", err)
+	}
+}
+
+func TestCSPSolverPrefersValuesNearAnchor(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 1, 1, 3))
+	v := makeVar(1)
+	domains := map[string][]Assignment{v.Key(): {
+		makeAssign(11, 21, 1), // new room
+		makeAssign(12, 20, 2), // new slot
+		makeAssign(12, 20, 1), // new teacher only
+	}}
+	solver := NewCSPSolver([]ScheduleVariable{v}, domains, slots, openChecker())
+	solver.SetAnchors(map[string]Assignment{v.Key(): makeAssign(10, 20, 1)})
+
+	result, err := solver.Solve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := result.Assignment[v.Key()]; got != makeAssign(12, 20, 1) {
+		t.Fatalf("expected the value that only changes teacher, got %+v", got)
+	}
+}
+
+func TestCSPSolverRestrictsPreplacedDomain(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 1, 1, 3))
+	v := makeVar(1)
+	domains := map[string][]Assignment{v.Key(): {
+		makeAssign(10, 20, 1), makeAssign(11, 20, 1), makeAssign(10, 20, 2),
+	}}
+	slot := mustUUID(2)
+	solver := NewCSPSolver([]ScheduleVariable{v}, domains, slots, openChecker())
+	solver.SetPreplaced(map[string]PartialAssignment{v.Key(): {SlotID: &slot}})
+
+	result, err := solver.Solve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := result.Assignment[v.Key()]; got != makeAssign(10, 20, 2) {
+		t.Fatalf("expected the only value in the pre-placed slot, got %+v", got)
+	}
+}
+
+func TestCSPSolverUnmatchedPreplacementReportsPinned(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 0, 1, 3))
+	v := makeVar(1)
+	domains := map[string][]Assignment{v.Key(): {makeAssign(10, 20, 1)}}
+	teacher := mustUUID(99)
+	solver := NewCSPSolver([]ScheduleVariable{v}, domains, slots, openChecker())
+	solver.SetPreplaced(map[string]PartialAssignment{v.Key(): {TeacherID: &teacher}})
+
+	_, err := solver.Solve(context.Background())
+	var infeasible *InfeasibleError
+	if !errors.As(err, &infeasible) {
+		t.Fatalf("expected InfeasibleError, got %v", err)
+	}
+	if got := infeasible.Report.EmptyDomains[0].Cause(); got != valueobject.ConstraintPinned {
+		t.Fatalf("expected cause %q, got %q", valueobject.ConstraintPinned, got)
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// PortfolioStrategies returns n strategies derived from seed. The first
+// keeps the plain LCV order so a portfolio never does worse than Solve with
+// the same seed; the others break ties at random, each from its own seed.
+func PortfolioStrategies(seed uint64, n int) []SolverStrategy {
+	if n < 1 {
+		n = 1
+	}
+	strategies := make([]SolverStrategy, n)
+	strategies[0] = SolverStrategy{Seed: seed}
+	for i := 1; i < n; i++ {
+		strategies[i] = SolverStrategy{Seed: splitmix64(seed + uint64(i)), ShuffleTies: true}
+	}
+	return strategies
+}
+
+// splitmix64 spreads consecutive member seeds over the whole range.
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// SolvePortfolio prunes the domains once, then runs one search per strategy
+// concurrently under ctx and returns the best result: complete before
+// partial, then more assigned sessions, fewer hard violations and lower
+// soft penalty, ties going to the earlier strategy. A member finishing with
+// a complete zero-penalty assignment stops the later members, which could
+// at best tie with it, so the winner only depends on the strategies unless
+// the deadline cuts members short. Search and local-search progress
+// callbacks are tagged with the member index, serialised and throttled
+// across members, so the portfolio reports no more often than Solve.
+func (csp *CSPSolver) SolvePortfolio(ctx context.Context, strategies []SolverStrategy) (*SolverResult, error) {
+	if len(strategies) == 0 {
+		strategies = []SolverStrategy{csp.strategy}
+	}
+	start := time.Now()
+	searchDomains, err := csp.prune()
+	if err != nil {
+		return nil, err
+	}
+
+	// One context per member so a perfect result can stop the ones after it
+	cancels := make([]context.CancelFunc, len(strategies))
+	contexts := make([]context.Context, len(strategies))
+	for i := range strategies {
+		contexts[i], cancels[i] = context.WithCancel(ctx)
+	}
+	defer func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}()
+
+	throttle := newProgressThrottle(start)
+	results := make([]*SolverResult, len(strategies))
+	errs := make([]error, len(strategies))
+	var wg sync.WaitGroup
+	for i, strategy := range strategies {
+		member := csp.fork(i, strategy, throttle)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = member.search(contexts[i], start, searchDomains)
+			if r := results[i]; r != nil {
+				r.Member = i
+				if !r.IsPartial && r.HardViolations == 0 && r.SoftPenalty == 0 {
+					for _, cancel := range cancels[i+1:] {
+						cancel()
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	var best *SolverResult
+	for _, r := range results {
+		if r != nil && (best == nil || betterResult(r, best)) {
+			best = r
+		}
+	}
+	if best == nil {
+		return nil, errs[0]
+	}
+	best.Duration = time.Since(start)
+	return best, nil
+}
+
+// fork copies the pruned solver for one portfolio member. The domain state
+// is cloned because the search narrows it; everything else is read-only.
+func (csp *CSPSolver) fork(member int, strategy SolverStrategy, throttle *progressThrottle) *CSPSolver {
+	m := *csp
+	m.state = csp.state.clone()
+	m.bestSoFar = map[string]Assignment{}
+	m.throttle = throttle
+	m.SetStrategy(strategy)
+	if csp.localSearch != nil {
+		opts := *csp.localSearch
+		if onProgress := opts.OnProgress; onProgress != nil {
+			opts.OnProgress = func(p LocalSearchProgress) {
+				p.Member = member
+				onProgress(p)
+			}
+		}
+		m.localSearch = &opts
+	}
+	if csp.progress != nil && csp.progress.OnProgress != nil {
+		opts := *csp.progress
+		onProgress := opts.OnProgress
+		opts.OnProgress = func(p SearchProgress) {
+			p.Member = member
+			onProgress(p)
+		}
+		m.progress = &opts
+	}
+	return &m
+}
+
+// betterResult reports whether a beats b; see SolvePortfolio for the order.
+func betterResult(a, b *SolverResult) bool {
+	if a.IsPartial != b.IsPartial {
+		return !a.IsPartial
+	}
+	if len(a.Assignment) != len(b.Assignment) {
+		return len(a.Assignment) > len(b.Assignment)
+	}
+	if a.HardViolations != b.HardViolations {
+		return a.HardViolations < b.HardViolations
+	}
+	return a.SoftPenalty < b.SoftPenalty
+}
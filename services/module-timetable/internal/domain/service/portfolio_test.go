@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"testing"
+)
+
+// newPortfolioSolver builds six sessions that any of three teachers can
+// teach in two rooms over six slots, so many values score equally and
+// tie-breaking matters.
+func newPortfolioSolver() *CSPSolver {
+	slots := slotsMap()
+	for id := 1; id <= 6; id++ {
+		slots[mustUUID(id)] = makeSlot(id, id%2, id, id+1)
+	}
+	var vars []ScheduleVariable
+	domains := map[string][]Assignment{}
+	for i := 1; i <= 6; i++ {
+		v := makeVar(i)
+		vars = append(vars, v)
+		for teacher := 10; teacher < 13; teacher++ {
+			for room := 20; room < 22; room++ {
+				for slot := 1; slot <= 6; slot++ {
+					domains[v.Key()] = append(domains[v.Key()], makeAssign(teacher, room, slot))
+				}
+			}
+		}
+	}
+	solver := NewCSPSolver(vars, domains, slots, openChecker())
+	solver.SetLocalSearch(&LocalSearchOptions{MaxStall: 300})
+	return solver
+}
+
+func TestPortfolioStrategies(t *testing.T) {
+	strategies := PortfolioStrategies(42, 4)
+	if len(strategies) != 4 {
+		t.Fatalf("expected 4 strategies, got %d", len(strategies))
+	}
+	if strategies[0] != (SolverStrategy{Seed: 42}) {
+		t.Fatalf("first strategy should keep the plain order, got %+v", strategies[0])
+	}
+	seen := map[uint64]bool{}
+	for _, s := range strategies[1:] {
+		if !s.ShuffleTies || seen[s.Seed] {
+			t.Fatalf("expected distinct shuffled strategies, got %+v", strategies)
+		}
+		seen[s.Seed] = true
+	}
+	if again := PortfolioStrategies(42, 4); again[3] != strategies[3] {
+		t.Fatal("strategies must be derived deterministically from the seed")
+	}
+	if len(PortfolioStrategies(1, 0)) != 1 {
+		t.Fatal("a portfolio has at least one member")
+	}
+}
+
+func TestCSPSolverSameSeedSameResult(t *testing.T) {
+	run := func() *SolverResult {
+		solver := newPortfolioSolver()
+		solver.SetStrategy(SolverStrategy{Seed: 99, ShuffleTies: true})
+		result, err := solver.Solve(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+	first := run()
+	for i := 0; i < 5; i++ {
+		if next := run(); !maps.Equal(first.Assignment, next.Assignment) || next.SoftPenalty != first.SoftPenalty {
+			t.Fatalf("run %d differs from the first with the same seed", i)
+		}
+	}
+}
+
+func TestSolvePortfolio(t *testing.T) {
+	var mu sync.Mutex
+	members := map[int]bool{}
+	solve := func() *SolverResult {
+		solver := newPortfolioSolver()
+		solver.localSearch.OnProgress = func(p LocalSearchProgress) {
+			mu.Lock()
+			members[p.Member] = true
+			mu.Unlock()
+		}
+		result, err := solver.SolvePortfolio(context.Background(), PortfolioStrategies(7, 4))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := solve()
+	if result.IsPartial || len(result.Entries) != 6 {
+		t.Fatalf("expected a complete schedule, got partial=%v entries=%d", result.IsPartial, len(result.Entries))
+	}
+	if result.Strategy != PortfolioStrategies(7, 4)[result.Member] {
+		t.Fatalf("result strategy %+v does not match member %d", result.Strategy, result.Member)
+	}
+	if !members[0] {
+		t.Fatal("expected progress reports tagged with the member index")
+	}
+
+	// The single solver with member 0's strategy never beats the portfolio
+	single := newPortfolioSolver()
+	single.SetStrategy(PortfolioStrategies(7, 4)[0])
+	alone, err := single.Solve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.SoftPenalty > alone.SoftPenalty {
+		t.Fatalf("portfolio penalty %v worse than a single run %v", result.SoftPenalty, alone.SoftPenalty)
+	}
+
+	if again := solve(); again.Member != result.Member || !maps.Equal(again.Assignment, result.Assignment) {
+		t.Fatalf("same seed gave member %d, then member %d", result.Member, again.Member)
+	}
+}
+
+func TestSolvePortfolioInfeasible(t *testing.T) {
+	subj := makeVar(1)
+	solver := NewCSPSolver([]ScheduleVariable{subj}, map[string][]Assignment{subj.Key(): {}}, nil, openChecker())
+	if _, err := solver.SolvePortfolio(context.Background(), PortfolioStrategies(1, 3)); err == nil {
+		t.Fatal("expected an infeasibility error")
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+const defaultProgressReportEvery = time.Second
+
+// ProgressOptions configures progress reports during backtracking search.
+// Zero values pick the defaults.
+type ProgressOptions struct {
+	ReportEvery time.Duration // minimum interval between OnProgress calls
+	OnProgress  func(SearchProgress)
+}
+
+// SearchProgress is one sample of the backtracking search. A last sample is
+// always reported when search ends, whether it completed or not.
+type SearchProgress struct {
+	Assigned     int           // sessions assigned on the current branch
+	Total        int           // sessions to assign
+	BestAssigned int           // most sessions assigned at once so far
+	Backtracks   int           // values taken back after everything below them failed
+	Nodes        int           // values tried so far, see SolverResult.Nodes
+	SoftPenalty  float64       // soft penalty of the best assignment so far
+	Elapsed      time.Duration // since Solve started, pre-processing included
+	Member       int           // portfolio member that sampled it (0 for Solve)
+}
+
+// SetProgress enables periodic progress reports from backtracking search, so
+// that callers can show how far a long solve has got. Pass nil to disable it.
+// Local search reports separately (see LocalSearchOptions.OnProgress).
+func (csp *CSPSolver) SetProgress(opts *ProgressOptions) {
+	if opts != nil && opts.ReportEvery <= 0 {
+		o := *opts
+		o.ReportEvery = defaultProgressReportEvery
+		opts = &o
+	}
+	csp.progress = opts
+}
+
+// reportProgress calls OnProgress when the report interval has passed since
+// the last call, or always when final is set. The soft penalty is only
+// evaluated for reports that are sent.
+func (csp *CSPSolver) reportProgress(assignment map[string]Assignment, final bool) {
+	if csp.progress == nil || csp.progress.OnProgress == nil {
+		return
+	}
+	now := time.Now()
+	if !final && now.Sub(csp.lastReport) < csp.progress.ReportEvery {
+		return
+	}
+	csp.lastReport = now
+	csp.throttle.send(false, csp.progress.ReportEvery, final, func() {
+		pinned := len(csp.pinned)
+		csp.progress.OnProgress(SearchProgress{
+			Assigned:     len(assignment) - pinned,
+			Total:        len(csp.variables),
+			BestAssigned: max(len(csp.bestSoFar)-pinned, len(assignment)-pinned, 0),
+			Backtracks:   csp.backtracks,
+			Nodes:        csp.nodes,
+			SoftPenalty:  csp.checker.EvaluateSoftConstraints(csp.bestSoFar, csp.slots),
+			Elapsed:      now.Sub(csp.started),
+		})
+	})
+}
+
+// progressThrottle is shared by the members of a portfolio. It serialises
+// their callbacks and keeps them, taken together, to one per report
+// interval, the same rate as a single Solve. Each member still checks its
+// own interval first, so it takes the lock at most once per interval.
+type progressThrottle struct {
+	mu          sync.Mutex
+	search      time.Time // last search report of any member
+	localSearch time.Time // last local-search report of any member
+}
+
+func newProgressThrottle(start time.Time) *progressThrottle {
+	return &progressThrottle{search: start, localSearch: start}
+}
+
+// send calls report unless another member sent a report of the same kind,
+// search or local search, within every. Final reports are always sent. A nil
+// throttle sends everything, for a solver running alone.
+func (t *progressThrottle) send(localSearch bool, every time.Duration, final bool, report func()) {
+	if t == nil {
+		report()
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	last := &t.search
+	if localSearch {
+		last = &t.localSearch
+	}
+	now := time.Now()
+	if !final && now.Sub(*last) < every {
+		return
+	}
+	*last = now
+	report()
+}
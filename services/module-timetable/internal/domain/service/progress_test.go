@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSolveReportsFinalProgress(t *testing.T) {
+	solver := newPortfolioSolver()
+	solver.SetLocalSearch(nil)
+	var reports []SearchProgress
+	solver.SetProgress(&ProgressOptions{ReportEvery: time.Hour, OnProgress: func(p SearchProgress) {
+		reports = append(reports, p)
+	}})
+
+	result, err := solver.Solve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The interval never passes, so only the final report is sent
+	if len(reports) != 1 {
+		t.Fatalf("expected exactly the final report, got %d", len(reports))
+	}
+	last := reports[0]
+	if last.Assigned != 6 || last.Total != 6 || last.BestAssigned != 6 {
+		t.Fatalf("expected 6/6 assigned, got %+v", last)
+	}
+	if last.Nodes != result.Nodes || last.Elapsed <= 0 {
+		t.Fatalf("unexpected counters: %+v (result nodes %d)", last, result.Nodes)
+	}
+	if last.SoftPenalty != result.InitialPenalty {
+		t.Fatalf("expected the penalty of the complete assignment %.2f, got %.2f", result.InitialPenalty, last.SoftPenalty)
+	}
+}
+
+func TestSolveReportsProgressDuringSearch(t *testing.T) {
+	solver := newPortfolioSolver()
+	solver.SetLocalSearch(nil)
+	var reports []SearchProgress
+	solver.SetProgress(&ProgressOptions{ReportEvery: time.Nanosecond, OnProgress: func(p SearchProgress) {
+		reports = append(reports, p)
+	}})
+	if _, err := solver.Solve(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reports) < 2 {
+		t.Fatalf("expected reports during search and at the end, got %d", len(reports))
+	}
+	for k := 1; k < len(reports); k++ {
+		if reports[k].BestAssigned < reports[k-1].BestAssigned || reports[k].Nodes < reports[k-1].Nodes {
+			t.Fatalf("best and nodes must never decrease: %+v then %+v", reports[k-1], reports[k])
+		}
+	}
+}
+
+func TestPortfolioTagsProgressWithMember(t *testing.T) {
+	solver := newPortfolioSolver()
+	solver.SetLocalSearch(nil)
+	var mu sync.Mutex
+	members := map[int]bool{}
+	solver.SetProgress(&ProgressOptions{ReportEvery: time.Hour, OnProgress: func(p SearchProgress) {
+		mu.Lock()
+		defer mu.Unlock()
+		members[p.Member] = true
+	}})
+	if _, err := solver.SolvePortfolio(context.Background(), PortfolioStrategies(7, 3)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(members) != 3 {
+		t.Fatalf("expected a final report from each of the 3 members, got %v", members)
+	}
+}
+
+func TestPortfolioProgressRespectsInterval(t *testing.T) {
+	// Eleven sessions of one teacher over ten disjoint slots: infeasible, and
+	// the search keeps every member busy until the deadline
+	slots := slotsMap()
+	var vars []ScheduleVariable
+	domains := map[string][]Assignment{}
+	for id := 1; id <= 10; id++ {
+		slots[mustUUID(id)] = makeSlot(id, id%7, 3*(id/7)+1, 3*(id/7)+2)
+	}
+	for i := 1; i <= 11; i++ {
+		v := makeVar(i)
+		vars = append(vars, v)
+		for slot := 1; slot <= 10; slot++ {
+			domains[v.Key()] = append(domains[v.Key()], makeAssign(10, 20+i, slot))
+		}
+	}
+	solver := NewCSPSolver(vars, domains, slots, openChecker())
+
+	const every, members = 20 * time.Millisecond, 4
+	var mu sync.Mutex
+	reports := 0
+	solver.SetProgress(&ProgressOptions{ReportEvery: every, OnProgress: func(SearchProgress) {
+		mu.Lock()
+		defer mu.Unlock()
+		reports++
+	}})
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := solver.SolvePortfolio(ctx, PortfolioStrategies(3, members)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// One report per interval for the whole portfolio, plus each member's final one
+	limit := int(time.Since(start)/every) + members
+	if reports > limit {
+		t.Fatalf("got %d reports, want at most %d for %d members", reports, limit, members)
+	}
+	if reports <= members {
+		t.Fatalf("expected reports during search, got %d", reports)
+	}
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+)
+
+// PublishedTimetable is a semester with the entries of its published
+// schedule. Its classes occupy their rooms on the days the semester
+// calendar teaches them.
+type PublishedTimetable struct {
+	Semester *entity.Semester
+	Entries  []*entity.ScheduleEntry
+}
+
+// RoomConflict is something already holding a room on a day.
+type RoomConflict struct {
+	Date        time.Time
+	StartPeriod int
+	EndPeriod   int
+	SubjectCode string    // class of a published schedule
+	BookingID   uuid.UUID // or another booking
+	Title       string
+}
+
+func (c RoomConflict) String() string {
+	what := "class " + c.SubjectCode
+	if c.BookingID != uuid.Nil {
+		what = fmt.Sprintf("booking %q", c.Title)
+	}
+	return fmt.Sprintf("%s periods %d-%d: %s", c.Date.Format(time.DateOnly), c.StartPeriod, c.EndPeriod, what)
+}
+
+// RoomOccupancy tells which rooms are taken on given days and periods by
+// published timetables and active bookings. Periods overlap as time slots do
+// (entity.TimeSlot.OverlapsWith): both ends are included, so a class in
+// periods 1-3 and a booking from period 3 clash.
+type RoomOccupancy struct {
+	timetables []PublishedTimetable
+	bookings   []*entity.RoomBooking
+}
+
+func NewRoomOccupancy(timetables []PublishedTimetable, bookings []*entity.RoomBooking) *RoomOccupancy {
+	return &RoomOccupancy{timetables: timetables, bookings: bookings}
+}
+
+// Conflicts lists what holds the room during periods start..end on any of
+// the days, in the order of the days. The booking with ID ignore is left
+// out, so a booking can be checked against everything but itself.
+func (o *RoomOccupancy) Conflicts(roomID uuid.UUID, days []time.Time, start, end int, ignore uuid.UUID) []RoomConflict {
+	var conflicts []RoomConflict
+	for _, day := range days {
+		for _, tt := range o.timetables {
+			for _, e := range tt.Entries {
+				window := &entity.TimeSlot{DayOfWeek: e.DayOfWeek, StartPeriod: start, EndPeriod: end}
+				if e.RoomID != roomID || !e.Slot().OverlapsWith(window) {
+					continue
+				}
+				if tt.Semester.TeachesOn(day, e.DayOfWeek, tt.Semester.WeekPatternOf(e.SubjectID)) {
+					conflicts = append(conflicts, RoomConflict{
+						Date: day, StartPeriod: e.StartPeriod, EndPeriod: e.EndPeriod,
+						SubjectCode: e.SubjectCode,
+					})
+				}
+			}
+		}
+		for _, b := range o.bookings {
+			if b.ID == ignore || b.RoomID != roomID || !b.Holds(day, start, end) {
+				continue
+			}
+			conflicts = append(conflicts, RoomConflict{
+				Date: day, StartPeriod: b.StartPeriod, EndPeriod: b.EndPeriod,
+				BookingID: b.ID, Title: b.Title,
+			})
+		}
+	}
+	return conflicts
+}
+
+// FreeRooms returns the rooms, in the given order, that nothing holds during
+// periods start..end on any of the days.
+func (o *RoomOccupancy) FreeRooms(rooms []*entity.Room, days []time.Time, start, end int) []*entity.Room {
+	var free []*entity.Room
+	for _, r := range rooms {
+		if len(o.Conflicts(r.ID, days, start, end, uuid.Nil)) == 0 {
+			free = append(free, r)
+		}
+	}
+	return free
+}
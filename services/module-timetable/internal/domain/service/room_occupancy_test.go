@@ -0,0 +1,99 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestRoomOccupancyConflicts(t *testing.T) {
+	date := func(d int) time.Time { return time.Date(2025, 9, d, 0, 0, 0, 0, time.UTC) }
+	room, other := mustUUID(1), mustUUID(2)
+	odd := mustUUID(20)
+	semester := &entity.Semester{
+		StartDate:    date(1), // Monday, week 1
+		EndDate:      date(30),
+		Holidays:     []entity.Holiday{{Name: "Holiday", StartDate: date(2), EndDate: date(2)}},
+		WeekPatterns: map[uuid.UUID]valueobject.WeekPattern{odd: valueobject.WeekPatternOdd},
+	}
+	occupancy := NewRoomOccupancy(
+		[]PublishedTimetable{{Semester: semester, Entries: []*entity.ScheduleEntry{
+			// Tuesdays periods 1-3
+			{SubjectID: mustUUID(10), SubjectCode: "CS101", RoomID: room, DayOfWeek: 1, StartPeriod: 1, EndPeriod: 3},
+			// Wednesdays periods 4-6 in odd weeks only
+			{SubjectID: odd, SubjectCode: "CS201", RoomID: room, DayOfWeek: 2, StartPeriod: 4, EndPeriod: 6},
+		}}},
+		[]*entity.RoomBooking{
+			{ID: mustUUID(30), RoomID: room, Title: "Meeting", StartDate: date(11), EndDate: date(11), StartPeriod: 7, EndPeriod: 8, Status: valueobject.BookingStatusPending},
+			{ID: mustUUID(31), RoomID: room, Title: "Old", StartDate: date(11), EndDate: date(11), StartPeriod: 1, EndPeriod: 2, Status: valueobject.BookingStatusCancelled},
+		},
+	)
+
+	tests := []struct {
+		name       string
+		room       uuid.UUID
+		day        time.Time
+		start, end int
+		want       int
+	}{
+		{"class shares period 3", room, date(9), 3, 5, 1},
+		{"after the class", room, date(9), 4, 5, 0},
+		{"class cancelled on the holiday", room, date(2), 1, 3, 0},
+		{"odd week class", room, date(3), 5, 5, 1},
+		{"even week is free", room, date(10), 5, 5, 0},
+		{"pending booking holds the room", room, date(11), 8, 9, 1},
+		{"cancelled booking does not", room, date(11), 1, 1, 0},
+		{"other room is free", other, date(9), 1, 3, 0},
+		{"outside the semester", room, time.Date(2025, 10, 7, 0, 0, 0, 0, time.UTC), 1, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := occupancy.Conflicts(tt.room, []time.Time{tt.day}, tt.start, tt.end, uuid.Nil)
+			if len(got) != tt.want {
+				t.Fatalf("expected %d conflicts, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if got := occupancy.Conflicts(room, []time.Time{date(11)}, 7, 8, mustUUID(30)); len(got) != 0 {
+		t.Fatalf("a booking must not conflict with itself, got %v", got)
+	}
+}
+
+func TestRoomOccupancyOverlapsLikeTimeSlots(t *testing.T) {
+	monday := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	room := mustUUID(1)
+	class := &entity.ScheduleEntry{SubjectID: mustUUID(10), RoomID: room, DayOfWeek: 0, StartPeriod: 3, EndPeriod: 4}
+	booking := &entity.RoomBooking{ID: mustUUID(30), RoomID: room, StartDate: monday, EndDate: monday,
+		StartPeriod: 3, EndPeriod: 4, Status: valueobject.BookingStatusApproved}
+	semester := &entity.Semester{StartDate: monday, EndDate: monday.AddDate(0, 0, 6)}
+
+	// Windows ending at, starting at and just missing periods 3-4
+	for _, w := range [][2]int{{1, 2}, {1, 3}, {2, 3}, {4, 5}, {4, 6}, {5, 6}} {
+		want := class.Slot().OverlapsWith(&entity.TimeSlot{DayOfWeek: 0, StartPeriod: w[0], EndPeriod: w[1]})
+		classes := NewRoomOccupancy([]PublishedTimetable{{Semester: semester, Entries: []*entity.ScheduleEntry{class}}}, nil)
+		if got := len(classes.Conflicts(room, []time.Time{monday}, w[0], w[1], uuid.Nil)) > 0; got != want {
+			t.Fatalf("class 3-4 against %d-%d: conflict=%v, slots overlap=%v", w[0], w[1], got, want)
+		}
+		bookings := NewRoomOccupancy(nil, []*entity.RoomBooking{booking})
+		if got := len(bookings.Conflicts(room, []time.Time{monday}, w[0], w[1], uuid.Nil)) > 0; got != want {
+			t.Fatalf("booking 3-4 against %d-%d: conflict=%v, slots overlap=%v", w[0], w[1], got, want)
+		}
+	}
+}
+
+func TestRoomOccupancyFreeRooms(t *testing.T) {
+	date := time.Date(2025, 9, 9, 0, 0, 0, 0, time.UTC)
+	rooms := []*entity.Room{{ID: mustUUID(1), Name: "A"}, {ID: mustUUID(2), Name: "B"}}
+	occupancy := NewRoomOccupancy(nil, []*entity.RoomBooking{
+		{ID: mustUUID(30), RoomID: mustUUID(1), Title: "Defence", StartDate: date, EndDate: date, StartPeriod: 3, EndPeriod: 5, Status: valueobject.BookingStatusApproved},
+	})
+	free := occupancy.FreeRooms(rooms, []time.Time{date}, 3, 5)
+	if len(free) != 1 || free[0].Name != "B" {
+		t.Fatalf("expected only room B, got %v", free)
+	}
+}
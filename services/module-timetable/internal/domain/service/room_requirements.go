@@ -0,0 +1,50 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// RoomRequirement describes the rooms a subject can be taught in.
+// Zero values mean "no requirement".
+type RoomRequirement struct {
+	MinCapacity int
+	RoomType    string   // e.g. "lab"; matched case-insensitively
+	Features    []string // every feature must be present on the room
+
+	// Enrollment is the offering's expected head count; rooms must also seat
+	// it when larger than MinCapacity.
+	Enrollment int
+}
+
+// Violation returns the hard constraint the room breaks, or "" when it fits.
+func (rr RoomRequirement) Violation(room *entity.Room) valueobject.ConstraintType {
+	if room.Capacity < max(rr.MinCapacity, rr.Enrollment) {
+		return valueobject.ConstraintRoomCapacity
+	}
+	if rr.RoomType != "" && !strings.EqualFold(room.Type, rr.RoomType) {
+		return valueobject.ConstraintRoomType
+	}
+	for _, want := range rr.Features {
+		if !hasFeature(room.Features, want) {
+			return valueobject.ConstraintRoomFeatureMissing
+		}
+	}
+	return ""
+}
+
+// Fits reports whether the room satisfies every part of the requirement.
+func (rr RoomRequirement) Fits(room *entity.Room) bool {
+	return rr.Violation(room) == ""
+}
+
+func hasFeature(features []string, want string) bool {
+	for _, f := range features {
+		if strings.EqualFold(f, want) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestRoomRequirementViolation(t *testing.T) {
+	lab := &entity.Room{ID: mustUUID(20), Capacity: 30, Type: "Lab", Features: []string{"projector", "computers"}}
+
+	tests := []struct {
+		name string
+		req  RoomRequirement
+		want valueobject.ConstraintType
+	}{
+		{name: "no requirement", req: RoomRequirement{}, want: ""},
+		{name: "fits", req: RoomRequirement{MinCapacity: 30, RoomType: "lab", Features: []string{"Projector"}}, want: ""},
+		{name: "too small", req: RoomRequirement{MinCapacity: 200}, want: valueobject.ConstraintRoomCapacity},
+		{name: "enrollment too large", req: RoomRequirement{MinCapacity: 20, Enrollment: 40}, want: valueobject.ConstraintRoomCapacity},
+		{name: "wrong type", req: RoomRequirement{RoomType: "lecture_hall"}, want: valueobject.ConstraintRoomType},
+		{name: "missing feature", req: RoomRequirement{Features: []string{"projector", "whiteboard"}}, want: valueobject.ConstraintRoomFeatureMissing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.req.Violation(lab); got != tt.want {
+				t.Fatalf("Violation() = %q, want %q", got, tt.want)
+			}
+			if got := tt.req.Fits(lab); got != (tt.want == "") {
+				t.Fatalf("Fits() = %v, want %v", got, tt.want == "")
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+)
+
+// ChangeKind classifies how a subject's session differs between two schedules.
+type ChangeKind string
+
+const (
+	ChangeMoved      ChangeKind = "moved"      // placed in another slot
+	ChangeReassigned ChangeKind = "reassigned" // same slot, other teacher or room
+	ChangeAdded      ChangeKind = "added"      // only in the target schedule
+	ChangeRemoved    ChangeKind = "removed"    // only in the base schedule
+)
+
+// EntryChange is one session that differs between the base and the target
+// schedule. Before is nil for added sessions, After for removed ones.
+type EntryChange struct {
+	Kind           ChangeKind
+	SubjectID      uuid.UUID
+	Before         *entity.ScheduleEntry
+	After          *entity.ScheduleEntry
+	TeacherChanged bool
+	RoomChanged    bool
+	SlotChanged    bool
+}
+
+// ResourceChanges counts how a diff affects one teacher or room. Gained and
+// Lost are sessions that came to or left the resource; Moved are sessions it
+// keeps but at another slot (or, for a teacher, in another room).
+type ResourceChanges struct {
+	ID     uuid.UUID
+	Name   string
+	Gained int
+	Lost   int
+	Moved  int
+}
+
+// ScheduleDiff is the result of DiffEntries.
+type ScheduleDiff struct {
+	Changes   []EntryChange
+	Teachers  []ResourceChanges // teachers with at least one change, by name
+	Rooms     []ResourceChanges // rooms with at least one change, by name
+	Unchanged int               // sessions identical in both schedules
+}
+
+// DiffEntries compares two schedules subject by subject. Sessions of a
+// subject are paired so that as few as possible are reported: identical
+// sessions first, then sessions kept in the same slot, then sessions kept by
+// the same teacher, then whatever remains in time order. Unpaired sessions
+// are added or removed.
+func DiffEntries(base, target []*entity.ScheduleEntry) ScheduleDiff {
+	before := groupBySubject(base)
+	after := groupBySubject(target)
+	subjects := make(map[uuid.UUID]bool, len(before)+len(after))
+	for id := range before {
+		subjects[id] = true
+	}
+	for id := range after {
+		subjects[id] = true
+	}
+
+	var diff ScheduleDiff
+	for subjectID := range subjects {
+		b, a := before[subjectID], after[subjectID]
+		pairs := pairEntries(b, a, func(x, y *entity.ScheduleEntry) bool {
+			return x.TimeSlotID == y.TimeSlotID && x.TeacherID == y.TeacherID && x.RoomID == y.RoomID
+		})
+		diff.Unchanged += len(pairs)
+		for _, same := range []func(x, y *entity.ScheduleEntry) bool{
+			func(x, y *entity.ScheduleEntry) bool { return x.TimeSlotID == y.TimeSlotID },
+			func(x, y *entity.ScheduleEntry) bool { return x.TeacherID == y.TeacherID },
+			func(x, y *entity.ScheduleEntry) bool { return true },
+		} {
+			for _, p := range pairEntries(b, a, same) {
+				diff.Changes = append(diff.Changes, pairedChange(p[0], p[1]))
+			}
+		}
+		for _, e := range b {
+			if e != nil {
+				diff.Changes = append(diff.Changes, EntryChange{Kind: ChangeRemoved, SubjectID: subjectID, Before: e})
+			}
+		}
+		for _, e := range a {
+			if e != nil {
+				diff.Changes = append(diff.Changes, EntryChange{Kind: ChangeAdded, SubjectID: subjectID, After: e})
+			}
+		}
+	}
+
+	sort.Slice(diff.Changes, func(i, j int) bool {
+		ci, cj := diff.Changes[i], diff.Changes[j]
+		if ki, kj := changeSortKey(ci), changeSortKey(cj); ki != kj {
+			return ki < kj
+		}
+		return ci.Kind < cj.Kind
+	})
+	diff.Teachers, diff.Rooms = summarizeChanges(diff.Changes)
+	return diff
+}
+
+// Describe renders the change as a sentence for notifications and logs.
+func (c EntryChange) Describe() string {
+	switch c.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("%s added: %s", subjectLabel(c.After), placement(c.After))
+	case ChangeRemoved:
+		return fmt.Sprintf("%s removed: %s", subjectLabel(c.Before), placement(c.Before))
+	}
+	return fmt.Sprintf("%s %s: %s -> %s", subjectLabel(c.After), c.Kind, placement(c.Before), placement(c.After))
+}
+
+// groupBySubject splits entries per subject, each list in time order.
+func groupBySubject(entries []*entity.ScheduleEntry) map[uuid.UUID][]*entity.ScheduleEntry {
+	out := map[uuid.UUID][]*entity.ScheduleEntry{}
+	for _, e := range entries {
+		out[e.SubjectID] = append(out[e.SubjectID], e)
+	}
+	for _, list := range out {
+		sort.Slice(list, func(i, j int) bool { return entryBefore(list[i], list[j]) })
+	}
+	return out
+}
+
+// pairEntries greedily pairs the entries of b and a accepted by match, in
+// order, and clears paired entries from both lists.
+func pairEntries(b, a []*entity.ScheduleEntry, match func(x, y *entity.ScheduleEntry) bool) [][2]*entity.ScheduleEntry {
+	var pairs [][2]*entity.ScheduleEntry
+	for i, x := range b {
+		if x == nil {
+			continue
+		}
+		for j, y := range a {
+			if y != nil && match(x, y) {
+				pairs = append(pairs, [2]*entity.ScheduleEntry{x, y})
+				b[i], a[j] = nil, nil
+				break
+			}
+		}
+	}
+	return pairs
+}
+
+func pairedChange(before, after *entity.ScheduleEntry) EntryChange {
+	c := EntryChange{
+		Kind:           ChangeReassigned,
+		SubjectID:      after.SubjectID,
+		Before:         before,
+		After:          after,
+		TeacherChanged: before.TeacherID != after.TeacherID,
+		RoomChanged:    before.RoomID != after.RoomID,
+		SlotChanged:    before.TimeSlotID != after.TimeSlotID,
+	}
+	if c.SlotChanged {
+		c.Kind = ChangeMoved
+	}
+	return c
+}
+
+// summarizeChanges tallies the changes per teacher and per room.
+func summarizeChanges(changes []EntryChange) (teachers, rooms []ResourceChanges) {
+	byTeacher := map[uuid.UUID]*ResourceChanges{}
+	byRoom := map[uuid.UUID]*ResourceChanges{}
+	tally := func(m map[uuid.UUID]*ResourceChanges, id uuid.UUID, name string) *ResourceChanges {
+		r := m[id]
+		if r == nil {
+			r = &ResourceChanges{ID: id, Name: name}
+			m[id] = r
+		}
+		return r
+	}
+	for _, c := range changes {
+		if c.Before != nil && (c.After == nil || c.TeacherChanged) {
+			tally(byTeacher, c.Before.TeacherID, c.Before.TeacherName).Lost++
+		}
+		if c.After != nil && (c.Before == nil || c.TeacherChanged) {
+			tally(byTeacher, c.After.TeacherID, c.After.TeacherName).Gained++
+		}
+		if c.Before != nil && c.After != nil && !c.TeacherChanged && (c.SlotChanged || c.RoomChanged) {
+			tally(byTeacher, c.After.TeacherID, c.After.TeacherName).Moved++
+		}
+
+		if c.Before != nil && (c.After == nil || c.RoomChanged) {
+			tally(byRoom, c.Before.RoomID, c.Before.RoomName).Lost++
+		}
+		if c.After != nil && (c.Before == nil || c.RoomChanged) {
+			tally(byRoom, c.After.RoomID, c.After.RoomName).Gained++
+		}
+		if c.Before != nil && c.After != nil && !c.RoomChanged && c.SlotChanged {
+			tally(byRoom, c.After.RoomID, c.After.RoomName).Moved++
+		}
+	}
+	return sortedResources(byTeacher), sortedResources(byRoom)
+}
+
+func sortedResources(m map[uuid.UUID]*ResourceChanges) []ResourceChanges {
+	out := make([]ResourceChanges, 0, len(m))
+	for _, r := range m {
+		out = append(out, *r)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].ID.String() < out[j].ID.String()
+	})
+	return out
+}
+
+// changeSortKey orders changes by subject, then by the time of the session.
+func changeSortKey(c EntryChange) string {
+	e := c.After
+	if e == nil {
+		e = c.Before
+	}
+	return fmt.Sprintf("%s|%s|%d|%03d|%s", e.SubjectCode, e.SubjectID, e.DayOfWeek, e.StartPeriod, e.TimeSlotID)
+}
+
+func entryBefore(x, y *entity.ScheduleEntry) bool {
+	if x.DayOfWeek != y.DayOfWeek {
+		return x.DayOfWeek < y.DayOfWeek
+	}
+	if x.StartPeriod != y.StartPeriod {
+		return x.StartPeriod < y.StartPeriod
+	}
+	return x.TimeSlotID.String() < y.TimeSlotID.String()
+}
+
+var dayNames = [...]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+func subjectLabel(e *entity.ScheduleEntry) string {
+	if e.SubjectCode != "" {
+		return e.SubjectCode
+	}
+	if e.SubjectName != "" {
+		return e.SubjectName
+	}
+	return e.SubjectID.String()
+}
+
+// placement renders an entry as "Mon P1-3, R101, Alice".
+func placement(e *entity.ScheduleEntry) string {
+	day := fmt.Sprintf("day %d", e.DayOfWeek)
+	if e.DayOfWeek >= 0 && e.DayOfWeek < len(dayNames) {
+		day = dayNames[e.DayOfWeek]
+	}
+	room, teacher := e.RoomName, e.TeacherName
+	if room == "" {
+		room = e.RoomID.String()
+	}
+	if teacher == "" {
+		teacher = e.TeacherID.String()
+	}
+	return fmt.Sprintf("%s P%d-%d, %s, %s", day, e.StartPeriod, e.EndPeriod, room, teacher)
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+)
+
+func makeEntry(subject, teacher, room, slot, day, start int) *entity.ScheduleEntry {
+	return &entity.ScheduleEntry{
+		SubjectID:   mustUUID(subject),
+		SubjectCode: "S",
+		TeacherID:   mustUUID(teacher),
+		TeacherName: "T",
+		RoomID:      mustUUID(room),
+		RoomName:    "R",
+		TimeSlotID:  mustUUID(slot),
+		DayOfWeek:   day,
+		StartPeriod: start,
+		EndPeriod:   start + 2,
+	}
+}
+
+func TestDiffEntriesClassifiesChanges(t *testing.T) {
+	base := []*entity.ScheduleEntry{
+		makeEntry(1, 10, 20, 30, 0, 1), // unchanged
+		makeEntry(1, 10, 20, 31, 1, 1), // moved to slot 32
+		makeEntry(2, 11, 21, 30, 0, 1), // teacher 11 -> 12, same slot
+		makeEntry(3, 13, 22, 33, 2, 1), // removed
+	}
+	target := []*entity.ScheduleEntry{
+		makeEntry(1, 10, 20, 30, 0, 1),
+		makeEntry(1, 10, 20, 32, 1, 4),
+		makeEntry(2, 12, 21, 30, 0, 1),
+		makeEntry(4, 13, 23, 34, 3, 1), // added
+	}
+
+	diff := DiffEntries(base, target)
+	if diff.Unchanged != 1 {
+		t.Fatalf("expected 1 unchanged session, got %d", diff.Unchanged)
+	}
+	kinds := map[ChangeKind]int{}
+	for _, c := range diff.Changes {
+		kinds[c.Kind]++
+		if c.Kind == ChangeReassigned && (!c.TeacherChanged || c.SlotChanged || c.RoomChanged) {
+			t.Fatalf("reassignment should only change the teacher: %+v", c)
+		}
+	}
+	want := map[ChangeKind]int{ChangeMoved: 1, ChangeReassigned: 1, ChangeAdded: 1, ChangeRemoved: 1}
+	for k, n := range want {
+		if kinds[k] != n {
+			t.Fatalf("expected %d %s changes, got %d (%+v)", n, k, kinds[k], diff.Changes)
+		}
+	}
+
+	teachers := map[int]ResourceChanges{}
+	for _, r := range diff.Teachers {
+		teachers[int(r.ID[15])] = r
+	}
+	if r := teachers[10]; r.Moved != 1 || r.Gained != 0 || r.Lost != 0 {
+		t.Fatalf("teacher 10 should have one moved session, got %+v", r)
+	}
+	if teachers[11].Lost != 1 || teachers[12].Gained != 1 {
+		t.Fatalf("reassignment should move a session from teacher 11 to 12, got %+v", diff.Teachers)
+	}
+	if r := teachers[13]; r.Gained != 1 || r.Lost != 1 {
+		t.Fatalf("teacher 13 should gain and lose a session, got %+v", r)
+	}
+	if len(diff.Rooms) != 3 {
+		t.Fatalf("expected rooms 20, 22 and 23 to change, got %+v", diff.Rooms)
+	}
+}
+
+func TestDiffEntriesPrefersPairsKeepingTheTeacher(t *testing.T) {
+	// Both sessions move; pairing by time order alone would swap teachers.
+	base := []*entity.ScheduleEntry{
+		makeEntry(1, 10, 20, 30, 0, 1),
+		makeEntry(1, 11, 20, 31, 1, 1),
+	}
+	target := []*entity.ScheduleEntry{
+		makeEntry(1, 11, 20, 32, 2, 1),
+		makeEntry(1, 10, 20, 33, 3, 1),
+	}
+	diff := DiffEntries(base, target)
+	if len(diff.Changes) != 2 {
+		t.Fatalf("expected 2 changes, got %+v", diff.Changes)
+	}
+	for _, c := range diff.Changes {
+		if c.Kind != ChangeMoved || c.TeacherChanged {
+			t.Fatalf("expected moves that keep the teacher, got %+v", c)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// sectionRef identifies one section of a subject; section 1 also stands for
+// subjects that are not split.
+type sectionRef struct {
+	subjectID uuid.UUID
+	section   int
+}
+
+func (v ScheduleVariable) sectionRef() sectionRef {
+	return sectionRef{v.SubjectID, max(v.Section, 1)}
+}
+
+// unitKey is the variable key without its session suffix: the bare subject
+// ID for section 1, as before sections existed, and "<subject>/<n>" for the
+// other sections. Sessions of one unit are kept on distinct days and, when
+// binding is enabled, with one teacher; sections are independent of each
+// other (see subjectKeyOf).
+func (v ScheduleVariable) unitKey() string {
+	if v.Section <= 1 {
+		return v.SubjectID.String()
+	}
+	return fmt.Sprintf("%s/%d", v.SubjectID, v.Section)
+}
+
+// parseKey recovers the subject and section of a variable key.
+func parseKey(key string) (subjectID uuid.UUID, section int, err error) {
+	unit := subjectKeyOf(key)
+	section = 1
+	if i := strings.IndexByte(unit, '/'); i >= 0 {
+		if section, err = strconv.Atoi(unit[i+1:]); err != nil {
+			return uuid.Nil, 0, fmt.Errorf("parse section of %q: %w", key, err)
+		}
+		unit = unit[:i]
+	}
+	subjectID, err = uuid.Parse(unit)
+	return subjectID, section, err
+}
+
+// ExpandSections turns one variable per subject into one per section of the
+// subject, each carrying its section number and the assistants its sessions
+// need. Subjects without sections keep a single section 1, keyed as before.
+// Expand sessions afterwards (see ExpandSessions).
+func ExpandSections(variables []ScheduleVariable, sections map[uuid.UUID][]entity.CourseSection) []ScheduleVariable {
+	expanded := make([]ScheduleVariable, 0, len(variables))
+	for _, v := range variables {
+		list := sections[v.SubjectID]
+		if len(list) == 0 {
+			v.Section = 1
+			expanded = append(expanded, v)
+			continue
+		}
+		for _, sec := range list {
+			section := v
+			section.Section = sec.Number
+			section.Assistants = sec.Assistants
+			expanded = append(expanded, section)
+		}
+	}
+	return expanded
+}
+
+// SetSections registers the sections of split subjects: a section's rooms
+// must seat its capacity instead of the offering's enrollment (never less than
+// the subject's minimum room capacity), and sections attended by the
+// same student group are kept apart like subjects sharing students. Call it
+// after SetCohortOverlap, which it extends.
+func (cc *ConstraintChecker) SetSections(sections map[uuid.UUID][]entity.CourseSection) {
+	cc.sectionCapacity = map[sectionRef]int{}
+	for subjectID, list := range sections {
+		for _, sec := range list {
+			if sec.Capacity > 0 {
+				cc.sectionCapacity[sectionRef{subjectID, max(sec.Number, 1)}] = sec.Capacity
+			}
+		}
+	}
+	cc.cohortOverlap = cc.cohortOverlap.withSections(sections)
+}
+
+// withSections extends an enrollment overlap to sections. Enrolled students
+// may take any open section, so open sections inherit their subject's
+// overlaps, except with their sibling sections; they are parallel offerings
+// of one course, not two courses a student takes. A section with a student
+// group is taken by that group alone: it overlaps exactly the other sections
+// of the group, counted by the smaller known head count (at least one).
+func (o CohortOverlap) withSections(sections map[uuid.UUID][]entity.CourseSection) CohortOverlap {
+	if len(sections) == 0 {
+		return o
+	}
+	type unit struct {
+		key, subject string
+		sec          entity.CourseSection
+	}
+	var open, grouped []unit
+	for subjectID, list := range sections {
+		for _, sec := range list {
+			u := unit{
+				key:     ScheduleVariable{SubjectID: subjectID, Section: sec.Number}.unitKey(),
+				subject: subjectID.String(),
+				sec:     sec,
+			}
+			if sec.StudentGroup == "" {
+				open = append(open, u)
+			} else {
+				grouped = append(grouped, u)
+			}
+		}
+	}
+
+	out := make(CohortOverlap, len(o))
+	set := func(a, b string, n int) {
+		if out[a] == nil {
+			out[a] = map[string]int{}
+		}
+		out[a][b] = n
+	}
+	split := func(key string) ([]entity.CourseSection, uuid.UUID, bool) {
+		id, err := uuid.Parse(key)
+		list, ok := sections[id]
+		return list, id, err == nil && ok
+	}
+	for a, row := range o {
+		if _, _, ok := split(a); ok {
+			continue // rebuilt per section below
+		}
+		for b, n := range row {
+			if _, _, ok := split(b); !ok {
+				set(a, b, n)
+			}
+		}
+	}
+	// Open sections take over their subject's row; the reverse direction
+	// keeps the overlap symmetric
+	for _, u := range open {
+		for other, n := range o[u.subject] {
+			if list, otherID, ok := split(other); ok {
+				for _, sec := range list {
+					if sec.StudentGroup == "" {
+						set(u.key, ScheduleVariable{SubjectID: otherID, Section: sec.Number}.unitKey(), n)
+					}
+				}
+				continue
+			}
+			set(u.key, other, n)
+			set(other, u.key, n)
+		}
+	}
+	for i, a := range grouped {
+		for _, b := range grouped[i+1:] {
+			if a.sec.StudentGroup != b.sec.StudentGroup {
+				continue
+			}
+			n := a.sec.Capacity
+			if b.sec.Capacity > 0 && (n == 0 || b.sec.Capacity < n) {
+				n = b.sec.Capacity
+			}
+			n = max(n, 1)
+			set(a.key, b.key, n)
+			set(b.key, a.key, n)
+		}
+	}
+	return out
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestExpandSectionsKeys(t *testing.T) {
+	sections := map[uuid.UUID][]entity.CourseSection{
+		mustUUID(2): {{Number: 1}, {Number: 2, Assistants: 1}},
+	}
+	one, two := makeVar(1), makeVar(2)
+	one.WeeklyHours, two.WeeklyHours = 4, 4
+	vars := ExpandSessions(ExpandSections([]ScheduleVariable{one, two}, sections), 2)
+
+	s1, s2 := mustUUID(1).String(), mustUUID(2).String()
+	want := []string{s1, s1 + "#1", s2, s2 + "#1", s2 + "/2", s2 + "/2#1"}
+	if len(vars) != len(want) {
+		t.Fatalf("expected %d variables, got %d", len(want), len(vars))
+	}
+	for i, v := range vars {
+		if v.Key() != want[i] {
+			t.Fatalf("variable %d: key %q, want %q", i, v.Key(), want[i])
+		}
+		subjectID, section, err := parseKey(v.Key())
+		if err != nil || subjectID != v.SubjectID || section != v.Section {
+			t.Fatalf("parseKey(%q) = %s, %d, %v", v.Key(), subjectID, section, err)
+		}
+	}
+	if vars[4].Assistants != 1 || vars[2].Assistants != 0 {
+		t.Fatalf("assistants not carried per section: %+v", vars)
+	}
+}
+
+func TestSectionCapacityKeepsSubjectRoomRequirements(t *testing.T) {
+	cc := openChecker()
+	cc.SetRoomRequirements(
+		[]*entity.Room{
+			{ID: mustUUID(20), Capacity: 40, Type: "lab", Features: []string{"projector"}},
+			{ID: mustUUID(21), Capacity: 25, Type: "lab", Features: []string{"projector"}},
+			{ID: mustUUID(22), Capacity: 60, Type: "lecture", Features: []string{"projector"}},
+			{ID: mustUUID(23), Capacity: 60, Type: "lab"},
+		},
+		map[uuid.UUID]RoomRequirement{
+			mustUUID(1): {MinCapacity: 30, RoomType: "lab", Features: []string{"projector"}, Enrollment: 120},
+		},
+	)
+	cc.SetSections(map[uuid.UUID][]entity.CourseSection{
+		mustUUID(1): {{Number: 1, Capacity: 40}, {Number: 2, Capacity: 45}, {Number: 3}, {Number: 4, Capacity: 20}},
+	})
+
+	tests := []struct {
+		name    string
+		section int
+		room    int
+		want    valueobject.ConstraintType
+	}{
+		{"section fits", 1, 20, ""},
+		{"section larger than room", 2, 20, valueobject.ConstraintRoomCapacity},
+		{"unsized section seats the offering", 3, 20, valueobject.ConstraintRoomCapacity},
+		{"small section keeps the subject minimum", 4, 21, valueobject.ConstraintRoomCapacity},
+		{"small section in a large enough room", 4, 20, ""},
+		{"room type kept", 1, 22, valueobject.ConstraintRoomType},
+		{"features kept", 1, 23, valueobject.ConstraintRoomFeatureMissing},
+	}
+	for _, tt := range tests {
+		v := ScheduleVariable{SubjectID: mustUUID(1), Section: tt.section}
+		if got := cc.roomViolation(v, mustUUID(tt.room)); got != tt.want {
+			t.Fatalf("%s: roomViolation() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSectionsCohortOverlap(t *testing.T) {
+	cc := openChecker()
+	cc.SetCohortOverlap(twoSubjectCohort(), true)
+	cc.SetSections(map[uuid.UUID][]entity.CourseSection{
+		mustUUID(2): {{Number: 1}, {Number: 2}},
+		mustUUID(4): {{Number: 1, StudentGroup: "K21-A", Capacity: 30}, {Number: 2, StudentGroup: "K21-B"}},
+		mustUUID(5): {{Number: 1, StudentGroup: "K21-A", Capacity: 25}},
+	})
+	o := cc.cohortOverlap
+	s1, s3 := mustUUID(1).String(), mustUUID(3).String()
+	open1 := ScheduleVariable{SubjectID: mustUUID(2), Section: 1}.unitKey()
+	open2 := ScheduleVariable{SubjectID: mustUUID(2), Section: 2}.unitKey()
+	groupA := ScheduleVariable{SubjectID: mustUUID(4), Section: 1}.unitKey()
+	groupB := ScheduleVariable{SubjectID: mustUUID(4), Section: 2}.unitKey()
+	otherA := ScheduleVariable{SubjectID: mustUUID(5), Section: 1}.unitKey()
+
+	if o.Shared(open2, s1) != 2 || o.Shared(s1, open2) != 2 || o.Shared(open1, s3) != 1 {
+		t.Fatalf("open sections must keep their subject's overlaps: %v", o)
+	}
+	if o.Shared(open1, open2) != 0 {
+		t.Fatal("sibling sections are alternatives and must not clash")
+	}
+	if o.Shared(groupA, otherA) != 25 || o.Shared(otherA, groupA) != 25 {
+		t.Fatalf("sections of one group overlap by the smaller head count, got %d", o.Shared(groupA, otherA))
+	}
+	if o.Shared(groupB, otherA) != 0 {
+		t.Fatal("sections of different groups must not clash")
+	}
+
+	slots := slotsMap(makeSlot(1, 0, 1, 3))
+	current := map[string]Assignment{groupA: makeAssign(10, 20, 1)}
+	if cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(5), Section: 1}, makeAssign(11, 21, 1), current, slots) {
+		t.Fatal("a group cannot attend two sections at once")
+	}
+	if !cc.IsConsistent(ScheduleVariable{SubjectID: mustUUID(4), Section: 2}, makeAssign(11, 21, 1), current, slots) {
+		t.Fatal("sections of one subject for different groups may run in parallel")
+	}
+}
+
+// TestSolverStaffsAssistants places two sections of one subject side by side;
+// the second needs an assistant, who must be neither its lead nor teaching
+// the other section.
+func TestSolverStaffsAssistants(t *testing.T) {
+	sections := map[uuid.UUID][]entity.CourseSection{
+		mustUUID(1): {{Number: 1}, {Number: 2, Assistants: 1}},
+	}
+	vars := ExpandSections([]ScheduleVariable{makeVar(1)}, sections)
+	slots := slotsMap(makeSlot(1, 0, 1, 3))
+	candidates := Candidates{
+		TeacherIDs: []uuid.UUID{mustUUID(10), mustUUID(11), mustUUID(12)},
+		RoomIDs:    []uuid.UUID{mustUUID(20), mustUUID(21)},
+		SlotIDs:    []uuid.UUID{mustUUID(1)},
+	}
+	cc := openChecker()
+	cc.SetSections(sections)
+
+	result, err := NewCSPSolverFromCandidates(vars, candidates, slots, cc).Solve(context.Background())
+	if err != nil {
+		t.Fatalf("Solve() error: %v", err)
+	}
+	if result.Unstaffed != 0 || result.HardViolations != 0 {
+		t.Fatalf("expected a fully staffed schedule, got %d unstaffed", result.Unstaffed)
+	}
+	second := vars[1].Key()
+	assistants := result.Assistants[second]
+	if len(assistants) != 1 {
+		t.Fatalf("expected one assistant for %s, got %v", second, assistants)
+	}
+	for _, a := range result.Assignment {
+		if a.TeacherID == assistants[0] {
+			t.Fatalf("assistant %s also leads a parallel section", assistants[0])
+		}
+	}
+	for _, e := range result.Entries {
+		if e.Section == 2 && (len(e.Assistants) != 1 || e.Assistants[0].Role != valueobject.TeacherRoleAssistant) {
+			t.Fatalf("entry of section 2 lacks its assistant: %+v", e)
+		}
+	}
+
+	// With only the two leads available nobody is left to assist
+	candidates.TeacherIDs = candidates.TeacherIDs[:2]
+	result, err = NewCSPSolverFromCandidates(vars, candidates, slots, cc).Solve(context.Background())
+	if err != nil {
+		t.Fatalf("Solve() error: %v", err)
+	}
+	if result.Unstaffed != 1 || result.HardViolations != 1 {
+		t.Fatalf("expected one unstaffed place as a hard violation, got %d/%d", result.Unstaffed, result.HardViolations)
+	}
+}
+
+// TestSolverKeepsAssistantsFree has two teachers for two sections, the second
+// needing an assistant, and only the first teacher free on Monday. Whoever
+// leads on Tuesday, the other teacher must be free to assist there, so search
+// has to back out of the placements that leave section 2 without one.
+func TestSolverKeepsAssistantsFree(t *testing.T) {
+	sections := map[uuid.UUID][]entity.CourseSection{
+		mustUUID(1): {{Number: 1}, {Number: 2, Assistants: 1}},
+	}
+	vars := ExpandSections([]ScheduleVariable{makeVar(1)}, sections)
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 1, 1, 3), makeSlot(3, 0, 1, 3))
+	candidates := Candidates{
+		TeacherIDs: []uuid.UUID{mustUUID(10), mustUUID(11)},
+		RoomIDs:    []uuid.UUID{mustUUID(20), mustUUID(21)},
+		SlotIDs:    []uuid.UUID{mustUUID(1), mustUUID(2), mustUUID(3)},
+	}
+	cc := NewConstraintChecker(map[uuid.UUID][]*entity.TimeSlot{
+		mustUUID(10): {makeSlot(90, 0, 1, 3), makeSlot(91, 1, 1, 3)},
+		mustUUID(11): {makeSlot(92, 1, 1, 3)},
+	}, nil, nil, nil)
+	cc.SetSections(sections)
+
+	result, err := NewCSPSolverFromCandidates(vars, candidates, slots, cc).Solve(context.Background())
+	if err != nil {
+		t.Fatalf("Solve() error: %v", err)
+	}
+	if result.IsPartial || result.Unstaffed != 0 || result.HardViolations != 0 {
+		t.Fatalf("expected a fully staffed schedule, got %d unstaffed (partial=%v)", result.Unstaffed, result.IsPartial)
+	}
+	if a := result.Assignment[vars[1].Key()]; a.SlotID != mustUUID(2) {
+		t.Fatalf("section 2 should meet on Tuesday, got slot %s", a.SlotID)
+	}
+}
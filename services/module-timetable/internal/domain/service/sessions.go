@@ -0,0 +1,51 @@
+package service
+
+import "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+
+// SessionCount returns how many weekly sessions a subject needs when each
+// session occupies a slot of sessionPeriods periods. Subjects without
+// configured weekly hours get a single session.
+func SessionCount(weeklyHours, sessionPeriods int) int {
+	if weeklyHours <= 0 {
+		return 1
+	}
+	if sessionPeriods <= 0 {
+		sessionPeriods = 1
+	}
+	return (weeklyHours + sessionPeriods - 1) / sessionPeriods
+}
+
+// SessionPeriods returns the shortest slot length (in periods, both ends
+// included) among the given slots, so that session counts never
+// under-schedule a subject.
+func SessionPeriods(slots []*entity.TimeSlot) int {
+	shortest := 0
+	for _, sl := range slots {
+		length := sl.EndPeriod - sl.StartPeriod + 1
+		if length <= 0 {
+			continue
+		}
+		if shortest == 0 || length < shortest {
+			shortest = length
+		}
+	}
+	if shortest == 0 {
+		return 1
+	}
+	return shortest
+}
+
+// ExpandSessions turns one variable per subject into one variable per weekly
+// session, based on each subject's WeeklyHours and the slot length.
+func ExpandSessions(variables []ScheduleVariable, sessionPeriods int) []ScheduleVariable {
+	expanded := make([]ScheduleVariable, 0, len(variables))
+	for _, v := range variables {
+		n := SessionCount(v.WeeklyHours, sessionPeriods)
+		for i := 0; i < n; i++ {
+			session := v
+			session.Session = i
+			expanded = append(expanded, session)
+		}
+	}
+	return expanded
+}
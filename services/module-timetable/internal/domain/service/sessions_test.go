@@ -0,0 +1,61 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+)
+
+func TestSessionCount(t *testing.T) {
+	tests := []struct {
+		name           string
+		weeklyHours    int
+		sessionPeriods int
+		want           int
+	}{
+		{name: "unconfigured hours", weeklyHours: 0, sessionPeriods: 2, want: 1},
+		{name: "exact fit", weeklyHours: 4, sessionPeriods: 2, want: 2},
+		{name: "rounds up", weeklyHours: 3, sessionPeriods: 2, want: 2},
+		{name: "single period slots", weeklyHours: 4, sessionPeriods: 1, want: 4},
+		{name: "invalid slot length", weeklyHours: 2, sessionPeriods: 0, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SessionCount(tt.weeklyHours, tt.sessionPeriods); got != tt.want {
+				t.Fatalf("SessionCount=%d want=%d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionPeriodsPicksShortestSlot(t *testing.T) {
+	slots := []*entity.TimeSlot{makeSlot(1, 0, 1, 3), makeSlot(2, 0, 3, 4), makeSlot(3, 1, 1, 4)}
+	if got := SessionPeriods(slots); got != 2 {
+		t.Fatalf("expected 2, got %d", got)
+	}
+	if got := SessionPeriods(nil); got != 1 {
+		t.Fatalf("expected fallback 1, got %d", got)
+	}
+}
+
+func TestExpandSessionsKeysAreUnique(t *testing.T) {
+	v := makeVar(1)
+	v.WeeklyHours = 3
+	expanded := ExpandSessions([]ScheduleVariable{v, makeVar(2)}, 1)
+	if len(expanded) != 4 {
+		t.Fatalf("expected 4 variables, got %d", len(expanded))
+	}
+	seen := map[string]bool{}
+	for _, e := range expanded {
+		if seen[e.Key()] {
+			t.Fatalf("duplicate key %s", e.Key())
+		}
+		seen[e.Key()] = true
+	}
+	if expanded[0].Key() != mustUUID(1).String() {
+		t.Fatal("first session should keep the bare subject key")
+	}
+	if subjectKeyOf(expanded[2].Key()) != mustUUID(1).String() {
+		t.Fatal("session key should map back to its subject")
+	}
+}
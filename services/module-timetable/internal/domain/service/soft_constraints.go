@@ -0,0 +1,474 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// SoftInput is everything a soft constraint may look at when scoring a
+// complete assignment. All data is pre-loaded; evaluation does no IO.
+type SoftInput struct {
+	Assignment map[string]Assignment
+	Slots      map[uuid.UUID]*entity.TimeSlot
+	// teacher_id -> preferred teaching windows (empty = no preference)
+	TeacherPreferences map[uuid.UUID][]*entity.TimeSlot
+	// teacher_id -> disliked teaching windows
+	TeacherDislikes map[uuid.UUID][]*entity.TimeSlot
+	// room_id -> building code (empty = unknown, never counts as travel)
+	RoomBuildings map[uuid.UUID]string
+	// room_id -> campus (missing = unknown) and travel minutes between
+	// campuses, scored by campus_travel
+	RoomCampuses map[uuid.UUID]uuid.UUID
+	CampusTravel entity.TravelMatrix
+	// shared students between subjects, scored by cohort_clash
+	CohortOverlap CohortOverlap
+}
+
+// SoftConstraint scores one timetable preference. Penalty is unweighted and
+// must be >= 0; the checker multiplies it by the configured weight.
+type SoftConstraint interface {
+	Type() valueobject.ConstraintType
+	Penalty(in *SoftInput) float64
+}
+
+// WeightedSoftConstraint pairs a constraint with its semester weight.
+type WeightedSoftConstraint struct {
+	Constraint SoftConstraint
+	Weight     float64
+}
+
+// SoftConstraintFactory builds a constraint from its per-semester params.
+type SoftConstraintFactory func(params map[string]int) (SoftConstraint, error)
+
+var softConstraintRegistry = map[valueobject.ConstraintType]SoftConstraintFactory{
+	valueobject.ConstraintTeacherGap: func(p map[string]int) (SoftConstraint, error) {
+		return teacherGapConstraint{allowedGap: paramOr(p, "allowed_gap", 2)}, nil
+	},
+	valueobject.ConstraintLoadImbalance: func(map[string]int) (SoftConstraint, error) {
+		return loadImbalanceConstraint{}, nil
+	},
+	valueobject.ConstraintPreferenceBreak: func(map[string]int) (SoftConstraint, error) {
+		return preferredPeriodsConstraint{}, nil
+	},
+	valueobject.ConstraintLatePeriod: func(p map[string]int) (SoftConstraint, error) {
+		n, ok := p["after_period"]
+		if !ok || n < 1 {
+			return nil, fmt.Errorf("%s requires param after_period >= 1", valueobject.ConstraintLatePeriod)
+		}
+		return latePeriodConstraint{afterPeriod: n}, nil
+	},
+	valueobject.ConstraintConsecutivePeriods: func(p map[string]int) (SoftConstraint, error) {
+		n := paramOr(p, "max_periods", 4)
+		if n < 1 {
+			return nil, fmt.Errorf("%s param max_periods must be >= 1", valueobject.ConstraintConsecutivePeriods)
+		}
+		return consecutivePeriodsConstraint{maxPeriods: n}, nil
+	},
+	valueobject.ConstraintBuildingTravel: func(map[string]int) (SoftConstraint, error) {
+		return buildingTravelConstraint{}, nil
+	},
+	valueobject.ConstraintCampusTravel: func(p map[string]int) (SoftConstraint, error) {
+		c := campusTravelConstraint{
+			breakMinutes:   paramOr(p, "break_minutes", 10),
+			defaultMinutes: paramOr(p, "default_minutes", 0),
+		}
+		if c.breakMinutes < 0 || c.defaultMinutes < 0 {
+			return nil, fmt.Errorf("%s params break_minutes and default_minutes must be >= 0", valueobject.ConstraintCampusTravel)
+		}
+		return c, nil
+	},
+	valueobject.ConstraintLunchBreak: func(p map[string]int) (SoftConstraint, error) {
+		start, end := paramOr(p, "start_period", 5), paramOr(p, "end_period", 6)
+		if start < 1 || end <= start {
+			return nil, fmt.Errorf("%s requires 1 <= start_period < end_period", valueobject.ConstraintLunchBreak)
+		}
+		return lunchBreakConstraint{start: start, end: end}, nil
+	},
+	valueobject.ConstraintCohortClash: func(map[string]int) (SoftConstraint, error) {
+		return cohortClashConstraint{}, nil
+	},
+	valueobject.ConstraintExamSpread: func(p map[string]int) (SoftConstraint, error) {
+		n := paramOr(p, "min_gap_days", 2)
+		if n < 1 {
+			return nil, fmt.Errorf("%s param min_gap_days must be >= 1", valueobject.ConstraintExamSpread)
+		}
+		return examSpreadConstraint{minGapDays: n}, nil
+	},
+}
+
+// RegisterSoftConstraint adds or replaces a soft constraint type so it can be
+// enabled per semester.
+func RegisterSoftConstraint(t valueobject.ConstraintType, factory SoftConstraintFactory) {
+	softConstraintRegistry[t] = factory
+}
+
+// SoftConstraintTypes lists every registered soft constraint type, sorted.
+func SoftConstraintTypes() []valueobject.ConstraintType {
+	types := make([]valueobject.ConstraintType, 0, len(softConstraintRegistry))
+	for t := range softConstraintRegistry {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// DefaultSoftConstraints is used when a semester has no settings of its own:
+// the historical gap ×2.0 and imbalance ×1.5, teacher preferences ×1.0,
+// campus travel ×1.0 (zero until campuses and travel times are set up), and
+// cohort clashes ×10.0 (only non-zero when a run relaxes the hard rule).
+func DefaultSoftConstraints() []entity.SoftConstraintSetting {
+	return []entity.SoftConstraintSetting{
+		{Type: valueobject.ConstraintTeacherGap, Weight: 2.0},
+		{Type: valueobject.ConstraintLoadImbalance, Weight: 1.5},
+		{Type: valueobject.ConstraintPreferenceBreak, Weight: 1.0},
+		{Type: valueobject.ConstraintCampusTravel, Weight: 1.0},
+		{Type: valueobject.ConstraintCohortClash, Weight: 10.0},
+	}
+}
+
+// BuildSoftConstraints turns semester settings into weighted constraints.
+// An empty list yields DefaultSoftConstraints; zero-weight entries are skipped.
+func BuildSoftConstraints(settings []entity.SoftConstraintSetting) ([]WeightedSoftConstraint, error) {
+	if len(settings) == 0 {
+		settings = DefaultSoftConstraints()
+	}
+	seen := map[valueobject.ConstraintType]bool{}
+	built := make([]WeightedSoftConstraint, 0, len(settings))
+	for _, s := range settings {
+		factory, ok := softConstraintRegistry[s.Type]
+		if !ok {
+			return nil, fmt.Errorf("unknown soft constraint %q", s.Type)
+		}
+		if seen[s.Type] {
+			return nil, fmt.Errorf("soft constraint %q configured twice", s.Type)
+		}
+		seen[s.Type] = true
+		if s.Weight < 0 {
+			return nil, fmt.Errorf("soft constraint %q: weight must be >= 0", s.Type)
+		}
+		c, err := factory(s.Params)
+		if err != nil {
+			return nil, err
+		}
+		if s.Weight == 0 {
+			continue
+		}
+		built = append(built, WeightedSoftConstraint{Constraint: c, Weight: s.Weight})
+	}
+	return built, nil
+}
+
+func defaultWeightedSoftConstraints() []WeightedSoftConstraint {
+	return []WeightedSoftConstraint{
+		{Constraint: teacherGapConstraint{allowedGap: 2}, Weight: 2.0},
+		{Constraint: loadImbalanceConstraint{}, Weight: 1.5},
+		{Constraint: preferredPeriodsConstraint{}, Weight: 1.0},
+		{Constraint: campusTravelConstraint{breakMinutes: 10}, Weight: 1.0},
+		{Constraint: cohortClashConstraint{}, Weight: 10.0},
+	}
+}
+
+func paramOr(params map[string]int, key string, def int) int {
+	if v, ok := params[key]; ok {
+		return v
+	}
+	return def
+}
+
+// teacherDaySlots groups the assigned slots per (teacher, day), sorted by start.
+func teacherDaySlots(in *SoftInput) map[teacherDay][]*entity.TimeSlot {
+	byDay := map[teacherDay][]*entity.TimeSlot{}
+	for _, a := range in.Assignment {
+		slot := in.Slots[a.SlotID]
+		if slot == nil {
+			continue
+		}
+		k := teacherDay{a.TeacherID, slot.DayOfWeek}
+		byDay[k] = append(byDay[k], slot)
+	}
+	for _, slots := range byDay {
+		sort.Slice(slots, func(i, j int) bool { return slots[i].StartPeriod < slots[j].StartPeriod })
+	}
+	return byDay
+}
+
+type teacherDay struct {
+	teacherID uuid.UUID
+	day       int
+}
+
+// backToBack reports whether next starts in the period right after prev ends,
+// as the preset slots 1-2 and 3-4 do.
+func backToBack(prev, next *entity.TimeSlot) bool {
+	return next.StartPeriod-prev.EndPeriod <= 1
+}
+
+// --- built-in soft constraints ---
+
+// teacherGapConstraint penalises idle periods between a teacher's classes
+// on the same day beyond allowedGap.
+type teacherGapConstraint struct{ allowedGap int }
+
+func (teacherGapConstraint) Type() valueobject.ConstraintType {
+	return valueobject.ConstraintTeacherGap
+}
+
+func (c teacherGapConstraint) Penalty(in *SoftInput) float64 {
+	penalty := 0.0
+	for _, slots := range teacherDaySlots(in) {
+		if len(slots) < 2 {
+			continue
+		}
+		span := slots[len(slots)-1].StartPeriod - slots[0].StartPeriod
+		gap := span - len(slots)
+		if gap > c.allowedGap {
+			penalty += float64(gap - c.allowedGap)
+		}
+	}
+	return penalty
+}
+
+// loadImbalanceConstraint penalises uneven numbers of sessions per teacher.
+type loadImbalanceConstraint struct{}
+
+func (loadImbalanceConstraint) Type() valueobject.ConstraintType {
+	return valueobject.ConstraintLoadImbalance
+}
+
+func (loadImbalanceConstraint) Penalty(in *SoftInput) float64 {
+	hours := map[uuid.UUID]int{}
+	for _, a := range in.Assignment {
+		hours[a.TeacherID]++
+	}
+	if len(hours) == 0 {
+		return 0
+	}
+	total := 0
+	for _, h := range hours {
+		total += h
+	}
+	// Sum |h - avg| scaled by the teacher count so the map order cannot
+	// change the float result.
+	n := len(hours)
+	scaled := 0
+	for _, h := range hours {
+		diff := h*n - total
+		if diff < 0 {
+			diff = -diff
+		}
+		scaled += diff
+	}
+	return float64(scaled) / float64(n)
+}
+
+// preferredPeriodsConstraint penalises every period a teacher teaches inside
+// a disliked window, or outside their preferred windows when they have any.
+type preferredPeriodsConstraint struct{}
+
+func (preferredPeriodsConstraint) Type() valueobject.ConstraintType {
+	return valueobject.ConstraintPreferenceBreak
+}
+
+func (preferredPeriodsConstraint) Penalty(in *SoftInput) float64 {
+	penalty := 0.0
+	for _, a := range in.Assignment {
+		slot := in.Slots[a.SlotID]
+		if slot == nil {
+			continue
+		}
+		if overlapsAny(slot, in.TeacherDislikes[a.TeacherID]) {
+			penalty += float64(slotPeriods(slot))
+			continue
+		}
+		if prefs := in.TeacherPreferences[a.TeacherID]; len(prefs) > 0 && !withinAny(slot, prefs) {
+			penalty += float64(slotPeriods(slot))
+		}
+	}
+	return penalty
+}
+
+func overlapsAny(slot *entity.TimeSlot, windows []*entity.TimeSlot) bool {
+	for _, w := range windows {
+		if slotsOverlap(slot, w) {
+			return true
+		}
+	}
+	return false
+}
+
+func withinAny(slot *entity.TimeSlot, windows []*entity.TimeSlot) bool {
+	for _, w := range windows {
+		if w.DayOfWeek == slot.DayOfWeek && w.StartPeriod <= slot.StartPeriod && w.EndPeriod >= slot.EndPeriod {
+			return true
+		}
+	}
+	return false
+}
+
+// latePeriodConstraint penalises each period a class occupies after
+// afterPeriod.
+type latePeriodConstraint struct{ afterPeriod int }
+
+func (latePeriodConstraint) Type() valueobject.ConstraintType {
+	return valueobject.ConstraintLatePeriod
+}
+
+func (c latePeriodConstraint) Penalty(in *SoftInput) float64 {
+	penalty := 0.0
+	for _, a := range in.Assignment {
+		slot := in.Slots[a.SlotID]
+		if slot == nil || slot.EndPeriod <= c.afterPeriod {
+			continue
+		}
+		from := max(c.afterPeriod+1, slot.StartPeriod)
+		penalty += float64(slot.EndPeriod - from + 1)
+	}
+	return penalty
+}
+
+// consecutivePeriodsConstraint penalises back-to-back teaching runs longer
+// than maxPeriods, by the number of excess periods.
+type consecutivePeriodsConstraint struct{ maxPeriods int }
+
+func (consecutivePeriodsConstraint) Type() valueobject.ConstraintType {
+	return valueobject.ConstraintConsecutivePeriods
+}
+
+func (c consecutivePeriodsConstraint) Penalty(in *SoftInput) float64 {
+	penalty := 0.0
+	for _, slots := range teacherDaySlots(in) {
+		run := slotPeriods(slots[0])
+		for i := 1; i <= len(slots); i++ {
+			if i < len(slots) && backToBack(slots[i-1], slots[i]) {
+				run += slotPeriods(slots[i])
+				continue
+			}
+			if run > c.maxPeriods {
+				penalty += float64(run - c.maxPeriods)
+			}
+			if i < len(slots) {
+				run = slotPeriods(slots[i])
+			}
+		}
+	}
+	return penalty
+}
+
+// buildingTravelConstraint penalises back-to-back classes of one teacher in
+// different buildings. Rooms without a known building never count.
+type buildingTravelConstraint struct{}
+
+func (buildingTravelConstraint) Type() valueobject.ConstraintType {
+	return valueobject.ConstraintBuildingTravel
+}
+
+func (buildingTravelConstraint) Penalty(in *SoftInput) float64 {
+	if len(in.RoomBuildings) == 0 {
+		return 0
+	}
+	penalty := 0.0
+	for _, list := range teacherDayRooms(in) {
+		for i := 1; i < len(list); i++ {
+			prev, next := in.RoomBuildings[list[i-1].roomID], in.RoomBuildings[list[i].roomID]
+			if prev == "" || next == "" || prev == next {
+				continue
+			}
+			if backToBack(list[i-1].slot, list[i].slot) {
+				penalty++
+			}
+		}
+	}
+	return penalty
+}
+
+// campusTravelConstraint penalises back-to-back classes of one teacher on
+// different campuses by the travel time exceeding the break between them,
+// in tens of minutes. Pairs missing from the matrix cost defaultMinutes;
+// rooms without a known campus never count.
+type campusTravelConstraint struct{ breakMinutes, defaultMinutes int }
+
+func (campusTravelConstraint) Type() valueobject.ConstraintType {
+	return valueobject.ConstraintCampusTravel
+}
+
+func (c campusTravelConstraint) Penalty(in *SoftInput) float64 {
+	if len(in.RoomCampuses) == 0 {
+		return 0
+	}
+	excess := 0
+	for _, list := range teacherDayRooms(in) {
+		for i := 1; i < len(list); i++ {
+			prev, okPrev := in.RoomCampuses[list[i-1].roomID]
+			next, okNext := in.RoomCampuses[list[i].roomID]
+			if !okPrev || !okNext || prev == next || !backToBack(list[i-1].slot, list[i].slot) {
+				continue
+			}
+			minutes, ok := in.CampusTravel.Minutes(prev, next)
+			if !ok {
+				minutes = c.defaultMinutes
+			}
+			if minutes > c.breakMinutes {
+				excess += minutes - c.breakMinutes
+			}
+		}
+	}
+	return float64(excess) / 10
+}
+
+// placedSlot is one class of a teacher: when and in which room.
+type placedSlot struct {
+	slot   *entity.TimeSlot
+	roomID uuid.UUID
+}
+
+// teacherDayRooms groups each teacher's classes per day with their rooms,
+// sorted by start period.
+func teacherDayRooms(in *SoftInput) map[teacherDay][]placedSlot {
+	byDay := map[teacherDay][]placedSlot{}
+	for _, a := range in.Assignment {
+		slot := in.Slots[a.SlotID]
+		if slot == nil {
+			continue
+		}
+		k := teacherDay{a.TeacherID, slot.DayOfWeek}
+		byDay[k] = append(byDay[k], placedSlot{slot, a.RoomID})
+	}
+	for _, list := range byDay {
+		sort.Slice(list, func(i, j int) bool { return list[i].slot.StartPeriod < list[j].slot.StartPeriod })
+	}
+	return byDay
+}
+
+// lunchBreakConstraint penalises each teacher-day whose classes leave no free
+// period inside the lunch window, periods start..end.
+type lunchBreakConstraint struct{ start, end int }
+
+func (lunchBreakConstraint) Type() valueobject.ConstraintType {
+	return valueobject.ConstraintLunchBreak
+}
+
+func (c lunchBreakConstraint) Penalty(in *SoftInput) float64 {
+	penalty := 0.0
+	for _, slots := range teacherDaySlots(in) {
+		free := false
+		for p := c.start; p <= c.end && !free; p++ {
+			busy := false
+			for _, s := range slots {
+				if s.StartPeriod <= p && p <= s.EndPeriod {
+					busy = true
+					break
+				}
+			}
+			free = !busy
+		}
+		if !free {
+			penalty++
+		}
+	}
+	return penalty
+}
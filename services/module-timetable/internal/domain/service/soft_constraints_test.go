@@ -0,0 +1,152 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestBuildSoftConstraintsValidates(t *testing.T) {
+	cases := map[string][]entity.SoftConstraintSetting{
+		"unknown type":    {{Type: "nap_time", Weight: 1}},
+		"duplicate":       {{Type: valueobject.ConstraintTeacherGap, Weight: 1}, {Type: valueobject.ConstraintTeacherGap, Weight: 2}},
+		"negative weight": {{Type: valueobject.ConstraintLoadImbalance, Weight: -1}},
+		"missing param":   {{Type: valueobject.ConstraintLatePeriod, Weight: 1}},
+		"bad lunch":       {{Type: valueobject.ConstraintLunchBreak, Weight: 1, Params: map[string]int{"start_period": 6, "end_period": 6}}},
+	}
+	for name, settings := range cases {
+		if _, err := BuildSoftConstraints(settings); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	built, err := BuildSoftConstraints(nil)
+	if err != nil || len(built) != 5 {
+		t.Fatalf("expected the five default constraints, got %v err=%v", built, err)
+	}
+	// the checker starts from the same defaults BuildSoftConstraints(nil) yields
+	defaults := openChecker().softConstraints
+	if len(defaults) != len(built) {
+		t.Fatalf("checker defaults %v differ from %v", defaults, built)
+	}
+	for i := range built {
+		if defaults[i] != built[i] {
+			t.Fatalf("default %d: checker has %+v, settings give %+v", i, defaults[i], built[i])
+		}
+		if built[i].Weight <= 0 {
+			t.Fatalf("default %s has no weight", built[i].Constraint.Type())
+		}
+	}
+}
+
+func TestSoftConstraintWeightsReplaceDefaults(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 0, 1, 2), makeSlot(2, 0, 9, 10))
+	assignment := map[string]Assignment{
+		"a": makeAssign(10, 20, 1),
+		"b": makeAssign(10, 20, 2),
+	}
+	cc := openChecker()
+	built, err := BuildSoftConstraints([]entity.SoftConstraintSetting{
+		{Type: valueobject.ConstraintLatePeriod, Weight: 3, Params: map[string]int{"after_period": 8}},
+		{Type: valueobject.ConstraintTeacherGap, Weight: 0}, // disabled
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cc.SetSoftConstraints(built)
+
+	// only slot 2 runs late: periods 9-10 → 2 periods × weight 3
+	if p := cc.EvaluateSoftConstraints(assignment, slots); p != 6 {
+		t.Fatalf("expected penalty 6, got %v", p)
+	}
+}
+
+func TestBuiltInSoftConstraintPenalties(t *testing.T) {
+	teacher := mustUUID(10)
+	// Monday periods 1-2, 3-4, 5-6 back to back; Tuesday 1-2 alone.
+	slots := slotsMap(
+		makeSlot(1, 0, 1, 2), makeSlot(2, 0, 3, 4), makeSlot(3, 0, 5, 6), makeSlot(4, 1, 1, 2),
+	)
+	in := &SoftInput{
+		Assignment: map[string]Assignment{
+			"a": makeAssign(10, 20, 1),
+			"b": makeAssign(10, 21, 2),
+			"c": makeAssign(10, 20, 3),
+			"d": makeAssign(10, 20, 4),
+		},
+		Slots: slots,
+		TeacherPreferences: map[uuid.UUID][]*entity.TimeSlot{
+			teacher: {makeSlot(90, 0, 1, 5)}, // prefers Monday mornings only
+		},
+		RoomBuildings: map[uuid.UUID]string{mustUUID(20): "A", mustUUID(21): "B"},
+	}
+
+	tests := []struct {
+		c    SoftConstraint
+		want float64
+	}{
+		{consecutivePeriodsConstraint{maxPeriods: 4}, 2}, // 6 straight periods on Monday
+		{lunchBreakConstraint{start: 5, end: 6}, 1},      // Monday covers period 5
+		{buildingTravelConstraint{}, 2},                  // A→B→A
+		{preferredPeriodsConstraint{}, 4},                // Monday 5-6 and Tuesday 1-2
+		{latePeriodConstraint{afterPeriod: 6}, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.c.Penalty(in); got != tt.want {
+			t.Errorf("%s: penalty=%v want %v", tt.c.Type(), got, tt.want)
+		}
+	}
+}
+
+func TestCampusTravelConstraint(t *testing.T) {
+	central, north, west := mustUUID(30), mustUUID(31), mustUUID(32)
+	// Monday 1-2 in room 20, 3-4 in room 21, 5-6 in room 22; Tuesday 1-2 in 21.
+	slots := slotsMap(makeSlot(1, 0, 1, 3), makeSlot(2, 0, 3, 5), makeSlot(3, 0, 5, 7), makeSlot(4, 1, 1, 3))
+	in := &SoftInput{
+		Assignment: map[string]Assignment{
+			"a": makeAssign(10, 20, 1),
+			"b": makeAssign(10, 21, 2),
+			"c": makeAssign(10, 22, 3),
+			"d": makeAssign(10, 21, 4),
+		},
+		Slots:        slots,
+		RoomCampuses: map[uuid.UUID]uuid.UUID{mustUUID(20): central, mustUUID(21): north, mustUUID(22): west},
+		CampusTravel: entity.TravelMatrix{entity.NewCampusPair(north, central): 40},
+	}
+
+	// central→north takes 40 minutes, 30 beyond the break; north→west is unknown.
+	if got := (campusTravelConstraint{breakMinutes: 10}).Penalty(in); got != 3 {
+		t.Fatalf("penalty=%v want 3", got)
+	}
+	// unknown pairs cost default_minutes: (40-10)/10 + (25-10)/10
+	if got := (campusTravelConstraint{breakMinutes: 10, defaultMinutes: 25}).Penalty(in); got != 4.5 {
+		t.Fatalf("penalty with default=%v want 4.5", got)
+	}
+
+	built, err := BuildSoftConstraints([]entity.SoftConstraintSetting{
+		{Type: valueobject.ConstraintCampusTravel, Weight: 1, Params: map[string]int{"break_minutes": -1}},
+	})
+	if err == nil {
+		t.Fatalf("negative break_minutes must be rejected, got %v", built)
+	}
+}
+
+func TestPreferenceConstraintPenalisesDislikedSlots(t *testing.T) {
+	teacher := mustUUID(10)
+	slots := slotsMap(makeSlot(1, 0, 1, 2), makeSlot(2, 2, 1, 2))
+	cc := openChecker()
+	cc.SetTeacherPreferences(nil, map[uuid.UUID][]*entity.TimeSlot{
+		teacher: {makeSlot(90, 2, 1, 3)}, // dislikes Wednesday mornings
+	})
+	monday := map[string]Assignment{"a": makeAssign(10, 20, 1)}
+	wednesday := map[string]Assignment{"a": makeAssign(10, 20, 2)}
+	if p := cc.EvaluateSoftConstraints(monday, slots); p != 0 {
+		t.Fatalf("monday should be free of penalty, got %v", p)
+	}
+	if p := cc.EvaluateSoftConstraints(wednesday, slots); p != 2 {
+		t.Fatalf("disliked slot should cost 2 periods, got %v", p)
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// SetPinnedAssistants registers the assistants of pinned assignments, keyed
+// like SetPinned, so that they are not staffed elsewhere at the same time.
+func (csp *CSPSolver) SetPinnedAssistants(assistants map[string][]uuid.UUID) {
+	csp.staffed = assistants
+}
+
+// staffAssistants picks the assistants of every session that needs them
+// once the leads are placed; search has kept enough of them free (see
+// staffable) unless no placement could. An assistant must pass canAssist:
+// qualified for the subject, available in the slot, free of any overlapping
+// lead or assistant session and within their weekly cap counting both
+// roles. Sessions with the fewest such teachers go first, and each takes the
+// least loaded of them so that assisting is spread across the staff. It
+// returns the assistants by variable key and the number of places no one
+// could fill.
+func (csp *CSPSolver) staffAssistants(assignment map[string]Assignment) (map[string][]uuid.UUID, int) {
+	var needs []ScheduleVariable
+	for _, v := range csp.variables {
+		if _, ok := assignment[v.Key()]; ok && v.Assistants > 0 {
+			needs = append(needs, v)
+		}
+	}
+	if len(needs) == 0 || csp.index == nil {
+		return nil, 0
+	}
+
+	b := csp.bookings(assignment)
+	staffed := map[string][]uuid.UUID{}
+	for key, ids := range csp.staffed {
+		if _, ok := csp.pinned[key]; ok && csp.slots[assignment[key].SlotID] != nil {
+			staffed[key] = ids
+		}
+	}
+	candidates := func(v ScheduleVariable) []uuid.UUID {
+		a := assignment[v.Key()]
+		slot := csp.slots[a.SlotID]
+		if slot == nil {
+			return nil
+		}
+		var ids []uuid.UUID
+		for _, id := range csp.index.teacherIDs {
+			if csp.canAssist(v, a, slot, id, b) {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+
+	counts := make(map[string]int, len(needs))
+	for _, v := range needs {
+		counts[v.Key()] = len(candidates(v))
+	}
+	sort.SliceStable(needs, func(i, j int) bool {
+		ki, kj := needs[i].Key(), needs[j].Key()
+		if counts[ki] != counts[kj] {
+			return counts[ki] < counts[kj]
+		}
+		return ki < kj
+	})
+
+	unstaffed := 0
+	for _, v := range needs {
+		key := v.Key()
+		slot := csp.slots[assignment[key].SlotID]
+		for range v.Assistants {
+			ids := candidates(v)
+			if len(ids) == 0 {
+				unstaffed++
+				continue
+			}
+			best := ids[0]
+			for _, id := range ids[1:] {
+				if b.load[id] < b.load[best] || (b.load[id] == b.load[best] && id.String() < best.String()) {
+					best = id
+				}
+			}
+			b.book(best, slot)
+			staffed[key] = append(staffed[key], best)
+		}
+	}
+	return staffed, unstaffed
+}
+
+// teacherBookings tracks the slots each teacher is booked into, as lead or
+// assistant, and the periods they add up to.
+type teacherBookings struct {
+	busy map[uuid.UUID][]*entity.TimeSlot
+	load map[uuid.UUID]int
+}
+
+func (b teacherBookings) book(teacherID uuid.UUID, slot *entity.TimeSlot) {
+	b.busy[teacherID] = append(b.busy[teacherID], slot)
+	b.load[teacherID] += slotPeriods(slot)
+}
+
+// bookings books the leads of assignment and the assistants of pinned
+// assignments.
+func (csp *CSPSolver) bookings(assignment map[string]Assignment) teacherBookings {
+	b := teacherBookings{busy: map[uuid.UUID][]*entity.TimeSlot{}, load: map[uuid.UUID]int{}}
+	for _, a := range assignment {
+		if slot := csp.slots[a.SlotID]; slot != nil {
+			b.book(a.TeacherID, slot)
+		}
+	}
+	for key, ids := range csp.staffed {
+		slot := csp.slots[assignment[key].SlotID]
+		if _, ok := csp.pinned[key]; !ok || slot == nil {
+			continue
+		}
+		for _, id := range ids {
+			b.book(id, slot)
+		}
+	}
+	return b
+}
+
+// canAssist reports whether a teacher may assist session v, placed at a in
+// slot: qualified for the subject, available in the slot, not its lead, free
+// of any overlapping booking and within their weekly cap counting both roles.
+func (csp *CSPSolver) canAssist(v ScheduleVariable, a Assignment, slot *entity.TimeSlot, teacherID uuid.UUID, b teacherBookings) bool {
+	cc := csp.checker
+	if teacherID == a.TeacherID || !cc.teacherHasSpecialization(teacherID, v.SubjectID) ||
+		!cc.teacherAvailableAt(teacherID, slot) || cc.ExceedsMaxHours(teacherID, b.load[teacherID]+slotPeriods(slot)) {
+		return false
+	}
+	for _, other := range b.busy[teacherID] {
+		if slotsOverlap(slot, other) {
+			return false
+		}
+	}
+	return true
+}
+
+// staffable reports whether the sessions needing assistants that overlap
+// slot can still be staffed under a partial assignment, so that search backs
+// out of lead placements that use up the assistants a session needs.
+// Sessions running in the same period compete for one pool: at every period
+// of slot their combined need may not exceed the teachers who could assist at
+// least one of them. This is a necessary condition; staffAssistants does the
+// actual staffing once the leads are placed.
+func (csp *CSPSolver) staffable(slot *entity.TimeSlot, assignment map[string]Assignment) bool {
+	if len(csp.assisted) == 0 || slot == nil {
+		return true
+	}
+	type session struct {
+		v    ScheduleVariable
+		a    Assignment
+		slot *entity.TimeSlot
+	}
+	var sessions []session
+	for key, v := range csp.assisted {
+		a, ok := assignment[key]
+		if !ok {
+			continue
+		}
+		if s := csp.slots[a.SlotID]; s != nil && slotsOverlap(slot, s) {
+			sessions = append(sessions, session{v, a, s})
+		}
+	}
+	if len(sessions) == 0 {
+		return true
+	}
+
+	b := csp.bookings(assignment)
+	pools := make([][]uuid.UUID, len(sessions))
+	for i, s := range sessions {
+		for _, id := range csp.index.teacherIDs {
+			if csp.canAssist(s.v, s.a, s.slot, id, b) {
+				pools[i] = append(pools[i], id)
+			}
+		}
+	}
+	for p := slot.StartPeriod; p <= slot.EndPeriod; p++ {
+		need := 0
+		pool := map[uuid.UUID]bool{}
+		for i, s := range sessions {
+			if s.slot.DayOfWeek != slot.DayOfWeek || p < s.slot.StartPeriod || p > s.slot.EndPeriod {
+				continue
+			}
+			need += s.v.Assistants
+			for _, id := range pools[i] {
+				pool[id] = true
+			}
+		}
+		if need > len(pool) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// AvailabilitySlot is one weekly window a teacher can teach in, together with
+// the teacher's preference for it.
+type AvailabilitySlot struct {
+	Slot       *entity.TimeSlot
+	Preference valueobject.TeacherPreference
+}
+
+// SplitAvailability separates HR availability into the hard availability
+// windows and the preferred / disliked windows used for soft scoring.
+func SplitAvailability(slots []AvailabilitySlot) (available, preferred, disliked []*entity.TimeSlot) {
+	for _, a := range slots {
+		available = append(available, a.Slot)
+		switch a.Preference {
+		case valueobject.TeacherPreferencePreferred:
+			preferred = append(preferred, a.Slot)
+		case valueobject.TeacherPreferenceDisliked:
+			disliked = append(disliked, a.Slot)
+		}
+	}
+	return available, preferred, disliked
+}
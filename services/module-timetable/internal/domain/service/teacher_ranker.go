@@ -1,9 +1,13 @@
 package service
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 )
 
 // TeacherInfo holds denormalised teacher data used for ranking.
@@ -15,11 +19,14 @@ type TeacherInfo struct {
 }
 
 // TeacherRank holds a scored teacher suggestion for a given subject/slot.
+// An unavailable teacher cannot take the session; their reasons start with
+// why not.
 type TeacherRank struct {
 	TeacherID uuid.UUID
 	Name      string
 	Score     float64
 	Reasons   []string
+	Available bool
 }
 
 // TeacherRanker ranks teachers for a given subject based on specialization,
@@ -72,6 +79,7 @@ func (r *TeacherRanker) RankForSubject(
 			Name:      t.FullName,
 			Score:     score,
 			Reasons:   reasons,
+			Available: true,
 		})
 	}
 
@@ -81,6 +89,97 @@ func (r *TeacherRanker) RankForSubject(
 	return ranks
 }
 
+// RankForSlot ranks teachers for one session of a subject in a given slot,
+// against the other sessions of the schedule. A teacher leading or assisting
+// an overlapping session, outside their availability or whose weekly periods
+// would go over their maximum with this one is unavailable; unavailable
+// teachers are ranked after every available one. Scores follow
+// RankForSubject, with capacity counted in periods, assisting included.
+func (r *TeacherRanker) RankForSlot(
+	subjectID uuid.UUID,
+	slot *entity.TimeSlot,
+	teachers []TeacherInfo,
+	others []*entity.ScheduleEntry,
+) []TeacherRank {
+	load := map[uuid.UUID]int{}
+	busy := map[uuid.UUID][]string{}
+	for _, e := range others {
+		other := &entity.TimeSlot{DayOfWeek: e.DayOfWeek, StartPeriod: e.StartPeriod, EndPeriod: e.EndPeriod}
+		overlaps := slotsOverlap(slot, other)
+		for _, t := range e.Teachers() {
+			if t.TeacherID == uuid.Nil {
+				continue
+			}
+			load[t.TeacherID] += slotPeriods(other)
+			if overlaps {
+				verb := "teaching"
+				if t.Role == valueobject.TeacherRoleAssistant {
+					verb = "assisting"
+				}
+				busy[t.TeacherID] = append(busy[t.TeacherID], fmt.Sprintf("%s %s at the same time", verb, entryLabel(e)))
+			}
+		}
+	}
+
+	ranks := make([]TeacherRank, 0, len(teachers))
+	for _, t := range teachers {
+		rank := TeacherRank{TeacherID: t.ID, Name: t.FullName, Available: true}
+		var disqualified, reasons []string
+
+		disqualified = append(disqualified, busy[t.ID]...)
+		if !r.checker.teacherAvailableAt(t.ID, slot) {
+			disqualified = append(disqualified, "outside availability")
+		} else if _, ok := r.checker.teacherAvailability[t.ID]; ok {
+			rank.Score += 5
+			reasons = append(reasons, "within availability")
+		}
+		periods := load[t.ID] + slotPeriods(slot)
+		if r.checker.ExceedsMaxHours(t.ID, periods) {
+			disqualified = append(disqualified, fmt.Sprintf("over max hours (%d of %d periods)", periods, t.MaxHoursPerWeek))
+		} else if headroom := t.MaxHoursPerWeek - periods; headroom > 0 {
+			rank.Score += float64(min(headroom, 20))
+			reasons = append(reasons, "available capacity")
+		}
+		if r.checker.teacherHasSpecialization(t.ID, subjectID) {
+			rank.Score += 30
+			reasons = append(reasons, "specialization match")
+		} else {
+			reasons = append(reasons, "no matching specialization")
+		}
+
+		rank.Available = len(disqualified) == 0
+		rank.Reasons = append(disqualified, reasons...)
+		ranks = append(ranks, rank)
+	}
+
+	sort.SliceStable(ranks, func(i, j int) bool {
+		if ranks[i].Available != ranks[j].Available {
+			return ranks[i].Available
+		}
+		if ranks[i].Score != ranks[j].Score {
+			return ranks[i].Score > ranks[j].Score
+		}
+		return ranks[i].Name < ranks[j].Name
+	})
+	return ranks
+}
+
+// entryLabel names an entry's subject for reasons: its code, or its name,
+// with the section when the subject is split.
+func entryLabel(e *entity.ScheduleEntry) string {
+	label := e.SubjectCode
+	if label == "" {
+		label = e.SubjectName
+	}
+	if label == "" {
+		label = "another class"
+	}
+	if e.Section > 1 {
+		label = fmt.Sprintf("%s (section %d)", label, e.Section)
+	}
+	return label
+}
+
 func countAssignedHours(teacherID uuid.UUID, assignment map[string]Assignment) int {
 	count := 0
 	for _, a := range assignment {
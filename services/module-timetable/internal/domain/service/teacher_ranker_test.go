@@ -0,0 +1,65 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestRankForSlotExplainsConflicts(t *testing.T) {
+	lead := makeEntry(2, 10, 20, 30, 0, 1)
+	lead.Assistants = []entity.EntryTeacher{{TeacherID: mustUUID(11), Role: valueobject.TeacherRoleAssistant}}
+	others := []*entity.ScheduleEntry{lead, makeEntry(3, 13, 21, 31, 2, 1)}
+
+	cc := NewConstraintChecker(
+		map[uuid.UUID][]*entity.TimeSlot{mustUUID(12): {makeSlot(40, 1, 1, 6)}},
+		map[uuid.UUID]map[string]bool{mustUUID(14): {"math": true}},
+		map[uuid.UUID][]string{mustUUID(1): {"math"}},
+		map[uuid.UUID]int{mustUUID(13): 3},
+	)
+	teachers := []TeacherInfo{
+		{ID: mustUUID(10), FullName: "Busy lead", MaxHoursPerWeek: 10},
+		{ID: mustUUID(11), FullName: "Busy assistant", MaxHoursPerWeek: 10},
+		{ID: mustUUID(12), FullName: "Away", MaxHoursPerWeek: 10},
+		{ID: mustUUID(13), FullName: "Full", MaxHoursPerWeek: 3},
+		{ID: mustUUID(14), FullName: "Specialist", MaxHoursPerWeek: 10},
+		{ID: mustUUID(15), FullName: "Generalist", MaxHoursPerWeek: 10},
+	}
+
+	ranks := NewTeacherRanker(cc).RankForSlot(mustUUID(1), makeSlot(1, 0, 2, 4), teachers, others)
+	if len(ranks) != len(teachers) {
+		t.Fatalf("expected every teacher ranked, got %d", len(ranks))
+	}
+	if ranks[0].Name != "Specialist" || ranks[1].Name != "Generalist" {
+		t.Fatalf("expected free teachers first, got %s, %s", ranks[0].Name, ranks[1].Name)
+	}
+	byName := make(map[string]TeacherRank, len(ranks))
+	for i, r := range ranks {
+		byName[r.Name] = r
+		if r.Available != (i < 2) {
+			t.Fatalf("rank %d (%s): available = %v", i, r.Name, r.Available)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		reason string
+	}{
+		{"Busy lead", "teaching S at the same time"},
+		{"Busy assistant", "assisting S at the same time"},
+		{"Away", "outside availability"},
+		{"Full", "over max hours (6 of 3 periods)"},
+	}
+	for _, tt := range tests {
+		if r := byName[tt.name]; len(r.Reasons) == 0 || r.Reasons[0] != tt.reason {
+			t.Fatalf("%s: reasons %v, want %q first", tt.name, r.Reasons, tt.reason)
+		}
+	}
+	if !slices.Contains(byName["Generalist"].Reasons, "no matching specialization") {
+		t.Fatalf("a missing specialization should be explained, got %v", byName["Generalist"].Reasons)
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// TeachingLoad is a teacher's timetabled hours for one subject in one role.
+type TeachingLoad struct {
+	TeacherID    uuid.UUID
+	SubjectID    uuid.UUID
+	Role         valueobject.TeacherRole
+	HoursPerWeek float64 // in a week the subject meets
+	TotalHours   float64 // over the semester's actual class dates
+}
+
+// TeachingLoads sums a schedule's hours per teacher and subject from the
+// semester's calendar: period lengths (breaks between periods excluded), the
+// subject's week pattern and holidays. Leads and assistants are counted
+// apart, each for the whole session. Hours are rounded to one decimal.
+// Unassigned entries and entries outside the period table are skipped.
+func TeachingLoads(semester *entity.Semester, entries []*entity.ScheduleEntry) []TeachingLoad {
+	type key struct {
+		teacher, subject uuid.UUID
+		role             valueobject.TeacherRole
+	}
+	loads := map[key]*TeachingLoad{}
+	for _, e := range entries {
+		if e.TeacherID == uuid.Nil {
+			continue
+		}
+		session := semester.TeachingTime(e.StartPeriod, e.EndPeriod).Hours()
+		if session == 0 {
+			continue
+		}
+		dates, _ := semester.ClassDates(e.DayOfWeek, semester.WeekPatternOf(e.SubjectID))
+		for _, t := range e.Teachers() {
+			k := key{t.TeacherID, e.SubjectID, t.Role}
+			if loads[k] == nil {
+				loads[k] = &TeachingLoad{TeacherID: t.TeacherID, SubjectID: e.SubjectID, Role: t.Role}
+			}
+			loads[k].HoursPerWeek += session
+			loads[k].TotalHours += session * float64(len(dates))
+		}
+	}
+
+	out := make([]TeachingLoad, 0, len(loads))
+	for _, l := range loads {
+		l.HoursPerWeek = math.Round(l.HoursPerWeek*10) / 10
+		l.TotalHours = math.Round(l.TotalHours*10) / 10
+		out = append(out, *l)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].TeacherID != out[j].TeacherID {
+			return out[i].TeacherID.String() < out[j].TeacherID.String()
+		}
+		if out[i].SubjectID != out[j].SubjectID {
+			return out[i].SubjectID.String() < out[j].SubjectID.String()
+		}
+		return out[i].Role == valueobject.TeacherRoleLead && out[j].Role != valueobject.TeacherRoleLead
+	})
+	return out
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestTeachingLoadsFollowSemesterCalendar(t *testing.T) {
+	date := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC) }
+	semester := &entity.Semester{
+		// Monday 2025-09-01 to Friday 2025-10-03: five Mondays, one a holiday
+		StartDate:    date(9, 1),
+		EndDate:      date(10, 3),
+		Holidays:     []entity.Holiday{{StartDate: date(9, 15), EndDate: date(9, 15)}},
+		WeekPatterns: map[uuid.UUID]valueobject.WeekPattern{mustUUID(2): valueobject.WeekPatternEven},
+	}
+	weekly := makeEntry(1, 10, 20, 30, 0, 1) // periods 1-3: 4.5h
+	weekly.EndPeriod = 3
+	again := makeEntry(1, 10, 20, 31, 2, 1) // Wednesday periods 1-2: 3h
+	again.EndPeriod = 2
+	even := makeEntry(2, 10, 20, 32, 0, 4) // periods 4-5, weeks 2 and 4
+	even.EndPeriod = 5
+	unassigned := makeEntry(3, 10, 20, 33, 1, 1)
+	unassigned.TeacherID = uuid.Nil
+
+	loads := TeachingLoads(semester, []*entity.ScheduleEntry{weekly, again, even, unassigned})
+	if len(loads) != 2 {
+		t.Fatalf("expected 2 loads, got %+v", loads)
+	}
+	byCourse := map[uuid.UUID]TeachingLoad{}
+	for _, l := range loads {
+		byCourse[l.SubjectID] = l
+	}
+	// Four Mondays x 4.5h plus five Wednesdays x 3h
+	if l := byCourse[mustUUID(1)]; l.HoursPerWeek != 7.5 || l.TotalHours != 33 {
+		t.Fatalf("weekly course: got %+v", l)
+	}
+	if l := byCourse[mustUUID(2)]; l.HoursPerWeek != 3 || l.TotalHours != 6 {
+		t.Fatalf("even-week course: got %+v", l)
+	}
+}
+
+func TestTeachingLoadsCountAssistantsApart(t *testing.T) {
+	semester := &entity.Semester{
+		StartDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 9, 7, 0, 0, 0, 0, time.UTC),
+	}
+	lab := makeEntry(1, 10, 20, 30, 0, 1) // periods 1-2: 3h
+	lab.EndPeriod = 2
+	lab.Assistants = []entity.EntryTeacher{{TeacherID: mustUUID(11), Role: valueobject.TeacherRoleAssistant}}
+	taught := makeEntry(2, 11, 20, 31, 1, 1) // the assistant's own lecture: 1.5h
+	taught.EndPeriod = 1
+
+	loads := TeachingLoads(semester, []*entity.ScheduleEntry{lab, taught})
+	if len(loads) != 3 {
+		t.Fatalf("expected 3 loads, got %+v", loads)
+	}
+	type key struct {
+		teacher, subject uuid.UUID
+		role             valueobject.TeacherRole
+	}
+	got := map[key]float64{}
+	for _, l := range loads {
+		got[key{l.TeacherID, l.SubjectID, l.Role}] = l.HoursPerWeek
+	}
+	want := map[key]float64{
+		{mustUUID(10), mustUUID(1), valueobject.TeacherRoleLead}:      3,
+		{mustUUID(11), mustUUID(1), valueobject.TeacherRoleAssistant}: 3,
+		{mustUUID(11), mustUUID(2), valueobject.TeacherRoleLead}:      1.5,
+	}
+	for k, hours := range want {
+		if got[k] != hours {
+			t.Fatalf("%v: expected %.1fh, got %+v", k, hours, loads)
+		}
+	}
+}
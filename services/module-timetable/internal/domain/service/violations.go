@@ -0,0 +1,127 @@
+package service
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// Violation is one hard constraint a complete assignment breaks.
+type Violation struct {
+	Constraint valueobject.ConstraintType
+	Key        string    // session that breaks the rule ("" for teacher overloads)
+	OtherKey   string    // second session of a pairwise conflict, "" otherwise
+	TeacherID  uuid.UUID // overloaded teacher, or the assistant of an assistant violation
+}
+
+// Violations lists every hard constraint broken by an assignment, e.g. one
+// edited by hand after solving. Pairs are reported once with Key < OtherKey,
+// and a teacher over their weekly cap is reported once rather than per pair.
+// The result is ordered so that two calls can be compared directly.
+func (cc *ConstraintChecker) Violations(
+	assignment map[string]Assignment,
+	slots map[uuid.UUID]*entity.TimeSlot,
+) []Violation {
+	keys := make([]string, 0, len(assignment))
+	for key := range assignment {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var violations []Violation
+	for i, key := range keys {
+		val := assignment[key]
+		subjectID, section, err := parseKey(key)
+		if err == nil {
+			v := ScheduleVariable{SubjectID: subjectID, Section: section}
+			if c := cc.UnaryViolation(v, val, slots); c != "" && c != valueobject.ConstraintTeacherOverload {
+				violations = append(violations, Violation{Constraint: c, Key: key})
+			}
+		}
+		for _, other := range keys[i+1:] {
+			c := cc.ConflictReason(key, val, other, assignment[other], slots)
+			if c == valueobject.ConstraintTeacherOverload {
+				// Counted per teacher below; the pair may still clash in time
+				c = ""
+				if a, b := slots[val.SlotID], slots[assignment[other].SlotID]; a != nil && b != nil && slotsOverlap(a, b) {
+					c = valueobject.ConstraintTeacherConflict
+				}
+			}
+			if c != "" {
+				violations = append(violations, Violation{Constraint: c, Key: key, OtherKey: other})
+			}
+		}
+	}
+	for _, o := range cc.TeacherOverloads(assignment, slots) {
+		violations = append(violations, Violation{Constraint: valueobject.ConstraintTeacherOverload, TeacherID: o.TeacherID})
+	}
+	return violations
+}
+
+// AssistantViolations lists the hard constraints broken by the assistants of
+// an assignment, keyed like it: an assistant outside their availability, or
+// also booked (as lead or assistant) in an overlapping session. Lead against
+// lead clashes are left to Violations. Pairs are reported once with
+// Key < OtherKey, and the result is ordered like Violations.
+func (cc *ConstraintChecker) AssistantViolations(
+	assignment map[string]Assignment,
+	assistants map[string][]uuid.UUID,
+	slots map[uuid.UUID]*entity.TimeSlot,
+) []Violation {
+	if len(assistants) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(assignment))
+	for key := range assignment {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var violations []Violation
+	for i, key := range keys {
+		val := assignment[key]
+		slot := slots[val.SlotID]
+		if slot == nil {
+			continue
+		}
+		for _, id := range assistants[key] {
+			switch {
+			case id == val.TeacherID:
+				violations = append(violations, Violation{Constraint: valueobject.ConstraintTeacherConflict, Key: key, TeacherID: id})
+			case !cc.teacherAvailableAt(id, slot):
+				violations = append(violations, Violation{Constraint: valueobject.ConstraintTeacherUnavailable, Key: key, TeacherID: id})
+			}
+		}
+		for _, other := range keys[i+1:] {
+			otherVal := assignment[other]
+			if s := slots[otherVal.SlotID]; s == nil || !slotsOverlap(slot, s) {
+				continue
+			}
+			for _, id := range append([]uuid.UUID{val.TeacherID}, assistants[key]...) {
+				lead := id == val.TeacherID
+				if (!lead && id == otherVal.TeacherID) || slices.Contains(assistants[other], id) {
+					violations = append(violations, Violation{Constraint: valueobject.ConstraintTeacherConflict, Key: key, OtherKey: other, TeacherID: id})
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// NewViolations returns the violations in after that were not already in before.
+func NewViolations(before, after []Violation) []Violation {
+	seen := make(map[Violation]bool, len(before))
+	for _, v := range before {
+		seen[v] = true
+	}
+	var added []Violation
+	for _, v := range after {
+		if !seen[v] {
+			added = append(added, v)
+		}
+	}
+	return added
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+func TestViolationsReportsPairwiseAndOverloads(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 1, 1, 3), makeSlot(2, 2, 1, 3))
+	a, b, c := mustUUID(1).String(), mustUUID(2).String(), mustUUID(3).String()
+	checker := NewConstraintChecker(nil, nil, nil, map[uuid.UUID]int{mustUUID(10): 3})
+
+	assignment := map[string]Assignment{
+		a: makeAssign(10, 20, 1),
+		b: makeAssign(10, 21, 1), // same teacher, same slot as a
+		c: makeAssign(11, 20, 2),
+	}
+	got := checker.Violations(assignment, slots)
+	want := []Violation{
+		{Constraint: valueobject.ConstraintTeacherConflict, Key: a, OtherKey: b},
+		{Constraint: valueobject.ConstraintTeacherOverload, TeacherID: mustUUID(10)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d violations, got %+v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("violation %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestNewViolationsIgnoresExistingOnes(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 1, 1, 3), makeSlot(2, 2, 1, 3))
+	a, b, c := mustUUID(1).String(), mustUUID(2).String(), mustUUID(3).String()
+	checker := openChecker()
+	assignment := map[string]Assignment{
+		a: makeAssign(10, 20, 1),
+		b: makeAssign(11, 20, 1), // room clash that predates the edit
+		c: makeAssign(12, 21, 2),
+	}
+	before := checker.Violations(assignment, slots)
+
+	assignment[c] = makeAssign(10, 22, 1) // moves c onto teacher 10's slot
+	added := NewViolations(before, checker.Violations(assignment, slots))
+	if len(added) != 1 || added[0].Constraint != valueobject.ConstraintTeacherConflict || added[0].OtherKey != c {
+		t.Fatalf("expected only the new teacher conflict with c, got %+v", added)
+	}
+}
+
+func TestAssistantViolations(t *testing.T) {
+	slots := slotsMap(makeSlot(1, 0, 1, 2), makeSlot(2, 0, 2, 3), makeSlot(3, 1, 1, 2))
+	a, b, c := mustUUID(1).String(), mustUUID(2).String(), mustUUID(3).String()
+	checker := NewConstraintChecker(map[uuid.UUID][]*entity.TimeSlot{
+		mustUUID(12): {makeSlot(90, 0, 1, 6)}, // Mondays only
+	}, nil, nil, nil)
+	assignment := map[string]Assignment{
+		a: makeAssign(10, 20, 1),
+		b: makeAssign(11, 21, 2), // overlaps a at period 2
+		c: makeAssign(13, 22, 3),
+	}
+	assistants := map[string][]uuid.UUID{
+		a: {mustUUID(11)}, // leads b at the same time
+		b: {mustUUID(12)},
+		c: {mustUUID(12)}, // unavailable on Tuesday
+	}
+	got := checker.AssistantViolations(assignment, assistants, slots)
+	want := []Violation{
+		{Constraint: valueobject.ConstraintTeacherConflict, Key: a, OtherKey: b, TeacherID: mustUUID(11)},
+		{Constraint: valueobject.ConstraintTeacherUnavailable, Key: c, TeacherID: mustUUID(12)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d violations, got %+v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("violation %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+
+	// Moving c onto Monday clears the availability but books 12 twice
+	assignment[c] = makeAssign(13, 22, 2)
+	got = checker.AssistantViolations(assignment, assistants, slots)
+	if len(got) != 2 || got[1] != (Violation{Constraint: valueobject.ConstraintTeacherConflict, Key: b, OtherKey: c, TeacherID: mustUUID(12)}) {
+		t.Fatalf("expected the assistant double booking of b and c, got %+v", got)
+	}
+}
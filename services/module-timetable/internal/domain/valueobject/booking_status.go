@@ -0,0 +1,27 @@
+package valueobject
+
+// BookingStatus is the lifecycle state of an ad-hoc room booking.
+type BookingStatus string
+
+const (
+	BookingStatusPending   BookingStatus = "pending" // waiting for approval of a restricted room
+	BookingStatusApproved  BookingStatus = "approved"
+	BookingStatusRejected  BookingStatus = "rejected"
+	BookingStatusCancelled BookingStatus = "cancelled"
+)
+
+func (s BookingStatus) IsValid() bool {
+	switch s {
+	case BookingStatusPending, BookingStatusApproved, BookingStatusRejected, BookingStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// IsActive reports whether the booking holds the room. Pending bookings do,
+// so that two requests for a restricted room cannot both be approved.
+func (s BookingStatus) IsActive() bool {
+	return s == BookingStatusPending || s == BookingStatusApproved
+}
+
+func (s BookingStatus) String() string { return string(s) }
@@ -10,11 +10,30 @@ const (
 	ConstraintRoomConflict          ConstraintType = "room_conflict"
 	ConstraintSpecializationMissing ConstraintType = "specialization_missing"
 	ConstraintTeacherUnavailable    ConstraintType = "teacher_unavailable"
+	ConstraintSessionSameDay        ConstraintType = "session_same_day"
+	ConstraintSessionTeacherSplit   ConstraintType = "session_teacher_split"
+	ConstraintRoomCapacity          ConstraintType = "room_capacity"
+	ConstraintRoomType              ConstraintType = "room_type_mismatch"
+	ConstraintRoomFeatureMissing    ConstraintType = "room_feature_missing"
+	ConstraintTeacherOverload       ConstraintType = "teacher_overload"
+	// Subjects sharing enrolled students overlap; hard unless a run relaxes
+	// it, in which case it is scored as a soft constraint instead.
+	ConstraintCohortClash ConstraintType = "cohort_clash"
+	// No candidate matches an admin's pre-placement of the session.
+	ConstraintPinned ConstraintType = "pinned"
+	// A student would sit more exams on one day than the exam period allows.
+	ConstraintExamDailyLimit ConstraintType = "exam_daily_limit"
 
 	// Soft constraints — violations accumulate a penalty score.
-	ConstraintTeacherGap      ConstraintType = "teacher_gap"
-	ConstraintLoadImbalance   ConstraintType = "load_imbalance"
-	ConstraintPreferenceBreak ConstraintType = "preference_break"
+	ConstraintTeacherGap         ConstraintType = "teacher_gap"
+	ConstraintLoadImbalance      ConstraintType = "load_imbalance"
+	ConstraintPreferenceBreak    ConstraintType = "preference_break"
+	ConstraintLatePeriod         ConstraintType = "late_period"
+	ConstraintConsecutivePeriods ConstraintType = "max_consecutive"
+	ConstraintBuildingTravel     ConstraintType = "building_travel"
+	ConstraintCampusTravel       ConstraintType = "campus_travel"
+	ConstraintLunchBreak         ConstraintType = "lunch_break"
+	ConstraintExamSpread         ConstraintType = "exam_spread"
 )
 
 // IsHard returns true for hard constraints.
@@ -23,7 +42,16 @@ func (c ConstraintType) IsHard() bool {
 	case ConstraintTeacherConflict,
 		ConstraintRoomConflict,
 		ConstraintSpecializationMissing,
-		ConstraintTeacherUnavailable:
+		ConstraintTeacherUnavailable,
+		ConstraintSessionSameDay,
+		ConstraintSessionTeacherSplit,
+		ConstraintRoomCapacity,
+		ConstraintRoomType,
+		ConstraintRoomFeatureMissing,
+		ConstraintTeacherOverload,
+		ConstraintCohortClash,
+		ConstraintPinned,
+		ConstraintExamDailyLimit:
 		return true
 	}
 	return false
@@ -0,0 +1,11 @@
+package valueobject
+
+// TeacherPreference is how much a teacher wants to teach in an available slot.
+// It is scored as a soft constraint; availability itself stays a hard one.
+type TeacherPreference string
+
+const (
+	TeacherPreferenceNeutral   TeacherPreference = "neutral"
+	TeacherPreferencePreferred TeacherPreference = "preferred"
+	TeacherPreferenceDisliked  TeacherPreference = "disliked"
+)
@@ -0,0 +1,16 @@
+package valueobject
+
+// TeacherRole is the part a teacher plays in a scheduled session. Every
+// session has one lead; labs and large lectures may add assistants.
+type TeacherRole string
+
+const (
+	TeacherRoleLead      TeacherRole = "lead"
+	TeacherRoleAssistant TeacherRole = "assistant"
+)
+
+func (r TeacherRole) IsValid() bool {
+	return r == TeacherRoleLead || r == TeacherRoleAssistant
+}
+
+func (r TeacherRole) String() string { return string(r) }
@@ -0,0 +1,28 @@
+package valueobject
+
+// WeekPattern selects the teaching weeks of a course within its semester.
+// Weeks are numbered from 1, starting with the week of the semester's start date.
+type WeekPattern string
+
+const (
+	WeekPatternWeekly     WeekPattern = "weekly"
+	WeekPatternOdd        WeekPattern = "odd_weeks"
+	WeekPatternEven       WeekPattern = "even_weeks"
+	WeekPatternFirstHalf  WeekPattern = "first_half"
+	WeekPatternSecondHalf WeekPattern = "second_half"
+)
+
+func (p WeekPattern) IsValid() bool {
+	switch p {
+	case WeekPatternWeekly, WeekPatternOdd, WeekPatternEven, WeekPatternFirstHalf, WeekPatternSecondHalf:
+		return true
+	}
+	return false
+}
+
+// Biweekly reports whether the course meets every other week.
+func (p WeekPattern) Biweekly() bool {
+	return p == WeekPatternOdd || p == WeekPatternEven
+}
+
+func (p WeekPattern) String() string { return string(p) }
@@ -0,0 +1,234 @@
+// Package benchmark loads solver benchmark instances — JSON snapshots of a
+// semester and ITC-2007 course timetabling files — and runs the CSP solver
+// on them without a database or the other modules.
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+)
+
+// Instance is a self-contained snapshot of everything the solver reads for
+// one semester: the same data the generate handler fetches from the
+// repositories and the HR, Subject and Student modules.
+type Instance struct {
+	Name     string            `json:"name"`
+	Semester SemesterSnapshot  `json:"semester"`
+	Subjects []SubjectSnapshot `json:"subjects"`
+	Teachers []TeacherSnapshot `json:"teachers"`
+	Rooms    []RoomSnapshot    `json:"rooms"`
+	Slots    []SlotSnapshot    `json:"slots"`
+}
+
+// SemesterSnapshot holds the per-semester solver settings.
+type SemesterSnapshot struct {
+	// SoftConstraints as configured on the semester; empty = defaults
+	SoftConstraints    []entity.SoftConstraintSetting `json:"soft_constraints,omitempty"`
+	BindSessionTeacher bool                           `json:"bind_session_teacher,omitempty"`
+	SoftCohortClash    bool                           `json:"soft_cohort_clash,omitempty"`
+}
+
+// SubjectSnapshot is one offered subject with its room requirements and the
+// students enrolled in it.
+type SubjectSnapshot struct {
+	ID                      uuid.UUID   `json:"id"`
+	Code                    string      `json:"code"`
+	Name                    string      `json:"name,omitempty"`
+	WeeklyHours             int         `json:"weekly_hours"`
+	RequiredSpecializations []string    `json:"required_specializations,omitempty"`
+	MinRoomCapacity         int         `json:"min_room_capacity,omitempty"`
+	RequiredRoomType        string      `json:"required_room_type,omitempty"`
+	RequiredRoomFeatures    []string    `json:"required_room_features,omitempty"`
+	EnrolledStudents        []uuid.UUID `json:"enrolled_students,omitempty"`
+}
+
+// TeacherSnapshot is one teacher; an empty Availability means the teacher
+// can teach at any time.
+type TeacherSnapshot struct {
+	ID              uuid.UUID              `json:"id"`
+	FullName        string                 `json:"full_name,omitempty"`
+	Specializations []string               `json:"specializations,omitempty"`
+	MaxHoursPerWeek int                    `json:"max_hours_per_week,omitempty"`
+	Availability    []AvailabilitySnapshot `json:"availability,omitempty"`
+}
+
+// AvailabilitySnapshot is a weekly window a teacher can teach in.
+type AvailabilitySnapshot struct {
+	DayOfWeek   int                           `json:"day_of_week"`
+	StartPeriod int                           `json:"start_period"`
+	EndPeriod   int                           `json:"end_period"`
+	Preference  valueobject.TeacherPreference `json:"preference,omitempty"`
+}
+
+// RoomSnapshot is one candidate room.
+type RoomSnapshot struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name,omitempty"`
+	Capacity int       `json:"capacity"`
+	Type     string    `json:"type,omitempty"`
+	Features []string  `json:"features,omitempty"`
+}
+
+// SlotSnapshot is one teaching slot of the semester.
+type SlotSnapshot struct {
+	ID          uuid.UUID `json:"id"`
+	DayOfWeek   int       `json:"day_of_week"`
+	StartPeriod int       `json:"start_period"`
+	EndPeriod   int       `json:"end_period"`
+}
+
+// ReadSnapshot decodes a JSON snapshot and checks that it can be solved.
+func ReadSnapshot(r io.Reader) (*Instance, error) {
+	var inst Instance
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&inst); err != nil {
+		return nil, fmt.Errorf("decode snapshot: %w", err)
+	}
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return &inst, nil
+}
+
+// WriteSnapshot encodes the instance as an indented JSON snapshot.
+func (inst *Instance) WriteSnapshot(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(inst)
+}
+
+// Validate rejects instances the solver cannot be set up for: nothing to
+// schedule, duplicate IDs, malformed slots and unknown soft constraints.
+func (inst *Instance) Validate() error {
+	if len(inst.Subjects) == 0 || len(inst.Teachers) == 0 || len(inst.Rooms) == 0 || len(inst.Slots) == 0 {
+		return fmt.Errorf("instance %q: subjects, teachers, rooms and slots are required", inst.Name)
+	}
+	seen := make(map[uuid.UUID]bool)
+	unique := func(kind string, id uuid.UUID) error {
+		if seen[id] {
+			return fmt.Errorf("instance %q: duplicate %s id %s", inst.Name, kind, id)
+		}
+		seen[id] = true
+		return nil
+	}
+	for _, s := range inst.Subjects {
+		if err := unique("subject", s.ID); err != nil {
+			return err
+		}
+	}
+	for _, t := range inst.Teachers {
+		if err := unique("teacher", t.ID); err != nil {
+			return err
+		}
+	}
+	for _, r := range inst.Rooms {
+		if err := unique("room", r.ID); err != nil {
+			return err
+		}
+	}
+	for _, s := range inst.Slots {
+		if err := unique("slot", s.ID); err != nil {
+			return err
+		}
+		slot := &entity.TimeSlot{DayOfWeek: s.DayOfWeek, StartPeriod: s.StartPeriod, EndPeriod: s.EndPeriod}
+		if err := slot.Validate(); err != nil {
+			return fmt.Errorf("instance %q: slot %s: %w", inst.Name, s.ID, err)
+		}
+	}
+	if _, err := service.BuildSoftConstraints(inst.Semester.SoftConstraints); err != nil {
+		return fmt.Errorf("instance %q: %w", inst.Name, err)
+	}
+	return nil
+}
+
+// problem is an instance turned into solver inputs, mirroring what the
+// generate handler builds from live data.
+type problem struct {
+	variables  []service.ScheduleVariable
+	candidates service.Candidates
+	slots      map[uuid.UUID]*entity.TimeSlot
+	checker    *service.ConstraintChecker
+}
+
+func (inst *Instance) problem() (*problem, error) {
+	p := &problem{slots: make(map[uuid.UUID]*entity.TimeSlot, len(inst.Slots))}
+	slots := make([]*entity.TimeSlot, 0, len(inst.Slots))
+	for _, s := range inst.Slots {
+		slot := &entity.TimeSlot{ID: s.ID, DayOfWeek: s.DayOfWeek, StartPeriod: s.StartPeriod, EndPeriod: s.EndPeriod}
+		slots = append(slots, slot)
+		p.slots[s.ID] = slot
+		p.candidates.SlotIDs = append(p.candidates.SlotIDs, s.ID)
+	}
+
+	availability := make(map[uuid.UUID][]*entity.TimeSlot)
+	preferred := make(map[uuid.UUID][]*entity.TimeSlot)
+	disliked := make(map[uuid.UUID][]*entity.TimeSlot)
+	teacherSpecs := make(map[uuid.UUID]map[string]bool, len(inst.Teachers))
+	maxHours := make(map[uuid.UUID]int, len(inst.Teachers))
+	for _, t := range inst.Teachers {
+		p.candidates.TeacherIDs = append(p.candidates.TeacherIDs, t.ID)
+		specs := make(map[string]bool, len(t.Specializations))
+		for _, s := range t.Specializations {
+			specs[s] = true
+		}
+		teacherSpecs[t.ID] = specs
+		maxHours[t.ID] = t.MaxHoursPerWeek
+		var windows []service.AvailabilitySlot
+		for _, a := range t.Availability {
+			windows = append(windows, service.AvailabilitySlot{
+				Slot:       &entity.TimeSlot{DayOfWeek: a.DayOfWeek, StartPeriod: a.StartPeriod, EndPeriod: a.EndPeriod},
+				Preference: a.Preference,
+			})
+		}
+		availability[t.ID], preferred[t.ID], disliked[t.ID] = service.SplitAvailability(windows)
+	}
+
+	rooms := make([]*entity.Room, 0, len(inst.Rooms))
+	for _, r := range inst.Rooms {
+		rooms = append(rooms, &entity.Room{ID: r.ID, Name: r.Name, Capacity: r.Capacity, Type: r.Type, Features: r.Features, IsActive: true})
+		p.candidates.RoomIDs = append(p.candidates.RoomIDs, r.ID)
+	}
+
+	variables := make([]service.ScheduleVariable, 0, len(inst.Subjects))
+	subjectSpecs := make(map[uuid.UUID][]string, len(inst.Subjects))
+	roomReqs := make(map[uuid.UUID]service.RoomRequirement, len(inst.Subjects))
+	enrolled := make(map[uuid.UUID][]uuid.UUID)
+	for _, s := range inst.Subjects {
+		variables = append(variables, service.ScheduleVariable{
+			SubjectID:               s.ID,
+			SubjectCode:             s.Code,
+			WeeklyHours:             s.WeeklyHours,
+			RequiredSpecializations: s.RequiredSpecializations,
+		})
+		subjectSpecs[s.ID] = s.RequiredSpecializations
+		roomReqs[s.ID] = service.RoomRequirement{
+			MinCapacity: s.MinRoomCapacity,
+			RoomType:    s.RequiredRoomType,
+			Features:    s.RequiredRoomFeatures,
+		}
+		if len(s.EnrolledStudents) > 0 {
+			enrolled[s.ID] = s.EnrolledStudents
+		}
+	}
+	p.variables = service.ExpandSessions(variables, service.SessionPeriods(slots))
+
+	p.checker = service.NewConstraintChecker(availability, teacherSpecs, subjectSpecs, maxHours)
+	p.checker.SetSessionTeacherBinding(inst.Semester.BindSessionTeacher)
+	p.checker.SetCohortOverlap(service.BuildCohortOverlap(enrolled), !inst.Semester.SoftCohortClash)
+	p.checker.SetRoomRequirements(rooms, roomReqs)
+	p.checker.SetTeacherPreferences(preferred, disliked)
+	softConstraints, err := service.BuildSoftConstraints(inst.Semester.SoftConstraints)
+	if err != nil {
+		return nil, fmt.Errorf("soft constraints: %w", err)
+	}
+	p.checker.SetSoftConstraints(softConstraints)
+	return p, nil
+}
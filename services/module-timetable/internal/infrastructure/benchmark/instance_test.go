@@ -0,0 +1,93 @@
+package benchmark
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	inst, err := ReadITC2007(strings.NewReader(toyCTT), ITCOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := inst.WriteSnapshot(&buf); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	got, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if !reflect.DeepEqual(got, inst) {
+		t.Fatalf("snapshot changed on round trip:\n got %+v\nwant %+v", got, inst)
+	}
+}
+
+func TestReadSnapshotRejectsInvalidInstances(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{name: "unknown field", json: `{"name":"x","sections":[]}`, want: "unknown field"},
+		{name: "empty", json: `{"name":"x"}`, want: "are required"},
+		{
+			name: "duplicate id",
+			json: `{"name":"x",
+				"subjects":[{"id":"00000000-0000-0000-0000-000000000001","code":"S","weekly_hours":2}],
+				"teachers":[{"id":"00000000-0000-0000-0000-000000000001"}],
+				"rooms":[{"id":"00000000-0000-0000-0000-000000000002","capacity":10}],
+				"slots":[{"id":"00000000-0000-0000-0000-000000000003","day_of_week":0,"start_period":1,"end_period":3}]}`,
+			want: "duplicate teacher id",
+		},
+		{
+			name: "malformed slot",
+			json: `{"name":"x",
+				"subjects":[{"id":"00000000-0000-0000-0000-000000000001","code":"S","weekly_hours":2}],
+				"teachers":[{"id":"00000000-0000-0000-0000-000000000004"}],
+				"rooms":[{"id":"00000000-0000-0000-0000-000000000002","capacity":10}],
+				"slots":[{"id":"00000000-0000-0000-0000-000000000003","day_of_week":0,"start_period":3,"end_period":3}]}`,
+			want: "end_period must be > start_period",
+		},
+		{
+			name: "unknown soft constraint",
+			json: `{"name":"x","semester":{"soft_constraints":[{"type":"nope","weight":1}]},
+				"subjects":[{"id":"00000000-0000-0000-0000-000000000001","code":"S","weekly_hours":2}],
+				"teachers":[{"id":"00000000-0000-0000-0000-000000000004"}],
+				"rooms":[{"id":"00000000-0000-0000-0000-000000000002","capacity":10}],
+				"slots":[{"id":"00000000-0000-0000-0000-000000000003","day_of_week":0,"start_period":1,"end_period":3}]}`,
+			want: "unknown soft constraint",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadSnapshot(strings.NewReader(tt.json))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestRunReportsInfeasibleInstance(t *testing.T) {
+	// Nobody teaches "chemistry"
+	const snapshot = `{"name":"no-teacher",
+		"subjects":[{"id":"00000000-0000-0000-0000-000000000001","code":"CHEM","weekly_hours":2,"required_specializations":["chemistry"]}],
+		"teachers":[{"id":"00000000-0000-0000-0000-000000000004","specializations":["physics"]}],
+		"rooms":[{"id":"00000000-0000-0000-0000-000000000002","capacity":10}],
+		"slots":[{"id":"00000000-0000-0000-0000-000000000003","day_of_week":0,"start_period":1,"end_period":3}]}`
+	inst, err := ReadSnapshot(strings.NewReader(snapshot))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	report, err := Run(context.Background(), inst, RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected run error: %v", err)
+	}
+	if report.Status != StatusInfeasible || report.Feasible || !strings.Contains(report.Detail, "CHEM") {
+		t.Fatalf("expected an infeasible report naming CHEM, got %+v", report)
+	}
+}
@@ -0,0 +1,347 @@
+package benchmark
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ITCOptions tunes how an ITC-2007 instance maps onto the solver's model.
+type ITCOptions struct {
+	// IgnoreCapacity drops the room capacity requirement. ITC-2007 only
+	// penalises students over capacity, while here a room that is too small
+	// is ruled out, which makes some instances infeasible.
+	IgnoreCapacity bool
+}
+
+// itcNamespace seeds the name-based IDs of imported entities, so that the
+// same file always yields the same IDs and solver runs can be compared.
+var itcNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("itc2007:curriculum-based-course-timetabling"))
+
+func itcID(kind, name string) uuid.UUID {
+	return uuid.NewSHA1(itcNamespace, []byte(kind+":"+name))
+}
+
+// ReadITC2007 imports an instance of the ITC-2007 curriculum-based course
+// timetabling track (the .ctt format). The mapping keeps every hard
+// constraint of the competition and tightens some, so any schedule the
+// solver finds is feasible for ITC-2007 as well:
+//   - each day × period becomes a slot; slots span at least two periods,
+//     so ITC period p is periods 2p+1..2p+2;
+//   - each course is taught by its own teacher, through a specialization
+//     only that teacher has;
+//   - a course's unavailable periods make its teacher unavailable, since
+//     availability is per teacher here;
+//   - each curriculum becomes a student enrolled in all of its courses, so
+//     courses of one curriculum never overlap;
+//   - room capacity is a hard constraint unless opts.IgnoreCapacity is set;
+//   - lectures of a course fall on distinct days, so a course with more
+//     lectures than days is split into parts ("code/2", ...).
+//
+// The soft constraints of the competition (minimum working days, curriculum
+// compactness, room stability) are not imported; runs are scored with the
+// default soft constraints.
+func ReadITC2007(r io.Reader, opts ITCOptions) (*Instance, error) {
+	f, err := parseCTT(r)
+	if err != nil {
+		return nil, err
+	}
+	if f.days < 1 || f.days > 7 || f.periods < 1 {
+		return nil, fmt.Errorf("itc2007: %d days × %d periods is not a weekly timetable", f.days, f.periods)
+	}
+
+	inst := &Instance{Name: f.name}
+	for d := range f.days {
+		for p := range f.periods {
+			inst.Slots = append(inst.Slots, SlotSnapshot{
+				ID:          itcID("slot", fmt.Sprintf("%d.%d", d, p)),
+				DayOfWeek:   d,
+				StartPeriod: 2*p + 1,
+				EndPeriod:   2*p + 2,
+			})
+		}
+	}
+	for _, room := range f.rooms {
+		inst.Rooms = append(inst.Rooms, RoomSnapshot{ID: itcID("room", room.name), Name: room.name, Capacity: room.capacity})
+	}
+
+	// Teachers in order of first appearance, unavailable wherever one of
+	// their courses is
+	teacherIndex := make(map[string]int)
+	courseTeacher := make(map[string]string, len(f.courses))
+	var unavailable []map[[2]int]bool
+	for _, c := range f.courses {
+		courseTeacher[c.code] = c.teacher
+		if _, ok := teacherIndex[c.teacher]; ok {
+			continue
+		}
+		teacherIndex[c.teacher] = len(inst.Teachers)
+		inst.Teachers = append(inst.Teachers, TeacherSnapshot{
+			ID:              itcID("teacher", c.teacher),
+			FullName:        c.teacher,
+			Specializations: []string{"teacher:" + c.teacher},
+		})
+		unavailable = append(unavailable, map[[2]int]bool{})
+	}
+	for _, u := range f.unavailable {
+		teacher, ok := courseTeacher[u.course]
+		if !ok {
+			return nil, fmt.Errorf("itc2007: unavailability for unknown course %q", u.course)
+		}
+		if u.day >= f.days || u.period >= f.periods {
+			return nil, fmt.Errorf("itc2007: unavailability of %q at day %d period %d is outside the timetable", u.course, u.day, u.period)
+		}
+		unavailable[teacherIndex[teacher]][[2]int{u.day, u.period}] = true
+	}
+	for ti, blocked := range unavailable {
+		if len(blocked) == 0 {
+			continue
+		}
+		t := &inst.Teachers[ti]
+		for d := range f.days {
+			for p := range f.periods {
+				if !blocked[[2]int{d, p}] {
+					t.Availability = append(t.Availability, AvailabilitySnapshot{DayOfWeek: d, StartPeriod: 2*p + 1, EndPeriod: 2*p + 2})
+				}
+			}
+		}
+		if len(t.Availability) == 0 {
+			return nil, fmt.Errorf("itc2007: teacher %q is never available", t.FullName)
+		}
+	}
+
+	students := make(map[string][]uuid.UUID)
+	for _, cur := range f.curricula {
+		for _, code := range cur.courses {
+			if _, ok := courseTeacher[code]; !ok {
+				return nil, fmt.Errorf("itc2007: curriculum %q lists unknown course %q", cur.name, code)
+			}
+			students[code] = append(students[code], itcID("curriculum", cur.name))
+		}
+	}
+
+	for _, c := range f.courses {
+		minCapacity := c.students
+		if opts.IgnoreCapacity {
+			minCapacity = 0
+		}
+		for part, left := 1, c.lectures; left > 0; part++ {
+			code := c.code
+			if part > 1 {
+				code = fmt.Sprintf("%s/%d", c.code, part)
+			}
+			lectures := min(left, f.days)
+			left -= lectures
+			// Weekly hours count periods: each lecture fills a two-period slot
+			inst.Subjects = append(inst.Subjects, SubjectSnapshot{
+				ID:                      itcID("course", code),
+				Code:                    code,
+				WeeklyHours:             2 * lectures,
+				RequiredSpecializations: []string{"teacher:" + c.teacher},
+				MinRoomCapacity:         minCapacity,
+				EnrolledStudents:        students[c.code],
+			})
+		}
+	}
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+// cttFile is the raw content of a .ctt file.
+type cttFile struct {
+	name           string
+	days, periods  int
+	courses        []cttCourse
+	rooms          []cttRoom
+	curricula      []cttCurriculum
+	unavailable    []cttUnavailability
+	declaredCounts map[string]int // header counts, checked against the sections
+}
+
+type cttCourse struct {
+	code, teacher     string
+	lectures, minDays int
+	students          int
+}
+
+type cttRoom struct {
+	name     string
+	capacity int
+}
+
+type cttCurriculum struct {
+	name    string
+	courses []string
+}
+
+type cttUnavailability struct {
+	course      string
+	day, period int
+}
+
+var cttSections = map[string]bool{"COURSES": true, "ROOMS": true, "CURRICULA": true, "UNAVAILABILITY_CONSTRAINTS": true}
+
+// parseCTT reads the header ("Key: value" lines) and the COURSES, ROOMS,
+// CURRICULA and UNAVAILABILITY_CONSTRAINTS sections up to "END.".
+func parseCTT(r io.Reader) (*cttFile, error) {
+	f := &cttFile{declaredCounts: map[string]int{}}
+	sc := bufio.NewScanner(r)
+	section := ""
+	lineNo := 0
+	ended := false
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		if line == "END." {
+			ended = true
+			break
+		}
+		if strings.HasSuffix(line, ":") && !strings.Contains(line, " ") {
+			section = strings.TrimSuffix(line, ":")
+			if !cttSections[section] {
+				return nil, fmt.Errorf("itc2007: line %d: unknown section %q", lineNo, section)
+			}
+			continue
+		}
+		fields := strings.Fields(line)
+		var err error
+		switch section {
+		case "":
+			err = f.header(line)
+		case "COURSES":
+			err = f.course(fields)
+		case "ROOMS":
+			err = f.room(fields)
+		case "CURRICULA":
+			err = f.curriculum(fields)
+		case "UNAVAILABILITY_CONSTRAINTS":
+			err = f.unavailability(fields)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("itc2007: line %d: %w", lineNo, err)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("itc2007: %w", err)
+	}
+	if !ended {
+		return nil, fmt.Errorf("itc2007: missing END.")
+	}
+	for _, c := range []struct {
+		key string
+		got int
+	}{
+		{"Courses", len(f.courses)},
+		{"Rooms", len(f.rooms)},
+		{"Curricula", len(f.curricula)},
+		{"Constraints", len(f.unavailable)},
+	} {
+		if want, ok := f.declaredCounts[c.key]; ok && want != c.got {
+			return nil, fmt.Errorf("itc2007: header declares %d %s, found %d", want, strings.ToLower(c.key), c.got)
+		}
+	}
+	return f, nil
+}
+
+func (f *cttFile) header(line string) error {
+	key, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return fmt.Errorf("expected \"Key: value\", got %q", line)
+	}
+	value = strings.TrimSpace(value)
+	if key == "Name" {
+		f.name = value
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	switch key {
+	case "Days":
+		f.days = n
+	case "Periods_per_day":
+		f.periods = n
+	default:
+		f.declaredCounts[key] = n
+	}
+	return nil
+}
+
+func (f *cttFile) course(fields []string) error {
+	if len(fields) != 5 {
+		return fmt.Errorf("course needs 5 fields (id teacher lectures min_days students), got %d", len(fields))
+	}
+	n, err := atois(fields[2:])
+	if err != nil {
+		return err
+	}
+	if n[0] < 1 {
+		return fmt.Errorf("course %q has no lectures", fields[0])
+	}
+	f.courses = append(f.courses, cttCourse{code: fields[0], teacher: fields[1], lectures: n[0], minDays: n[1], students: n[2]})
+	return nil
+}
+
+func (f *cttFile) room(fields []string) error {
+	if len(fields) < 2 {
+		return fmt.Errorf("room needs an id and a capacity")
+	}
+	n, err := atois(fields[1:2])
+	if err != nil {
+		return err
+	}
+	f.rooms = append(f.rooms, cttRoom{name: fields[0], capacity: n[0]})
+	return nil
+}
+
+func (f *cttFile) curriculum(fields []string) error {
+	if len(fields) < 2 {
+		return fmt.Errorf("curriculum needs an id and a course count")
+	}
+	n, err := atois(fields[1:2])
+	if err != nil {
+		return err
+	}
+	if n[0] != len(fields)-2 {
+		return fmt.Errorf("curriculum %q declares %d courses, lists %d", fields[0], n[0], len(fields)-2)
+	}
+	f.curricula = append(f.curricula, cttCurriculum{name: fields[0], courses: fields[2:]})
+	return nil
+}
+
+func (f *cttFile) unavailability(fields []string) error {
+	if len(fields) != 3 {
+		return fmt.Errorf("unavailability needs 3 fields (course day period), got %d", len(fields))
+	}
+	n, err := atois(fields[1:])
+	if err != nil {
+		return err
+	}
+	if n[0] < 0 || n[1] < 0 {
+		return fmt.Errorf("negative day or period for %q", fields[0])
+	}
+	f.unavailable = append(f.unavailable, cttUnavailability{course: fields[0], day: n[0], period: n[1]})
+	return nil
+}
+
+func atois(fields []string) ([]int, error) {
+	n := make([]int, len(fields))
+	for i, s := range fields {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+		n[i] = v
+	}
+	return n, nil
+}
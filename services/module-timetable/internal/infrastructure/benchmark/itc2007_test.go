@@ -0,0 +1,147 @@
+package benchmark
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// toyCTT is the sample instance from the ITC-2007 track 3 description.
+const toyCTT = `Name: Toy
+Courses: 4
+Rooms: 3
+Days: 5
+Periods_per_day: 4
+Curricula: 2
+Constraints: 8
+
+COURSES:
+SceCosC Ocra 3 3 30
+ArcTec Indaco 3 2 42
+TecCos Rosa 5 4 40
+Geotec Scarlatti 5 4 18
+
+ROOMS:
+A 32
+B 50
+C 40
+
+CURRICULA:
+Cur1 3 SceCosC ArcTec TecCos
+Cur2 2 TecCos Geotec
+
+UNAVAILABILITY_CONSTRAINTS:
+TecCos 2 0
+TecCos 2 1
+TecCos 3 2
+TecCos 3 3
+ArcTec 4 0
+ArcTec 4 1
+ArcTec 4 2
+ArcTec 4 3
+
+END.
+`
+
+func TestReadITC2007Toy(t *testing.T) {
+	inst, err := ReadITC2007(strings.NewReader(toyCTT), ITCOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inst.Name != "Toy" || len(inst.Subjects) != 4 || len(inst.Teachers) != 4 || len(inst.Rooms) != 3 || len(inst.Slots) != 20 {
+		t.Fatalf("unexpected shape: name=%q subjects=%d teachers=%d rooms=%d slots=%d",
+			inst.Name, len(inst.Subjects), len(inst.Teachers), len(inst.Rooms), len(inst.Slots))
+	}
+	for _, teacher := range inst.Teachers {
+		want := 0
+		if teacher.FullName == "Rosa" || teacher.FullName == "Indaco" {
+			want = 16
+		}
+		if len(teacher.Availability) != want {
+			t.Fatalf("%s: expected %d available periods, got %d", teacher.FullName, want, len(teacher.Availability))
+		}
+	}
+	tecCos := inst.Subjects[2]
+	if tecCos.Code != "TecCos" || tecCos.WeeklyHours != 10 || tecCos.MinRoomCapacity != 40 || len(tecCos.EnrolledStudents) != 2 {
+		t.Fatalf("unexpected TecCos import: %+v", tecCos)
+	}
+
+	report, err := Run(context.Background(), inst, RunOptions{Timeout: 10 * time.Second, Seed: 1})
+	if err != nil {
+		t.Fatalf("unexpected run error: %v", err)
+	}
+	if !report.Feasible || report.Sessions != 16 || report.Placed != 16 || report.HardViolations != 0 {
+		t.Fatalf("expected a feasible schedule of 16 lectures, got %+v", report)
+	}
+	if report.Nodes < report.Sessions {
+		t.Fatalf("expected at least one node per session, got %d", report.Nodes)
+	}
+}
+
+func TestReadITC2007SplitsCoursesWithMoreLecturesThanDays(t *testing.T) {
+	const ctt = `Name: Short
+Courses: 1
+Rooms: 1
+Days: 2
+Periods_per_day: 3
+Curricula: 0
+Constraints: 0
+
+COURSES:
+Long Ann 5 2 10
+
+ROOMS:
+R 20
+
+END.
+`
+	inst, err := ReadITC2007(strings.NewReader(ctt), ITCOptions{IgnoreCapacity: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var codes []string
+	total := 0
+	for _, s := range inst.Subjects {
+		codes = append(codes, s.Code)
+		total += s.WeeklyHours / 2
+		if s.WeeklyHours > 4 || s.MinRoomCapacity != 0 {
+			t.Fatalf("part %s: expected at most 2 lectures and no capacity, got %+v", s.Code, s)
+		}
+	}
+	if strings.Join(codes, ",") != "Long,Long/2,Long/3" || total != 5 {
+		t.Fatalf("expected parts Long,Long/2,Long/3 with 5 lectures, got %v with %d", codes, total)
+	}
+
+	report, err := Run(context.Background(), inst, RunOptions{Timeout: 10 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected run error: %v", err)
+	}
+	if !report.Feasible {
+		t.Fatalf("expected a feasible schedule, got %+v", report)
+	}
+}
+
+func TestReadITC2007RejectsMalformedFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		replace [2]string
+		want    string
+	}{
+		{name: "count mismatch", replace: [2]string{"Courses: 4", "Courses: 5"}, want: "declares 5 courses"},
+		{name: "unknown section", replace: [2]string{"ROOMS:", "ROOM_CONSTRAINTS:"}, want: "unknown section"},
+		{name: "missing end", replace: [2]string{"END.", ""}, want: "missing END."},
+		{name: "unknown course", replace: [2]string{"Cur2 2 TecCos Geotec", "Cur2 2 TecCos Nope"}, want: "unknown course"},
+		{name: "bad course line", replace: [2]string{"Geotec Scarlatti 5 4 18", "Geotec Scarlatti five 4 18"}, want: "line 13"},
+		{name: "outside timetable", replace: [2]string{"TecCos 2 0", "TecCos 7 0"}, want: "outside the timetable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := strings.Replace(toyCTT, tt.replace[0], tt.replace[1], 1)
+			_, err := ReadITC2007(strings.NewReader(src), ITCOptions{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
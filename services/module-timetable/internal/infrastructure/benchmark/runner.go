@@ -0,0 +1,98 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+)
+
+// Run outcomes, from best to worst.
+const (
+	StatusFeasible   = "feasible"    // every session placed, no hard violation
+	StatusViolations = "violations"  // every session placed, some hard violation
+	StatusPartial    = "partial"     // the deadline hit before every session was placed
+	StatusInfeasible = "infeasible"  // pruning proved that no schedule exists
+	StatusNoSolution = "no_solution" // search gave up without placing anything
+)
+
+// RunOptions configures one benchmark run.
+type RunOptions struct {
+	Timeout     time.Duration // whole-run budget; 0 = no deadline
+	Seed        uint64
+	Portfolio   int  // parallel search strategies, as in schedule generation; < 1 = 1
+	LocalSearch bool // spend what is left of Timeout improving soft penalties
+}
+
+// Report is the outcome of one run of the solver on an instance.
+type Report struct {
+	Instance       string        `json:"instance"`
+	Subjects       int           `json:"subjects"`
+	Sessions       int           `json:"sessions"`
+	Seed           uint64        `json:"seed"`
+	Status         string        `json:"status"`
+	Feasible       bool          `json:"feasible"`
+	Placed         int           `json:"placed"`
+	Score          float64       `json:"score"`
+	SoftPenalty    float64       `json:"soft_penalty"`
+	HardViolations int           `json:"hard_violations"`
+	Nodes          int           `json:"nodes"`
+	WallTime       time.Duration `json:"wall_time_ns"`
+	Detail         string        `json:"detail,omitempty"` // why the instance is infeasible
+}
+
+// Run solves the instance the way schedule generation does and reports the
+// result. Hard violations are recounted on the final assignment rather than
+// taken from the solver, so that a solver bug shows up as a violation instead
+// of going unnoticed. The error is only set when the instance cannot be set
+// up; an infeasible instance is a report with StatusInfeasible.
+func Run(ctx context.Context, inst *Instance, opts RunOptions) (*Report, error) {
+	p, err := inst.problem()
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Instance: inst.Name, Subjects: len(inst.Subjects), Sessions: len(p.variables), Seed: opts.Seed}
+
+	solver := service.NewCSPSolverFromCandidates(p.variables, p.candidates, p.slots, p.checker)
+	if opts.LocalSearch {
+		solver.SetLocalSearch(&service.LocalSearchOptions{})
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	start := time.Now()
+	result, err := solver.SolvePortfolio(ctx, service.PortfolioStrategies(opts.Seed, opts.Portfolio))
+	report.WallTime = time.Since(start)
+
+	var infeasible *service.InfeasibleError
+	switch {
+	case errors.As(err, &infeasible):
+		report.Status = StatusInfeasible
+		report.Detail = infeasible.Error()
+		return report, nil
+	case errors.Is(err, service.ErrNoFeasibleSolution):
+		report.Status = StatusNoSolution
+		return report, nil
+	case err != nil:
+		return nil, err
+	}
+
+	report.Placed = len(result.Assignment)
+	report.Score = result.Score
+	report.SoftPenalty = result.SoftPenalty
+	report.HardViolations = len(p.checker.Violations(result.Assignment, p.slots))
+	report.Nodes = result.Nodes
+	switch {
+	case result.IsPartial:
+		report.Status = StatusPartial
+	case report.HardViolations > 0:
+		report.Status = StatusViolations
+	default:
+		report.Status = StatusFeasible
+		report.Feasible = true
+	}
+	return report, nil
+}
@@ -8,6 +8,7 @@ import (
 	hrv1 "github.com/HuynhHoangPhuc/myrmex/gen/go/hr/v1"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -31,6 +32,9 @@ func NewHRClientWithTeacherClient(teacher hrv1.TeacherServiceClient) *HRClient {
 	return &HRClient{teacher: teacher}
 }
 
+// defaultMaxHoursPerWeek applies to teachers whose HR record has no cap set.
+const defaultMaxHoursPerWeek = 20
+
 // ListTeachers fetches all active teachers from the HR module.
 func (c *HRClient) ListTeachers(ctx context.Context) ([]service.TeacherInfo, error) {
 	resp, err := c.teacher.ListTeachers(ctx, &hrv1.ListTeachersRequest{})
@@ -46,29 +50,42 @@ func (c *HRClient) ListTeachers(ctx context.Context) ([]service.TeacherInfo, err
 		if err != nil {
 			return nil, fmt.Errorf("parse teacher id %q: %w", t.Id, err)
 		}
+		maxHours := int(t.MaxHoursPerWeek)
+		if maxHours <= 0 {
+			maxHours = defaultMaxHoursPerWeek
+		}
 		result = append(result, service.TeacherInfo{
 			ID:              id,
 			FullName:        t.FullName,
-			MaxHoursPerWeek: 20, // default; specializations fetched separately
+			Specializations: t.Specializations,
+			MaxHoursPerWeek: maxHours,
 		})
 	}
 	return result, nil
 }
 
-// GetTeacherAvailability fetches availability slots for a single teacher.
-func (c *HRClient) GetTeacherAvailability(ctx context.Context, teacherID uuid.UUID) ([]*entity.TimeSlot, error) {
+// GetTeacherAvailability fetches availability slots, with their preference
+// level, for a single teacher.
+func (c *HRClient) GetTeacherAvailability(ctx context.Context, teacherID uuid.UUID) ([]service.AvailabilitySlot, error) {
 	resp, err := c.teacher.ListTeacherAvailability(ctx, &hrv1.ListTeacherAvailabilityRequest{
 		TeacherId: teacherID.String(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("get availability for teacher %s: %w", teacherID, err)
 	}
-	slots := make([]*entity.TimeSlot, 0, len(resp.AvailableSlots))
+	slots := make([]service.AvailabilitySlot, 0, len(resp.AvailableSlots))
 	for _, s := range resp.AvailableSlots {
-		slots = append(slots, &entity.TimeSlot{
-			DayOfWeek:   int(s.DayOfWeek),
-			StartPeriod: int(s.StartPeriod),
-			EndPeriod:   int(s.EndPeriod),
+		pref := valueobject.TeacherPreference(s.Preference)
+		if pref == "" {
+			pref = valueobject.TeacherPreferenceNeutral
+		}
+		slots = append(slots, service.AvailabilitySlot{
+			Slot: &entity.TimeSlot{
+				DayOfWeek:   int(s.DayOfWeek),
+				StartPeriod: int(s.StartPeriod),
+				EndPeriod:   int(s.EndPeriod),
+			},
+			Preference: pref,
 		})
 	}
 	return slots, nil
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	corev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/core/v1"
+	studentv1 "github.com/HuynhHoangPhuc/myrmex/gen/go/student/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// enrollmentPageSize is how many enrollment requests are fetched per call.
+const enrollmentPageSize = 200
+
+// StudentClient wraps the Student module gRPC connection.
+type StudentClient struct {
+	student studentv1.StudentServiceClient
+}
+
+// NewStudentClient dials the Student gRPC server.
+func NewStudentClient(addr string) (*StudentClient, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dial student service %s: %w", addr, err)
+	}
+	return &StudentClient{student: studentv1.NewStudentServiceClient(conn)}, nil
+}
+
+// NewStudentClientWithStudentClient constructs a client from a pre-built gRPC client.
+func NewStudentClientWithStudentClient(student studentv1.StudentServiceClient) *StudentClient {
+	return &StudentClient{student: student}
+}
+
+// ListApprovedEnrollments returns subject_id -> enrolled student IDs for the
+// semester, counting only approved enrollment requests.
+func (c *StudentClient) ListApprovedEnrollments(ctx context.Context, semesterID uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	semester := semesterID.String()
+	approved := "approved"
+	enrolled := map[uuid.UUID][]uuid.UUID{}
+	for page, seen := int32(1), int32(0); ; page++ {
+		resp, err := c.student.ListEnrollmentRequests(ctx, &studentv1.ListEnrollmentRequestsRequest{
+			Pagination: &corev1.PaginationRequest{Page: page, PageSize: enrollmentPageSize},
+			SemesterId: &semester,
+			Status:     &approved,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list approved enrollments: %w", err)
+		}
+		for _, e := range resp.Enrollments {
+			subjectID, err := uuid.Parse(e.SubjectId)
+			if err != nil {
+				return nil, fmt.Errorf("parse subject id %q: %w", e.SubjectId, err)
+			}
+			studentID, err := uuid.Parse(e.StudentId)
+			if err != nil {
+				return nil, fmt.Errorf("parse student id %q: %w", e.StudentId, err)
+			}
+			enrolled[subjectID] = append(enrolled[subjectID], studentID)
+		}
+		seen += int32(len(resp.Enrollments))
+		if len(resp.Enrollments) < enrollmentPageSize || seen >= resp.GetPagination().GetTotal() {
+			return enrolled, nil
+		}
+	}
+}
@@ -17,7 +17,11 @@ type SubjectInfo struct {
 	Name                    string
 	DepartmentID            uuid.UUID
 	Credits                 int
+	WeeklyHours             int      // teaching periods per week; 0 when not configured
 	RequiredSpecializations []string // sourced from department mapping (empty if none)
+	MinRoomCapacity         int      // 0 when any room size is fine
+	RequiredRoomType        string   // empty when any room type is fine
+	RequiredRoomFeatures    []string // features every assigned room must have
 }
 
 // SubjectClient wraps the Subject module gRPC connection.
@@ -75,11 +79,34 @@ func (c *SubjectClient) ListSubjectsByIDs(ctx context.Context, ids []uuid.UUID)
 			Name:         s.Name,
 			DepartmentID: deptID,
 			Credits:      int(s.Credits),
+			WeeklyHours:  int(s.WeeklyHours),
+
+			MinRoomCapacity:      int(s.GetRoomRequirements().GetMinCapacity()),
+			RequiredRoomType:     s.GetRoomRequirements().GetRoomType(),
+			RequiredRoomFeatures: s.GetRoomRequirements().GetFeatures(),
 		})
 	}
 	return result, nil
 }
 
+// ListEnrollmentCaps returns the maximum enrollment of each subject offered in
+// the semester. Subjects without an offering are absent.
+func (c *SubjectClient) ListEnrollmentCaps(ctx context.Context, semesterID uuid.UUID) (map[uuid.UUID]int, error) {
+	resp, err := c.subject.ListSemesterOfferings(ctx, &subjectv1.ListSemesterOfferingsRequest{SemesterId: semesterID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("list semester offerings: %w", err)
+	}
+	caps := make(map[uuid.UUID]int, len(resp.Offerings))
+	for _, o := range resp.Offerings {
+		id, err := uuid.Parse(o.SubjectId)
+		if err != nil {
+			return nil, fmt.Errorf("parse subject id %q: %w", o.SubjectId, err)
+		}
+		caps[id] = max(caps[id], int(o.MaxEnrollment))
+	}
+	return caps, nil
+}
+
 // TopologicalSort returns subject IDs ordered by prerequisite DAG (dependencies first).
 func (c *SubjectClient) TopologicalSort(ctx context.Context) ([]uuid.UUID, error) {
 	resp, err := c.prerequisite.TopologicalSort(ctx, &subjectv1.TopologicalSortRequest{})
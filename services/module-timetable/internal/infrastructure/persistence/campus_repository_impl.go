@@ -0,0 +1,132 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/persistence/sqlc"
+)
+
+// CampusRepositoryImpl implements domain/repository.CampusRepository.
+type CampusRepositoryImpl struct {
+	q *sqlc.Queries
+}
+
+func NewCampusRepository(q *sqlc.Queries) *CampusRepositoryImpl {
+	return &CampusRepositoryImpl{q: q}
+}
+
+func (r *CampusRepositoryImpl) CreateCampus(ctx context.Context, c *entity.Campus) (*entity.Campus, error) {
+	row, err := r.q.CreateCampus(ctx, c.Code, c.Name)
+	if err != nil {
+		return nil, locationWriteError("create campus", err)
+	}
+	return campusToEntity(row), nil
+}
+
+func (r *CampusRepositoryImpl) GetCampusByID(ctx context.Context, id uuid.UUID) (*entity.Campus, error) {
+	row, err := r.q.GetCampusByID(ctx, uuidToPg(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrCampusNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get campus: %w", err)
+	}
+	return campusToEntity(row), nil
+}
+
+func (r *CampusRepositoryImpl) ListCampuses(ctx context.Context) ([]*entity.Campus, error) {
+	rows, err := r.q.ListCampuses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list campuses: %w", err)
+	}
+	result := make([]*entity.Campus, len(rows))
+	for i, row := range rows {
+		result[i] = campusToEntity(row)
+	}
+	return result, nil
+}
+
+func (r *CampusRepositoryImpl) CreateBuilding(ctx context.Context, b *entity.Building) (*entity.Building, error) {
+	row, err := r.q.CreateBuilding(ctx, uuidToPg(b.CampusID), b.Code, b.Name)
+	if err != nil {
+		return nil, locationWriteError("create building", err)
+	}
+	return buildingToEntity(row), nil
+}
+
+func (r *CampusRepositoryImpl) GetBuildingByID(ctx context.Context, id uuid.UUID) (*entity.Building, error) {
+	row, err := r.q.GetBuildingByID(ctx, uuidToPg(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrBuildingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get building: %w", err)
+	}
+	return buildingToEntity(row), nil
+}
+
+func (r *CampusRepositoryImpl) ListBuildings(ctx context.Context, campusID uuid.UUID) ([]*entity.Building, error) {
+	rows, err := r.q.ListBuildings(ctx, uuidToPg(campusID))
+	if err != nil {
+		return nil, fmt.Errorf("list buildings: %w", err)
+	}
+	result := make([]*entity.Building, len(rows))
+	for i, row := range rows {
+		result[i] = buildingToEntity(row)
+	}
+	return result, nil
+}
+
+func (r *CampusRepositoryImpl) SetTravelTime(ctx context.Context, a, b uuid.UUID, minutes int) error {
+	pair := entity.NewCampusPair(a, b)
+	if err := r.q.UpsertCampusTravelTime(ctx, uuidToPg(pair.A), uuidToPg(pair.B), int32(minutes)); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return repository.ErrCampusNotFound
+		}
+		return fmt.Errorf("set campus travel time: %w", err)
+	}
+	return nil
+}
+
+func (r *CampusRepositoryImpl) TravelMatrix(ctx context.Context) (entity.TravelMatrix, error) {
+	rows, err := r.q.ListCampusTravelTimes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list campus travel times: %w", err)
+	}
+	m := make(entity.TravelMatrix, len(rows))
+	for _, row := range rows {
+		m[entity.NewCampusPair(pgToUUID(row.FromCampusID), pgToUUID(row.ToCampusID))] = int(row.Minutes)
+	}
+	return m, nil
+}
+
+// locationWriteError maps a duplicate code to ErrLocationCodeTaken and a
+// missing campus to ErrCampusNotFound.
+func locationWriteError(op string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return repository.ErrLocationCodeTaken
+		case foreignKeyViolation:
+			return repository.ErrCampusNotFound
+		}
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
+
+func campusToEntity(c sqlc.TimetableCampus) *entity.Campus {
+	return &entity.Campus{ID: pgToUUID(c.ID), Code: c.Code, Name: c.Name}
+}
+
+func buildingToEntity(b sqlc.TimetableBuilding) *entity.Building {
+	return &entity.Building{ID: pgToUUID(b.ID), CampusID: pgToUUID(b.CampusID), Code: b.Code, Name: b.Name}
+}
@@ -0,0 +1,101 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/persistence/sqlc"
+)
+
+// ExamScheduleRepositoryImpl implements domain/repository.ExamScheduleRepository.
+type ExamScheduleRepositoryImpl struct {
+	q *sqlc.Queries
+}
+
+func NewExamScheduleRepository(q *sqlc.Queries) *ExamScheduleRepositoryImpl {
+	return &ExamScheduleRepositoryImpl{q: q}
+}
+
+func (r *ExamScheduleRepositoryImpl) Create(ctx context.Context, e *entity.ExamSchedule) (*entity.ExamSchedule, error) {
+	settings, err := json.Marshal(e.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("encode exam settings: %w", err)
+	}
+	entries := e.Entries
+	if entries == nil {
+		entries = []entity.ExamEntry{}
+	}
+	encoded, err := json.Marshal(entries)
+	if err != nil {
+		return nil, fmt.Errorf("encode exam entries: %w", err)
+	}
+	row, err := r.q.CreateExamSchedule(ctx, sqlc.CreateExamScheduleParams{
+		SemesterID:            uuidToPg(e.SemesterID),
+		Name:                  e.Name,
+		StartDate:             timeToPgDate(e.StartDate),
+		EndDate:               timeToPgDate(e.EndDate),
+		Settings:              settings,
+		Score:                 e.Score,
+		SoftPenalty:           e.SoftPenalty,
+		Entries:               encoded,
+		UnscheduledSubjectIDs: uuidsToPostgres(e.Unscheduled),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create exam schedule: %w", err)
+	}
+	return examScheduleToEntity(row)
+}
+
+func (r *ExamScheduleRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.ExamSchedule, error) {
+	row, err := r.q.GetExamScheduleByID(ctx, uuidToPg(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrExamScheduleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get exam schedule: %w", err)
+	}
+	return examScheduleToEntity(row)
+}
+
+func (r *ExamScheduleRepositoryImpl) ListBySemester(ctx context.Context, semesterID uuid.UUID) ([]*entity.ExamSchedule, error) {
+	rows, err := r.q.ListExamSchedules(ctx, uuidToPg(semesterID))
+	if err != nil {
+		return nil, fmt.Errorf("list exam schedules: %w", err)
+	}
+	result := make([]*entity.ExamSchedule, len(rows))
+	for i, row := range rows {
+		if result[i], err = examScheduleToEntity(row); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func examScheduleToEntity(r sqlc.TimetableExamSchedule) (*entity.ExamSchedule, error) {
+	e := &entity.ExamSchedule{
+		ID:          pgToUUID(r.ID),
+		SemesterID:  pgToUUID(r.SemesterID),
+		Name:        r.Name,
+		StartDate:   r.StartDate.Time,
+		EndDate:     r.EndDate.Time,
+		Score:       r.Score,
+		SoftPenalty: r.SoftPenalty,
+		CreatedAt:   r.CreatedAt.Time,
+	}
+	for _, id := range r.UnscheduledSubjectIDs {
+		e.Unscheduled = append(e.Unscheduled, pgToUUID(id))
+	}
+	if err := json.Unmarshal(r.Settings, &e.Settings); err != nil {
+		return nil, fmt.Errorf("decode exam settings: %w", err)
+	}
+	if err := json.Unmarshal(r.Entries, &e.Entries); err != nil {
+		return nil, fmt.Errorf("decode exam entries: %w", err)
+	}
+	return e, nil
+}
@@ -0,0 +1,128 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/persistence/sqlc"
+)
+
+// GenerationJobRepositoryImpl implements domain/repository.GenerationJobRepository.
+type GenerationJobRepositoryImpl struct {
+	q *sqlc.Queries
+}
+
+func NewGenerationJobRepository(q *sqlc.Queries) *GenerationJobRepositoryImpl {
+	return &GenerationJobRepositoryImpl{q: q}
+}
+
+func (r *GenerationJobRepositoryImpl) Create(ctx context.Context, job *entity.GenerationJob) (*entity.GenerationJob, error) {
+	row, err := r.q.CreateGenerationJob(ctx, uuidToPg(job.ScheduleID), uuidToPg(job.SemesterID), job.Params, int32(job.MaxAttempts))
+	if err != nil {
+		return nil, fmt.Errorf("create generation job: %w", err)
+	}
+	return generationJobToEntity(row), nil
+}
+
+func (r *GenerationJobRepositoryImpl) GetBySchedule(ctx context.Context, scheduleID uuid.UUID) (*entity.GenerationJob, error) {
+	row, err := r.q.GetGenerationJobBySchedule(ctx, uuidToPg(scheduleID))
+	if err != nil {
+		return nil, fmt.Errorf("get generation job: %w", err)
+	}
+	return generationJobToEntity(row), nil
+}
+
+func (r *GenerationJobRepositoryImpl) Claim(ctx context.Context, owner string, lease time.Duration) (*entity.GenerationJob, error) {
+	row, err := r.q.ClaimGenerationJob(ctx, owner, lease.Seconds())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("claim generation job: %w", err)
+	}
+	return generationJobToEntity(row), nil
+}
+
+func (r *GenerationJobRepositoryImpl) RenewLease(ctx context.Context, id uuid.UUID, owner string, lease time.Duration) (bool, error) {
+	cancelRequested, err := r.q.RenewGenerationJobLease(ctx, uuidToPg(id), owner, lease.Seconds())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, repository.ErrLeaseLost
+	}
+	if err != nil {
+		return false, fmt.Errorf("renew generation job lease: %w", err)
+	}
+	return cancelRequested, nil
+}
+
+func (r *GenerationJobRepositoryImpl) Finish(ctx context.Context, id uuid.UUID, owner string, status valueobject.JobStatus, errMsg string) error {
+	if err := r.q.FinishGenerationJob(ctx, uuidToPg(id), owner, status.String(), errMsg); err != nil {
+		return fmt.Errorf("finish generation job: %w", err)
+	}
+	return nil
+}
+
+func (r *GenerationJobRepositoryImpl) RequestCancel(ctx context.Context, scheduleID uuid.UUID) (*entity.GenerationJob, error) {
+	row, err := r.q.RequestGenerationJobCancel(ctx, uuidToPg(scheduleID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrNoActiveJob
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cancel generation job: %w", err)
+	}
+	return generationJobToEntity(row), nil
+}
+
+func (r *GenerationJobRepositoryImpl) Release(ctx context.Context, owner string, refund bool) (int, error) {
+	n, err := r.q.ReleaseGenerationJobs(ctx, owner, refund)
+	if err != nil {
+		return 0, fmt.Errorf("release generation jobs: %w", err)
+	}
+	return int(n), nil
+}
+
+func (r *GenerationJobRepositoryImpl) Reap(ctx context.Context) ([]*entity.GenerationJob, error) {
+	rows, err := r.q.ReapGenerationJobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reap generation jobs: %w", err)
+	}
+	result := make([]*entity.GenerationJob, len(rows))
+	for i, row := range rows {
+		result[i] = generationJobToEntity(row)
+	}
+	return result, nil
+}
+
+func generationJobToEntity(r sqlc.TimetableGenerationJob) *entity.GenerationJob {
+	return &entity.GenerationJob{
+		ID:              pgToUUID(r.ID),
+		ScheduleID:      pgToUUID(r.ScheduleID),
+		SemesterID:      pgToUUID(r.SemesterID),
+		Status:          valueobject.JobStatus(r.Status),
+		Params:          r.Params,
+		Attempts:        int(r.Attempts),
+		MaxAttempts:     int(r.MaxAttempts),
+		LeaseOwner:      r.LeaseOwner,
+		LeaseExpiresAt:  pgTimePtr(r.LeaseExpiresAt),
+		CancelRequested: r.CancelRequested,
+		ErrorMessage:    r.ErrorMessage,
+		CreatedAt:       r.CreatedAt.Time,
+		StartedAt:       pgTimePtr(r.StartedAt),
+		FinishedAt:      pgTimePtr(r.FinishedAt),
+	}
+}
+
+func pgTimePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
@@ -0,0 +1,134 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/persistence/sqlc"
+)
+
+// exclusionViolation is the SQLSTATE raised by room_bookings_no_overlap.
+const exclusionViolation = "23P01"
+
+// RoomBookingRepositoryImpl implements domain/repository.RoomBookingRepository.
+type RoomBookingRepositoryImpl struct {
+	q *sqlc.Queries
+}
+
+func NewRoomBookingRepository(q *sqlc.Queries) *RoomBookingRepositoryImpl {
+	return &RoomBookingRepositoryImpl{q: q}
+}
+
+func (r *RoomBookingRepositoryImpl) Create(ctx context.Context, b *entity.RoomBooking) (*entity.RoomBooking, error) {
+	row, err := r.q.CreateRoomBooking(ctx, sqlc.CreateRoomBookingParams{
+		RoomID:      uuidToPg(b.RoomID),
+		Title:       b.Title,
+		Purpose:     b.Purpose,
+		RequestedBy: uuidToPg(b.RequestedBy),
+		StartDate:   timeToPgDate(b.StartDate),
+		EndDate:     timeToPgDate(b.EndDate),
+		StartPeriod: int32(b.StartPeriod),
+		EndPeriod:   int32(b.EndPeriod),
+		Status:      b.Status.String(),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == exclusionViolation {
+			return nil, repository.ErrRoomBookingOverlap
+		}
+		return nil, fmt.Errorf("create room booking: %w", err)
+	}
+	return roomBookingToEntity(row), nil
+}
+
+func (r *RoomBookingRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.RoomBooking, error) {
+	row, err := r.q.GetRoomBookingByID(ctx, uuidToPg(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrRoomBookingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get room booking: %w", err)
+	}
+	return roomBookingToEntity(row), nil
+}
+
+func (r *RoomBookingRepositoryImpl) List(ctx context.Context, f repository.RoomBookingFilter) ([]*entity.RoomBooking, error) {
+	p := sqlc.ListRoomBookingsParams{
+		RoomID:      uuidToPg(f.RoomID),
+		RequestedBy: uuidToPg(f.RequestedBy),
+	}
+	for _, s := range f.Statuses {
+		p.Statuses = append(p.Statuses, s.String())
+	}
+	if !f.From.IsZero() {
+		p.From = timeToPgDate(f.From)
+	}
+	if !f.To.IsZero() {
+		p.To = timeToPgDate(f.To)
+	}
+	rows, err := r.q.ListRoomBookings(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("list room bookings: %w", err)
+	}
+	result := make([]*entity.RoomBooking, len(rows))
+	for i, row := range rows {
+		result[i] = roomBookingToEntity(row)
+	}
+	return result, nil
+}
+
+func (r *RoomBookingRepositoryImpl) UpdateStatus(ctx context.Context, b *entity.RoomBooking, from valueobject.BookingStatus) (*entity.RoomBooking, error) {
+	p := sqlc.UpdateRoomBookingStatusParams{
+		ID:         uuidToPg(b.ID),
+		Status:     b.Status.String(),
+		ReviewNote: b.ReviewNote,
+		FromStatus: from.String(),
+	}
+	if b.ReviewedBy != uuid.Nil {
+		p.ReviewedBy = uuidToPg(b.ReviewedBy)
+	}
+	if !b.ReviewedAt.IsZero() {
+		p.ReviewedAt = pgtype.Timestamptz{Time: b.ReviewedAt, Valid: true}
+	}
+	row, err := r.q.UpdateRoomBookingStatus(ctx, p)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrRoomBookingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("update room booking: %w", err)
+	}
+	return roomBookingToEntity(row), nil
+}
+
+func roomBookingToEntity(r sqlc.TimetableRoomBooking) *entity.RoomBooking {
+	b := &entity.RoomBooking{
+		ID:          pgToUUID(r.ID),
+		RoomID:      pgToUUID(r.RoomID),
+		RoomName:    r.RoomName,
+		Title:       r.Title,
+		Purpose:     r.Purpose,
+		RequestedBy: pgToUUID(r.RequestedBy),
+		StartDate:   r.StartDate.Time,
+		EndDate:     r.EndDate.Time,
+		StartPeriod: int(r.StartPeriod),
+		EndPeriod:   int(r.EndPeriod),
+		Status:      valueobject.BookingStatus(r.Status),
+		ReviewNote:  r.ReviewNote,
+		CreatedAt:   r.CreatedAt.Time,
+	}
+	if r.ReviewedBy.Valid {
+		b.ReviewedBy = pgToUUID(r.ReviewedBy)
+	}
+	if r.ReviewedAt.Valid {
+		b.ReviewedAt = r.ReviewedAt.Time
+	}
+	return b
+}
@@ -2,13 +2,24 @@ package persistence
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/persistence/sqlc"
 )
 
+// SQLSTATEs of unique and foreign key violations.
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
 // RoomRepositoryImpl implements domain/repository.RoomRepository.
 type RoomRepositoryImpl struct {
 	q *sqlc.Queries
@@ -20,19 +31,24 @@ func NewRoomRepository(q *sqlc.Queries) *RoomRepositoryImpl {
 
 func (r *RoomRepositoryImpl) Create(ctx context.Context, room *entity.Room) (*entity.Room, error) {
 	row, err := r.q.CreateRoom(ctx, sqlc.CreateRoomParams{
-		Name:     room.Name,
-		Capacity: int32(room.Capacity),
-		Type:     room.Type,
-		Features: room.Features,
+		Name:             room.Name,
+		Capacity:         int32(room.Capacity),
+		Type:             room.Type,
+		Features:         room.Features,
+		RequiresApproval: room.RequiresApproval,
+		BuildingID:       optionalUUIDToPg(room.BuildingID),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("create room: %w", err)
+		return nil, roomWriteError("create room", err)
 	}
 	return roomToEntity(row), nil
 }
 
 func (r *RoomRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Room, error) {
 	row, err := r.q.GetRoomByID(ctx, uuidToPg(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrRoomNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("get room: %w", err)
 	}
@@ -40,7 +56,15 @@ func (r *RoomRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity
 }
 
 func (r *RoomRepositoryImpl) List(ctx context.Context, limit, offset int32) ([]*entity.Room, error) {
-	rows, err := r.q.ListRooms(ctx, limit, offset)
+	return r.list(ctx, false, limit, offset)
+}
+
+func (r *RoomRepositoryImpl) ListAll(ctx context.Context, limit, offset int32) ([]*entity.Room, error) {
+	return r.list(ctx, true, limit, offset)
+}
+
+func (r *RoomRepositoryImpl) list(ctx context.Context, includeInactive bool, limit, offset int32) ([]*entity.Room, error) {
+	rows, err := r.q.ListRooms(ctx, includeInactive, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("list rooms: %w", err)
 	}
@@ -55,13 +79,77 @@ func (r *RoomRepositoryImpl) Count(ctx context.Context) (int64, error) {
 	return r.q.CountRooms(ctx)
 }
 
+func (r *RoomRepositoryImpl) Update(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+	row, err := r.q.UpdateRoom(ctx, sqlc.UpdateRoomParams{
+		ID:               uuidToPg(room.ID),
+		Name:             room.Name,
+		Capacity:         int32(room.Capacity),
+		Type:             room.Type,
+		Features:         room.Features,
+		RequiresApproval: room.RequiresApproval,
+		BuildingID:       optionalUUIDToPg(room.BuildingID),
+		IsActive:         room.IsActive,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrRoomNotFound
+	}
+	if err != nil {
+		return nil, roomWriteError("update room", err)
+	}
+	return roomToEntity(row), nil
+}
+
+func (r *RoomRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	n, err := r.q.DeleteRoom(ctx, uuidToPg(id))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return fmt.Errorf("%w: schedules or bookings refer to it", repository.ErrRoomInUse)
+		}
+		return fmt.Errorf("delete room: %w", err)
+	}
+	if n == 0 {
+		return repository.ErrRoomNotFound
+	}
+	return nil
+}
+
+func (r *RoomRepositoryImpl) CountPublishedEntries(ctx context.Context, id uuid.UUID) (int64, error) {
+	n, err := r.q.CountPublishedRoomEntries(ctx, uuidToPg(id))
+	if err != nil {
+		return 0, fmt.Errorf("count published room entries: %w", err)
+	}
+	return n, nil
+}
+
+// roomWriteError maps a duplicate name to ErrRoomNameTaken.
+func roomWriteError(op string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return repository.ErrRoomNameTaken
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
+
 func roomToEntity(r sqlc.TimetableRoom) *entity.Room {
 	return &entity.Room{
-		ID:       pgToUUID(r.ID),
-		Name:     r.Name,
-		Capacity: int(r.Capacity),
-		Type:     r.Type,
-		Features: r.Features,
-		IsActive: r.IsActive,
+		ID:               pgToUUID(r.ID),
+		Name:             r.Name,
+		Capacity:         int(r.Capacity),
+		Type:             r.Type,
+		Features:         r.Features,
+		IsActive:         r.IsActive,
+		RequiresApproval: r.RequiresApproval,
+		BuildingID:       pgToUUID(r.BuildingID),
+		BuildingCode:     r.BuildingCode.String,
+		CampusID:         pgToUUID(r.CampusID),
+	}
+}
+
+// optionalUUIDToPg maps uuid.Nil to SQL NULL.
+func optionalUUIDToPg(id uuid.UUID) pgtype.UUID {
+	if id == uuid.Nil {
+		return pgtype.UUID{}
 	}
+	return uuidToPg(id)
 }
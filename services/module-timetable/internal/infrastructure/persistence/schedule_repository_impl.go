@@ -3,12 +3,15 @@ package persistence
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/persistence/sqlc"
 )
@@ -86,8 +89,41 @@ func (r *ScheduleRepositoryImpl) UpdateStatus(ctx context.Context, id uuid.UUID,
 	return scheduleToEntity(row), nil
 }
 
+func (r *ScheduleRepositoryImpl) UpdateFailure(ctx context.Context, id uuid.UUID, reason string, diagnosis *entity.GenerationDiagnosis) (*entity.Schedule, error) {
+	var raw []byte
+	if diagnosis != nil {
+		var err error
+		raw, err = json.Marshal(diagnosis)
+		if err != nil {
+			return nil, fmt.Errorf("marshal diagnosis: %w", err)
+		}
+	}
+	row, err := r.q.UpdateScheduleFailure(ctx, uuidToPg(id), reason, raw)
+	if err != nil {
+		return nil, fmt.Errorf("update schedule failure: %w", err)
+	}
+	return scheduleToEntity(row), nil
+}
+
+func (r *ScheduleRepositoryImpl) UpdateCohortClashes(ctx context.Context, id uuid.UUID, clashes int) (*entity.Schedule, error) {
+	row, err := r.q.UpdateScheduleCohortClashes(ctx, uuidToPg(id), int32(clashes))
+	if err != nil {
+		return nil, fmt.Errorf("update schedule cohort clashes: %w", err)
+	}
+	return scheduleToEntity(row), nil
+}
+
 func (r *ScheduleRepositoryImpl) CreateEntry(ctx context.Context, e *entity.ScheduleEntry) (*entity.ScheduleEntry, error) {
-	row, err := r.q.CreateScheduleEntry(ctx, sqlc.CreateScheduleEntryParams{
+	return createEntry(ctx, r.q, e)
+}
+
+// createEntry inserts an entry with q, which may be bound to a transaction.
+func createEntry(ctx context.Context, q *sqlc.Queries, e *entity.ScheduleEntry) (*entity.ScheduleEntry, error) {
+	rawAssistants, err := marshalAssistants(e.Assistants)
+	if err != nil {
+		return nil, err
+	}
+	row, err := q.CreateScheduleEntry(ctx, sqlc.CreateScheduleEntryParams{
 		ScheduleID:       uuidToPg(e.ScheduleID),
 		SubjectID:        uuidToPg(e.SubjectID),
 		TeacherID:        uuidToPg(e.TeacherID),
@@ -98,6 +134,8 @@ func (r *ScheduleRepositoryImpl) CreateEntry(ctx context.Context, e *entity.Sche
 		SubjectCode:      e.SubjectCode,
 		TeacherName:      e.TeacherName,
 		DepartmentID:     uuidToPg(e.DepartmentID),
+		Section:          int32(e.SectionNumber()),
+		Assistants:       rawAssistants,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create schedule entry: %w", err)
@@ -126,10 +164,18 @@ func (r *ScheduleRepositoryImpl) ListEntries(ctx context.Context, scheduleID uui
 }
 
 func (r *ScheduleRepositoryImpl) UpdateEntry(ctx context.Context, e *entity.ScheduleEntry) (*entity.ScheduleEntry, error) {
+	rawAssistants, err := marshalAssistants(e.Assistants)
+	if err != nil {
+		return nil, err
+	}
 	row, err := r.q.UpdateScheduleEntry(ctx,
 		uuidToPg(e.ID), uuidToPg(e.TeacherID), uuidToPg(e.RoomID),
-		uuidToPg(e.TimeSlotID), e.IsManualOverride)
+		uuidToPg(e.TimeSlotID), e.IsManualOverride, e.TeacherName, rawAssistants)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return nil, repository.ErrSlotTaken
+		}
 		return nil, fmt.Errorf("update entry: %w", err)
 	}
 	return entryToEntity(row), nil
@@ -149,6 +195,18 @@ func (r *ScheduleRepositoryImpl) AppendEvent(ctx context.Context, aggregateID uu
 
 // --- mapping helpers ---
 
+// marshalAssistants encodes an entry's assistants; none is stored as [].
+func marshalAssistants(assistants []entity.EntryTeacher) ([]byte, error) {
+	if assistants == nil {
+		assistants = []entity.EntryTeacher{}
+	}
+	raw, err := json.Marshal(assistants)
+	if err != nil {
+		return nil, fmt.Errorf("marshal assistants: %w", err)
+	}
+	return raw, nil
+}
+
 func scheduleToEntity(r sqlc.TimetableSchedule) *entity.Schedule {
 	s := &entity.Schedule{
 		ID:             pgToUUID(r.ID),
@@ -158,6 +216,8 @@ func scheduleToEntity(r sqlc.TimetableSchedule) *entity.Schedule {
 		HardViolations: int(r.HardViolations),
 		SoftPenalty:    r.SoftPenalty,
 		CreatedAt:      r.CreatedAt.Time,
+		FailureReason:  r.FailureReason,
+		CohortClashes:  int(r.CohortClashes),
 	}
 	if r.Score != nil {
 		s.Score = *r.Score
@@ -166,14 +226,21 @@ func scheduleToEntity(r sqlc.TimetableSchedule) *entity.Schedule {
 		t := r.GeneratedAt.Time
 		s.GeneratedAt = &t
 	}
+	if len(r.Diagnosis) > 0 {
+		var d entity.GenerationDiagnosis
+		if err := json.Unmarshal(r.Diagnosis, &d); err == nil {
+			s.Diagnosis = &d
+		}
+	}
 	return s
 }
 
 func entryToEntity(r sqlc.TimetableScheduleEntry) *entity.ScheduleEntry {
-	return &entity.ScheduleEntry{
+	e := &entity.ScheduleEntry{
 		ID:               pgToUUID(r.ID),
 		ScheduleID:       pgToUUID(r.ScheduleID),
 		SubjectID:        pgToUUID(r.SubjectID),
+		Section:          int(r.Section),
 		TeacherID:        pgToUUID(r.TeacherID),
 		RoomID:           pgToUUID(r.RoomID),
 		TimeSlotID:       pgToUUID(r.TimeSlotID),
@@ -184,6 +251,10 @@ func entryToEntity(r sqlc.TimetableScheduleEntry) *entity.ScheduleEntry {
 		TeacherName:      r.TeacherName,
 		DepartmentID:     pgToUUID(r.DepartmentID),
 	}
+	if len(r.Assistants) > 0 {
+		_ = json.Unmarshal(r.Assistants, &e.Assistants)
+	}
+	return e
 }
 
 func enrichedEntryToEntity(r sqlc.TimetableScheduleEntryRow) *entity.ScheduleEntry {
@@ -0,0 +1,178 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/persistence/sqlc"
+)
+
+// ScheduleVersionRepositoryImpl implements domain/repository.ScheduleVersionRepository.
+type ScheduleVersionRepositoryImpl struct {
+	q *sqlc.Queries
+}
+
+func NewScheduleVersionRepository(q *sqlc.Queries) *ScheduleVersionRepositoryImpl {
+	return &ScheduleVersionRepositoryImpl{q: q}
+}
+
+func (r *ScheduleVersionRepositoryImpl) Publish(ctx context.Context, scheduleID uuid.UUID) (*entity.ScheduleVersion, []uuid.UUID, error) {
+	var (
+		version  *entity.ScheduleVersion
+		archived []uuid.UUID
+	)
+	err := r.q.InTx(ctx, func(q *sqlc.Queries) error {
+		var err error
+		version, archived, err = publishInTx(ctx, q, scheduleID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("publish schedule: %w", err)
+	}
+	return version, archived, nil
+}
+
+func (r *ScheduleVersionRepositoryImpl) Restore(ctx context.Context, semesterID uuid.UUID, number int) (*entity.ScheduleVersion, []uuid.UUID, error) {
+	var (
+		version  *entity.ScheduleVersion
+		archived []uuid.UUID
+	)
+	err := r.q.InTx(ctx, func(q *sqlc.Queries) error {
+		row, err := q.GetScheduleVersion(ctx, uuidToPg(semesterID), int32(number))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrVersionNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("get schedule version: %w", err)
+		}
+		source, err := scheduleVersionToEntity(row)
+		if err != nil {
+			return err
+		}
+
+		clone, err := q.CreateSchedule(ctx, row.SemesterID, fmt.Sprintf("Rollback to v%d", source.Version), valueobject.ScheduleStatusCompleted.String())
+		if err != nil {
+			return fmt.Errorf("create schedule: %w", err)
+		}
+		for _, ve := range source.Entries {
+			entry := ve.ScheduleEntry()
+			entry.ScheduleID = pgToUUID(clone.ID)
+			if _, err := createEntry(ctx, q, entry); err != nil {
+				return fmt.Errorf("restore entry for subject %s: %w", ve.SubjectID, err)
+			}
+		}
+		if _, err := q.UpdateScheduleResult(ctx, clone.ID, source.Score, int32(source.HardViolations), source.SoftPenalty); err != nil {
+			return fmt.Errorf("restore score: %w", err)
+		}
+
+		version, archived, err = publishInTx(ctx, q, pgToUUID(clone.ID))
+		return err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("restore version %d: %w", number, err)
+	}
+	return version, archived, nil
+}
+
+// publishInTx publishes a schedule with q bound to a transaction: it locks the
+// schedule, archives the semester's published one and records the version.
+func publishInTx(ctx context.Context, q *sqlc.Queries, scheduleID uuid.UUID) (*entity.ScheduleVersion, []uuid.UUID, error) {
+	row, err := q.LockScheduleByID(ctx, uuidToPg(scheduleID))
+	if err != nil {
+		return nil, nil, fmt.Errorf("get schedule: %w", err)
+	}
+	schedule := scheduleToEntity(row)
+	if err := schedule.Publish(); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := q.ListEntriesByScheduleEnriched(ctx, row.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list entries: %w", err)
+	}
+	snapshot := make([]entity.VersionEntry, len(rows))
+	for i, e := range rows {
+		snapshot[i] = entity.VersionEntryOf(enrichedEntryToEntity(e))
+	}
+	entries, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encode entries: %w", err)
+	}
+
+	ids, err := q.ArchivePublishedSchedules(ctx, row.SemesterID, row.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("archive published schedule: %w", err)
+	}
+	archived := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		archived = append(archived, pgToUUID(id))
+	}
+	if _, err := q.UpdateScheduleStatus(ctx, row.ID, schedule.Status.String()); err != nil {
+		return nil, nil, fmt.Errorf("update status: %w", err)
+	}
+	created, err := q.CreateScheduleVersion(ctx, sqlc.CreateScheduleVersionParams{
+		SemesterID:     row.SemesterID,
+		ScheduleID:     row.ID,
+		Score:          schedule.Score,
+		HardViolations: int32(schedule.HardViolations),
+		SoftPenalty:    schedule.SoftPenalty,
+		Entries:        entries,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("create version: %w", err)
+	}
+	version, err := scheduleVersionToEntity(created)
+	if err != nil {
+		return nil, nil, err
+	}
+	return version, archived, nil
+}
+
+func (r *ScheduleVersionRepositoryImpl) ListBySemester(ctx context.Context, semesterID uuid.UUID) ([]*entity.ScheduleVersion, error) {
+	rows, err := r.q.ListScheduleVersions(ctx, uuidToPg(semesterID))
+	if err != nil {
+		return nil, fmt.Errorf("list schedule versions: %w", err)
+	}
+	result := make([]*entity.ScheduleVersion, len(rows))
+	for i, row := range rows {
+		if result[i], err = scheduleVersionToEntity(row); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *ScheduleVersionRepositoryImpl) Get(ctx context.Context, semesterID uuid.UUID, version int) (*entity.ScheduleVersion, error) {
+	row, err := r.q.GetScheduleVersion(ctx, uuidToPg(semesterID), int32(version))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrVersionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get schedule version: %w", err)
+	}
+	return scheduleVersionToEntity(row)
+}
+
+func scheduleVersionToEntity(r sqlc.TimetableScheduleVersion) (*entity.ScheduleVersion, error) {
+	v := &entity.ScheduleVersion{
+		ID:             pgToUUID(r.ID),
+		SemesterID:     pgToUUID(r.SemesterID),
+		ScheduleID:     pgToUUID(r.ScheduleID),
+		Version:        int(r.Version),
+		Score:          r.Score,
+		HardViolations: int(r.HardViolations),
+		SoftPenalty:    r.SoftPenalty,
+		PublishedAt:    r.PublishedAt.Time,
+	}
+	if err := json.Unmarshal(r.Entries, &v.Entries); err != nil {
+		return nil, fmt.Errorf("decode version entries: %w", err)
+	}
+	return v, nil
+}
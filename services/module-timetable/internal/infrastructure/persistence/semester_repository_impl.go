@@ -2,12 +2,14 @@ package persistence
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/persistence/sqlc"
 )
 
@@ -85,6 +87,78 @@ func (r *SemesterRepositoryImpl) SetRoomIDs(ctx context.Context, semesterID uuid
 	return semesterToEntity(row), nil
 }
 
+func (r *SemesterRepositoryImpl) SetSoftConstraints(ctx context.Context, semesterID uuid.UUID, settings []entity.SoftConstraintSetting) (*entity.Semester, error) {
+	if settings == nil {
+		settings = []entity.SoftConstraintSetting{}
+	}
+	raw, err := json.Marshal(settings)
+	if err != nil {
+		return nil, fmt.Errorf("marshal soft constraints: %w", err)
+	}
+	row, err := r.q.SetSemesterSoftConstraints(ctx, uuidToPg(semesterID), raw)
+	if err != nil {
+		return nil, fmt.Errorf("set semester soft constraints: %w", err)
+	}
+	return semesterToEntity(row), nil
+}
+
+func (r *SemesterRepositoryImpl) SetPeriods(ctx context.Context, semesterID uuid.UUID, periods []entity.PeriodDefinition) (*entity.Semester, error) {
+	if periods == nil {
+		periods = []entity.PeriodDefinition{}
+	}
+	raw, err := json.Marshal(periods)
+	if err != nil {
+		return nil, fmt.Errorf("marshal periods: %w", err)
+	}
+	row, err := r.q.SetSemesterPeriods(ctx, uuidToPg(semesterID), raw)
+	if err != nil {
+		return nil, fmt.Errorf("set semester periods: %w", err)
+	}
+	return semesterToEntity(row), nil
+}
+
+func (r *SemesterRepositoryImpl) SetHolidays(ctx context.Context, semesterID uuid.UUID, holidays []entity.Holiday) (*entity.Semester, error) {
+	if holidays == nil {
+		holidays = []entity.Holiday{}
+	}
+	raw, err := json.Marshal(holidays)
+	if err != nil {
+		return nil, fmt.Errorf("marshal holidays: %w", err)
+	}
+	row, err := r.q.SetSemesterHolidays(ctx, uuidToPg(semesterID), raw)
+	if err != nil {
+		return nil, fmt.Errorf("set semester holidays: %w", err)
+	}
+	return semesterToEntity(row), nil
+}
+
+func (r *SemesterRepositoryImpl) SetWeekPattern(ctx context.Context, semesterID, subjectID uuid.UUID, pattern valueobject.WeekPattern) (*entity.Semester, error) {
+	stored := string(pattern)
+	if pattern == valueobject.WeekPatternWeekly {
+		stored = "" // weekly is the default, so drop the entry
+	}
+	row, err := r.q.SetSemesterWeekPattern(ctx, uuidToPg(semesterID), subjectID.String(), stored)
+	if err != nil {
+		return nil, fmt.Errorf("set semester week pattern: %w", err)
+	}
+	return semesterToEntity(row), nil
+}
+
+func (r *SemesterRepositoryImpl) SetSections(ctx context.Context, semesterID, subjectID uuid.UUID, sections []entity.CourseSection) (*entity.Semester, error) {
+	var raw []byte // a single plain section is the default, so drop the entry
+	if len(sections) > 1 || (len(sections) == 1 && sections[0] != entity.CourseSection{Number: 1}) {
+		var err error
+		if raw, err = json.Marshal(sections); err != nil {
+			return nil, fmt.Errorf("marshal sections: %w", err)
+		}
+	}
+	row, err := r.q.SetSemesterSections(ctx, uuidToPg(semesterID), subjectID.String(), raw)
+	if err != nil {
+		return nil, fmt.Errorf("set semester sections: %w", err)
+	}
+	return semesterToEntity(row), nil
+}
+
 func (r *SemesterRepositoryImpl) CreateTimeSlot(ctx context.Context, ts *entity.TimeSlot) (*entity.TimeSlot, error) {
 	row, err := r.q.CreateTimeSlot(ctx, sqlc.CreateTimeSlotParams{
 		SemesterID:  uuidToPg(ts.SemesterID),
@@ -142,6 +216,21 @@ func semesterToEntity(r sqlc.TimetableSemester) *entity.Semester {
 	for _, pgID := range r.RoomIDs {
 		s.RoomIDs = append(s.RoomIDs, pgToUUID(pgID))
 	}
+	if len(r.SoftConstraints) > 0 {
+		_ = json.Unmarshal(r.SoftConstraints, &s.SoftConstraints)
+	}
+	if len(r.Periods) > 0 {
+		_ = json.Unmarshal(r.Periods, &s.Periods)
+	}
+	if len(r.Holidays) > 0 {
+		_ = json.Unmarshal(r.Holidays, &s.Holidays)
+	}
+	if len(r.WeekPatterns) > 0 {
+		_ = json.Unmarshal(r.WeekPatterns, &s.WeekPatterns)
+	}
+	if len(r.Sections) > 0 {
+		_ = json.Unmarshal(r.Sections, &s.Sections)
+	}
 	return s
 }
 
@@ -17,16 +17,29 @@ type TimetableSemester struct {
 	OfferedSubjectIDs  []pgtype.UUID      `db:"offered_subject_ids"`
 	RoomIDs            []pgtype.UUID      `db:"room_ids"`
 	CreatedAt          pgtype.Timestamptz `db:"created_at"`
+	// Soft-constraint settings (JSON array) added by migration 010.
+	SoftConstraints []byte `db:"soft_constraints"`
+	// Calendar settings (JSON) added by migration 014.
+	Periods      []byte `db:"periods"`
+	Holidays     []byte `db:"holidays"`
+	WeekPatterns []byte `db:"week_patterns"`
+	// Subject sections (JSON object) added by migration 018.
+	Sections []byte `db:"sections"`
 }
 
 // TimetableRoom mirrors the timetable.rooms table row.
 type TimetableRoom struct {
-	ID       pgtype.UUID `db:"id"`
-	Name     string      `db:"name"`
-	Capacity int32       `db:"capacity"`
-	Type     string      `db:"type"`
-	Features []string    `db:"features"`
-	IsActive bool        `db:"is_active"`
+	ID               pgtype.UUID `db:"id"`
+	Name             string      `db:"name"`
+	Capacity         int32       `db:"capacity"`
+	Type             string      `db:"type"`
+	Features         []string    `db:"features"`
+	IsActive         bool        `db:"is_active"`
+	RequiresApproval bool        `db:"requires_approval"`
+	BuildingID       pgtype.UUID `db:"building_id"`
+	// joined from the room's building (migration 017)
+	BuildingCode pgtype.Text `db:"building_code"`
+	CampusID     pgtype.UUID `db:"campus_id"`
 }
 
 // TimetableTimeSlot mirrors the timetable.time_slots table row.
@@ -49,6 +62,11 @@ type TimetableSchedule struct {
 	SoftPenalty    float64            `db:"soft_penalty"`
 	GeneratedAt    pgtype.Timestamptz `db:"generated_at"`
 	CreatedAt      pgtype.Timestamptz `db:"created_at"`
+	// Failure details added by migration 009.
+	FailureReason string `db:"failure_reason"`
+	Diagnosis     []byte `db:"diagnosis"`
+	// Added by migration 011.
+	CohortClashes int32 `db:"cohort_clashes"`
 }
 
 // TimetableScheduleEntry mirrors the timetable.schedule_entries table row (after migration 007).
@@ -66,6 +84,9 @@ type TimetableScheduleEntry struct {
 	SubjectCode  string      `db:"subject_code"`
 	TeacherName  string      `db:"teacher_name"`
 	DepartmentID pgtype.UUID `db:"department_id"`
+	// Section and assistants added by migration 018.
+	Section    int32  `db:"section"`
+	Assistants []byte `db:"assistants"`
 }
 
 // TimetableScheduleEntryRow is returned by ListEntriesBySchedule (JOIN query).
@@ -77,3 +98,93 @@ type TimetableScheduleEntryRow struct {
 	EndPeriod   int32  `db:"end_period"`
 	RoomName    string `db:"room_name"`
 }
+
+// TimetableGenerationJob mirrors the timetable.generation_jobs table row (migration 012).
+type TimetableGenerationJob struct {
+	ID              pgtype.UUID        `db:"id"`
+	ScheduleID      pgtype.UUID        `db:"schedule_id"`
+	SemesterID      pgtype.UUID        `db:"semester_id"`
+	Status          string             `db:"status"`
+	Params          []byte             `db:"params"`
+	Attempts        int32              `db:"attempts"`
+	MaxAttempts     int32              `db:"max_attempts"`
+	LeaseOwner      string             `db:"lease_owner"`
+	LeaseExpiresAt  pgtype.Timestamptz `db:"lease_expires_at"`
+	CancelRequested bool               `db:"cancel_requested"`
+	ErrorMessage    string             `db:"error_message"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at"`
+	StartedAt       pgtype.Timestamptz `db:"started_at"`
+	FinishedAt      pgtype.Timestamptz `db:"finished_at"`
+}
+
+// TimetableScheduleVersion mirrors the timetable.schedule_versions table row (migration 013).
+type TimetableScheduleVersion struct {
+	ID             pgtype.UUID        `db:"id"`
+	SemesterID     pgtype.UUID        `db:"semester_id"`
+	ScheduleID     pgtype.UUID        `db:"schedule_id"`
+	Version        int32              `db:"version"`
+	Score          float64            `db:"score"`
+	HardViolations int32              `db:"hard_violations"`
+	SoftPenalty    float64            `db:"soft_penalty"`
+	Entries        []byte             `db:"entries"`
+	PublishedAt    pgtype.Timestamptz `db:"published_at"`
+}
+
+// TimetableExamSchedule mirrors the timetable.exam_schedules table row (migration 015).
+type TimetableExamSchedule struct {
+	ID                    pgtype.UUID        `db:"id"`
+	SemesterID            pgtype.UUID        `db:"semester_id"`
+	Name                  string             `db:"name"`
+	StartDate             pgtype.Date        `db:"start_date"`
+	EndDate               pgtype.Date        `db:"end_date"`
+	Settings              []byte             `db:"settings"`
+	Score                 float64            `db:"score"`
+	SoftPenalty           float64            `db:"soft_penalty"`
+	Entries               []byte             `db:"entries"`
+	UnscheduledSubjectIDs []pgtype.UUID      `db:"unscheduled_subject_ids"`
+	CreatedAt             pgtype.Timestamptz `db:"created_at"`
+}
+
+// TimetableRoomBooking mirrors the timetable.room_bookings table row
+// (migration 016) joined with its room's name.
+type TimetableRoomBooking struct {
+	ID          pgtype.UUID        `db:"id"`
+	RoomID      pgtype.UUID        `db:"room_id"`
+	Title       string             `db:"title"`
+	Purpose     string             `db:"purpose"`
+	RequestedBy pgtype.UUID        `db:"requested_by"`
+	StartDate   pgtype.Date        `db:"start_date"`
+	EndDate     pgtype.Date        `db:"end_date"`
+	StartPeriod int32              `db:"start_period"`
+	EndPeriod   int32              `db:"end_period"`
+	Status      string             `db:"status"`
+	ReviewedBy  pgtype.UUID        `db:"reviewed_by"`
+	ReviewNote  string             `db:"review_note"`
+	ReviewedAt  pgtype.Timestamptz `db:"reviewed_at"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at"`
+	RoomName    string             `db:"room_name"`
+}
+
+// TimetableCampus mirrors the timetable.campuses table row (migration 017).
+type TimetableCampus struct {
+	ID        pgtype.UUID        `db:"id"`
+	Code      string             `db:"code"`
+	Name      string             `db:"name"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
+
+// TimetableBuilding mirrors the timetable.buildings table row.
+type TimetableBuilding struct {
+	ID        pgtype.UUID        `db:"id"`
+	CampusID  pgtype.UUID        `db:"campus_id"`
+	Code      string             `db:"code"`
+	Name      string             `db:"name"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
+
+// TimetableCampusTravelTime mirrors the timetable.campus_travel_times row.
+type TimetableCampusTravelTime struct {
+	FromCampusID pgtype.UUID `db:"from_campus_id"`
+	ToCampusID   pgtype.UUID `db:"to_campus_id"`
+	Minutes      int32       `db:"minutes"`
+}
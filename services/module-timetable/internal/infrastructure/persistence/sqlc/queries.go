@@ -8,18 +8,35 @@ import (
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBTX is satisfied by both the pool and a transaction.
+type DBTX interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
 // Queries wraps a pgxpool.Pool and exposes typed query methods.
 type Queries struct {
+	db   DBTX
 	pool *pgxpool.Pool
 }
 
 // New creates a Queries instance backed by the given pool.
 func New(pool *pgxpool.Pool) *Queries {
-	return &Queries{pool: pool}
+	return &Queries{db: pool, pool: pool}
+}
+
+// InTx runs fn with queries bound to a single transaction, committing when fn
+// returns nil and rolling back otherwise.
+func (q *Queries) InTx(ctx context.Context, fn func(*Queries) error) error {
+	return pgx.BeginFunc(ctx, q.pool, func(tx pgx.Tx) error {
+		return fn(&Queries{db: tx, pool: q.pool})
+	})
 }
 
 // --- Semester queries ---
@@ -34,10 +51,10 @@ type CreateSemesterParams struct {
 }
 
 func (q *Queries) CreateSemester(ctx context.Context, p CreateSemesterParams) (TimetableSemester, error) {
-	row := q.pool.QueryRow(ctx, `
+	row := q.db.QueryRow(ctx, `
 		INSERT INTO timetable.semesters (name, year, term, start_date, end_date, offered_subject_ids)
 		VALUES ($1,$2,$3,$4,$5,$6)
-		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids`,
+		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections`,
 		p.Name, p.Year, p.Term, p.StartDate, p.EndDate, p.OfferedSubjectIDs,
 	)
 	return scanSemester(row)
@@ -45,23 +62,76 @@ func (q *Queries) CreateSemester(ctx context.Context, p CreateSemesterParams) (T
 
 // SetSemesterRooms replaces the room_ids array for a semester.
 func (q *Queries) SetSemesterRooms(ctx context.Context, semesterID pgtype.UUID, roomIDs []pgtype.UUID) (TimetableSemester, error) {
-	row := q.pool.QueryRow(ctx, `
+	row := q.db.QueryRow(ctx, `
 		UPDATE timetable.semesters SET room_ids=$2 WHERE id=$1
-		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids`,
+		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections`,
 		semesterID, roomIDs)
 	return scanSemester(row)
 }
 
+// SetSemesterSoftConstraints replaces the soft-constraint settings (JSON) for a semester.
+func (q *Queries) SetSemesterSoftConstraints(ctx context.Context, semesterID pgtype.UUID, settings []byte) (TimetableSemester, error) {
+	row := q.db.QueryRow(ctx, `
+		UPDATE timetable.semesters SET soft_constraints=$2 WHERE id=$1
+		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections`,
+		semesterID, settings)
+	return scanSemester(row)
+}
+
+// SetSemesterPeriods replaces the period definitions (JSON) for a semester.
+func (q *Queries) SetSemesterPeriods(ctx context.Context, semesterID pgtype.UUID, periods []byte) (TimetableSemester, error) {
+	row := q.db.QueryRow(ctx, `
+		UPDATE timetable.semesters SET periods=$2 WHERE id=$1
+		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections`,
+		semesterID, periods)
+	return scanSemester(row)
+}
+
+// SetSemesterHolidays replaces the holiday calendar (JSON) for a semester.
+func (q *Queries) SetSemesterHolidays(ctx context.Context, semesterID pgtype.UUID, holidays []byte) (TimetableSemester, error) {
+	row := q.db.QueryRow(ctx, `
+		UPDATE timetable.semesters SET holidays=$2 WHERE id=$1
+		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections`,
+		semesterID, holidays)
+	return scanSemester(row)
+}
+
+// SetSemesterWeekPattern sets one subject's week pattern; an empty pattern
+// removes the subject's entry so that it meets weekly again.
+func (q *Queries) SetSemesterWeekPattern(ctx context.Context, semesterID pgtype.UUID, subjectID, pattern string) (TimetableSemester, error) {
+	row := q.db.QueryRow(ctx, `
+		UPDATE timetable.semesters
+		SET week_patterns = CASE WHEN $3::text = '' THEN week_patterns - $2::text
+			ELSE week_patterns || jsonb_build_object($2::text, $3::text) END
+		WHERE id=$1
+		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections`,
+		semesterID, subjectID, pattern)
+	return scanSemester(row)
+}
+
+// SetSemesterSections sets one subject's sections (JSON array); nil sections
+// remove the subject's entry so that it has a single section again.
+func (q *Queries) SetSemesterSections(ctx context.Context, semesterID pgtype.UUID, subjectID string, sections []byte) (TimetableSemester, error) {
+	row := q.db.QueryRow(ctx, `
+		UPDATE timetable.semesters
+		SET sections = CASE WHEN $3::jsonb IS NULL THEN sections - $2::text
+			ELSE sections || jsonb_build_object($2::text, $3::jsonb) END
+		WHERE id=$1
+		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections`,
+		semesterID, subjectID, sections)
+	return scanSemester(row)
+}
+
 func (q *Queries) GetSemesterByID(ctx context.Context, id pgtype.UUID) (TimetableSemester, error) {
-	row := q.pool.QueryRow(ctx,
-		`SELECT id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids
+	row := q.db.QueryRow(ctx,
+		`SELECT id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections
 		 FROM timetable.semesters WHERE id = $1`, id)
 	return scanSemester(row)
 }
 
 func (q *Queries) ListSemesters(ctx context.Context, limit, offset int32) ([]TimetableSemester, error) {
-	rows, err := q.pool.Query(ctx,
-		`SELECT id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids
+	rows, err := q.db.Query(ctx,
+		`SELECT id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections
 		 FROM timetable.semesters ORDER BY year DESC, term DESC LIMIT $1 OFFSET $2`,
 		limit, offset)
 	if err != nil {
@@ -75,24 +145,24 @@ func (q *Queries) ListSemesters(ctx context.Context, limit, offset int32) ([]Tim
 
 func (q *Queries) CountSemesters(ctx context.Context) (int64, error) {
 	var n int64
-	err := q.pool.QueryRow(ctx, `SELECT COUNT(*) FROM timetable.semesters`).Scan(&n)
+	err := q.db.QueryRow(ctx, `SELECT COUNT(*) FROM timetable.semesters`).Scan(&n)
 	return n, err
 }
 
 func (q *Queries) AddOfferedSubject(ctx context.Context, semesterID, subjectID pgtype.UUID) (TimetableSemester, error) {
-	row := q.pool.QueryRow(ctx, `
+	row := q.db.QueryRow(ctx, `
 		UPDATE timetable.semesters SET offered_subject_ids = array_append(offered_subject_ids,$2)
 		WHERE id=$1
-		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids`,
+		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections`,
 		semesterID, subjectID)
 	return scanSemester(row)
 }
 
 func (q *Queries) RemoveOfferedSubject(ctx context.Context, semesterID, subjectID pgtype.UUID) (TimetableSemester, error) {
-	row := q.pool.QueryRow(ctx, `
+	row := q.db.QueryRow(ctx, `
 		UPDATE timetable.semesters SET offered_subject_ids = array_remove(offered_subject_ids,$2)
 		WHERE id=$1
-		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids`,
+		RETURNING id, name, year, term, start_date, end_date, offered_subject_ids, created_at, room_ids, soft_constraints, periods, holidays, week_patterns, sections`,
 		semesterID, subjectID)
 	return scanSemester(row)
 }
@@ -107,7 +177,7 @@ type CreateTimeSlotParams struct {
 }
 
 func (q *Queries) CreateTimeSlot(ctx context.Context, p CreateTimeSlotParams) (TimetableTimeSlot, error) {
-	row := q.pool.QueryRow(ctx, `
+	row := q.db.QueryRow(ctx, `
 		INSERT INTO timetable.time_slots (semester_id, day_of_week, start_period, end_period)
 		VALUES ($1,$2,$3,$4) RETURNING *`,
 		p.SemesterID, p.DayOfWeek, p.StartPeriod, p.EndPeriod)
@@ -117,7 +187,7 @@ func (q *Queries) CreateTimeSlot(ctx context.Context, p CreateTimeSlotParams) (T
 }
 
 func (q *Queries) ListTimeSlotsBySemester(ctx context.Context, semesterID pgtype.UUID) ([]TimetableTimeSlot, error) {
-	rows, err := q.pool.Query(ctx,
+	rows, err := q.db.Query(ctx,
 		`SELECT * FROM timetable.time_slots WHERE semester_id=$1 ORDER BY day_of_week, start_period`,
 		semesterID)
 	if err != nil {
@@ -132,41 +202,55 @@ func (q *Queries) ListTimeSlotsBySemester(ctx context.Context, semesterID pgtype
 }
 
 func (q *Queries) DeleteTimeSlot(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.pool.Exec(ctx, `DELETE FROM timetable.time_slots WHERE id=$1`, id)
+	_, err := q.db.Exec(ctx, `DELETE FROM timetable.time_slots WHERE id=$1`, id)
 	return err
 }
 
 func (q *Queries) DeleteTimeSlotsBySemester(ctx context.Context, semesterID pgtype.UUID) error {
-	_, err := q.pool.Exec(ctx, `DELETE FROM timetable.time_slots WHERE semester_id=$1`, semesterID)
+	_, err := q.db.Exec(ctx, `DELETE FROM timetable.time_slots WHERE semester_id=$1`, semesterID)
 	return err
 }
 
 // --- Room queries ---
 
+// roomSelect reads rooms (aliased r) with the code and campus of their
+// building; append FROM/WHERE clauses.
+const roomSelect = `SELECT r.*, bl.code AS building_code, bl.campus_id`
+
 type CreateRoomParams struct {
-	Name     string
-	Capacity int32
-	Type     string
-	Features []string
+	Name             string
+	Capacity         int32
+	Type             string
+	Features         []string
+	RequiresApproval bool
+	BuildingID       pgtype.UUID // invalid = no building
 }
 
 func (q *Queries) CreateRoom(ctx context.Context, p CreateRoomParams) (TimetableRoom, error) {
-	row := q.pool.QueryRow(ctx, `
-		INSERT INTO timetable.rooms (name, capacity, type, features)
-		VALUES ($1,$2,$3,$4) RETURNING *`,
-		p.Name, p.Capacity, p.Type, p.Features)
+	row := q.db.QueryRow(ctx, `
+		WITH r AS (
+		  INSERT INTO timetable.rooms (name, capacity, type, features, requires_approval, building_id)
+		  VALUES ($1,$2,$3,$4,$5,$6) RETURNING *
+		)
+		`+roomSelect+` FROM r LEFT JOIN timetable.buildings bl ON bl.id = r.building_id`,
+		p.Name, p.Capacity, p.Type, p.Features, p.RequiresApproval, p.BuildingID)
 	return scanRoom(row)
 }
 
 func (q *Queries) GetRoomByID(ctx context.Context, id pgtype.UUID) (TimetableRoom, error) {
-	row := q.pool.QueryRow(ctx, `SELECT * FROM timetable.rooms WHERE id=$1`, id)
+	row := q.db.QueryRow(ctx, roomSelect+`
+		FROM timetable.rooms r LEFT JOIN timetable.buildings bl ON bl.id = r.building_id
+		WHERE r.id=$1`, id)
 	return scanRoom(row)
 }
 
-func (q *Queries) ListRooms(ctx context.Context, limit, offset int32) ([]TimetableRoom, error) {
-	rows, err := q.pool.Query(ctx,
-		`SELECT * FROM timetable.rooms WHERE is_active=true ORDER BY name LIMIT $1 OFFSET $2`,
-		limit, offset)
+// ListRooms lists rooms by name; deactivated rooms only when includeInactive.
+func (q *Queries) ListRooms(ctx context.Context, includeInactive bool, limit, offset int32) ([]TimetableRoom, error) {
+	rows, err := q.db.Query(ctx, roomSelect+`
+		FROM timetable.rooms r LEFT JOIN timetable.buildings bl ON bl.id = r.building_id
+		WHERE $1 OR r.is_active=true
+		ORDER BY r.name LIMIT $2 OFFSET $3`,
+		includeInactive, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -178,14 +262,125 @@ func (q *Queries) ListRooms(ctx context.Context, limit, offset int32) ([]Timetab
 
 func (q *Queries) CountRooms(ctx context.Context) (int64, error) {
 	var n int64
-	err := q.pool.QueryRow(ctx, `SELECT COUNT(*) FROM timetable.rooms WHERE is_active=true`).Scan(&n)
+	err := q.db.QueryRow(ctx, `SELECT COUNT(*) FROM timetable.rooms WHERE is_active=true`).Scan(&n)
+	return n, err
+}
+
+type UpdateRoomParams struct {
+	ID               pgtype.UUID
+	Name             string
+	Capacity         int32
+	Type             string
+	Features         []string
+	RequiresApproval bool
+	BuildingID       pgtype.UUID
+	IsActive         bool
+}
+
+func (q *Queries) UpdateRoom(ctx context.Context, p UpdateRoomParams) (TimetableRoom, error) {
+	row := q.db.QueryRow(ctx, `
+		WITH r AS (
+		  UPDATE timetable.rooms
+		  SET name=$2, capacity=$3, type=$4, features=$5, requires_approval=$6, building_id=$7, is_active=$8
+		  WHERE id=$1 RETURNING *
+		)
+		`+roomSelect+` FROM r LEFT JOIN timetable.buildings bl ON bl.id = r.building_id`,
+		p.ID, p.Name, p.Capacity, p.Type, p.Features, p.RequiresApproval, p.BuildingID, p.IsActive)
+	return scanRoom(row)
+}
+
+// DeleteRoom removes a room and returns the number of rows deleted.
+func (q *Queries) DeleteRoom(ctx context.Context, id pgtype.UUID) (int64, error) {
+	tag, err := q.db.Exec(ctx, `DELETE FROM timetable.rooms WHERE id=$1`, id)
+	return tag.RowsAffected(), err
+}
+
+func (q *Queries) CountPublishedRoomEntries(ctx context.Context, roomID pgtype.UUID) (int64, error) {
+	var n int64
+	err := q.db.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM timetable.schedule_entries e
+		JOIN timetable.schedules s ON s.id = e.schedule_id
+		WHERE e.room_id=$1 AND s.status='published'`, roomID).Scan(&n)
 	return n, err
 }
 
+// --- Campus and building queries ---
+
+func (q *Queries) CreateCampus(ctx context.Context, code, name string) (TimetableCampus, error) {
+	row := q.db.QueryRow(ctx, `
+		INSERT INTO timetable.campuses (code, name) VALUES ($1,$2) RETURNING *`,
+		code, name)
+	return scanCampus(row)
+}
+
+func (q *Queries) GetCampusByID(ctx context.Context, id pgtype.UUID) (TimetableCampus, error) {
+	row := q.db.QueryRow(ctx, `SELECT * FROM timetable.campuses WHERE id=$1`, id)
+	return scanCampus(row)
+}
+
+func (q *Queries) ListCampuses(ctx context.Context) ([]TimetableCampus, error) {
+	rows, err := q.db.Query(ctx, `SELECT * FROM timetable.campuses ORDER BY code`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return pgx.CollectRows(rows, scanCampusRow)
+}
+
+func (q *Queries) CreateBuilding(ctx context.Context, campusID pgtype.UUID, code, name string) (TimetableBuilding, error) {
+	row := q.db.QueryRow(ctx, `
+		INSERT INTO timetable.buildings (campus_id, code, name) VALUES ($1,$2,$3) RETURNING *`,
+		campusID, code, name)
+	return scanBuilding(row)
+}
+
+func (q *Queries) GetBuildingByID(ctx context.Context, id pgtype.UUID) (TimetableBuilding, error) {
+	row := q.db.QueryRow(ctx, `SELECT * FROM timetable.buildings WHERE id=$1`, id)
+	return scanBuilding(row)
+}
+
+// ListBuildings lists the buildings of a campus; the zero UUID lists all.
+func (q *Queries) ListBuildings(ctx context.Context, campusID pgtype.UUID) ([]TimetableBuilding, error) {
+	rows, err := q.db.Query(ctx, `
+		SELECT * FROM timetable.buildings
+		WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR campus_id = $1)
+		ORDER BY code`, campusID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return pgx.CollectRows(rows, scanBuildingRow)
+}
+
+// UpsertCampusTravelTime stores the travel minutes of a campus pair; fromID
+// must sort before toID.
+func (q *Queries) UpsertCampusTravelTime(ctx context.Context, fromID, toID pgtype.UUID, minutes int32) error {
+	_, err := q.db.Exec(ctx, `
+		INSERT INTO timetable.campus_travel_times (from_campus_id, to_campus_id, minutes)
+		VALUES ($1,$2,$3)
+		ON CONFLICT (from_campus_id, to_campus_id) DO UPDATE SET minutes = EXCLUDED.minutes`,
+		fromID, toID, minutes)
+	return err
+}
+
+func (q *Queries) ListCampusTravelTimes(ctx context.Context) ([]TimetableCampusTravelTime, error) {
+	rows, err := q.db.Query(ctx, `SELECT * FROM timetable.campus_travel_times`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (TimetableCampusTravelTime, error) {
+		var t TimetableCampusTravelTime
+		err := row.Scan(&t.FromCampusID, &t.ToCampusID, &t.Minutes)
+		return t, err
+	})
+}
+
 // --- Schedule queries ---
 
 func (q *Queries) CreateSchedule(ctx context.Context, semesterID pgtype.UUID, name, status string) (TimetableSchedule, error) {
-	row := q.pool.QueryRow(ctx, `
+	row := q.db.QueryRow(ctx, `
 		INSERT INTO timetable.schedules (semester_id, name, status)
 		VALUES ($1,$2,$3) RETURNING *`,
 		semesterID, name, status)
@@ -193,12 +388,12 @@ func (q *Queries) CreateSchedule(ctx context.Context, semesterID pgtype.UUID, na
 }
 
 func (q *Queries) GetScheduleByID(ctx context.Context, id pgtype.UUID) (TimetableSchedule, error) {
-	row := q.pool.QueryRow(ctx, `SELECT * FROM timetable.schedules WHERE id=$1`, id)
+	row := q.db.QueryRow(ctx, `SELECT * FROM timetable.schedules WHERE id=$1`, id)
 	return scanSchedule(row)
 }
 
 func (q *Queries) ListSchedulesBySemester(ctx context.Context, semesterID pgtype.UUID) ([]TimetableSchedule, error) {
-	rows, err := q.pool.Query(ctx,
+	rows, err := q.db.Query(ctx,
 		`SELECT * FROM timetable.schedules WHERE semester_id=$1 ORDER BY created_at DESC`, semesterID)
 	if err != nil {
 		return nil, err
@@ -212,7 +407,7 @@ func (q *Queries) ListSchedulesBySemester(ctx context.Context, semesterID pgtype
 // ListSchedulesPaged returns schedules with optional semester filter and pagination.
 // Pass a zero-value UUID as semesterID to skip the filter.
 func (q *Queries) ListSchedulesPaged(ctx context.Context, semesterID pgtype.UUID, limit, offset int32) ([]TimetableSchedule, error) {
-	rows, err := q.pool.Query(ctx, `
+	rows, err := q.db.Query(ctx, `
 		SELECT * FROM timetable.schedules
 		WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR semester_id = $1)
 		ORDER BY created_at DESC
@@ -230,7 +425,7 @@ func (q *Queries) ListSchedulesPaged(ctx context.Context, semesterID pgtype.UUID
 // CountSchedulesPaged returns the total schedule count with optional semester filter.
 func (q *Queries) CountSchedulesPaged(ctx context.Context, semesterID pgtype.UUID) (int64, error) {
 	var n int64
-	err := q.pool.QueryRow(ctx, `
+	err := q.db.QueryRow(ctx, `
 		SELECT COUNT(*) FROM timetable.schedules
 		WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR semester_id = $1)`,
 		semesterID).Scan(&n)
@@ -238,7 +433,7 @@ func (q *Queries) CountSchedulesPaged(ctx context.Context, semesterID pgtype.UUI
 }
 
 func (q *Queries) UpdateScheduleResult(ctx context.Context, id pgtype.UUID, score float64, hardViolations int32, softPenalty float64) (TimetableSchedule, error) {
-	row := q.pool.QueryRow(ctx, `
+	row := q.db.QueryRow(ctx, `
 		UPDATE timetable.schedules SET score=$2, hard_violations=$3, soft_penalty=$4, generated_at=NOW()
 		WHERE id=$1 RETURNING *`,
 		id, score, hardViolations, softPenalty)
@@ -246,7 +441,22 @@ func (q *Queries) UpdateScheduleResult(ctx context.Context, id pgtype.UUID, scor
 }
 
 func (q *Queries) UpdateScheduleStatus(ctx context.Context, id pgtype.UUID, status string) (TimetableSchedule, error) {
-	row := q.pool.QueryRow(ctx, `UPDATE timetable.schedules SET status=$2 WHERE id=$1 RETURNING *`, id, status)
+	row := q.db.QueryRow(ctx, `UPDATE timetable.schedules SET status=$2 WHERE id=$1 RETURNING *`, id, status)
+	return scanSchedule(row)
+}
+
+// UpdateScheduleFailure records why generation failed; diagnosis may be nil.
+func (q *Queries) UpdateScheduleFailure(ctx context.Context, id pgtype.UUID, reason string, diagnosis []byte) (TimetableSchedule, error) {
+	row := q.db.QueryRow(ctx, `
+		UPDATE timetable.schedules SET failure_reason=$2, diagnosis=$3
+		WHERE id=$1 RETURNING *`,
+		id, reason, diagnosis)
+	return scanSchedule(row)
+}
+
+// UpdateScheduleCohortClashes records how many students the schedule double-books.
+func (q *Queries) UpdateScheduleCohortClashes(ctx context.Context, id pgtype.UUID, clashes int32) (TimetableSchedule, error) {
+	row := q.db.QueryRow(ctx, `UPDATE timetable.schedules SET cohort_clashes=$2 WHERE id=$1 RETURNING *`, id, clashes)
 	return scanSchedule(row)
 }
 
@@ -263,38 +473,41 @@ type CreateScheduleEntryParams struct {
 	SubjectCode      string
 	TeacherName      string
 	DepartmentID     pgtype.UUID
+	Section          int32
+	Assistants       []byte // JSON array of {teacher_id, teacher_name, role}
 }
 
 func (q *Queries) CreateScheduleEntry(ctx context.Context, p CreateScheduleEntryParams) (TimetableScheduleEntry, error) {
-	row := q.pool.QueryRow(ctx, `
+	row := q.db.QueryRow(ctx, `
 		INSERT INTO timetable.schedule_entries
 		  (schedule_id, subject_id, teacher_id, room_id, time_slot_id, is_manual_override,
-		   subject_name, subject_code, teacher_name, department_id)
-		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING *`,
+		   subject_name, subject_code, teacher_name, department_id, section, assistants)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING *`,
 		p.ScheduleID, p.SubjectID, p.TeacherID, p.RoomID, p.TimeSlotID, p.IsManualOverride,
-		p.SubjectName, p.SubjectCode, p.TeacherName, p.DepartmentID)
+		p.SubjectName, p.SubjectCode, p.TeacherName, p.DepartmentID, p.Section, p.Assistants)
 	return scanEntry(row)
 }
 
 func (q *Queries) GetScheduleEntry(ctx context.Context, id pgtype.UUID) (TimetableScheduleEntry, error) {
-	row := q.pool.QueryRow(ctx, `SELECT * FROM timetable.schedule_entries WHERE id=$1`, id)
+	row := q.db.QueryRow(ctx, `SELECT * FROM timetable.schedule_entries WHERE id=$1`, id)
 	return scanEntry(row)
 }
 
 // ListEntriesByScheduleEnriched returns entries with time-slot and room fields via JOIN.
 func (q *Queries) ListEntriesByScheduleEnriched(ctx context.Context, scheduleID pgtype.UUID) ([]TimetableScheduleEntryRow, error) {
-	rows, err := q.pool.Query(ctx, `
+	rows, err := q.db.Query(ctx, `
 		SELECT
 		  e.id, e.schedule_id, e.subject_id, e.teacher_id, e.room_id, e.time_slot_id,
 		  e.is_manual_override, e.created_at,
 		  e.subject_name, e.subject_code, e.teacher_name, e.department_id,
+		  e.section, e.assistants,
 		  ts.day_of_week, ts.start_period, ts.end_period,
 		  r.name AS room_name
 		FROM timetable.schedule_entries e
 		JOIN timetable.time_slots ts ON e.time_slot_id = ts.id
 		JOIN timetable.rooms r       ON e.room_id      = r.id
 		WHERE e.schedule_id = $1
-		ORDER BY ts.day_of_week, ts.start_period`, scheduleID)
+		ORDER BY ts.day_of_week, ts.start_period, e.section`, scheduleID)
 	if err != nil {
 		return nil, err
 	}
@@ -304,40 +517,354 @@ func (q *Queries) ListEntriesByScheduleEnriched(ctx context.Context, scheduleID
 	})
 }
 
-func (q *Queries) UpdateScheduleEntry(ctx context.Context, id, teacherID, roomID, timeSlotID pgtype.UUID, isManual bool) (TimetableScheduleEntry, error) {
-	row := q.pool.QueryRow(ctx, `
+func (q *Queries) UpdateScheduleEntry(ctx context.Context, id, teacherID, roomID, timeSlotID pgtype.UUID, isManual bool, teacherName string, assistants []byte) (TimetableScheduleEntry, error) {
+	row := q.db.QueryRow(ctx, `
 		UPDATE timetable.schedule_entries
-		SET teacher_id=$2, room_id=$3, time_slot_id=$4, is_manual_override=$5
+		SET teacher_id=$2, room_id=$3, time_slot_id=$4, is_manual_override=$5, teacher_name=$6, assistants=$7
 		WHERE id=$1 RETURNING *`,
-		id, teacherID, roomID, timeSlotID, isManual)
+		id, teacherID, roomID, timeSlotID, isManual, teacherName, assistants)
 	return scanEntry(row)
 }
 
 func (q *Queries) DeleteScheduleEntry(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.pool.Exec(ctx, `DELETE FROM timetable.schedule_entries WHERE id=$1`, id)
+	_, err := q.db.Exec(ctx, `DELETE FROM timetable.schedule_entries WHERE id=$1`, id)
 	return err
 }
 
 func (q *Queries) DeleteEntriesBySchedule(ctx context.Context, scheduleID pgtype.UUID) error {
-	_, err := q.pool.Exec(ctx, `DELETE FROM timetable.schedule_entries WHERE schedule_id=$1`, scheduleID)
+	_, err := q.db.Exec(ctx, `DELETE FROM timetable.schedule_entries WHERE schedule_id=$1`, scheduleID)
 	return err
 }
 
 // --- Event store ---
 
 func (q *Queries) AppendEvent(ctx context.Context, aggregateID pgtype.UUID, aggregateType, eventType string, payload json.RawMessage) error {
-	_, err := q.pool.Exec(ctx, `
+	_, err := q.db.Exec(ctx, `
 		INSERT INTO timetable.event_store (aggregate_id, aggregate_type, event_type, payload)
 		VALUES ($1,$2,$3,$4)`,
 		aggregateID, aggregateType, eventType, payload)
 	return err
 }
 
+// --- GenerationJob queries ---
+
+func (q *Queries) CreateGenerationJob(ctx context.Context, scheduleID, semesterID pgtype.UUID, params []byte, maxAttempts int32) (TimetableGenerationJob, error) {
+	row := q.db.QueryRow(ctx, `
+		INSERT INTO timetable.generation_jobs (schedule_id, semester_id, params, max_attempts)
+		VALUES ($1,$2,$3,$4) RETURNING *`,
+		scheduleID, semesterID, params, maxAttempts)
+	return scanGenerationJob(row)
+}
+
+func (q *Queries) GetGenerationJobBySchedule(ctx context.Context, scheduleID pgtype.UUID) (TimetableGenerationJob, error) {
+	row := q.db.QueryRow(ctx, `SELECT * FROM timetable.generation_jobs WHERE schedule_id=$1`, scheduleID)
+	return scanGenerationJob(row)
+}
+
+// ClaimGenerationJob leases the oldest queued job, or a running one whose
+// lease expired, to owner. Returns pgx.ErrNoRows when nothing is claimable.
+func (q *Queries) ClaimGenerationJob(ctx context.Context, owner string, leaseSeconds float64) (TimetableGenerationJob, error) {
+	row := q.db.QueryRow(ctx, `
+		UPDATE timetable.generation_jobs
+		SET status='running', lease_owner=$1,
+		    lease_expires_at=NOW() + make_interval(secs => $2),
+		    attempts=attempts+1, started_at=COALESCE(started_at, NOW())
+		WHERE id = (
+		    SELECT id FROM timetable.generation_jobs
+		    WHERE NOT cancel_requested AND attempts < max_attempts
+		      AND (status='queued' OR (status='running' AND lease_expires_at < NOW()))
+		    ORDER BY created_at
+		    LIMIT 1
+		    FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		owner, leaseSeconds)
+	return scanGenerationJob(row)
+}
+
+// RenewGenerationJobLease extends owner's lease and reports whether a cancel
+// was requested. Returns pgx.ErrNoRows when owner no longer holds the lease.
+func (q *Queries) RenewGenerationJobLease(ctx context.Context, id pgtype.UUID, owner string, leaseSeconds float64) (bool, error) {
+	var cancelRequested bool
+	err := q.db.QueryRow(ctx, `
+		UPDATE timetable.generation_jobs
+		SET lease_expires_at=NOW() + make_interval(secs => $3)
+		WHERE id=$1 AND lease_owner=$2 AND status='running'
+		RETURNING cancel_requested`,
+		id, owner, leaseSeconds).Scan(&cancelRequested)
+	return cancelRequested, err
+}
+
+func (q *Queries) FinishGenerationJob(ctx context.Context, id pgtype.UUID, owner, status, errMsg string) error {
+	_, err := q.db.Exec(ctx, `
+		UPDATE timetable.generation_jobs
+		SET status=$3, error_message=$4, finished_at=NOW(), lease_expires_at=NULL
+		WHERE id=$1 AND lease_owner=$2 AND status='running'`,
+		id, owner, status, errMsg)
+	return err
+}
+
+// RequestGenerationJobCancel flags the schedule's active job; a queued job is
+// cancelled at once. Returns pgx.ErrNoRows when no job is active.
+func (q *Queries) RequestGenerationJobCancel(ctx context.Context, scheduleID pgtype.UUID) (TimetableGenerationJob, error) {
+	row := q.db.QueryRow(ctx, `
+		UPDATE timetable.generation_jobs
+		SET cancel_requested=true,
+		    status=CASE WHEN status='queued' THEN 'cancelled' ELSE status END,
+		    finished_at=CASE WHEN status='queued' THEN NOW() ELSE finished_at END
+		WHERE schedule_id=$1 AND status IN ('queued','running')
+		RETURNING *`,
+		scheduleID)
+	return scanGenerationJob(row)
+}
+
+// ReleaseGenerationJobs puts owner's running jobs back in the queue. With
+// refund the interrupted attempt does not count against max_attempts.
+func (q *Queries) ReleaseGenerationJobs(ctx context.Context, owner string, refund bool) (int64, error) {
+	tag, err := q.db.Exec(ctx, `
+		UPDATE timetable.generation_jobs
+		SET status='queued', lease_owner='', lease_expires_at=NULL,
+		    attempts=CASE WHEN $2 THEN GREATEST(attempts-1, 0) ELSE attempts END
+		WHERE status='running' AND lease_owner=$1`,
+		owner, refund)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ReapGenerationJobs ends jobs that cannot be claimed again: running ones whose
+// lease expired after a cancel request or on their last attempt, and queued
+// ones that were released with no attempts left.
+func (q *Queries) ReapGenerationJobs(ctx context.Context) ([]TimetableGenerationJob, error) {
+	rows, err := q.db.Query(ctx, `
+		UPDATE timetable.generation_jobs
+		SET status=CASE WHEN cancel_requested THEN 'cancelled' ELSE 'failed' END,
+		    error_message=CASE WHEN cancel_requested THEN 'generation cancelled'
+		                       ELSE 'generation interrupted ' || attempts || ' times' END,
+		    finished_at=NOW(), lease_expires_at=NULL
+		WHERE (status='running' AND lease_expires_at < NOW()
+		       AND (cancel_requested OR attempts >= max_attempts))
+		   OR (status='queued' AND attempts >= max_attempts)
+		RETURNING *`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return pgx.CollectRows(rows, scanGenerationJobRow)
+}
+
+// --- ScheduleVersion queries ---
+
+// LockScheduleByID reads a schedule and locks its row until the transaction ends.
+func (q *Queries) LockScheduleByID(ctx context.Context, id pgtype.UUID) (TimetableSchedule, error) {
+	row := q.db.QueryRow(ctx, `SELECT * FROM timetable.schedules WHERE id=$1 FOR UPDATE`, id)
+	return scanSchedule(row)
+}
+
+// ArchivePublishedSchedules archives the semester's published schedule other
+// than keepID and returns the IDs it archived.
+func (q *Queries) ArchivePublishedSchedules(ctx context.Context, semesterID, keepID pgtype.UUID) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, `
+		UPDATE timetable.schedules SET status='archived'
+		WHERE semester_id=$1 AND status='published' AND id<>$2
+		RETURNING id`,
+		semesterID, keepID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return pgx.CollectRows(rows, pgx.RowTo[pgtype.UUID])
+}
+
+type CreateScheduleVersionParams struct {
+	SemesterID     pgtype.UUID
+	ScheduleID     pgtype.UUID
+	Score          float64
+	HardViolations int32
+	SoftPenalty    float64
+	Entries        []byte
+}
+
+// CreateScheduleVersion appends the semester's next version; the unique key
+// rejects a concurrent publish that picked the same number.
+func (q *Queries) CreateScheduleVersion(ctx context.Context, p CreateScheduleVersionParams) (TimetableScheduleVersion, error) {
+	row := q.db.QueryRow(ctx, `
+		INSERT INTO timetable.schedule_versions
+		  (semester_id, schedule_id, version, score, hard_violations, soft_penalty, entries)
+		SELECT $1, $2, COALESCE(MAX(version), 0) + 1, $3, $4, $5, $6
+		FROM timetable.schedule_versions WHERE semester_id=$1
+		RETURNING *`,
+		p.SemesterID, p.ScheduleID, p.Score, p.HardViolations, p.SoftPenalty, p.Entries)
+	return scanScheduleVersion(row)
+}
+
+// ListScheduleVersions returns a semester's versions, newest first, without
+// their entry snapshots.
+func (q *Queries) ListScheduleVersions(ctx context.Context, semesterID pgtype.UUID) ([]TimetableScheduleVersion, error) {
+	rows, err := q.db.Query(ctx, `
+		SELECT id, semester_id, schedule_id, version, score, hard_violations, soft_penalty,
+		       '[]'::jsonb AS entries, published_at
+		FROM timetable.schedule_versions
+		WHERE semester_id=$1
+		ORDER BY version DESC`,
+		semesterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return pgx.CollectRows(rows, scanScheduleVersionRow)
+}
+
+func (q *Queries) GetScheduleVersion(ctx context.Context, semesterID pgtype.UUID, version int32) (TimetableScheduleVersion, error) {
+	row := q.db.QueryRow(ctx, `
+		SELECT * FROM timetable.schedule_versions WHERE semester_id=$1 AND version=$2`,
+		semesterID, version)
+	return scanScheduleVersion(row)
+}
+
+// --- ExamSchedule queries ---
+
+type CreateExamScheduleParams struct {
+	SemesterID            pgtype.UUID
+	Name                  string
+	StartDate             pgtype.Date
+	EndDate               pgtype.Date
+	Settings              []byte
+	Score                 float64
+	SoftPenalty           float64
+	Entries               []byte
+	UnscheduledSubjectIDs []pgtype.UUID
+}
+
+func (q *Queries) CreateExamSchedule(ctx context.Context, p CreateExamScheduleParams) (TimetableExamSchedule, error) {
+	row := q.db.QueryRow(ctx, `
+		INSERT INTO timetable.exam_schedules
+		  (semester_id, name, start_date, end_date, settings, score, soft_penalty, entries, unscheduled_subject_ids)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+		RETURNING *`,
+		p.SemesterID, p.Name, p.StartDate, p.EndDate, p.Settings, p.Score, p.SoftPenalty, p.Entries, p.UnscheduledSubjectIDs)
+	return scanExamSchedule(row)
+}
+
+func (q *Queries) GetExamScheduleByID(ctx context.Context, id pgtype.UUID) (TimetableExamSchedule, error) {
+	row := q.db.QueryRow(ctx, `SELECT * FROM timetable.exam_schedules WHERE id=$1`, id)
+	return scanExamSchedule(row)
+}
+
+// ListExamSchedules returns a semester's exam schedules, newest first,
+// without their entries.
+func (q *Queries) ListExamSchedules(ctx context.Context, semesterID pgtype.UUID) ([]TimetableExamSchedule, error) {
+	rows, err := q.db.Query(ctx, `
+		SELECT id, semester_id, name, start_date, end_date, settings, score, soft_penalty,
+		       '[]'::jsonb AS entries, unscheduled_subject_ids, created_at
+		FROM timetable.exam_schedules
+		WHERE semester_id=$1
+		ORDER BY created_at DESC`,
+		semesterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return pgx.CollectRows(rows, scanExamScheduleRow)
+}
+
+// --- RoomBooking queries ---
+
+type CreateRoomBookingParams struct {
+	RoomID      pgtype.UUID
+	Title       string
+	Purpose     string
+	RequestedBy pgtype.UUID
+	StartDate   pgtype.Date
+	EndDate     pgtype.Date
+	StartPeriod int32
+	EndPeriod   int32
+	Status      string
+}
+
+func (q *Queries) CreateRoomBooking(ctx context.Context, p CreateRoomBookingParams) (TimetableRoomBooking, error) {
+	row := q.db.QueryRow(ctx, `
+		WITH b AS (
+		  INSERT INTO timetable.room_bookings
+		    (room_id, title, purpose, requested_by, start_date, end_date, start_period, end_period, status)
+		  VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+		  RETURNING *
+		)
+		SELECT b.*, r.name AS room_name FROM b JOIN timetable.rooms r ON r.id = b.room_id`,
+		p.RoomID, p.Title, p.Purpose, p.RequestedBy, p.StartDate, p.EndDate, p.StartPeriod, p.EndPeriod, p.Status)
+	return scanRoomBooking(row)
+}
+
+func (q *Queries) GetRoomBookingByID(ctx context.Context, id pgtype.UUID) (TimetableRoomBooking, error) {
+	row := q.db.QueryRow(ctx, `
+		SELECT b.*, r.name AS room_name
+		FROM timetable.room_bookings b JOIN timetable.rooms r ON r.id = b.room_id
+		WHERE b.id=$1`, id)
+	return scanRoomBooking(row)
+}
+
+// ListRoomBookingsParams filters bookings; zero UUIDs, an empty status list
+// and invalid dates skip their filter. From/To select bookings whose range
+// overlaps it.
+type ListRoomBookingsParams struct {
+	RoomID      pgtype.UUID
+	RequestedBy pgtype.UUID
+	Statuses    []string
+	From        pgtype.Date
+	To          pgtype.Date
+}
+
+func (q *Queries) ListRoomBookings(ctx context.Context, p ListRoomBookingsParams) ([]TimetableRoomBooking, error) {
+	if p.Statuses == nil {
+		p.Statuses = []string{}
+	}
+	rows, err := q.db.Query(ctx, `
+		SELECT b.*, r.name AS room_name
+		FROM timetable.room_bookings b JOIN timetable.rooms r ON r.id = b.room_id
+		WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR b.room_id = $1)
+		  AND ($2 = '00000000-0000-0000-0000-000000000000'::uuid OR b.requested_by = $2)
+		  AND (cardinality($3::text[]) = 0 OR b.status = ANY($3))
+		  AND ($4::date IS NULL OR b.end_date >= $4)
+		  AND ($5::date IS NULL OR b.start_date <= $5)
+		ORDER BY b.start_date, b.start_period, b.created_at`,
+		p.RoomID, p.RequestedBy, p.Statuses, p.From, p.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return pgx.CollectRows(rows, scanRoomBookingRow)
+}
+
+type UpdateRoomBookingStatusParams struct {
+	ID         pgtype.UUID
+	Status     string
+	ReviewedBy pgtype.UUID
+	ReviewNote string
+	ReviewedAt pgtype.Timestamptz
+	// FromStatus is the status the booking must still have; returns
+	// pgx.ErrNoRows when it changed meanwhile.
+	FromStatus string
+}
+
+func (q *Queries) UpdateRoomBookingStatus(ctx context.Context, p UpdateRoomBookingStatusParams) (TimetableRoomBooking, error) {
+	row := q.db.QueryRow(ctx, `
+		WITH b AS (
+		  UPDATE timetable.room_bookings
+		  SET status=$2, reviewed_by=$3, review_note=$4, reviewed_at=$5
+		  WHERE id=$1 AND status=$6
+		  RETURNING *
+		)
+		SELECT b.*, r.name AS room_name FROM b JOIN timetable.rooms r ON r.id = b.room_id`,
+		p.ID, p.Status, p.ReviewedBy, p.ReviewNote, p.ReviewedAt, p.FromStatus)
+	return scanRoomBooking(row)
+}
+
 // --- scan helpers ---
 
 func scanSemester(row pgx.Row) (TimetableSemester, error) {
 	var s TimetableSemester
-	err := row.Scan(&s.ID, &s.Name, &s.Year, &s.Term, &s.StartDate, &s.EndDate, &s.OfferedSubjectIDs, &s.CreatedAt, &s.RoomIDs)
+	err := row.Scan(&s.ID, &s.Name, &s.Year, &s.Term, &s.StartDate, &s.EndDate, &s.OfferedSubjectIDs, &s.CreatedAt, &s.RoomIDs, &s.SoftConstraints, &s.Periods, &s.Holidays, &s.WeekPatterns, &s.Sections)
 	if err != nil {
 		return s, fmt.Errorf("scan semester: %w", err)
 	}
@@ -346,13 +873,14 @@ func scanSemester(row pgx.Row) (TimetableSemester, error) {
 
 func scanSemesterRow(row pgx.CollectableRow) (TimetableSemester, error) {
 	var s TimetableSemester
-	err := row.Scan(&s.ID, &s.Name, &s.Year, &s.Term, &s.StartDate, &s.EndDate, &s.OfferedSubjectIDs, &s.CreatedAt, &s.RoomIDs)
+	err := row.Scan(&s.ID, &s.Name, &s.Year, &s.Term, &s.StartDate, &s.EndDate, &s.OfferedSubjectIDs, &s.CreatedAt, &s.RoomIDs, &s.SoftConstraints, &s.Periods, &s.Holidays, &s.WeekPatterns, &s.Sections)
 	return s, err
 }
 
 func scanRoom(row pgx.Row) (TimetableRoom, error) {
 	var r TimetableRoom
-	err := row.Scan(&r.ID, &r.Name, &r.Capacity, &r.Type, &r.Features, &r.IsActive)
+	err := row.Scan(&r.ID, &r.Name, &r.Capacity, &r.Type, &r.Features, &r.IsActive, &r.RequiresApproval,
+		&r.BuildingID, &r.BuildingCode, &r.CampusID)
 	if err != nil {
 		return r, fmt.Errorf("scan room: %w", err)
 	}
@@ -361,14 +889,42 @@ func scanRoom(row pgx.Row) (TimetableRoom, error) {
 
 func scanRoomRow(row pgx.CollectableRow) (TimetableRoom, error) {
 	var r TimetableRoom
-	err := row.Scan(&r.ID, &r.Name, &r.Capacity, &r.Type, &r.Features, &r.IsActive)
+	err := row.Scan(&r.ID, &r.Name, &r.Capacity, &r.Type, &r.Features, &r.IsActive, &r.RequiresApproval,
+		&r.BuildingID, &r.BuildingCode, &r.CampusID)
 	return r, err
 }
 
+func scanCampus(row pgx.Row) (TimetableCampus, error) {
+	var c TimetableCampus
+	err := row.Scan(&c.ID, &c.Code, &c.Name, &c.CreatedAt)
+	if err != nil {
+		return c, fmt.Errorf("scan campus: %w", err)
+	}
+	return c, nil
+}
+
+func scanCampusRow(row pgx.CollectableRow) (TimetableCampus, error) {
+	return scanCampus(row)
+}
+
+func scanBuilding(row pgx.Row) (TimetableBuilding, error) {
+	var b TimetableBuilding
+	err := row.Scan(&b.ID, &b.CampusID, &b.Code, &b.Name, &b.CreatedAt)
+	if err != nil {
+		return b, fmt.Errorf("scan building: %w", err)
+	}
+	return b, nil
+}
+
+func scanBuildingRow(row pgx.CollectableRow) (TimetableBuilding, error) {
+	return scanBuilding(row)
+}
+
 func scanSchedule(row pgx.Row) (TimetableSchedule, error) {
 	var s TimetableSchedule
 	err := row.Scan(&s.ID, &s.SemesterID, &s.Name, &s.Status,
-		&s.Score, &s.HardViolations, &s.SoftPenalty, &s.GeneratedAt, &s.CreatedAt)
+		&s.Score, &s.HardViolations, &s.SoftPenalty, &s.GeneratedAt, &s.CreatedAt,
+		&s.FailureReason, &s.Diagnosis, &s.CohortClashes)
 	if err != nil {
 		return s, fmt.Errorf("scan schedule: %w", err)
 	}
@@ -378,7 +934,8 @@ func scanSchedule(row pgx.Row) (TimetableSchedule, error) {
 func scanScheduleRow(row pgx.CollectableRow) (TimetableSchedule, error) {
 	var s TimetableSchedule
 	err := row.Scan(&s.ID, &s.SemesterID, &s.Name, &s.Status,
-		&s.Score, &s.HardViolations, &s.SoftPenalty, &s.GeneratedAt, &s.CreatedAt)
+		&s.Score, &s.HardViolations, &s.SoftPenalty, &s.GeneratedAt, &s.CreatedAt,
+		&s.FailureReason, &s.Diagnosis, &s.CohortClashes)
 	return s, err
 }
 
@@ -388,6 +945,7 @@ func scanEntry(row pgx.Row) (TimetableScheduleEntry, error) {
 		&e.ID, &e.ScheduleID, &e.SubjectID, &e.TeacherID,
 		&e.RoomID, &e.TimeSlotID, &e.IsManualOverride, &e.CreatedAt,
 		&e.SubjectName, &e.SubjectCode, &e.TeacherName, &e.DepartmentID,
+		&e.Section, &e.Assistants,
 	)
 	if err != nil {
 		return e, fmt.Errorf("scan entry: %w", err)
@@ -401,7 +959,65 @@ func scanEntryEnrichedRow(row pgx.CollectableRow) (TimetableScheduleEntryRow, er
 		&e.ID, &e.ScheduleID, &e.SubjectID, &e.TeacherID,
 		&e.RoomID, &e.TimeSlotID, &e.IsManualOverride, &e.CreatedAt,
 		&e.SubjectName, &e.SubjectCode, &e.TeacherName, &e.DepartmentID,
+		&e.Section, &e.Assistants,
 		&e.DayOfWeek, &e.StartPeriod, &e.EndPeriod, &e.RoomName,
 	)
 	return e, err
 }
+
+func scanGenerationJob(row pgx.Row) (TimetableGenerationJob, error) {
+	var j TimetableGenerationJob
+	err := row.Scan(&j.ID, &j.ScheduleID, &j.SemesterID, &j.Status, &j.Params,
+		&j.Attempts, &j.MaxAttempts, &j.LeaseOwner, &j.LeaseExpiresAt, &j.CancelRequested,
+		&j.ErrorMessage, &j.CreatedAt, &j.StartedAt, &j.FinishedAt)
+	if err != nil {
+		return j, fmt.Errorf("scan generation job: %w", err)
+	}
+	return j, nil
+}
+
+func scanGenerationJobRow(row pgx.CollectableRow) (TimetableGenerationJob, error) {
+	return scanGenerationJob(row)
+}
+
+func scanScheduleVersion(row pgx.Row) (TimetableScheduleVersion, error) {
+	var v TimetableScheduleVersion
+	err := row.Scan(&v.ID, &v.SemesterID, &v.ScheduleID, &v.Version,
+		&v.Score, &v.HardViolations, &v.SoftPenalty, &v.Entries, &v.PublishedAt)
+	if err != nil {
+		return v, fmt.Errorf("scan schedule version: %w", err)
+	}
+	return v, nil
+}
+
+func scanScheduleVersionRow(row pgx.CollectableRow) (TimetableScheduleVersion, error) {
+	return scanScheduleVersion(row)
+}
+
+func scanExamSchedule(row pgx.Row) (TimetableExamSchedule, error) {
+	var e TimetableExamSchedule
+	err := row.Scan(&e.ID, &e.SemesterID, &e.Name, &e.StartDate, &e.EndDate, &e.Settings,
+		&e.Score, &e.SoftPenalty, &e.Entries, &e.UnscheduledSubjectIDs, &e.CreatedAt)
+	if err != nil {
+		return e, fmt.Errorf("scan exam schedule: %w", err)
+	}
+	return e, nil
+}
+
+func scanExamScheduleRow(row pgx.CollectableRow) (TimetableExamSchedule, error) {
+	return scanExamSchedule(row)
+}
+
+func scanRoomBooking(row pgx.Row) (TimetableRoomBooking, error) {
+	var b TimetableRoomBooking
+	err := row.Scan(&b.ID, &b.RoomID, &b.Title, &b.Purpose, &b.RequestedBy, &b.StartDate, &b.EndDate,
+		&b.StartPeriod, &b.EndPeriod, &b.Status, &b.ReviewedBy, &b.ReviewNote, &b.ReviewedAt, &b.CreatedAt, &b.RoomName)
+	if err != nil {
+		return b, fmt.Errorf("scan room booking: %w", err)
+	}
+	return b, nil
+}
+
+func scanRoomBookingRow(row pgx.CollectableRow) (TimetableRoomBooking, error) {
+	return scanRoomBooking(row)
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *TimetableServer) ExportICalendar(ctx context.Context, req *timetablev1.ExportICalendarRequest) (*timetablev1.ExportICalendarResponse, error) {
+	var q query.ExportICalendarQuery
+	ids := []struct {
+		name  string
+		value string
+		dst   *uuid.UUID
+	}{
+		{"schedule_id", req.ScheduleId, &q.ScheduleID},
+		{"semester_id", req.SemesterId, &q.SemesterID},
+		{"teacher_id", req.TeacherId, &q.TeacherID},
+		{"room_id", req.RoomId, &q.RoomID},
+		{"student_id", req.StudentId, &q.StudentID},
+		{"department_id", req.DepartmentId, &q.DepartmentID},
+	}
+	filters := 0
+	for i, id := range ids {
+		if id.value == "" {
+			continue
+		}
+		parsed, err := uuid.Parse(id.value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s", id.name)
+		}
+		*id.dst = parsed
+		if i >= 2 {
+			filters++
+		}
+	}
+	if q.ScheduleID == uuid.Nil && q.SemesterID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "schedule_id or semester_id is required")
+	}
+	if filters > 1 {
+		return nil, status.Error(codes.InvalidArgument, "set at most one of teacher_id, room_id, student_id and department_id")
+	}
+
+	result, err := s.exportCalendar.Handle(ctx, q)
+	if err != nil {
+		if errors.Is(err, query.ErrNoPublishedSchedule) {
+			return nil, status.Errorf(codes.NotFound, "export calendar: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "export calendar: %v", err)
+	}
+	return &timetablev1.ExportICalendarResponse{Calendar: result.Calendar, Filename: result.Filename}, nil
+}
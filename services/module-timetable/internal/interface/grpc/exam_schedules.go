@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/command"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/query"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExamScheduling groups the handlers behind the exam timetable RPCs of
+// TimetableServer.
+type ExamScheduling struct {
+	Generate *command.GenerateExamScheduleHandler
+	Get      *query.GetExamScheduleHandler
+	List     *query.ListExamSchedulesHandler
+}
+
+func (s *TimetableServer) GenerateExamSchedule(ctx context.Context, req *timetablev1.GenerateExamScheduleRequest) (*timetablev1.GenerateExamScheduleResponse, error) {
+	semesterID, err := uuid.Parse(req.SemesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+	}
+	if req.StartDate == nil || req.EndDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_date and end_date are required")
+	}
+	cmd := command.GenerateExamScheduleCommand{
+		SemesterID:     semesterID,
+		Name:           req.Name,
+		StartDate:      req.StartDate.AsTime(),
+		EndDate:        req.EndDate.AsTime(),
+		Settings:       examSettingsFromProto(req.Settings),
+		TimeoutSeconds: int(req.TimeoutSeconds),
+	}
+	exam, err := s.exams.Generate.Handle(ctx, cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, command.ErrInvalidExamPeriod):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, service.ErrNoFeasibleSolution):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "generate exam schedule: %v", err)
+	}
+	return &timetablev1.GenerateExamScheduleResponse{ExamSchedule: examScheduleToProto(exam)}, nil
+}
+
+func (s *TimetableServer) GetExamSchedule(ctx context.Context, req *timetablev1.GetExamScheduleRequest) (*timetablev1.GetExamScheduleResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	exam, err := s.exams.Get.Handle(ctx, query.GetExamScheduleQuery{ID: id})
+	if err != nil {
+		if errors.Is(err, repository.ErrExamScheduleNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "get exam schedule: %v", err)
+	}
+	return &timetablev1.GetExamScheduleResponse{ExamSchedule: examScheduleToProto(exam)}, nil
+}
+
+func (s *TimetableServer) ListExamSchedules(ctx context.Context, req *timetablev1.ListExamSchedulesRequest) (*timetablev1.ListExamSchedulesResponse, error) {
+	semesterID, err := uuid.Parse(req.SemesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+	}
+	exams, err := s.exams.List.Handle(ctx, query.ListExamSchedulesQuery{SemesterID: semesterID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list exam schedules: %v", err)
+	}
+	resp := &timetablev1.ListExamSchedulesResponse{}
+	for _, e := range exams {
+		resp.ExamSchedules = append(resp.ExamSchedules, examScheduleToProto(e))
+	}
+	return resp, nil
+}
+
+func examSettingsFromProto(p *timetablev1.ExamSettings) entity.ExamSettings {
+	if p == nil {
+		return entity.ExamSettings{}
+	}
+	settings := entity.ExamSettings{
+		MaxPerDay:        int(p.MaxPerDay),
+		SeatSpacing:      int(p.SeatSpacing),
+		MinGapDays:       int(p.MinGapDays),
+		MaxInvigilations: int(p.MaxInvigilations),
+	}
+	for _, s := range p.Sessions {
+		settings.Sessions = append(settings.Sessions, entity.ExamSession{StartPeriod: int(s.StartPeriod), EndPeriod: int(s.EndPeriod)})
+	}
+	return settings
+}
+
+func examScheduleToProto(e *entity.ExamSchedule) *timetablev1.ExamSchedule {
+	p := &timetablev1.ExamSchedule{
+		Id:                    e.ID.String(),
+		SemesterId:            e.SemesterID.String(),
+		Name:                  e.Name,
+		StartDate:             timestamppb.New(e.StartDate),
+		EndDate:               timestamppb.New(e.EndDate),
+		Score:                 e.Score,
+		SoftPenalty:           e.SoftPenalty,
+		UnscheduledSubjectIds: uuidStrings(e.Unscheduled),
+		CreatedAt:             timestamppb.New(e.CreatedAt),
+		Settings: &timetablev1.ExamSettings{
+			MaxPerDay:        int32(e.Settings.MaxPerDay),
+			SeatSpacing:      int32(e.Settings.SeatSpacing),
+			MinGapDays:       int32(e.Settings.MinGapDays),
+			MaxInvigilations: int32(e.Settings.MaxInvigilations),
+		},
+	}
+	for _, s := range e.Settings.Sessions {
+		p.Settings.Sessions = append(p.Settings.Sessions, &timetablev1.ExamSession{
+			StartPeriod: int32(s.StartPeriod),
+			EndPeriod:   int32(s.EndPeriod),
+		})
+	}
+	for _, en := range e.Entries {
+		p.Entries = append(p.Entries, &timetablev1.ExamEntry{
+			SubjectId:       en.SubjectID.String(),
+			SubjectCode:     en.SubjectCode,
+			SubjectName:     en.SubjectName,
+			Date:            timestamppb.New(en.Date),
+			StartPeriod:     int32(en.StartPeriod),
+			EndPeriod:       int32(en.EndPeriod),
+			RoomId:          en.RoomID.String(),
+			RoomName:        en.RoomName,
+			InvigilatorId:   en.InvigilatorID.String(),
+			InvigilatorName: en.InvigilatorName,
+			StudentCount:    int32(en.StudentCount),
+		})
+	}
+	return p
+}
@@ -0,0 +1,239 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/command"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/query"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RoomBookingServer implements timetablev1.RoomBookingServiceServer.
+type RoomBookingServer struct {
+	timetablev1.UnimplementedRoomBookingServiceServer
+
+	create    *command.CreateRoomBookingHandler
+	review    *command.ReviewRoomBookingHandler
+	cancel    *command.CancelRoomBookingHandler
+	get       *query.GetRoomBookingHandler
+	list      *query.ListRoomBookingsHandler
+	freeRooms *query.FindFreeRoomsHandler
+}
+
+func NewRoomBookingServer(
+	create *command.CreateRoomBookingHandler,
+	review *command.ReviewRoomBookingHandler,
+	cancel *command.CancelRoomBookingHandler,
+	get *query.GetRoomBookingHandler,
+	list *query.ListRoomBookingsHandler,
+	freeRooms *query.FindFreeRoomsHandler,
+) *RoomBookingServer {
+	return &RoomBookingServer{
+		create:    create,
+		review:    review,
+		cancel:    cancel,
+		get:       get,
+		list:      list,
+		freeRooms: freeRooms,
+	}
+}
+
+func (s *RoomBookingServer) CreateRoomBooking(ctx context.Context, req *timetablev1.CreateRoomBookingRequest) (*timetablev1.CreateRoomBookingResponse, error) {
+	roomID, err := uuid.Parse(req.RoomId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid room_id")
+	}
+	requestedBy, err := uuid.Parse(req.RequestedBy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid requested_by")
+	}
+	if req.StartDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_date is required")
+	}
+	cmd := command.CreateRoomBookingCommand{
+		RoomID:      roomID,
+		Title:       req.Title,
+		Purpose:     req.Purpose,
+		RequestedBy: requestedBy,
+		StartDate:   req.StartDate.AsTime(),
+		StartPeriod: int(req.StartPeriod),
+		EndPeriod:   int(req.EndPeriod),
+	}
+	if req.EndDate != nil {
+		cmd.EndDate = req.EndDate.AsTime()
+	}
+	booking, err := s.create.Handle(ctx, cmd)
+	if err != nil {
+		return nil, roomBookingError("create room booking", err)
+	}
+	return &timetablev1.CreateRoomBookingResponse{Booking: roomBookingToProto(booking)}, nil
+}
+
+func (s *RoomBookingServer) GetRoomBooking(ctx context.Context, req *timetablev1.GetRoomBookingRequest) (*timetablev1.GetRoomBookingResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	booking, err := s.get.Handle(ctx, query.GetRoomBookingQuery{ID: id})
+	if err != nil {
+		return nil, roomBookingError("get room booking", err)
+	}
+	return &timetablev1.GetRoomBookingResponse{Booking: roomBookingToProto(booking)}, nil
+}
+
+func (s *RoomBookingServer) ListRoomBookings(ctx context.Context, req *timetablev1.ListRoomBookingsRequest) (*timetablev1.ListRoomBookingsResponse, error) {
+	var filter repository.RoomBookingFilter
+	var err error
+	if req.RoomId != "" {
+		if filter.RoomID, err = uuid.Parse(req.RoomId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid room_id")
+		}
+	}
+	if req.RequestedBy != "" {
+		if filter.RequestedBy, err = uuid.Parse(req.RequestedBy); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid requested_by")
+		}
+	}
+	for _, raw := range req.Statuses {
+		st := valueobject.BookingStatus(raw)
+		if !st.IsValid() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid status %q", raw)
+		}
+		filter.Statuses = append(filter.Statuses, st)
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+	bookings, err := s.list.Handle(ctx, query.ListRoomBookingsQuery{Filter: filter})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list room bookings: %v", err)
+	}
+	resp := &timetablev1.ListRoomBookingsResponse{}
+	for _, b := range bookings {
+		resp.Bookings = append(resp.Bookings, roomBookingToProto(b))
+	}
+	return resp, nil
+}
+
+func (s *RoomBookingServer) ReviewRoomBooking(ctx context.Context, req *timetablev1.ReviewRoomBookingRequest) (*timetablev1.ReviewRoomBookingResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	reviewer, err := uuid.Parse(req.ReviewerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reviewer_id")
+	}
+	booking, err := s.review.Handle(ctx, command.ReviewRoomBookingCommand{
+		ID:         id,
+		ReviewerID: reviewer,
+		Approve:    req.Approve,
+		Note:       req.Note,
+	})
+	if err != nil {
+		return nil, roomBookingError("review room booking", err)
+	}
+	return &timetablev1.ReviewRoomBookingResponse{Booking: roomBookingToProto(booking)}, nil
+}
+
+func (s *RoomBookingServer) CancelRoomBooking(ctx context.Context, req *timetablev1.CancelRoomBookingRequest) (*timetablev1.CancelRoomBookingResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	booking, err := s.cancel.Handle(ctx, command.CancelRoomBookingCommand{ID: id, UserID: userID, Override: req.Override})
+	if err != nil {
+		return nil, roomBookingError("cancel room booking", err)
+	}
+	return &timetablev1.CancelRoomBookingResponse{Booking: roomBookingToProto(booking)}, nil
+}
+
+func (s *RoomBookingServer) FindFreeRooms(ctx context.Context, req *timetablev1.FindFreeRoomsRequest) (*timetablev1.FindFreeRoomsResponse, error) {
+	if req.StartDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_date is required")
+	}
+	q := query.FindFreeRoomsQuery{
+		StartDate:   req.StartDate.AsTime(),
+		StartPeriod: int(req.StartPeriod),
+		EndPeriod:   int(req.EndPeriod),
+		MinCapacity: int(req.MinCapacity),
+		RoomType:    req.RoomType,
+	}
+	if req.EndDate != nil {
+		q.EndDate = req.EndDate.AsTime()
+	}
+	if req.DayOfWeek != nil {
+		day := int(req.GetDayOfWeek())
+		if day < 0 || day > 6 {
+			return nil, status.Error(codes.InvalidArgument, "day_of_week must be 0-6")
+		}
+		q.DayOfWeek = &day
+	}
+	rooms, err := s.freeRooms.Handle(ctx, q)
+	if err != nil {
+		if errors.Is(err, query.ErrInvalidAvailabilityQuery) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "find free rooms: %v", err)
+	}
+	resp := &timetablev1.FindFreeRoomsResponse{}
+	for _, r := range rooms {
+		resp.Rooms = append(resp.Rooms, roomToProto(r))
+	}
+	return resp, nil
+}
+
+// roomBookingError maps booking use-case errors to gRPC statuses.
+func roomBookingError(op string, err error) error {
+	switch {
+	case errors.Is(err, command.ErrInvalidRoomBooking):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, repository.ErrRoomBookingNotFound), errors.Is(err, repository.ErrRoomNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, command.ErrBookingNotOwned):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, command.ErrRoomUnavailable), errors.Is(err, entity.ErrBookingTransition):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", op, err)
+}
+
+func roomBookingToProto(b *entity.RoomBooking) *timetablev1.RoomBooking {
+	p := &timetablev1.RoomBooking{
+		Id:          b.ID.String(),
+		RoomId:      b.RoomID.String(),
+		RoomName:    b.RoomName,
+		Title:       b.Title,
+		Purpose:     b.Purpose,
+		RequestedBy: b.RequestedBy.String(),
+		StartDate:   timestamppb.New(b.StartDate),
+		EndDate:     timestamppb.New(b.EndDate),
+		StartPeriod: int32(b.StartPeriod),
+		EndPeriod:   int32(b.EndPeriod),
+		Status:      b.Status.String(),
+		ReviewNote:  b.ReviewNote,
+		CreatedAt:   timestamppb.New(b.CreatedAt),
+	}
+	if b.ReviewedBy != uuid.Nil {
+		p.ReviewedBy = b.ReviewedBy.String()
+	}
+	if !b.ReviewedAt.IsZero() {
+		p.ReviewedAt = timestamppb.New(b.ReviewedAt)
+	}
+	return p
+}
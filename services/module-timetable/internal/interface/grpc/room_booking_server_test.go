@@ -0,0 +1,186 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/command"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/query"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRoomBookingServer(t *testing.T) {
+	semesterID, scheduleID := uuid.New(), uuid.New()
+	hall, lab, boardroom := uuid.New(), uuid.New(), uuid.New()
+	alice, bob, admin := uuid.New(), uuid.New(), uuid.New()
+
+	semesterRepo := &mockSemesterRepository{getByID: map[uuid.UUID]*entity.Semester{
+		semesterID: {
+			ID:        semesterID,
+			StartDate: time.Date(2026, 9, 7, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2026, 12, 18, 0, 0, 0, 0, time.UTC),
+		},
+	}}
+	// The published schedule holds the hall on Tuesdays, periods 1-3
+	scheduleRepo := &mockScheduleRepository{
+		byID: map[uuid.UUID]*entity.Schedule{
+			scheduleID: {ID: scheduleID, SemesterID: semesterID, Status: valueobject.ScheduleStatusPublished},
+		},
+		entries: map[uuid.UUID][]*entity.ScheduleEntry{
+			scheduleID: {{SubjectCode: "CS101", RoomID: hall, DayOfWeek: 1, StartPeriod: 1, EndPeriod: 3}},
+		},
+	}
+	roomRepo := &mockRoomRepository{rooms: []*entity.Room{
+		{ID: hall, Name: "Hall", Capacity: 120, Type: "lecture_hall", IsActive: true},
+		{ID: lab, Name: "Lab", Capacity: 30, Type: "lab", IsActive: true},
+		{ID: boardroom, Name: "Boardroom", Capacity: 40, Type: "meeting", IsActive: true, RequiresApproval: true},
+	}}
+	bookingRepo := &mockRoomBookingRepository{}
+	loader := query.NewRoomOccupancyLoader(semesterRepo, scheduleRepo, bookingRepo)
+	server := NewRoomBookingServer(
+		command.NewCreateRoomBookingHandler(roomRepo, bookingRepo, loader, &mockEventPublisher{}),
+		command.NewReviewRoomBookingHandler(bookingRepo, loader, &mockEventPublisher{}),
+		command.NewCancelRoomBookingHandler(bookingRepo, &mockEventPublisher{}),
+		query.NewGetRoomBookingHandler(bookingRepo),
+		query.NewListRoomBookingsHandler(bookingRepo),
+		query.NewFindFreeRoomsHandler(roomRepo, loader),
+	)
+	conn := startTimetableTestServer(t, func(s *grpc.Server) {
+		timetablev1.RegisterRoomBookingServiceServer(s, server)
+	})
+	client := timetablev1.NewRoomBookingServiceClient(conn)
+	ctx := context.Background()
+	tuesday := timestamppbFromTime(time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC))
+
+	// Period 3 is taught in the hall
+	_, err := client.CreateRoomBooking(ctx, &timetablev1.CreateRoomBookingRequest{
+		RoomId: hall.String(), Title: "Defence", RequestedBy: alice.String(),
+		StartDate: tuesday, StartPeriod: 3, EndPeriod: 5,
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for a clash with a class, got %v", err)
+	}
+
+	defence, err := client.CreateRoomBooking(ctx, &timetablev1.CreateRoomBookingRequest{
+		RoomId: hall.String(), Title: "Defence", RequestedBy: alice.String(),
+		StartDate: tuesday, StartPeriod: 4, EndPeriod: 5,
+	})
+	if err != nil {
+		t.Fatalf("CreateRoomBooking: %v", err)
+	}
+	if defence.Booking.Status != "approved" || defence.Booking.RoomName != "Hall" {
+		t.Fatalf("unrestricted rooms are approved at once, got %+v", defence.Booking)
+	}
+
+	// A restricted room waits for approval, and the pending request holds it
+	meeting, err := client.CreateRoomBooking(ctx, &timetablev1.CreateRoomBookingRequest{
+		RoomId: boardroom.String(), Title: "Board meeting", RequestedBy: bob.String(),
+		StartDate: tuesday, StartPeriod: 3, EndPeriod: 5,
+	})
+	if err != nil || meeting.Booking.Status != "pending" {
+		t.Fatalf("expected a pending booking, got %v %+v", err, meeting)
+	}
+
+	// Free rooms with at least 40 seats on Tuesday periods 3-5: only the
+	// lab is unbooked, and it is too small
+	free, err := client.FindFreeRooms(ctx, &timetablev1.FindFreeRoomsRequest{
+		StartDate: tuesday, StartPeriod: 3, EndPeriod: 5, MinCapacity: 40,
+	})
+	if err != nil || len(free.Rooms) != 0 {
+		t.Fatalf("expected no free room, got %v %+v", err, free)
+	}
+	free, err = client.FindFreeRooms(ctx, &timetablev1.FindFreeRoomsRequest{
+		StartDate: tuesday, StartPeriod: 6, EndPeriod: 7, MinCapacity: 40,
+	})
+	if err != nil || len(free.Rooms) != 2 {
+		t.Fatalf("expected the hall and the boardroom to be free later, got %v %+v", err, free)
+	}
+
+	if _, err := client.CancelRoomBooking(ctx, &timetablev1.CancelRoomBookingRequest{Id: meeting.Booking.Id, UserId: alice.String()}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied when cancelling someone else's booking, got %v", err)
+	}
+	reviewed, err := client.ReviewRoomBooking(ctx, &timetablev1.ReviewRoomBookingRequest{Id: meeting.Booking.Id, ReviewerId: admin.String(), Approve: true})
+	if err != nil || reviewed.Booking.Status != "approved" || reviewed.Booking.ReviewedBy != admin.String() {
+		t.Fatalf("ReviewRoomBooking: %v %+v", err, reviewed)
+	}
+	if _, err := client.ReviewRoomBooking(ctx, &timetablev1.ReviewRoomBookingRequest{Id: meeting.Booking.Id, ReviewerId: admin.String()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition when reviewing twice, got %v", err)
+	}
+
+	cancelled, err := client.CancelRoomBooking(ctx, &timetablev1.CancelRoomBookingRequest{Id: defence.Booking.Id, UserId: alice.String()})
+	if err != nil || cancelled.Booking.Status != "cancelled" {
+		t.Fatalf("CancelRoomBooking: %v %+v", err, cancelled)
+	}
+	list, err := client.ListRoomBookings(ctx, &timetablev1.ListRoomBookingsRequest{Statuses: []string{"approved"}})
+	if err != nil || len(list.Bookings) != 1 || list.Bookings[0].Title != "Board meeting" {
+		t.Fatalf("ListRoomBookings: %v %+v", err, list)
+	}
+	if _, err := client.GetRoomBooking(ctx, &timetablev1.GetRoomBookingRequest{Id: uuid.NewString()}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for an unknown booking, got %v", err)
+	}
+}
+
+// mockRoomBookingRepository keeps bookings in memory.
+type mockRoomBookingRepository struct {
+	bookings []*entity.RoomBooking
+}
+
+var _ repository.RoomBookingRepository = (*mockRoomBookingRepository)(nil)
+
+func (m *mockRoomBookingRepository) Create(_ context.Context, b *entity.RoomBooking) (*entity.RoomBooking, error) {
+	b.ID = uuid.New()
+	b.CreatedAt = time.Now()
+	copied := *b
+	m.bookings = append(m.bookings, &copied)
+	return b, nil
+}
+
+func (m *mockRoomBookingRepository) GetByID(_ context.Context, id uuid.UUID) (*entity.RoomBooking, error) {
+	for _, b := range m.bookings {
+		if b.ID == id {
+			copied := *b
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrRoomBookingNotFound
+}
+
+func (m *mockRoomBookingRepository) List(_ context.Context, f repository.RoomBookingFilter) ([]*entity.RoomBooking, error) {
+	var out []*entity.RoomBooking
+	for _, b := range m.bookings {
+		if f.RoomID != uuid.Nil && b.RoomID != f.RoomID {
+			continue
+		}
+		if !f.From.IsZero() && b.EndDate.Before(f.From) || !f.To.IsZero() && b.StartDate.After(f.To) {
+			continue
+		}
+		match := len(f.Statuses) == 0
+		for _, s := range f.Statuses {
+			match = match || b.Status == s
+		}
+		if match {
+			copied := *b
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockRoomBookingRepository) UpdateStatus(_ context.Context, b *entity.RoomBooking, from valueobject.BookingStatus) (*entity.RoomBooking, error) {
+	for i, stored := range m.bookings {
+		if stored.ID == b.ID && stored.Status == from {
+			copied := *b
+			m.bookings[i] = &copied
+			return b, nil
+		}
+	}
+	return nil, repository.ErrRoomBookingNotFound
+}
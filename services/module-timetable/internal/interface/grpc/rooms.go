@@ -0,0 +1,287 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/command"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RoomManagement groups the handlers behind the room, building and campus
+// RPCs of TimetableServer.
+type RoomManagement struct {
+	Create     *command.CreateRoomHandler
+	Update     *command.UpdateRoomHandler
+	SetActive  *command.SetRoomActiveHandler
+	Delete     *command.DeleteRoomHandler
+	Campuses   *command.CampusHandler
+	CampusRepo repository.CampusRepository
+}
+
+func (s *TimetableServer) ListRooms(ctx context.Context, req *timetablev1.ListRoomsRequest) (*timetablev1.ListRoomsResponse, error) {
+	list := s.roomRepo.List
+	if req.IncludeInactive {
+		list = s.roomRepo.ListAll
+	}
+	rooms, err := list(ctx, 200, 0)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list rooms: %v", err)
+	}
+	protos := make([]*timetablev1.Room, len(rooms))
+	for i, r := range rooms {
+		protos[i] = roomToProto(r)
+	}
+	return &timetablev1.ListRoomsResponse{Rooms: protos}, nil
+}
+
+func (s *TimetableServer) CreateRoom(ctx context.Context, req *timetablev1.CreateRoomRequest) (*timetablev1.CreateRoomResponse, error) {
+	cmd := command.CreateRoomCommand{
+		Name:             req.Name,
+		Capacity:         int(req.Capacity),
+		Type:             req.RoomType,
+		Features:         req.Features,
+		RequiresApproval: req.RequiresApproval,
+	}
+	if req.BuildingId != "" {
+		id, err := uuid.Parse(req.BuildingId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid building_id")
+		}
+		cmd.BuildingID = id
+	}
+	room, err := s.rooms.Create.Handle(ctx, cmd)
+	if err != nil {
+		return nil, roomError("create room", err)
+	}
+	return &timetablev1.CreateRoomResponse{Room: roomToProto(room)}, nil
+}
+
+func (s *TimetableServer) GetRoom(ctx context.Context, req *timetablev1.GetRoomRequest) (*timetablev1.GetRoomResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	room, err := s.roomRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, roomError("get room", err)
+	}
+	return &timetablev1.GetRoomResponse{Room: roomToProto(room)}, nil
+}
+
+func (s *TimetableServer) UpdateRoom(ctx context.Context, req *timetablev1.UpdateRoomRequest) (*timetablev1.UpdateRoomResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	cmd := command.UpdateRoomCommand{
+		ID:               id,
+		Name:             req.Name,
+		Type:             req.RoomType,
+		RequiresApproval: req.RequiresApproval,
+	}
+	if req.Capacity != nil {
+		capacity := int(req.GetCapacity())
+		cmd.Capacity = &capacity
+	}
+	if req.ReplaceFeatures {
+		cmd.Features = append([]string{}, req.Features...)
+	}
+	if req.BuildingId != nil {
+		var building uuid.UUID
+		if raw := req.GetBuildingId(); raw != "" {
+			if building, err = uuid.Parse(raw); err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid building_id")
+			}
+		}
+		cmd.BuildingID = &building
+	}
+	room, err := s.rooms.Update.Handle(ctx, cmd)
+	if err != nil {
+		return nil, roomError("update room", err)
+	}
+	return &timetablev1.UpdateRoomResponse{Room: roomToProto(room)}, nil
+}
+
+func (s *TimetableServer) DeactivateRoom(ctx context.Context, req *timetablev1.DeactivateRoomRequest) (*timetablev1.DeactivateRoomResponse, error) {
+	room, err := s.setRoomActive(ctx, req.Id, false)
+	if err != nil {
+		return nil, err
+	}
+	return &timetablev1.DeactivateRoomResponse{Room: roomToProto(room)}, nil
+}
+
+func (s *TimetableServer) ActivateRoom(ctx context.Context, req *timetablev1.ActivateRoomRequest) (*timetablev1.ActivateRoomResponse, error) {
+	room, err := s.setRoomActive(ctx, req.Id, true)
+	if err != nil {
+		return nil, err
+	}
+	return &timetablev1.ActivateRoomResponse{Room: roomToProto(room)}, nil
+}
+
+func (s *TimetableServer) setRoomActive(ctx context.Context, rawID string, active bool) (*entity.Room, error) {
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	room, err := s.rooms.SetActive.Handle(ctx, command.SetRoomActiveCommand{ID: id, Active: active})
+	if err != nil {
+		return nil, roomError("set room active", err)
+	}
+	return room, nil
+}
+
+func (s *TimetableServer) DeleteRoom(ctx context.Context, req *timetablev1.DeleteRoomRequest) (*timetablev1.DeleteRoomResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	if err := s.rooms.Delete.Handle(ctx, command.DeleteRoomCommand{ID: id}); err != nil {
+		return nil, roomError("delete room", err)
+	}
+	return &timetablev1.DeleteRoomResponse{}, nil
+}
+
+func (s *TimetableServer) CreateCampus(ctx context.Context, req *timetablev1.CreateCampusRequest) (*timetablev1.CreateCampusResponse, error) {
+	campus, err := s.rooms.Campuses.CreateCampus(ctx, command.CreateCampusCommand{Code: req.Code, Name: req.Name})
+	if err != nil {
+		return nil, roomError("create campus", err)
+	}
+	return &timetablev1.CreateCampusResponse{Campus: campusToProto(campus)}, nil
+}
+
+func (s *TimetableServer) ListCampuses(ctx context.Context, _ *timetablev1.ListCampusesRequest) (*timetablev1.ListCampusesResponse, error) {
+	campuses, err := s.rooms.CampusRepo.ListCampuses(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list campuses: %v", err)
+	}
+	travel, err := s.rooms.CampusRepo.TravelMatrix(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list campus travel times: %v", err)
+	}
+	resp := &timetablev1.ListCampusesResponse{}
+	for _, c := range campuses {
+		resp.Campuses = append(resp.Campuses, campusToProto(c))
+	}
+	// Emit the matrix in campus order so responses are stable.
+	for i, a := range campuses {
+		for _, b := range campuses[i+1:] {
+			if minutes, ok := travel.Minutes(a.ID, b.ID); ok {
+				resp.TravelTimes = append(resp.TravelTimes, &timetablev1.CampusTravelTime{
+					FromCampusId: a.ID.String(),
+					ToCampusId:   b.ID.String(),
+					Minutes:      int32(minutes),
+				})
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (s *TimetableServer) CreateBuilding(ctx context.Context, req *timetablev1.CreateBuildingRequest) (*timetablev1.CreateBuildingResponse, error) {
+	campusID, err := uuid.Parse(req.CampusId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid campus_id")
+	}
+	building, err := s.rooms.Campuses.CreateBuilding(ctx, command.CreateBuildingCommand{
+		CampusID: campusID,
+		Code:     req.Code,
+		Name:     req.Name,
+	})
+	if err != nil {
+		return nil, roomError("create building", err)
+	}
+	return &timetablev1.CreateBuildingResponse{Building: buildingToProto(building)}, nil
+}
+
+func (s *TimetableServer) ListBuildings(ctx context.Context, req *timetablev1.ListBuildingsRequest) (*timetablev1.ListBuildingsResponse, error) {
+	var campusID uuid.UUID
+	if req.CampusId != "" {
+		var err error
+		if campusID, err = uuid.Parse(req.CampusId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid campus_id")
+		}
+	}
+	buildings, err := s.rooms.CampusRepo.ListBuildings(ctx, campusID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list buildings: %v", err)
+	}
+	resp := &timetablev1.ListBuildingsResponse{}
+	for _, b := range buildings {
+		resp.Buildings = append(resp.Buildings, buildingToProto(b))
+	}
+	return resp, nil
+}
+
+func (s *TimetableServer) SetCampusTravelTime(ctx context.Context, req *timetablev1.SetCampusTravelTimeRequest) (*timetablev1.SetCampusTravelTimeResponse, error) {
+	from, err := uuid.Parse(req.FromCampusId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid from_campus_id")
+	}
+	to, err := uuid.Parse(req.ToCampusId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid to_campus_id")
+	}
+	err = s.rooms.Campuses.SetTravelTime(ctx, command.SetCampusTravelTimeCommand{
+		FromCampusID: from,
+		ToCampusID:   to,
+		Minutes:      int(req.Minutes),
+	})
+	if err != nil {
+		return nil, roomError("set campus travel time", err)
+	}
+	return &timetablev1.SetCampusTravelTimeResponse{TravelTime: &timetablev1.CampusTravelTime{
+		FromCampusId: req.FromCampusId,
+		ToCampusId:   req.ToCampusId,
+		Minutes:      req.Minutes,
+	}}, nil
+}
+
+// roomError maps room and location use-case errors to gRPC statuses.
+func roomError(op string, err error) error {
+	switch {
+	case errors.Is(err, command.ErrInvalidRoom), errors.Is(err, command.ErrInvalidLocation):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, repository.ErrRoomNotFound), errors.Is(err, repository.ErrCampusNotFound),
+		errors.Is(err, repository.ErrBuildingNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, repository.ErrRoomNameTaken), errors.Is(err, repository.ErrLocationCodeTaken):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, repository.ErrRoomInUse):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", op, err)
+}
+
+func roomToProto(r *entity.Room) *timetablev1.Room {
+	p := &timetablev1.Room{
+		Id:               r.ID.String(),
+		Name:             r.Name,
+		Capacity:         int32(r.Capacity),
+		RoomType:         r.Type,
+		RequiresApproval: r.RequiresApproval,
+		Features:         r.Features,
+		IsActive:         r.IsActive,
+		BuildingCode:     r.BuildingCode,
+	}
+	if r.BuildingID != uuid.Nil {
+		p.BuildingId = r.BuildingID.String()
+	}
+	if r.CampusID != uuid.Nil {
+		p.CampusId = r.CampusID.String()
+	}
+	return p
+}
+
+func campusToProto(c *entity.Campus) *timetablev1.Campus {
+	return &timetablev1.Campus{Id: c.ID.String(), Code: c.Code, Name: c.Name}
+}
+
+func buildingToProto(b *entity.Building) *timetablev1.Building {
+	return &timetablev1.Building{Id: b.ID.String(), CampusId: b.CampusID.String(), Code: b.Code, Name: b.Name}
+}
@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/command"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRoomLifecycle(t *testing.T) {
+	used := uuid.New()
+	roomRepo := &mockRoomRepository{
+		rooms:     []*entity.Room{{ID: used, Name: "Hall", Capacity: 120, Type: "lecture_hall", IsActive: true}},
+		published: map[uuid.UUID]int64{used: 3},
+	}
+	campusRepo := newMockCampusRepository()
+	publisher := &mockEventPublisher{}
+	rooms := RoomManagement{
+		Create:     command.NewCreateRoomHandler(roomRepo, campusRepo),
+		Update:     command.NewUpdateRoomHandler(roomRepo, campusRepo, publisher),
+		SetActive:  command.NewSetRoomActiveHandler(roomRepo, publisher),
+		Delete:     command.NewDeleteRoomHandler(roomRepo, publisher),
+		Campuses:   command.NewCampusHandler(campusRepo),
+		CampusRepo: campusRepo,
+	}
+	conn := startTimetableTestServer(t, func(s *grpc.Server) {
+		timetablev1.RegisterTimetableServiceServer(s, NewTimetableServer(nil, nil, nil, nil, nil, nil, nil, roomRepo, ScheduleLifecycle{}, nil, ExamScheduling{}, rooms))
+	})
+	client := timetablev1.NewTimetableServiceClient(conn)
+	ctx := context.Background()
+
+	central, err := client.CreateCampus(ctx, &timetablev1.CreateCampusRequest{Code: "C", Name: "Central"})
+	if err != nil {
+		t.Fatalf("CreateCampus: %v", err)
+	}
+	north, err := client.CreateCampus(ctx, &timetablev1.CreateCampusRequest{Code: "N", Name: "North"})
+	if err != nil {
+		t.Fatalf("CreateCampus: %v", err)
+	}
+	building, err := client.CreateBuilding(ctx, &timetablev1.CreateBuildingRequest{CampusId: north.Campus.Id, Code: "N1", Name: "North 1"})
+	if err != nil {
+		t.Fatalf("CreateBuilding: %v", err)
+	}
+	if _, err := client.SetCampusTravelTime(ctx, &timetablev1.SetCampusTravelTimeRequest{
+		FromCampusId: north.Campus.Id, ToCampusId: central.Campus.Id, Minutes: 35,
+	}); err != nil {
+		t.Fatalf("SetCampusTravelTime: %v", err)
+	}
+	campuses, err := client.ListCampuses(ctx, &timetablev1.ListCampusesRequest{})
+	if err != nil || len(campuses.Campuses) != 2 || len(campuses.TravelTimes) != 1 || campuses.TravelTimes[0].Minutes != 35 {
+		t.Fatalf("ListCampuses = %+v, %v", campuses, err)
+	}
+
+	// Unknown buildings are rejected
+	_, err = client.CreateRoom(ctx, &timetablev1.CreateRoomRequest{Name: "Lab", Capacity: 30, BuildingId: uuid.NewString()})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an unknown building, got %v", err)
+	}
+	created, err := client.CreateRoom(ctx, &timetablev1.CreateRoomRequest{Name: "Lab", Capacity: 30, RoomType: "lab"})
+	if err != nil {
+		t.Fatalf("CreateRoom: %v", err)
+	}
+
+	updated, err := client.UpdateRoom(ctx, &timetablev1.UpdateRoomRequest{
+		Id: created.Room.Id, Capacity: proto.Int32(40), BuildingId: proto.String(building.Building.Id),
+		Features: []string{"projector"}, ReplaceFeatures: true,
+	})
+	if err != nil {
+		t.Fatalf("UpdateRoom: %v", err)
+	}
+	if updated.Room.Capacity != 40 || updated.Room.Name != "Lab" || updated.Room.BuildingId != building.Building.Id || len(updated.Room.Features) != 1 {
+		t.Fatalf("unexpected room after update: %+v", updated.Room)
+	}
+	_, err = client.UpdateRoom(ctx, &timetablev1.UpdateRoomRequest{Id: created.Room.Id, Capacity: proto.Int32(0)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for zero capacity, got %v", err)
+	}
+
+	// A room used by a published schedule can be deactivated but not deleted
+	_, err = client.DeleteRoom(ctx, &timetablev1.DeleteRoomRequest{Id: used.String()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition deleting a published room, got %v", err)
+	}
+	deactivated, err := client.DeactivateRoom(ctx, &timetablev1.DeactivateRoomRequest{Id: used.String()})
+	if err != nil || deactivated.Room.IsActive {
+		t.Fatalf("DeactivateRoom = %+v, %v", deactivated, err)
+	}
+	reactivated, err := client.ActivateRoom(ctx, &timetablev1.ActivateRoomRequest{Id: used.String()})
+	if err != nil || !reactivated.Room.IsActive {
+		t.Fatalf("ActivateRoom = %+v, %v", reactivated, err)
+	}
+
+	if _, err := client.DeleteRoom(ctx, &timetablev1.DeleteRoomRequest{Id: created.Room.Id}); err != nil {
+		t.Fatalf("DeleteRoom: %v", err)
+	}
+	_, err = client.GetRoom(ctx, &timetablev1.GetRoomRequest{Id: created.Room.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound after delete, got %v", err)
+	}
+}
+
+type mockCampusRepository struct {
+	campuses  map[uuid.UUID]*entity.Campus
+	buildings map[uuid.UUID]*entity.Building
+	travel    entity.TravelMatrix
+}
+
+var _ repository.CampusRepository = (*mockCampusRepository)(nil)
+
+func newMockCampusRepository() *mockCampusRepository {
+	return &mockCampusRepository{
+		campuses:  map[uuid.UUID]*entity.Campus{},
+		buildings: map[uuid.UUID]*entity.Building{},
+		travel:    entity.TravelMatrix{},
+	}
+}
+
+func (m *mockCampusRepository) CreateCampus(_ context.Context, c *entity.Campus) (*entity.Campus, error) {
+	m.campuses[c.ID] = c
+	return c, nil
+}
+
+func (m *mockCampusRepository) GetCampusByID(_ context.Context, id uuid.UUID) (*entity.Campus, error) {
+	if c, ok := m.campuses[id]; ok {
+		return c, nil
+	}
+	return nil, repository.ErrCampusNotFound
+}
+
+func (m *mockCampusRepository) ListCampuses(_ context.Context) ([]*entity.Campus, error) {
+	var out []*entity.Campus
+	for _, c := range m.campuses {
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func (m *mockCampusRepository) CreateBuilding(_ context.Context, b *entity.Building) (*entity.Building, error) {
+	m.buildings[b.ID] = b
+	return b, nil
+}
+
+func (m *mockCampusRepository) GetBuildingByID(_ context.Context, id uuid.UUID) (*entity.Building, error) {
+	if b, ok := m.buildings[id]; ok {
+		return b, nil
+	}
+	return nil, repository.ErrBuildingNotFound
+}
+
+func (m *mockCampusRepository) ListBuildings(_ context.Context, campusID uuid.UUID) ([]*entity.Building, error) {
+	var out []*entity.Building
+	for _, b := range m.buildings {
+		if campusID == uuid.Nil || b.CampusID == campusID {
+			out = append(out, b)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockCampusRepository) SetTravelTime(_ context.Context, a, b uuid.UUID, minutes int) error {
+	m.travel[entity.NewCampusPair(a, b)] = minutes
+	return nil
+}
+
+func (m *mockCampusRepository) TravelMatrix(_ context.Context) (entity.TravelMatrix, error) {
+	return m.travel, nil
+}
@@ -0,0 +1,234 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/command"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/query"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScheduleLifecycle groups the handlers behind the publish, archive and
+// version history RPCs of TimetableServer.
+type ScheduleLifecycle struct {
+	Publish   *command.PublishScheduleHandler
+	Unpublish *command.UnpublishScheduleHandler
+	Archive   *command.ArchiveScheduleHandler
+	Rollback  *command.RollbackScheduleHandler
+	Versions  *query.ListScheduleVersionsHandler
+	Version   *query.GetScheduleVersionHandler
+	Diff      *query.DiffSchedulesHandler
+}
+
+func (s *TimetableServer) PublishSchedule(ctx context.Context, req *timetablev1.PublishScheduleRequest) (*timetablev1.PublishScheduleResponse, error) {
+	scheduleID, err := uuid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid schedule_id")
+	}
+	result, err := s.lifecycle.Publish.Handle(ctx, command.PublishScheduleCommand{ScheduleID: scheduleID})
+	if err != nil {
+		return nil, lifecycleStatus("publish schedule", err)
+	}
+	return &timetablev1.PublishScheduleResponse{
+		Schedule:            scheduleToProto(result.Schedule, nil),
+		Version:             versionToProto(result.Version),
+		ArchivedScheduleIds: uuidStrings(result.Archived),
+	}, nil
+}
+
+func (s *TimetableServer) UnpublishSchedule(ctx context.Context, req *timetablev1.UnpublishScheduleRequest) (*timetablev1.UnpublishScheduleResponse, error) {
+	scheduleID, err := uuid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid schedule_id")
+	}
+	schedule, err := s.lifecycle.Unpublish.Handle(ctx, command.UnpublishScheduleCommand{ScheduleID: scheduleID})
+	if err != nil {
+		return nil, lifecycleStatus("unpublish schedule", err)
+	}
+	return &timetablev1.UnpublishScheduleResponse{Schedule: scheduleToProto(schedule, nil)}, nil
+}
+
+func (s *TimetableServer) ArchiveSchedule(ctx context.Context, req *timetablev1.ArchiveScheduleRequest) (*timetablev1.ArchiveScheduleResponse, error) {
+	scheduleID, err := uuid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid schedule_id")
+	}
+	schedule, err := s.lifecycle.Archive.Handle(ctx, command.ArchiveScheduleCommand{ScheduleID: scheduleID})
+	if err != nil {
+		return nil, lifecycleStatus("archive schedule", err)
+	}
+	return &timetablev1.ArchiveScheduleResponse{Schedule: scheduleToProto(schedule, nil)}, nil
+}
+
+func (s *TimetableServer) ListScheduleVersions(ctx context.Context, req *timetablev1.ListScheduleVersionsRequest) (*timetablev1.ListScheduleVersionsResponse, error) {
+	semesterID, err := uuid.Parse(req.SemesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+	}
+	versions, err := s.lifecycle.Versions.Handle(ctx, query.ListScheduleVersionsQuery{SemesterID: semesterID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list schedule versions: %v", err)
+	}
+	resp := &timetablev1.ListScheduleVersionsResponse{}
+	for _, v := range versions {
+		resp.Versions = append(resp.Versions, versionToProto(v))
+	}
+	return resp, nil
+}
+
+func (s *TimetableServer) GetScheduleVersion(ctx context.Context, req *timetablev1.GetScheduleVersionRequest) (*timetablev1.GetScheduleVersionResponse, error) {
+	semesterID, err := uuid.Parse(req.SemesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+	}
+	version, err := s.lifecycle.Version.Handle(ctx, query.GetScheduleVersionQuery{SemesterID: semesterID, Version: int(req.Version)})
+	if err != nil {
+		return nil, lifecycleStatus("get schedule version", err)
+	}
+	return &timetablev1.GetScheduleVersionResponse{Version: versionToProto(version)}, nil
+}
+
+func (s *TimetableServer) RollbackSchedule(ctx context.Context, req *timetablev1.RollbackScheduleRequest) (*timetablev1.RollbackScheduleResponse, error) {
+	semesterID, err := uuid.Parse(req.SemesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+	}
+	result, err := s.lifecycle.Rollback.Handle(ctx, command.RollbackScheduleCommand{SemesterID: semesterID, Version: int(req.Version)})
+	if err != nil {
+		return nil, lifecycleStatus("rollback schedule", err)
+	}
+	return &timetablev1.RollbackScheduleResponse{
+		Schedule:            scheduleToProto(result.Schedule, nil),
+		Version:             versionToProto(result.Version),
+		ArchivedScheduleIds: uuidStrings(result.Archived),
+	}, nil
+}
+
+func (s *TimetableServer) DiffSchedules(ctx context.Context, req *timetablev1.DiffSchedulesRequest) (*timetablev1.DiffSchedulesResponse, error) {
+	q := query.DiffSchedulesQuery{}
+	if req.SemesterId != "" {
+		id, err := uuid.Parse(req.SemesterId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+		}
+		q.SemesterID = id
+	}
+	var err error
+	if q.Base, err = scheduleRefFromProto("base", req.BaseScheduleId, req.BaseVersion, q.SemesterID); err != nil {
+		return nil, err
+	}
+	if q.Target, err = scheduleRefFromProto("target", req.TargetScheduleId, req.TargetVersion, q.SemesterID); err != nil {
+		return nil, err
+	}
+
+	diff, err := s.lifecycle.Diff.Handle(ctx, q)
+	if err != nil {
+		return nil, lifecycleStatus("diff schedules", err)
+	}
+	resp := &timetablev1.DiffSchedulesResponse{
+		Teachers:  resourceChangesToProto(diff.Teachers),
+		Rooms:     resourceChangesToProto(diff.Rooms),
+		Unchanged: int32(diff.Unchanged),
+	}
+	for _, c := range diff.Changes {
+		resp.Changes = append(resp.Changes, &timetablev1.ScheduleChange{
+			Kind:           string(c.Kind),
+			SubjectId:      c.SubjectID.String(),
+			Before:         diffEntryToProto(c.Before),
+			After:          diffEntryToProto(c.After),
+			TeacherChanged: c.TeacherChanged,
+			RoomChanged:    c.RoomChanged,
+			SlotChanged:    c.SlotChanged,
+			Description:    c.Describe(),
+		})
+	}
+	return resp, nil
+}
+
+// scheduleRefFromProto reads one side of a diff request: a schedule ID, or a
+// version number of the request's semester.
+func scheduleRefFromProto(side, scheduleID string, version int32, semesterID uuid.UUID) (query.ScheduleRef, error) {
+	if scheduleID != "" {
+		id, err := uuid.Parse(scheduleID)
+		if err != nil {
+			return query.ScheduleRef{}, status.Errorf(codes.InvalidArgument, "invalid %s_schedule_id", side)
+		}
+		return query.ScheduleRef{ScheduleID: id}, nil
+	}
+	if version < 1 || semesterID == uuid.Nil {
+		return query.ScheduleRef{}, status.Errorf(codes.InvalidArgument, "%s needs a schedule id, or semester_id and a %s_version", side, side)
+	}
+	return query.ScheduleRef{Version: int(version)}, nil
+}
+
+func diffEntryToProto(e *entity.ScheduleEntry) *timetablev1.ScheduleEntry {
+	if e == nil {
+		return nil
+	}
+	p := entryToProto(e)
+	if e.ID == uuid.Nil {
+		p.Id = "" // taken from a version snapshot
+	}
+	return p
+}
+
+func resourceChangesToProto(in []service.ResourceChanges) []*timetablev1.ResourceChangeSummary {
+	out := make([]*timetablev1.ResourceChangeSummary, len(in))
+	for i, r := range in {
+		out[i] = &timetablev1.ResourceChangeSummary{
+			Id:     r.ID.String(),
+			Name:   r.Name,
+			Gained: int32(r.Gained),
+			Lost:   int32(r.Lost),
+			Moved:  int32(r.Moved),
+		}
+	}
+	return out
+}
+
+// lifecycleStatus maps lifecycle errors: a disallowed transition or a schedule
+// with hard violations is a failed precondition, a missing version not found.
+func lifecycleStatus(op string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrStatusTransition), errors.Is(err, entity.ErrHardViolations):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", op, err)
+	case errors.Is(err, repository.ErrVersionNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", op, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", op, err)
+}
+
+func versionToProto(v *entity.ScheduleVersion) *timetablev1.ScheduleVersion {
+	p := &timetablev1.ScheduleVersion{
+		Id:             v.ID.String(),
+		SemesterId:     v.SemesterID.String(),
+		ScheduleId:     v.ScheduleID.String(),
+		Version:        int32(v.Version),
+		Score:          v.Score,
+		HardViolations: int32(v.HardViolations),
+		SoftPenalty:    v.SoftPenalty,
+		PublishedAt:    timestamppb.New(v.PublishedAt),
+	}
+	for _, ve := range v.Entries {
+		e := entryToProto(ve.ScheduleEntry())
+		e.Id = "" // snapshots are not live entries
+		p.Entries = append(p.Entries, e)
+	}
+	return p
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id.String()
+	}
+	return out
+}
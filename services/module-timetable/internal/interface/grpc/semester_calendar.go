@@ -0,0 +1,224 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetPeriodDefinitions replaces the semester's period table. The table must
+// still cover every period used by the semester's time slots.
+func (s *SemesterServer) SetPeriodDefinitions(ctx context.Context, req *timetablev1.SetPeriodDefinitionsRequest) (*timetablev1.SetPeriodDefinitionsResponse, error) {
+	semesterID, err := uuid.Parse(req.SemesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+	}
+	if len(req.Periods) > 0 && req.Generate != nil {
+		return nil, status.Error(codes.InvalidArgument, "set either periods or generate, not both")
+	}
+
+	var periods []entity.PeriodDefinition
+	if g := req.Generate; g != nil {
+		longBreaks := make(map[int]int, len(g.LongBreaks))
+		for after, minutes := range g.LongBreaks {
+			longBreaks[int(after)] = int(minutes)
+		}
+		periods, err = entity.GeneratePeriods(g.FirstStart, int(g.Count), int(g.PeriodMinutes), int(g.BreakMinutes), longBreaks)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "generate periods: %v", err)
+		}
+	} else {
+		for _, p := range req.Periods {
+			periods = append(periods, entity.PeriodDefinition{Period: int(p.Period), Start: p.Start, End: p.End})
+		}
+	}
+	if err := entity.ValidatePeriods(periods); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	slots, err := s.semesterRepo.ListTimeSlots(ctx, semesterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list time slots: %v", err)
+	}
+	table := (&entity.Semester{Periods: periods}).PeriodTable()
+	// Slots include their end period, so that period must be in the table
+	for _, sl := range slots {
+		if sl.EndPeriod > len(table) {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"time slots use period %d but the table defines %d periods", sl.EndPeriod, len(table))
+		}
+	}
+
+	sem, err := s.semesterRepo.SetPeriods(ctx, semesterID, periods)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "set periods: %v", err)
+	}
+	return &timetablev1.SetPeriodDefinitionsResponse{Semester: semesterToProto(sem)}, nil
+}
+
+// SetHolidays replaces the semester's non-teaching days.
+func (s *SemesterServer) SetHolidays(ctx context.Context, req *timetablev1.SetHolidaysRequest) (*timetablev1.SetHolidaysResponse, error) {
+	semesterID, err := uuid.Parse(req.SemesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+	}
+	holidays := make([]entity.Holiday, 0, len(req.Holidays))
+	for _, h := range req.Holidays {
+		if h.StartDate == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "holiday %q needs a start_date", h.Name)
+		}
+		holiday := entity.Holiday{Name: h.Name, StartDate: h.StartDate.AsTime()}
+		holiday.EndDate = holiday.StartDate // single day unless a range is given
+		if h.EndDate != nil {
+			holiday.EndDate = h.EndDate.AsTime()
+		}
+		holidays = append(holidays, holiday)
+	}
+
+	current, err := s.semesterRepo.GetByID(ctx, semesterID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "semester not found: %v", err)
+	}
+	if err := current.ValidateHolidays(holidays); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sem, err := s.semesterRepo.SetHolidays(ctx, semesterID, holidays)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "set holidays: %v", err)
+	}
+	return &timetablev1.SetHolidaysResponse{Semester: semesterToProto(sem)}, nil
+}
+
+// SetWeekPattern sets how often an offered subject meets; "weekly" (or an
+// empty pattern) restores the default.
+func (s *SemesterServer) SetWeekPattern(ctx context.Context, req *timetablev1.SetWeekPatternRequest) (*timetablev1.SetWeekPatternResponse, error) {
+	semesterID, err := uuid.Parse(req.SemesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+	}
+	subjectID, err := uuid.Parse(req.SubjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid subject_id")
+	}
+	pattern := valueobject.WeekPattern(req.Pattern)
+	if pattern == "" {
+		pattern = valueobject.WeekPatternWeekly
+	}
+	if !pattern.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"unknown pattern %q; use weekly|odd_weeks|even_weeks|first_half|second_half", req.Pattern)
+	}
+
+	current, err := s.semesterRepo.GetByID(ctx, semesterID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "semester not found: %v", err)
+	}
+	offered := false
+	for _, id := range current.OfferedSubjectIDs {
+		offered = offered || id == subjectID
+	}
+	if !offered {
+		return nil, status.Error(codes.FailedPrecondition, "subject is not offered in this semester")
+	}
+
+	sem, err := s.semesterRepo.SetWeekPattern(ctx, semesterID, subjectID, pattern)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "set week pattern: %v", err)
+	}
+	return &timetablev1.SetWeekPatternResponse{Semester: semesterToProto(sem)}, nil
+}
+
+// SetSubjectSections splits an offered subject into sections, each scheduled
+// on its own; a single plain section puts it back to one for everyone.
+func (s *SemesterServer) SetSubjectSections(ctx context.Context, req *timetablev1.SetSubjectSectionsRequest) (*timetablev1.SetSubjectSectionsResponse, error) {
+	semesterID, err := uuid.Parse(req.SemesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+	}
+	subjectID, err := uuid.Parse(req.SubjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid subject_id")
+	}
+	sections := make([]entity.CourseSection, 0, len(req.Sections))
+	for _, cs := range req.Sections {
+		sections = append(sections, entity.CourseSection{
+			Number:       int(cs.Number),
+			Capacity:     int(cs.Capacity),
+			StudentGroup: cs.StudentGroup,
+			Assistants:   int(cs.Assistants),
+		})
+	}
+	if err := entity.ValidateSections(sections); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	current, err := s.semesterRepo.GetByID(ctx, semesterID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "semester not found: %v", err)
+	}
+	offered := false
+	for _, id := range current.OfferedSubjectIDs {
+		offered = offered || id == subjectID
+	}
+	if !offered {
+		return nil, status.Error(codes.FailedPrecondition, "subject is not offered in this semester")
+	}
+
+	sem, err := s.semesterRepo.SetSections(ctx, semesterID, subjectID, sections)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "set sections: %v", err)
+	}
+	return &timetablev1.SetSubjectSectionsResponse{Semester: semesterToProto(sem)}, nil
+}
+
+// setCalendarProto fills the calendar fields of a semester message. The
+// period table is always spelled out, so clients need no default of their own.
+func setCalendarProto(p *timetablev1.Semester, s *entity.Semester) {
+	table := s.PeriodTable()
+	for i, def := range table {
+		pd := &timetablev1.PeriodDefinition{Period: int32(def.Period), Start: def.Start, End: def.End}
+		if i+1 < len(table) {
+			_, end, err1 := def.Times()
+			next, _, err2 := table[i+1].Times()
+			if err1 == nil && err2 == nil {
+				pd.BreakMinutes = int32((next - end) / time.Minute)
+			}
+		}
+		p.Periods = append(p.Periods, pd)
+	}
+	for _, h := range s.Holidays {
+		p.Holidays = append(p.Holidays, &timetablev1.Holiday{
+			Name:      h.Name,
+			StartDate: timestamppb.New(h.StartDate),
+			EndDate:   timestamppb.New(h.EndDate),
+		})
+	}
+	if len(s.WeekPatterns) > 0 {
+		p.WeekPatterns = make(map[string]string, len(s.WeekPatterns))
+		for id, pattern := range s.WeekPatterns {
+			p.WeekPatterns[id.String()] = string(pattern)
+		}
+	}
+	if len(s.Sections) > 0 {
+		p.Sections = make(map[string]*timetablev1.SubjectSections, len(s.Sections))
+		for id, sections := range s.Sections {
+			ss := &timetablev1.SubjectSections{}
+			for _, cs := range sections {
+				ss.Sections = append(ss.Sections, &timetablev1.CourseSection{
+					Number:       int32(cs.Number),
+					Capacity:     int32(cs.Capacity),
+					StudentGroup: cs.StudentGroup,
+					Assistants:   int32(cs.Assistants),
+				})
+			}
+			p.Sections[id.String()] = ss
+		}
+	}
+}
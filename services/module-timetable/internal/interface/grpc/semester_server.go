@@ -9,6 +9,8 @@ import (
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/command"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/query"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -31,6 +33,11 @@ type semesterReader interface {
 	AddOfferedSubject(ctx context.Context, semesterID, subjectID uuid.UUID) (*entity.Semester, error)
 	RemoveOfferedSubject(ctx context.Context, semesterID, subjectID uuid.UUID) (*entity.Semester, error)
 	SetRoomIDs(ctx context.Context, semesterID uuid.UUID, roomIDs []uuid.UUID) (*entity.Semester, error)
+	SetSoftConstraints(ctx context.Context, semesterID uuid.UUID, settings []entity.SoftConstraintSetting) (*entity.Semester, error)
+	SetPeriods(ctx context.Context, semesterID uuid.UUID, periods []entity.PeriodDefinition) (*entity.Semester, error)
+	SetHolidays(ctx context.Context, semesterID uuid.UUID, holidays []entity.Holiday) (*entity.Semester, error)
+	SetWeekPattern(ctx context.Context, semesterID, subjectID uuid.UUID, pattern valueobject.WeekPattern) (*entity.Semester, error)
+	SetSections(ctx context.Context, semesterID, subjectID uuid.UUID, sections []entity.CourseSection) (*entity.Semester, error)
 	ListTimeSlots(ctx context.Context, semesterID uuid.UUID) ([]*entity.TimeSlot, error)
 	CreateTimeSlot(ctx context.Context, ts *entity.TimeSlot) (*entity.TimeSlot, error)
 	DeleteTimeSlot(ctx context.Context, slotID uuid.UUID) error
@@ -304,8 +311,41 @@ func (s *SemesterServer) SetSemesterRooms(ctx context.Context, req *timetablev1.
 	return resp, nil
 }
 
+// SetSoftConstraints replaces the semester's weighted soft-constraint settings.
+// Unknown types, duplicates, negative weights and bad params are rejected.
+func (s *SemesterServer) SetSoftConstraints(ctx context.Context, req *timetablev1.SetSoftConstraintsRequest) (*timetablev1.SetSoftConstraintsResponse, error) {
+	semesterID, err := uuid.Parse(req.SemesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
+	}
+	settings := softConstraintsFromProto(req.SoftConstraints)
+	if _, err := service.BuildSoftConstraints(settings); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sem, err := s.semesterRepo.SetSoftConstraints(ctx, semesterID, settings)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "set soft constraints: %v", err)
+	}
+	return &timetablev1.SetSoftConstraintsResponse{Semester: semesterToProto(sem)}, nil
+}
+
 // --- proto helpers ---
 
+func softConstraintsFromProto(in []*timetablev1.SoftConstraintSetting) []entity.SoftConstraintSetting {
+	out := make([]entity.SoftConstraintSetting, 0, len(in))
+	for _, p := range in {
+		setting := entity.SoftConstraintSetting{Type: valueobject.ConstraintType(p.Type), Weight: p.Weight}
+		if len(p.Params) > 0 {
+			setting.Params = make(map[string]int, len(p.Params))
+			for k, v := range p.Params {
+				setting.Params[k] = int(v)
+			}
+		}
+		out = append(out, setting)
+	}
+	return out
+}
+
 func semesterToProto(s *entity.Semester) *timetablev1.Semester {
 	p := &timetablev1.Semester{
 		Id:        s.ID.String(),
@@ -322,5 +362,16 @@ func semesterToProto(s *entity.Semester) *timetablev1.Semester {
 	for _, id := range s.RoomIDs {
 		p.RoomIds = append(p.RoomIds, id.String())
 	}
+	for _, c := range s.SoftConstraints {
+		setting := &timetablev1.SoftConstraintSetting{Type: string(c.Type), Weight: c.Weight}
+		if len(c.Params) > 0 {
+			setting.Params = make(map[string]int32, len(c.Params))
+			for k, v := range c.Params {
+				setting.Params[k] = int32(v)
+			}
+		}
+		p.SoftConstraints = append(p.SoftConstraints, setting)
+	}
+	setCalendarProto(p, s)
 	return p
 }
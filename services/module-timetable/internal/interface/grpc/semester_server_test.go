@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net"
 	"testing"
 	"time"
@@ -87,7 +88,11 @@ func (m *mockSemesterRepository) GetByID(_ context.Context, id uuid.UUID) (*enti
 }
 
 func (m *mockSemesterRepository) List(_ context.Context, _, _ int32) ([]*entity.Semester, error) {
-	return nil, nil
+	var out []*entity.Semester
+	for _, sem := range m.getByID {
+		out = append(out, sem)
+	}
+	return out, nil
 }
 
 func (m *mockSemesterRepository) Count(_ context.Context) (int64, error) {
@@ -106,6 +111,60 @@ func (m *mockSemesterRepository) SetRoomIDs(_ context.Context, _ uuid.UUID, _ []
 	return nil, nil
 }
 
+func (m *mockSemesterRepository) SetSoftConstraints(_ context.Context, _ uuid.UUID, _ []entity.SoftConstraintSetting) (*entity.Semester, error) {
+	return nil, nil
+}
+
+func (m *mockSemesterRepository) SetPeriods(_ context.Context, id uuid.UUID, periods []entity.PeriodDefinition) (*entity.Semester, error) {
+	sem, ok := m.getByID[id]
+	if !ok {
+		return nil, errors.New("semester not found")
+	}
+	sem.Periods = periods
+	return sem, nil
+}
+
+func (m *mockSemesterRepository) SetHolidays(_ context.Context, id uuid.UUID, holidays []entity.Holiday) (*entity.Semester, error) {
+	sem, ok := m.getByID[id]
+	if !ok {
+		return nil, errors.New("semester not found")
+	}
+	sem.Holidays = holidays
+	return sem, nil
+}
+
+func (m *mockSemesterRepository) SetWeekPattern(_ context.Context, id, subjectID uuid.UUID, pattern valueobject.WeekPattern) (*entity.Semester, error) {
+	sem, ok := m.getByID[id]
+	if !ok {
+		return nil, errors.New("semester not found")
+	}
+	if sem.WeekPatterns == nil {
+		sem.WeekPatterns = map[uuid.UUID]valueobject.WeekPattern{}
+	}
+	if pattern == valueobject.WeekPatternWeekly {
+		delete(sem.WeekPatterns, subjectID)
+	} else {
+		sem.WeekPatterns[subjectID] = pattern
+	}
+	return sem, nil
+}
+
+func (m *mockSemesterRepository) SetSections(_ context.Context, id, subjectID uuid.UUID, sections []entity.CourseSection) (*entity.Semester, error) {
+	sem, ok := m.getByID[id]
+	if !ok {
+		return nil, errors.New("semester not found")
+	}
+	if sem.Sections == nil {
+		sem.Sections = map[uuid.UUID][]entity.CourseSection{}
+	}
+	if len(sections) == 1 && sections[0] == (entity.CourseSection{Number: 1}) {
+		delete(sem.Sections, subjectID)
+	} else {
+		sem.Sections[subjectID] = sections
+	}
+	return sem, nil
+}
+
 func (m *mockSemesterRepository) CreateTimeSlot(_ context.Context, _ *entity.TimeSlot) (*entity.TimeSlot, error) {
 	return nil, nil
 }
@@ -131,6 +190,7 @@ type mockScheduleRepository struct {
 	created         *entity.Schedule
 	createdID       uuid.UUID
 	createCallCount int
+	updatedEntries  []*entity.ScheduleEntry
 }
 
 var _ repository.ScheduleRepository = (*mockScheduleRepository)(nil)
@@ -143,18 +203,32 @@ func (m *mockScheduleRepository) Create(_ context.Context, schedule *entity.Sche
 	if m.createdID != uuid.Nil {
 		schedule.ID = m.createdID
 	}
+	if m.byID != nil {
+		if schedule.ID == uuid.Nil {
+			schedule.ID = uuid.New()
+		}
+		m.byID[schedule.ID] = schedule
+	}
 	return schedule, nil
 }
 
 func (m *mockScheduleRepository) GetByID(_ context.Context, id uuid.UUID) (*entity.Schedule, error) {
 	if schedule, ok := m.byID[id]; ok {
-		return schedule, nil
+		copied := *schedule // callers may mutate what they read, as with a real row
+		return &copied, nil
 	}
 	return nil, errors.New("schedule not found")
 }
 
-func (m *mockScheduleRepository) ListBySemester(_ context.Context, _ uuid.UUID) ([]*entity.Schedule, error) {
-	return nil, nil
+func (m *mockScheduleRepository) ListBySemester(_ context.Context, semesterID uuid.UUID) ([]*entity.Schedule, error) {
+	var out []*entity.Schedule
+	for _, s := range m.byID {
+		if s.SemesterID == semesterID {
+			copied := *s
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
 }
 
 func (m *mockScheduleRepository) ListPaged(_ context.Context, _ uuid.UUID, _, _ int32) ([]*entity.Schedule, error) {
@@ -165,15 +239,36 @@ func (m *mockScheduleRepository) CountSchedules(_ context.Context, _ uuid.UUID)
 	return 0, nil
 }
 
-func (m *mockScheduleRepository) UpdateResult(_ context.Context, _ uuid.UUID, _ float64, _ int, _ float64) (*entity.Schedule, error) {
+func (m *mockScheduleRepository) UpdateResult(_ context.Context, id uuid.UUID, score float64, hardViolations int, softPenalty float64) (*entity.Schedule, error) {
+	schedule, ok := m.byID[id]
+	if !ok {
+		return nil, nil
+	}
+	schedule.Score, schedule.HardViolations, schedule.SoftPenalty = score, hardViolations, softPenalty
+	return schedule, nil
+}
+
+func (m *mockScheduleRepository) UpdateStatus(_ context.Context, id uuid.UUID, status valueobject.ScheduleStatus) (*entity.Schedule, error) {
+	schedule, ok := m.byID[id]
+	if !ok {
+		return nil, nil
+	}
+	schedule.Status = status
+	return schedule, nil
+}
+
+func (m *mockScheduleRepository) UpdateFailure(_ context.Context, _ uuid.UUID, _ string, _ *entity.GenerationDiagnosis) (*entity.Schedule, error) {
 	return nil, nil
 }
 
-func (m *mockScheduleRepository) UpdateStatus(_ context.Context, _ uuid.UUID, _ valueobject.ScheduleStatus) (*entity.Schedule, error) {
+func (m *mockScheduleRepository) UpdateCohortClashes(_ context.Context, _ uuid.UUID, _ int) (*entity.Schedule, error) {
 	return nil, nil
 }
 
 func (m *mockScheduleRepository) CreateEntry(_ context.Context, entry *entity.ScheduleEntry) (*entity.ScheduleEntry, error) {
+	if m.entries != nil {
+		m.entries[entry.ScheduleID] = append(m.entries[entry.ScheduleID], entry)
+	}
 	return entry, nil
 }
 
@@ -189,6 +284,7 @@ func (m *mockScheduleRepository) ListEntries(_ context.Context, scheduleID uuid.
 }
 
 func (m *mockScheduleRepository) UpdateEntry(_ context.Context, entry *entity.ScheduleEntry) (*entity.ScheduleEntry, error) {
+	m.updatedEntries = append(m.updatedEntries, entry)
 	return entry, nil
 }
 
@@ -204,6 +300,152 @@ func (m *mockScheduleRepository) AppendEvent(_ context.Context, _ uuid.UUID, _,
 	return nil
 }
 
+// mockScheduleVersionRepository publishes schedules held by a mockScheduleRepository.
+type mockScheduleVersionRepository struct {
+	schedules *mockScheduleRepository
+	versions  []*entity.ScheduleVersion
+}
+
+var _ repository.ScheduleVersionRepository = (*mockScheduleVersionRepository)(nil)
+
+func (m *mockScheduleVersionRepository) Publish(_ context.Context, scheduleID uuid.UUID) (*entity.ScheduleVersion, []uuid.UUID, error) {
+	schedule, ok := m.schedules.byID[scheduleID]
+	if !ok {
+		return nil, nil, errors.New("schedule not found")
+	}
+	if err := schedule.Publish(); err != nil {
+		return nil, nil, err
+	}
+	var archived []uuid.UUID
+	for id, other := range m.schedules.byID {
+		if id != scheduleID && other.SemesterID == schedule.SemesterID && other.Status == valueobject.ScheduleStatusPublished {
+			other.Status = valueobject.ScheduleStatusArchived
+			archived = append(archived, id)
+		}
+	}
+	version := &entity.ScheduleVersion{
+		ID:         uuid.New(),
+		SemesterID: schedule.SemesterID,
+		ScheduleID: scheduleID,
+		Version:    len(m.versions) + 1,
+	}
+	for _, e := range m.schedules.entries[scheduleID] {
+		version.Entries = append(version.Entries, entity.VersionEntryOf(e))
+	}
+	m.versions = append(m.versions, version)
+	return version, archived, nil
+}
+
+// Restore clones a version into a new schedule and publishes it, dropping the
+// clone again when publishing fails as the rolled back transaction would.
+func (m *mockScheduleVersionRepository) Restore(ctx context.Context, semesterID uuid.UUID, number int) (*entity.ScheduleVersion, []uuid.UUID, error) {
+	source, err := m.Get(ctx, semesterID, number)
+	if err != nil {
+		return nil, nil, err
+	}
+	clone := &entity.Schedule{
+		ID:         uuid.New(),
+		SemesterID: source.SemesterID,
+		Name:       fmt.Sprintf("Rollback to v%d", source.Version),
+		Status:     valueobject.ScheduleStatusCompleted,
+	}
+	m.schedules.byID[clone.ID] = clone
+	for _, ve := range source.Entries {
+		entry := ve.ScheduleEntry()
+		entry.ScheduleID = clone.ID
+		m.schedules.entries[clone.ID] = append(m.schedules.entries[clone.ID], entry)
+	}
+	version, archived, err := m.Publish(ctx, clone.ID)
+	if err != nil {
+		delete(m.schedules.byID, clone.ID)
+		delete(m.schedules.entries, clone.ID)
+		return nil, nil, err
+	}
+	return version, archived, nil
+}
+
+func (m *mockScheduleVersionRepository) ListBySemester(_ context.Context, _ uuid.UUID) ([]*entity.ScheduleVersion, error) {
+	return m.versions, nil
+}
+
+func (m *mockScheduleVersionRepository) Get(_ context.Context, _ uuid.UUID, version int) (*entity.ScheduleVersion, error) {
+	if version < 1 || version > len(m.versions) {
+		return nil, repository.ErrVersionNotFound
+	}
+	return m.versions[version-1], nil
+}
+
+// mockGenerationJobRepository keeps jobs in memory, keyed by schedule.
+type mockGenerationJobRepository struct {
+	jobs map[uuid.UUID]*entity.GenerationJob
+}
+
+var _ repository.GenerationJobRepository = (*mockGenerationJobRepository)(nil)
+
+func newMockGenerationJobRepository() *mockGenerationJobRepository {
+	return &mockGenerationJobRepository{jobs: map[uuid.UUID]*entity.GenerationJob{}}
+}
+
+func (m *mockGenerationJobRepository) Create(_ context.Context, job *entity.GenerationJob) (*entity.GenerationJob, error) {
+	job.ID = uuid.New()
+	job.Status = valueobject.JobStatusQueued
+	m.jobs[job.ScheduleID] = job
+	return job, nil
+}
+
+func (m *mockGenerationJobRepository) GetBySchedule(_ context.Context, scheduleID uuid.UUID) (*entity.GenerationJob, error) {
+	if job, ok := m.jobs[scheduleID]; ok {
+		return job, nil
+	}
+	return nil, context.Canceled
+}
+
+func (m *mockGenerationJobRepository) Claim(_ context.Context, owner string, _ time.Duration) (*entity.GenerationJob, error) {
+	for _, job := range m.jobs {
+		if job.Status == valueobject.JobStatusQueued {
+			job.Status = valueobject.JobStatusRunning
+			job.LeaseOwner = owner
+			job.Attempts++
+			return job, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockGenerationJobRepository) RenewLease(_ context.Context, _ uuid.UUID, _ string, _ time.Duration) (bool, error) {
+	return false, nil
+}
+
+func (m *mockGenerationJobRepository) Finish(_ context.Context, id uuid.UUID, _ string, status valueobject.JobStatus, errMsg string) error {
+	for _, job := range m.jobs {
+		if job.ID == id {
+			job.Status = status
+			job.ErrorMessage = errMsg
+		}
+	}
+	return nil
+}
+
+func (m *mockGenerationJobRepository) RequestCancel(_ context.Context, scheduleID uuid.UUID) (*entity.GenerationJob, error) {
+	job, ok := m.jobs[scheduleID]
+	if !ok || job.Status.IsTerminal() {
+		return nil, repository.ErrNoActiveJob
+	}
+	job.CancelRequested = true
+	if job.Status == valueobject.JobStatusQueued {
+		job.Status = valueobject.JobStatusCancelled
+	}
+	return job, nil
+}
+
+func (m *mockGenerationJobRepository) Release(_ context.Context, _ string, _ bool) (int, error) {
+	return 0, nil
+}
+
+func (m *mockGenerationJobRepository) Reap(_ context.Context) ([]*entity.GenerationJob, error) {
+	return nil, nil
+}
+
 func TestSemesterServer_CreateSemester_Success(t *testing.T) {
 	now := time.Now()
 	semesterID := uuid.New()
@@ -254,6 +496,129 @@ func TestSemesterServer_CreateSemester_InvalidArgument(t *testing.T) {
 	}
 }
 
+func TestPresetSlotsSessionCount(t *testing.T) {
+	presets := map[string][]struct{ day, start, end int }{
+		"standard": standardPresetSlots,
+		"mwf":      mwfPresetSlots,
+		"tuth":     tuthPresetSlots,
+	}
+	for name, preset := range presets {
+		slots := make([]*entity.TimeSlot, 0, len(preset))
+		for _, p := range preset {
+			slots = append(slots, &entity.TimeSlot{DayOfWeek: p.day, StartPeriod: p.start, EndPeriod: p.end})
+		}
+		// Preset slots are two periods long, so 4 weekly hours is two sessions
+		if got := service.SessionCount(4, service.SessionPeriods(slots)); got != 2 {
+			t.Fatalf("%s: 4 weekly hours gives %d sessions, want 2", name, got)
+		}
+	}
+}
+
+func TestSemesterServer_Calendar(t *testing.T) {
+	semesterID, subjectID := uuid.New(), uuid.New()
+	start := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	repo := &mockSemesterRepository{
+		getByID: map[uuid.UUID]*entity.Semester{semesterID: {
+			ID:                semesterID,
+			StartDate:         start,
+			EndDate:           start.AddDate(0, 4, 0),
+			OfferedSubjectIDs: []uuid.UUID{subjectID},
+		}},
+		slots: []*entity.TimeSlot{{SemesterID: semesterID, DayOfWeek: 0, StartPeriod: 1, EndPeriod: 4}},
+	}
+	conn := startTimetableTestServer(t, func(server *grpc.Server) {
+		timetablev1.RegisterSemesterServiceServer(server, NewSemesterServer(nil, nil, repo))
+	})
+	client := timetablev1.NewSemesterServiceClient(conn)
+	ctx := context.Background()
+
+	// Three periods cannot cover a slot ending in period 4
+	_, err := client.SetPeriodDefinitions(ctx, &timetablev1.SetPeriodDefinitionsRequest{
+		SemesterId: semesterID.String(),
+		Generate:   &timetablev1.PeriodGenerator{FirstStart: "07:00", Count: 3, PeriodMinutes: 50, BreakMinutes: 10},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+	periods, err := client.SetPeriodDefinitions(ctx, &timetablev1.SetPeriodDefinitionsRequest{
+		SemesterId: semesterID.String(),
+		Generate: &timetablev1.PeriodGenerator{
+			FirstStart: "07:00", Count: 6, PeriodMinutes: 50, BreakMinutes: 10,
+			LongBreaks: map[int32]int32{3: 60},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SetPeriodDefinitions error: %v", err)
+	}
+	got := periods.Semester.Periods
+	if len(got) != 6 || got[3].Start != "10:50" || got[2].BreakMinutes != 60 || got[5].BreakMinutes != 0 {
+		t.Fatalf("unexpected periods: %v", got)
+	}
+
+	outside := timestamppbFromTime(start.AddDate(1, 0, 0))
+	_, err = client.SetHolidays(ctx, &timetablev1.SetHolidaysRequest{
+		SemesterId: semesterID.String(),
+		Holidays:   []*timetablev1.Holiday{{Name: "Later", StartDate: outside}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a holiday outside the semester, got %v", err)
+	}
+	holidays, err := client.SetHolidays(ctx, &timetablev1.SetHolidaysRequest{
+		SemesterId: semesterID.String(),
+		Holidays:   []*timetablev1.Holiday{{Name: "National day", StartDate: timestamppbFromTime(start.AddDate(0, 0, 1))}},
+	})
+	if err != nil {
+		t.Fatalf("SetHolidays error: %v", err)
+	}
+	if h := holidays.Semester.Holidays; len(h) != 1 || !h[0].EndDate.AsTime().Equal(h[0].StartDate.AsTime()) {
+		t.Fatalf("expected a single-day holiday, got %v", h)
+	}
+
+	_, err = client.SetWeekPattern(ctx, &timetablev1.SetWeekPatternRequest{
+		SemesterId: semesterID.String(), SubjectId: uuid.NewString(), Pattern: "odd_weeks",
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for a subject not offered, got %v", err)
+	}
+	_, err = client.SetWeekPattern(ctx, &timetablev1.SetWeekPatternRequest{
+		SemesterId: semesterID.String(), SubjectId: subjectID.String(), Pattern: "fortnightly",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an unknown pattern, got %v", err)
+	}
+	pattern, err := client.SetWeekPattern(ctx, &timetablev1.SetWeekPatternRequest{
+		SemesterId: semesterID.String(), SubjectId: subjectID.String(), Pattern: "odd_weeks",
+	})
+	if err != nil {
+		t.Fatalf("SetWeekPattern error: %v", err)
+	}
+	if pattern.Semester.WeekPatterns[subjectID.String()] != "odd_weeks" {
+		t.Fatalf("unexpected week patterns: %v", pattern.Semester.WeekPatterns)
+	}
+
+	_, err = client.SetSubjectSections(ctx, &timetablev1.SetSubjectSectionsRequest{
+		SemesterId: semesterID.String(), SubjectId: subjectID.String(),
+		Sections: []*timetablev1.CourseSection{{Number: 1}, {Number: 1}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for duplicate section numbers, got %v", err)
+	}
+	sections, err := client.SetSubjectSections(ctx, &timetablev1.SetSubjectSectionsRequest{
+		SemesterId: semesterID.String(), SubjectId: subjectID.String(),
+		Sections: []*timetablev1.CourseSection{
+			{Number: 1, Capacity: 40, StudentGroup: " K21-A "},
+			{Number: 2, Capacity: 35, Assistants: 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SetSubjectSections error: %v", err)
+	}
+	split := sections.Semester.Sections[subjectID.String()].GetSections()
+	if len(split) != 2 || split[0].StudentGroup != "K21-A" || split[1].Assistants != 1 {
+		t.Fatalf("unexpected sections: %v", split)
+	}
+}
+
 func TestTimetableServer_GenerateSchedule_Success(t *testing.T) {
 	scheduleID := uuid.New()
 	semesterID := uuid.New()
@@ -302,17 +667,21 @@ func TestTimetableServer_GenerateSchedule_Success(t *testing.T) {
 	teacherServiceClient := &mockTeacherServiceClient{teachers: hrClient.teachers, availability: hrClient.availability}
 	subjectServiceClient := &mockSubjectServiceClient{subjects: subjectClient.subjects}
 
+	jobRepo := newMockGenerationJobRepository()
 	generatorHandler := command.NewGenerateScheduleHandler(
 		semesterRepo,
 		scheduleRepo,
+		jobRepo,
 		roomRepo,
+		nil,
 		infragrpc.NewHRClientWithTeacherClient(teacherServiceClient),
 		infragrpc.NewSubjectClientWithServices(subjectServiceClient, &mockPrerequisiteServiceClient{}),
+		nil,
 		publisher,
 	)
 	getHandler := query.NewGetScheduleHandler(scheduleRepo)
 	conn := startTimetableTestServer(t, func(server *grpc.Server) {
-		timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(generatorHandler, nil, getHandler, nil, nil, roomRepo))
+		timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(generatorHandler, nil, nil, getHandler, nil, nil, nil, roomRepo, ScheduleLifecycle{}, nil, ExamScheduling{}, RoomManagement{}))
 	})
 
 	client := timetablev1.NewTimetableServiceClient(conn)
@@ -329,6 +698,15 @@ func TestTimetableServer_GenerateSchedule_Success(t *testing.T) {
 	if scheduleRepo.createCallCount == 0 {
 		t.Fatal("expected schedule repository create to be called")
 	}
+	job := jobRepo.jobs[scheduleID]
+	if job == nil || job.Status != valueobject.JobStatusQueued {
+		t.Fatalf("expected a queued generation job, got %+v", job)
+	}
+
+	claimed, _ := jobRepo.Claim(context.Background(), "test", time.Minute)
+	if err := generatorHandler.Run(context.Background(), claimed); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
 }
 
 func timestamppbFromTime(t time.Time) *timestamppb.Timestamp {
@@ -336,17 +714,24 @@ func timestamppbFromTime(t time.Time) *timestamppb.Timestamp {
 }
 
 type mockRoomRepository struct {
-	rooms []*entity.Room
+	rooms     []*entity.Room
+	published map[uuid.UUID]int64 // room_id -> entries of published schedules
 }
 
 var _ repository.RoomRepository = (*mockRoomRepository)(nil)
 
 func (m *mockRoomRepository) Create(_ context.Context, room *entity.Room) (*entity.Room, error) {
+	m.rooms = append(m.rooms, room)
 	return room, nil
 }
 
-func (m *mockRoomRepository) GetByID(_ context.Context, _ uuid.UUID) (*entity.Room, error) {
-	return nil, context.Canceled
+func (m *mockRoomRepository) GetByID(_ context.Context, id uuid.UUID) (*entity.Room, error) {
+	for _, r := range m.rooms {
+		if r.ID == id {
+			return r, nil
+		}
+	}
+	return nil, repository.ErrRoomNotFound
 }
 
 func (m *mockRoomRepository) List(_ context.Context, _, _ int32) ([]*entity.Room, error) {
@@ -356,10 +741,38 @@ func (m *mockRoomRepository) List(_ context.Context, _, _ int32) ([]*entity.Room
 	return nil, nil
 }
 
+func (m *mockRoomRepository) ListAll(ctx context.Context, limit, offset int32) ([]*entity.Room, error) {
+	return m.List(ctx, limit, offset)
+}
+
 func (m *mockRoomRepository) Count(_ context.Context) (int64, error) {
 	return int64(len(m.rooms)), nil
 }
 
+func (m *mockRoomRepository) Update(_ context.Context, room *entity.Room) (*entity.Room, error) {
+	for i, r := range m.rooms {
+		if r.ID == room.ID {
+			m.rooms[i] = room
+			return room, nil
+		}
+	}
+	return nil, repository.ErrRoomNotFound
+}
+
+func (m *mockRoomRepository) Delete(_ context.Context, id uuid.UUID) error {
+	for i, r := range m.rooms {
+		if r.ID == id {
+			m.rooms = append(m.rooms[:i], m.rooms[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrRoomNotFound
+}
+
+func (m *mockRoomRepository) CountPublishedEntries(_ context.Context, id uuid.UUID) (int64, error) {
+	return m.published[id], nil
+}
+
 type mockHRClient struct {
 	teachers     []service.TeacherInfo
 	availability map[uuid.UUID][]*entity.TimeSlot
@@ -377,7 +790,8 @@ type mockTeacherServiceClient struct {
 }
 
 type mockSubjectServiceClient struct {
-	subjects []infragrpc.SubjectInfo
+	subjects   []infragrpc.SubjectInfo
+	enrollment map[uuid.UUID]int32
 }
 
 type mockPrerequisiteServiceClient struct{}
@@ -394,13 +808,12 @@ func (m *mockHRClient) ListTeachers(_ context.Context) ([]service.TeacherInfo, e
 	return nil, nil
 }
 
-func (m *mockHRClient) GetTeacherAvailability(_ context.Context, teacherID uuid.UUID) ([]*entity.TimeSlot, error) {
-	if m.availability != nil {
-		if slots, ok := m.availability[teacherID]; ok {
-			return slots, nil
-		}
+func (m *mockHRClient) GetTeacherAvailability(_ context.Context, teacherID uuid.UUID) ([]service.AvailabilitySlot, error) {
+	var result []service.AvailabilitySlot
+	for _, s := range m.availability[teacherID] {
+		result = append(result, service.AvailabilitySlot{Slot: s, Preference: valueobject.TeacherPreferenceNeutral})
 	}
-	return nil, nil
+	return result, nil
 }
 
 func (m *mockTeacherServiceClient) CreateTeacher(context.Context, *hrv1.CreateTeacherRequest, ...grpc.CallOption) (*hrv1.CreateTeacherResponse, error) {
@@ -448,6 +861,14 @@ func (m *mockTeacherServiceClient) UpdateTeacherAvailability(context.Context, *h
 	return &hrv1.UpdateTeacherAvailabilityResponse{}, nil
 }
 
+func (m *mockTeacherServiceClient) ListTeacherPreferences(context.Context, *hrv1.ListTeacherPreferencesRequest, ...grpc.CallOption) (*hrv1.ListTeacherPreferencesResponse, error) {
+	return &hrv1.ListTeacherPreferencesResponse{}, nil
+}
+
+func (m *mockTeacherServiceClient) UpdateTeacherPreferences(context.Context, *hrv1.UpdateTeacherPreferencesRequest, ...grpc.CallOption) (*hrv1.UpdateTeacherPreferencesResponse, error) {
+	return &hrv1.UpdateTeacherPreferencesResponse{}, nil
+}
+
 func (m *mockSubjectClient) ListSubjectsByIDs(_ context.Context, _ []uuid.UUID) ([]infragrpc.SubjectInfo, error) {
 	if m.subjects != nil {
 		return m.subjects, nil
@@ -470,7 +891,12 @@ func (m *mockSubjectServiceClient) GetSubject(context.Context, *subjectv1.GetSub
 func (m *mockSubjectServiceClient) ListSubjects(ctx context.Context, _ *subjectv1.ListSubjectsRequest, _ ...grpc.CallOption) (*subjectv1.ListSubjectsResponse, error) {
 	subjects := make([]*subjectv1.Subject, len(m.subjects))
 	for i, s := range m.subjects {
-		subjects[i] = &subjectv1.Subject{Id: s.ID.String(), Code: s.Code, Credits: int32(s.Credits)}
+		subjects[i] = &subjectv1.Subject{
+			Id:               s.ID.String(),
+			Code:             s.Code,
+			Credits:          int32(s.Credits),
+			RoomRequirements: &subjectv1.RoomRequirements{MinCapacity: int32(s.MinRoomCapacity)},
+		}
 	}
 	return &subjectv1.ListSubjectsResponse{Subjects: subjects}, nil
 }
@@ -483,6 +909,14 @@ func (m *mockSubjectServiceClient) DeleteSubject(context.Context, *subjectv1.Del
 	return &subjectv1.DeleteSubjectResponse{}, nil
 }
 
+func (m *mockSubjectServiceClient) ListSemesterOfferings(context.Context, *subjectv1.ListSemesterOfferingsRequest, ...grpc.CallOption) (*subjectv1.ListSemesterOfferingsResponse, error) {
+	resp := &subjectv1.ListSemesterOfferingsResponse{}
+	for id, n := range m.enrollment {
+		resp.Offerings = append(resp.Offerings, &subjectv1.SemesterOffering{SubjectId: id.String(), MaxEnrollment: n})
+	}
+	return resp, nil
+}
+
 func (m *mockPrerequisiteServiceClient) AddPrerequisite(context.Context, *subjectv1.AddPrerequisiteRequest, ...grpc.CallOption) (*subjectv1.AddPrerequisiteResponse, error) {
 	return &subjectv1.AddPrerequisiteResponse{}, nil
 }
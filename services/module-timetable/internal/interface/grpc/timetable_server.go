@@ -2,6 +2,9 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
 
 	"github.com/google/uuid"
 	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
@@ -9,8 +12,10 @@ import (
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/query"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
 	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -20,27 +25,45 @@ type TimetableServer struct {
 
 	generateSchedule *command.GenerateScheduleHandler
 	manualAssign     *command.ManualAssignHandler
+	repairSchedule   *command.RepairScheduleHandler
 	getSchedule      *query.GetScheduleHandler
 	listSchedules    *query.ListSchedulesHandler
 	suggestTeachers  *query.SuggestTeachersHandler
+	generationStatus *query.GetGenerationStatusHandler
 	roomRepo         repository.RoomRepository
+	lifecycle        ScheduleLifecycle
+	exportCalendar   *query.ExportICalendarHandler
+	exams            ExamScheduling
+	rooms            RoomManagement
 }
 
 func NewTimetableServer(
 	generateSchedule *command.GenerateScheduleHandler,
 	manualAssign     *command.ManualAssignHandler,
+	repairSchedule   *command.RepairScheduleHandler,
 	getSchedule      *query.GetScheduleHandler,
 	listSchedules    *query.ListSchedulesHandler,
 	suggestTeachers  *query.SuggestTeachersHandler,
+	generationStatus *query.GetGenerationStatusHandler,
 	roomRepo         repository.RoomRepository,
+	lifecycle        ScheduleLifecycle,
+	exportCalendar   *query.ExportICalendarHandler,
+	exams            ExamScheduling,
+	rooms            RoomManagement,
 ) *TimetableServer {
 	return &TimetableServer{
 		generateSchedule: generateSchedule,
 		manualAssign:     manualAssign,
+		repairSchedule:   repairSchedule,
 		getSchedule:      getSchedule,
 		listSchedules:    listSchedules,
 		suggestTeachers:  suggestTeachers,
+		generationStatus: generationStatus,
 		roomRepo:         roomRepo,
+		lifecycle:        lifecycle,
+		exportCalendar:   exportCalendar,
+		exams:            exams,
+		rooms:            rooms,
 	}
 }
 
@@ -50,11 +73,37 @@ func (s *TimetableServer) GenerateSchedule(ctx context.Context, req *timetablev1
 		return nil, status.Error(codes.InvalidArgument, "invalid semester_id")
 	}
 
+	pins, err := pinsFromProto(req.Pins)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pins: %v", err)
+	}
+	var lockedFrom uuid.UUID
+	if req.LockFromScheduleId != "" {
+		if lockedFrom, err = uuid.Parse(req.LockFromScheduleId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid lock_from_schedule_id")
+		}
+	}
+
+	seed := req.Seed
+	if seed == 0 {
+		// Stay below 2^53 so the seed survives JSON clients unchanged
+		seed = rand.Uint64N(1<<53-1) + 1
+	}
+
 	scheduleID, err := s.generateSchedule.Handle(ctx, command.GenerateScheduleCommand{
-		SemesterID:     semesterID,
-		TimeoutSeconds: int(req.TimeoutSeconds),
+		SemesterID:           semesterID,
+		TimeoutSeconds:       int(req.TimeoutSeconds),
+		BindSessionTeacher:   req.BindSessionTeacher,
+		SoftCohortClash:      req.SoftCohortClash,
+		Pins:                 pins,
+		LockedFromScheduleID: lockedFrom,
+		Seed:                 seed,
+		PortfolioSize:        int(req.PortfolioSize),
 	})
 	if err != nil {
+		if errors.Is(err, command.ErrInvalidPin) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "generate schedule: %v", err)
 	}
 
@@ -68,6 +117,7 @@ func (s *TimetableServer) GenerateSchedule(ctx context.Context, req *timetablev1
 		Schedule:        scheduleToProto(result.Schedule, result.Entries),
 		IsPartial:       result.Schedule.Score < 100 && result.Schedule.Score >= 0,
 		UnassignedCount: int32(len(result.Entries)),
+		Seed:            seed,
 	}, nil
 }
 
@@ -100,34 +150,69 @@ func (s *TimetableServer) ManualAssign(ctx context.Context, req *timetablev1.Man
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid teacher_id")
 	}
-
-	entry, err := s.manualAssign.Handle(ctx, command.ManualAssignCommand{
+	cmd := command.ManualAssignCommand{
 		ScheduleID: scheduleID,
 		EntryID:    entryID,
 		TeacherID:  teacherID,
-	})
+		DryRun:     req.DryRun,
+	}
+	if req.RoomId != "" {
+		if cmd.RoomID, err = uuid.Parse(req.RoomId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid room_id")
+		}
+	}
+	if req.TimeSlotId != "" {
+		if cmd.SlotID, err = uuid.Parse(req.TimeSlotId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid time_slot_id")
+		}
+	}
+
+	result, err := s.manualAssign.Handle(ctx, cmd)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "manual assign: %v", err)
+		return nil, manualAssignStatus(err)
 	}
 
 	return &timetablev1.ManualAssignResponse{
-		Entry: entryToProto(entry),
+		Entry:          entryToProto(result.Entry),
+		Violations:     violationsToProto(result.Violations),
+		Score:          result.Schedule.Score,
+		HardViolations: int32(result.Schedule.HardViolations),
+		SoftPenalty:    result.Schedule.SoftPenalty,
 	}, nil
 }
 
 func (s *TimetableServer) SuggestTeachers(ctx context.Context, req *timetablev1.SuggestTeachersRequest) (*timetablev1.SuggestTeachersResponse, error) {
-	subjectID, err := uuid.Parse(req.SubjectId)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid subject_id")
-	}
-
-	ranks, err := s.suggestTeachers.Handle(ctx, query.SuggestTeachersQuery{
-		SubjectID:   subjectID,
+	q := query.SuggestTeachersQuery{
 		DayOfWeek:   int(req.DayOfWeek),
 		StartPeriod: int(req.StartPeriod),
 		EndPeriod:   int(req.EndPeriod),
-	})
+	}
+	ids := []struct {
+		value string
+		name  string
+		dst   *uuid.UUID
+	}{
+		{req.SubjectId, "subject_id", &q.SubjectID},
+		{req.ScheduleId, "schedule_id", &q.ScheduleID},
+		{req.EntryId, "entry_id", &q.EntryID},
+		{req.TimeSlotId, "time_slot_id", &q.TimeSlotID},
+	}
+	for _, id := range ids {
+		if id.value == "" {
+			continue
+		}
+		parsed, err := uuid.Parse(id.value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s", id.name)
+		}
+		*id.dst = parsed
+	}
+
+	ranks, err := s.suggestTeachers.Handle(ctx, q)
 	if err != nil {
+		if errors.Is(err, query.ErrInvalidSuggestionQuery) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "suggest teachers: %v", err)
 	}
 
@@ -137,6 +222,8 @@ func (s *TimetableServer) SuggestTeachers(ctx context.Context, req *timetablev1.
 			TeacherId:   r.TeacherID.String(),
 			TeacherName: r.Name,
 			Score:       float32(r.Score),
+			Reasons:     r.Reasons,
+			Available:   r.Available,
 		}
 	}
 
@@ -174,26 +261,158 @@ func (s *TimetableServer) ListSchedules(ctx context.Context, req *timetablev1.Li
 	}, nil
 }
 
-func (s *TimetableServer) ListRooms(ctx context.Context, _ *timetablev1.ListRoomsRequest) (*timetablev1.ListRoomsResponse, error) {
-	rooms, err := s.roomRepo.List(ctx, 200, 0)
+// GetGenerationStatus reports the state of an async generation run, including
+// the infeasibility diagnosis when the solver could not place every subject.
+func (s *TimetableServer) GetGenerationStatus(ctx context.Context, req *timetablev1.GetGenerationStatusRequest) (*timetablev1.GetGenerationStatusResponse, error) {
+	id, err := uuid.Parse(req.ScheduleId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "list rooms: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid schedule_id")
+	}
+
+	st, err := s.generationStatus.Handle(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "schedule not found: %v", err)
+	}
+
+	resp := &timetablev1.GetGenerationStatusResponse{
+		ScheduleId:    st.Schedule.ID.String(),
+		Status:        st.Schedule.Status.String(),
+		IsComplete:    st.IsComplete,
+		IsPartial:     st.IsPartial,
+		IsFailed:      st.IsFailed,
+		FailureReason: st.Schedule.FailureReason,
+		Diagnosis:     diagnosisToProto(st.Schedule.Diagnosis),
+	}
+	if st.Job != nil {
+		resp.JobStatus = st.Job.Status.String()
+		resp.JobAttempts = int32(st.Job.Attempts)
+	}
+	return resp, nil
+}
+
+// CancelGeneration stops a queued or running generation.
+func (s *TimetableServer) CancelGeneration(ctx context.Context, req *timetablev1.CancelGenerationRequest) (*timetablev1.CancelGenerationResponse, error) {
+	scheduleID, err := uuid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid schedule_id")
 	}
-	protos := make([]*timetablev1.Room, len(rooms))
-	for i, r := range rooms {
-		protos[i] = &timetablev1.Room{
-			Id:       r.ID.String(),
-			Name:     r.Name,
-			Capacity: int32(r.Capacity),
-			RoomType: r.Type,
+	job, err := s.generateSchedule.Cancel(ctx, scheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoActiveJob) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
 		}
+		return nil, status.Errorf(codes.Internal, "cancel generation: %v", err)
 	}
-	return &timetablev1.ListRoomsResponse{Rooms: protos}, nil
+	return &timetablev1.CancelGenerationResponse{
+		ScheduleId: scheduleID.String(),
+		JobStatus:  job.Status.String(),
+	}, nil
+}
+
+// RepairSchedule re-solves the entries of a schedule invalidated by removed
+// teachers, closed rooms or deleted slots, leaving everything else in place.
+func (s *TimetableServer) RepairSchedule(ctx context.Context, req *timetablev1.RepairScheduleRequest) (*timetablev1.RepairScheduleResponse, error) {
+	scheduleID, err := uuid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid schedule_id")
+	}
+	cmd := command.RepairScheduleCommand{ScheduleID: scheduleID, TimeoutSeconds: int(req.TimeoutSeconds)}
+	if cmd.RemovedTeacherIDs, err = parseUUIDs(req.RemovedTeacherIds); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid removed_teacher_ids: %v", err)
+	}
+	if cmd.ClosedRoomIDs, err = parseUUIDs(req.ClosedRoomIds); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid closed_room_ids: %v", err)
+	}
+	if cmd.RemovedSlotIDs, err = parseUUIDs(req.RemovedSlotIds); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid removed_slot_ids: %v", err)
+	}
+
+	result, err := s.repairSchedule.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, service.ErrNoFeasibleSolution) || errors.Is(err, entity.ErrStatusTransition) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "repair schedule: %v", err)
+	}
+	return &timetablev1.RepairScheduleResponse{
+		Schedule:          scheduleToProto(result.Schedule, result.Entries),
+		AffectedEntries:   int32(result.Affected),
+		ReassignedEntries: int32(result.Reassigned),
+		MovedEntries:      int32(result.Moved),
+		UnresolvedEntries: int32(result.Unresolved),
+	}, nil
 }
 
 func (s *TimetableServer) UpdateScheduleEntry(ctx context.Context, req *timetablev1.UpdateScheduleEntryRequest) (*timetablev1.UpdateScheduleEntryResponse, error) {
-	// Minimal implementation — field updates delegated to ManualAssign for teacher changes.
-	return nil, status.Error(codes.Unimplemented, "use ManualAssign for teacher changes")
+	scheduleID, err := uuid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid schedule_id")
+	}
+	entryID, err := uuid.Parse(req.EntryId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid entry_id")
+	}
+	cmd := command.ManualAssignCommand{ScheduleID: scheduleID, EntryID: entryID, DryRun: req.DryRun}
+	if req.TeacherId != nil {
+		if cmd.TeacherID, err = uuid.Parse(req.GetTeacherId()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid teacher_id")
+		}
+	}
+	// A room may be given by ID or by name
+	if req.Room != nil {
+		if id, err := uuid.Parse(req.GetRoom()); err == nil {
+			cmd.RoomID = id
+		} else {
+			cmd.RoomName = req.GetRoom()
+		}
+	}
+	switch {
+	case req.DayOfWeek != nil && req.StartPeriod != nil && req.EndPeriod != nil:
+		cmd.SlotAt = &command.SlotRef{
+			DayOfWeek:   int(req.GetDayOfWeek()),
+			StartPeriod: int(req.GetStartPeriod()),
+			EndPeriod:   int(req.GetEndPeriod()),
+		}
+	case req.DayOfWeek != nil || req.StartPeriod != nil || req.EndPeriod != nil:
+		return nil, status.Error(codes.InvalidArgument, "day_of_week, start_period and end_period must be set together")
+	}
+
+	result, err := s.manualAssign.Handle(ctx, cmd)
+	if err != nil {
+		return nil, manualAssignStatus(err)
+	}
+
+	return &timetablev1.UpdateScheduleEntryResponse{
+		Entry:          entryToProto(result.Entry),
+		Violations:     violationsToProto(result.Violations),
+		Score:          result.Schedule.Score,
+		HardViolations: int32(result.Schedule.HardViolations),
+		SoftPenalty:    result.Schedule.SoftPenalty,
+	}, nil
+}
+
+// manualAssignStatus maps a manual edit error to a gRPC status. An edit
+// booking a teacher or room twice in one slot is a failed precondition whose
+// details carry the clashing entries as ScheduleViolations.
+func manualAssignStatus(err error) error {
+	var taken *command.SlotTakenError
+	switch {
+	case errors.Is(err, command.ErrInvalidEdit):
+		return status.Errorf(codes.InvalidArgument, "manual assign: %v", err)
+	case errors.As(err, &taken):
+		st := status.Newf(codes.FailedPrecondition, "manual assign: %v", err)
+		details := make([]protoadapt.MessageV1, 0, len(taken.Violations))
+		for _, v := range violationsToProto(taken.Violations) {
+			details = append(details, v)
+		}
+		if withDetails, derr := st.WithDetails(details...); derr == nil {
+			st = withDetails
+		}
+		return st.Err()
+	case errors.Is(err, repository.ErrSlotTaken):
+		return status.Errorf(codes.FailedPrecondition, "manual assign: %v", err)
+	}
+	return status.Errorf(codes.Internal, "manual assign: %v", err)
 }
 
 // --- proto mapping helpers ---
@@ -206,6 +425,7 @@ func scheduleToProto(s *entity.Schedule, entries []*entity.ScheduleEntry) *timet
 		Score:          s.Score,
 		HardViolations: int32(s.HardViolations),
 		SoftViolations: s.SoftPenalty,
+		CohortClashes:  int32(s.CohortClashes),
 		CreatedAt:      timestamppb.New(s.CreatedAt),
 	}
 	for _, e := range entries {
@@ -214,8 +434,52 @@ func scheduleToProto(s *entity.Schedule, entries []*entity.ScheduleEntry) *timet
 	return p
 }
 
+// parseUUIDs parses a list of ID strings, failing on the first invalid one.
+func parseUUIDs(raw []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, r := range raw {
+		id, err := uuid.Parse(r)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", r, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// pinsFromProto parses pinned assignments; empty ids stay nil.
+func pinsFromProto(raw []*timetablev1.PinnedAssignment) ([]command.PinnedAssignment, error) {
+	pins := make([]command.PinnedAssignment, 0, len(raw))
+	for _, p := range raw {
+		subjectID, err := uuid.Parse(p.SubjectId)
+		if err != nil {
+			return nil, fmt.Errorf("subject_id %q: %w", p.SubjectId, err)
+		}
+		pin := command.PinnedAssignment{SubjectID: subjectID, Section: int(p.Section), Session: int(p.Session)}
+		for _, f := range []struct {
+			name, raw string
+			dst       **uuid.UUID
+		}{
+			{"teacher_id", p.TeacherId, &pin.TeacherID},
+			{"room_id", p.RoomId, &pin.RoomID},
+			{"time_slot_id", p.TimeSlotId, &pin.SlotID},
+		} {
+			if f.raw == "" {
+				continue
+			}
+			id, err := uuid.Parse(f.raw)
+			if err != nil {
+				return nil, fmt.Errorf("%s %q: %w", f.name, f.raw, err)
+			}
+			*f.dst = &id
+		}
+		pins = append(pins, pin)
+	}
+	return pins, nil
+}
+
 func entryToProto(e *entity.ScheduleEntry) *timetablev1.ScheduleEntry {
-	return &timetablev1.ScheduleEntry{
+	p := &timetablev1.ScheduleEntry{
 		Id:               e.ID.String(),
 		SubjectId:        e.SubjectID.String(),
 		TeacherId:        e.TeacherID.String(),
@@ -229,5 +493,61 @@ func entryToProto(e *entity.ScheduleEntry) *timetablev1.ScheduleEntry {
 		RoomName:         e.RoomName,
 		IsManualOverride: e.IsManualOverride,
 		DepartmentId:     e.DepartmentID.String(),
+		Section:          int32(e.SectionNumber()),
 	}
+	for _, t := range e.Teachers() {
+		p.Teachers = append(p.Teachers, &timetablev1.EntryTeacher{
+			TeacherId:   t.TeacherID.String(),
+			TeacherName: t.TeacherName,
+			Role:        string(t.Role),
+		})
+	}
+	return p
+}
+
+func violationsToProto(violations []command.EntryViolation) []*timetablev1.ScheduleViolation {
+	out := make([]*timetablev1.ScheduleViolation, 0, len(violations))
+	for _, v := range violations {
+		pv := &timetablev1.ScheduleViolation{Constraint: string(v.Constraint)}
+		if v.EntryID != uuid.Nil {
+			pv.EntryId = v.EntryID.String()
+		}
+		if v.OtherEntryID != uuid.Nil {
+			pv.OtherEntryId = v.OtherEntryID.String()
+		}
+		if v.TeacherID != uuid.Nil {
+			pv.TeacherId = v.TeacherID.String()
+		}
+		out = append(out, pv)
+	}
+	return out
+}
+
+func diagnosisToProto(d *entity.GenerationDiagnosis) *timetablev1.InfeasibilityDiagnosis {
+	if d == nil {
+		return nil
+	}
+	p := &timetablev1.InfeasibilityDiagnosis{}
+	for _, e := range d.EmptyDomains {
+		eliminated := make(map[string]int32, len(e.Eliminated))
+		for c, n := range e.Eliminated {
+			eliminated[string(c)] = int32(n)
+		}
+		p.EmptyDomains = append(p.EmptyDomains, &timetablev1.EmptyDomain{
+			SubjectId:   e.SubjectID.String(),
+			SubjectCode: e.SubjectCode,
+			SubjectName: e.SubjectName,
+			Session:     int32(e.Session),
+			Candidates:  int32(e.Candidates),
+			Cause:       string(e.Cause),
+			Eliminated:  eliminated,
+		})
+	}
+	for _, r := range d.ConflictSubjects {
+		p.ConflictSubjects = append(p.ConflictSubjects, &timetablev1.DiagnosisRef{Id: r.ID.String(), Name: r.Name})
+	}
+	for _, r := range d.ConflictTeachers {
+		p.ConflictTeachers = append(p.ConflictTeachers, &timetablev1.DiagnosisRef{Id: r.ID.String(), Name: r.Name})
+	}
+	return p
 }
@@ -0,0 +1,693 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	studentv1 "github.com/HuynhHoangPhuc/myrmex/gen/go/student/v1"
+	timetablev1 "github.com/HuynhHoangPhuc/myrmex/gen/go/timetable/v1"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/command"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/application/query"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/entity"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/repository"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/service"
+	"github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/domain/valueobject"
+	infragrpc "github.com/HuynhHoangPhuc/myrmex/services/module-timetable/internal/infrastructure/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTimetableServerRepairScheduleReassignsOnlyAffectedEntries(t *testing.T) {
+	semesterID := uuid.New()
+	scheduleID := uuid.New()
+	leaving, staying := uuid.New(), uuid.New()
+	roomID := uuid.New()
+	monday, tuesday := uuid.New(), uuid.New()
+	algebra, physics := uuid.New(), uuid.New()
+
+	semesterRepo := &mockSemesterRepository{
+		getByID: map[uuid.UUID]*entity.Semester{
+			semesterID: {
+				ID:                semesterID,
+				Name:              "Fall 2026",
+				StartDate:         time.Now(),
+				EndDate:           time.Now().Add(24 * time.Hour),
+				OfferedSubjectIDs: []uuid.UUID{algebra, physics},
+			},
+		},
+		slots: []*entity.TimeSlot{
+			{ID: monday, SemesterID: semesterID, DayOfWeek: 1, StartPeriod: 1, EndPeriod: 3},
+			{ID: tuesday, SemesterID: semesterID, DayOfWeek: 2, StartPeriod: 1, EndPeriod: 3},
+		},
+	}
+	roomRepo := &mockRoomRepository{rooms: []*entity.Room{{ID: roomID, Name: "R1", Capacity: 30, Type: "classroom", IsActive: true}}}
+	affected := &entity.ScheduleEntry{ID: uuid.New(), ScheduleID: scheduleID, SubjectID: algebra, TeacherID: leaving, RoomID: roomID, TimeSlotID: monday, DayOfWeek: 1, StartPeriod: 1}
+	kept := &entity.ScheduleEntry{ID: uuid.New(), ScheduleID: scheduleID, SubjectID: physics, TeacherID: staying, RoomID: roomID, TimeSlotID: tuesday, DayOfWeek: 2, StartPeriod: 1, IsManualOverride: true}
+	scheduleRepo := &mockScheduleRepository{
+		byID:    map[uuid.UUID]*entity.Schedule{scheduleID: {ID: scheduleID, SemesterID: semesterID, Status: valueobject.ScheduleStatusCompleted}},
+		entries: map[uuid.UUID][]*entity.ScheduleEntry{scheduleID: {affected, kept}},
+	}
+	teachers := &mockTeacherServiceClient{teachers: []service.TeacherInfo{
+		{ID: leaving, FullName: "Leaving"},
+		{ID: staying, FullName: "Staying"},
+	}}
+	subjects := &mockSubjectServiceClient{subjects: []infragrpc.SubjectInfo{{ID: algebra, Code: "MATH1"}, {ID: physics, Code: "PHYS1"}}}
+
+	repair := command.NewRepairScheduleHandler(
+		semesterRepo, scheduleRepo, nil, roomRepo, nil,
+		infragrpc.NewHRClientWithTeacherClient(teachers),
+		infragrpc.NewSubjectClientWithServices(subjects, &mockPrerequisiteServiceClient{}),
+		nil, &mockEventPublisher{},
+	)
+	conn := startTimetableTestServer(t, func(server *grpc.Server) {
+		timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(nil, nil, repair, nil, nil, nil, nil, roomRepo, ScheduleLifecycle{}, nil, ExamScheduling{}, RoomManagement{}))
+	})
+	client := timetablev1.NewTimetableServiceClient(conn)
+
+	resp, err := client.RepairSchedule(context.Background(), &timetablev1.RepairScheduleRequest{
+		ScheduleId:        scheduleID.String(),
+		RemovedTeacherIds: []string{leaving.String()},
+	})
+	if err != nil {
+		t.Fatalf("RepairSchedule error: %v", err)
+	}
+	if resp.AffectedEntries != 1 || resp.ReassignedEntries != 1 || resp.MovedEntries != 0 || resp.UnresolvedEntries != 0 {
+		t.Fatalf("unexpected counts: %+v", resp)
+	}
+	if len(scheduleRepo.updatedEntries) != 1 {
+		t.Fatalf("only the affected entry should be written, got %d", len(scheduleRepo.updatedEntries))
+	}
+	got := scheduleRepo.updatedEntries[0]
+	if got.ID != affected.ID || got.TeacherID != staying || got.TimeSlotID != monday || got.RoomID != roomID {
+		t.Fatalf("expected algebra to keep its slot and room with the remaining teacher, got %+v", got)
+	}
+	if got.TeacherName != "Staying" {
+		t.Fatalf("expected teacher name to follow the reassignment, got %q", got.TeacherName)
+	}
+
+	_, err = client.RepairSchedule(context.Background(), &timetablev1.RepairScheduleRequest{
+		ScheduleId:     scheduleID.String(),
+		RemovedSlotIds: []string{"not-a-uuid"},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a bad slot id, got %v", err)
+	}
+}
+
+// TestTimetableServerRepairScheduleSeatsOfferingEnrollment closes an entry's
+// room: the small room meets the subject's own minimum but not the 40 students
+// of its offering, so the entry must move to the larger one.
+func TestTimetableServerRepairScheduleSeatsOfferingEnrollment(t *testing.T) {
+	semesterID := uuid.New()
+	scheduleID := uuid.New()
+	teacherID := uuid.New()
+	closed, small, large := uuid.New(), uuid.New(), uuid.New()
+	monday := uuid.New()
+	algebra := uuid.New()
+
+	semesterRepo := &mockSemesterRepository{
+		getByID: map[uuid.UUID]*entity.Semester{
+			semesterID: {ID: semesterID, Name: "Fall 2026", OfferedSubjectIDs: []uuid.UUID{algebra}},
+		},
+		slots: []*entity.TimeSlot{{ID: monday, SemesterID: semesterID, DayOfWeek: 1, StartPeriod: 1, EndPeriod: 3}},
+	}
+	roomRepo := &mockRoomRepository{rooms: []*entity.Room{
+		{ID: closed, Name: "Closed", Capacity: 60, IsActive: true},
+		{ID: small, Name: "Small", Capacity: 30, IsActive: true},
+		{ID: large, Name: "Large", Capacity: 50, IsActive: true},
+	}}
+	entry := &entity.ScheduleEntry{ID: uuid.New(), ScheduleID: scheduleID, SubjectID: algebra, TeacherID: teacherID, RoomID: closed, TimeSlotID: monday, DayOfWeek: 1, StartPeriod: 1}
+	scheduleRepo := &mockScheduleRepository{
+		byID:    map[uuid.UUID]*entity.Schedule{scheduleID: {ID: scheduleID, SemesterID: semesterID, Status: valueobject.ScheduleStatusCompleted}},
+		entries: map[uuid.UUID][]*entity.ScheduleEntry{scheduleID: {entry}},
+	}
+	teachers := &mockTeacherServiceClient{teachers: []service.TeacherInfo{{ID: teacherID, FullName: "Teacher"}}}
+	subjects := &mockSubjectServiceClient{
+		subjects:   []infragrpc.SubjectInfo{{ID: algebra, Code: "MATH1", MinRoomCapacity: 20}},
+		enrollment: map[uuid.UUID]int32{algebra: 40},
+	}
+
+	repair := command.NewRepairScheduleHandler(
+		semesterRepo, scheduleRepo, nil, roomRepo, nil,
+		infragrpc.NewHRClientWithTeacherClient(teachers),
+		infragrpc.NewSubjectClientWithServices(subjects, &mockPrerequisiteServiceClient{}),
+		nil, &mockEventPublisher{},
+	)
+	conn := startTimetableTestServer(t, func(server *grpc.Server) {
+		timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(nil, nil, repair, nil, nil, nil, nil, roomRepo, ScheduleLifecycle{}, nil, ExamScheduling{}, RoomManagement{}))
+	})
+	client := timetablev1.NewTimetableServiceClient(conn)
+
+	resp, err := client.RepairSchedule(context.Background(), &timetablev1.RepairScheduleRequest{
+		ScheduleId:    scheduleID.String(),
+		ClosedRoomIds: []string{closed.String()},
+	})
+	if err != nil {
+		t.Fatalf("RepairSchedule error: %v", err)
+	}
+	if resp.ReassignedEntries != 1 || resp.UnresolvedEntries != 0 {
+		t.Fatalf("unexpected counts: %+v", resp)
+	}
+	if got := scheduleRepo.updatedEntries[0].RoomID; got != large {
+		t.Fatalf("expected the room seating the offering's enrollment, got %s", got)
+	}
+}
+
+// TestTimetableServerRepairScheduleUsesGenerationOptions repairs a schedule
+// generated with the cohort rule relaxed: the only slot left for algebra
+// shares a student with physics, which the stored options allow.
+func TestTimetableServerRepairScheduleUsesGenerationOptions(t *testing.T) {
+	semesterID := uuid.New()
+	leaving, staying, spare := uuid.New(), uuid.New(), uuid.New()
+	room1, room2 := uuid.New(), uuid.New()
+	monday := uuid.New()
+	algebra, physics := uuid.New(), uuid.New()
+
+	semesterRepo := &mockSemesterRepository{
+		getByID: map[uuid.UUID]*entity.Semester{
+			semesterID: {ID: semesterID, Name: "Fall 2026", OfferedSubjectIDs: []uuid.UUID{algebra, physics}},
+		},
+		slots: []*entity.TimeSlot{{ID: monday, SemesterID: semesterID, DayOfWeek: 1, StartPeriod: 1, EndPeriod: 2}},
+	}
+	roomRepo := &mockRoomRepository{rooms: []*entity.Room{
+		{ID: room1, Name: "R1", Capacity: 30, IsActive: true},
+		{ID: room2, Name: "R2", Capacity: 30, IsActive: true},
+	}}
+	teachers := &mockTeacherServiceClient{teachers: []service.TeacherInfo{
+		{ID: leaving, FullName: "Leaving"}, {ID: staying, FullName: "Staying"}, {ID: spare, FullName: "Spare"},
+	}}
+	subjects := &mockSubjectServiceClient{subjects: []infragrpc.SubjectInfo{{ID: algebra, Code: "MATH1"}, {ID: physics, Code: "PHYS1"}}}
+	student := uuid.New().String()
+	students := &mockStudentServiceClient{enrollments: []*studentv1.EnrollmentRequest{
+		{StudentId: student, SubjectId: algebra.String()},
+		{StudentId: student, SubjectId: physics.String()},
+	}}
+	params, _ := json.Marshal(command.GenerateScheduleCommand{SemesterID: semesterID, SoftCohortClash: true})
+
+	tests := []struct {
+		name   string
+		status valueobject.ScheduleStatus
+		params []byte // generation job params; nil = no job record
+		want   codes.Code
+	}{
+		{"relaxed cohort rule reused", valueobject.ScheduleStatusCompleted, params, codes.OK},
+		{"default options keep the cohort rule hard", valueobject.ScheduleStatusCompleted, nil, codes.FailedPrecondition},
+		{"published schedule refused", valueobject.ScheduleStatusPublished, params, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduleID := uuid.New()
+			scheduleRepo := &mockScheduleRepository{
+				byID: map[uuid.UUID]*entity.Schedule{scheduleID: {ID: scheduleID, SemesterID: semesterID, Status: tt.status}},
+				entries: map[uuid.UUID][]*entity.ScheduleEntry{scheduleID: {
+					{ID: uuid.New(), ScheduleID: scheduleID, SubjectID: algebra, TeacherID: leaving, RoomID: room1, TimeSlotID: monday, DayOfWeek: 1, StartPeriod: 1},
+					{ID: uuid.New(), ScheduleID: scheduleID, SubjectID: physics, TeacherID: staying, RoomID: room2, TimeSlotID: monday, DayOfWeek: 1, StartPeriod: 1},
+				}},
+			}
+			jobRepo := newMockGenerationJobRepository()
+			if tt.params != nil {
+				jobRepo.jobs[scheduleID] = &entity.GenerationJob{ID: uuid.New(), ScheduleID: scheduleID, Params: tt.params}
+			}
+			repair := command.NewRepairScheduleHandler(
+				semesterRepo, scheduleRepo, jobRepo, roomRepo, nil,
+				infragrpc.NewHRClientWithTeacherClient(teachers),
+				infragrpc.NewSubjectClientWithServices(subjects, &mockPrerequisiteServiceClient{}),
+				infragrpc.NewStudentClientWithStudentClient(students), &mockEventPublisher{},
+			)
+			conn := startTimetableTestServer(t, func(server *grpc.Server) {
+				timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(nil, nil, repair, nil, nil, nil, nil, roomRepo, ScheduleLifecycle{}, nil, ExamScheduling{}, RoomManagement{}))
+			})
+
+			_, err := timetablev1.NewTimetableServiceClient(conn).RepairSchedule(context.Background(), &timetablev1.RepairScheduleRequest{
+				ScheduleId:        scheduleID.String(),
+				RemovedTeacherIds: []string{leaving.String()},
+			})
+			if status.Code(err) != tt.want {
+				t.Fatalf("expected %s, got %v", tt.want, err)
+			}
+			if tt.want != codes.OK {
+				if len(scheduleRepo.updatedEntries) != 0 {
+					t.Fatal("a refused repair must not write entries")
+				}
+				return
+			}
+			if len(scheduleRepo.updatedEntries) != 1 || scheduleRepo.updatedEntries[0].TeacherID != spare {
+				t.Fatalf("expected algebra to move to the spare teacher, got %+v", scheduleRepo.updatedEntries)
+			}
+		})
+	}
+}
+
+// mockStudentServiceClient serves a fixed list of approved enrollments.
+type mockStudentServiceClient struct {
+	studentv1.StudentServiceClient
+	enrollments []*studentv1.EnrollmentRequest
+}
+
+func (m *mockStudentServiceClient) ListEnrollmentRequests(_ context.Context, _ *studentv1.ListEnrollmentRequestsRequest, _ ...grpc.CallOption) (*studentv1.ListEnrollmentRequestsResponse, error) {
+	return &studentv1.ListEnrollmentRequestsResponse{Enrollments: m.enrollments}, nil
+}
+
+func TestTimetableServerGenerateScheduleRejectsInvalidPins(t *testing.T) {
+	semesterID := uuid.New()
+	offered, other := uuid.New(), uuid.New()
+	semesterRepo := &mockSemesterRepository{
+		getByID: map[uuid.UUID]*entity.Semester{
+			semesterID: {ID: semesterID, Name: "Fall 2026", OfferedSubjectIDs: []uuid.UUID{offered}},
+		},
+	}
+	scheduleRepo := &mockScheduleRepository{byID: map[uuid.UUID]*entity.Schedule{}}
+	generate := command.NewGenerateScheduleHandler(semesterRepo, scheduleRepo, newMockGenerationJobRepository(), nil, nil, nil, nil, nil, &mockEventPublisher{})
+	conn := startTimetableTestServer(t, func(server *grpc.Server) {
+		timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(generate, nil, nil, nil, nil, nil, nil, nil, ScheduleLifecycle{}, nil, ExamScheduling{}, RoomManagement{}))
+	})
+	client := timetablev1.NewTimetableServiceClient(conn)
+
+	for name, pin := range map[string]*timetablev1.PinnedAssignment{
+		"subject not offered": {SubjectId: other.String()},
+		"bad slot id":         {SubjectId: offered.String(), TimeSlotId: "not-a-uuid"},
+	} {
+		_, err := client.GenerateSchedule(context.Background(), &timetablev1.GenerateScheduleRequest{
+			SemesterId: semesterID.String(),
+			Pins:       []*timetablev1.PinnedAssignment{pin},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+}
+
+func TestTimetableServerCancelGeneration(t *testing.T) {
+	queued, finished := uuid.New(), uuid.New()
+	jobRepo := newMockGenerationJobRepository()
+	jobRepo.jobs[queued] = &entity.GenerationJob{ID: uuid.New(), ScheduleID: queued, Status: valueobject.JobStatusQueued}
+	jobRepo.jobs[finished] = &entity.GenerationJob{ID: uuid.New(), ScheduleID: finished, Status: valueobject.JobStatusSucceeded}
+	generate := command.NewGenerateScheduleHandler(nil, &mockScheduleRepository{}, jobRepo, nil, nil, nil, nil, nil, &mockEventPublisher{})
+	conn := startTimetableTestServer(t, func(server *grpc.Server) {
+		timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(generate, nil, nil, nil, nil, nil, nil, nil, ScheduleLifecycle{}, nil, ExamScheduling{}, RoomManagement{}))
+	})
+	client := timetablev1.NewTimetableServiceClient(conn)
+
+	resp, err := client.CancelGeneration(context.Background(), &timetablev1.CancelGenerationRequest{ScheduleId: queued.String()})
+	if err != nil {
+		t.Fatalf("CancelGeneration error: %v", err)
+	}
+	if resp.JobStatus != string(valueobject.JobStatusCancelled) {
+		t.Fatalf("queued job should be cancelled at once, got %q", resp.JobStatus)
+	}
+
+	_, err = client.CancelGeneration(context.Background(), &timetablev1.CancelGenerationRequest{ScheduleId: finished.String()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for a finished job, got %v", err)
+	}
+}
+
+func TestTimetableServerManualAssignReportsViolations(t *testing.T) {
+	semesterID := uuid.New()
+	scheduleID := uuid.New()
+	alice, bob := uuid.New(), uuid.New()
+	room1, room2 := uuid.New(), uuid.New()
+	monday, mondayLate, tuesday := uuid.New(), uuid.New(), uuid.New()
+	algebra, physics := uuid.New(), uuid.New()
+
+	semesterRepo := &mockSemesterRepository{
+		getByID: map[uuid.UUID]*entity.Semester{
+			semesterID: {ID: semesterID, Name: "Fall 2026", OfferedSubjectIDs: []uuid.UUID{algebra, physics}},
+		},
+		slots: []*entity.TimeSlot{
+			{ID: monday, SemesterID: semesterID, DayOfWeek: 1, StartPeriod: 1, EndPeriod: 3},
+			{ID: mondayLate, SemesterID: semesterID, DayOfWeek: 1, StartPeriod: 2, EndPeriod: 4},
+			{ID: tuesday, SemesterID: semesterID, DayOfWeek: 2, StartPeriod: 1, EndPeriod: 3},
+		},
+	}
+	roomRepo := &mockRoomRepository{rooms: []*entity.Room{
+		{ID: room1, Name: "R1", Capacity: 30, Type: "classroom", IsActive: true},
+		{ID: room2, Name: "R2", Capacity: 30, Type: "classroom", IsActive: true},
+	}}
+	algebraEntry := &entity.ScheduleEntry{ID: uuid.New(), ScheduleID: scheduleID, SubjectID: algebra, TeacherID: alice, RoomID: room1, TimeSlotID: monday, DayOfWeek: 1, StartPeriod: 1}
+	physicsEntry := &entity.ScheduleEntry{ID: uuid.New(), ScheduleID: scheduleID, SubjectID: physics, TeacherID: bob, RoomID: room1, TimeSlotID: tuesday, DayOfWeek: 2, StartPeriod: 1}
+	schedule := &entity.Schedule{ID: scheduleID, SemesterID: semesterID, Name: "Draft", Status: valueobject.ScheduleStatusDraft}
+	scheduleRepo := &mockScheduleRepository{
+		byID:    map[uuid.UUID]*entity.Schedule{scheduleID: schedule},
+		entries: map[uuid.UUID][]*entity.ScheduleEntry{scheduleID: {algebraEntry, physicsEntry}},
+	}
+	teachers := &mockTeacherServiceClient{teachers: []service.TeacherInfo{
+		{ID: alice, FullName: "Alice"},
+		{ID: bob, FullName: "Bob"},
+	}}
+	subjects := &mockSubjectServiceClient{subjects: []infragrpc.SubjectInfo{{ID: algebra, Code: "MATH1"}, {ID: physics, Code: "PHYS1"}}}
+
+	manualAssign := command.NewManualAssignHandler(
+		semesterRepo, scheduleRepo, nil, roomRepo, nil,
+		infragrpc.NewHRClientWithTeacherClient(teachers),
+		infragrpc.NewSubjectClientWithServices(subjects, &mockPrerequisiteServiceClient{}),
+		nil, &mockEventPublisher{},
+	)
+	conn := startTimetableTestServer(t, func(server *grpc.Server) {
+		timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(nil, manualAssign, nil, nil, nil, nil, nil, roomRepo, ScheduleLifecycle{}, nil, ExamScheduling{}, RoomManagement{}))
+	})
+	client := timetablev1.NewTimetableServiceClient(conn)
+
+	// Moving physics onto Monday in the same room double-books R1
+	preview, err := client.ManualAssign(context.Background(), &timetablev1.ManualAssignRequest{
+		ScheduleId: scheduleID.String(),
+		EntryId:    physicsEntry.ID.String(),
+		TeacherId:  bob.String(),
+		TimeSlotId: monday.String(),
+		DryRun:     true,
+	})
+	if err != nil {
+		t.Fatalf("ManualAssign dry run error: %v", err)
+	}
+	if len(preview.Violations) != 1 || preview.Violations[0].Constraint != string(valueobject.ConstraintRoomConflict) {
+		t.Fatalf("expected one room conflict, got %+v", preview.Violations)
+	}
+	if preview.HardViolations != 1 {
+		t.Fatalf("expected the preview to count 1 hard violation, got %d", preview.HardViolations)
+	}
+	if len(scheduleRepo.updatedEntries) != 0 || schedule.HardViolations != 0 {
+		t.Fatal("a dry run must not save anything")
+	}
+
+	// Booking a room or teacher twice in the very same slot cannot be stored
+	// and is refused with the clash
+	for name, tc := range map[string]struct {
+		req  *timetablev1.ManualAssignRequest
+		want valueobject.ConstraintType
+	}{
+		"same room": {
+			req:  &timetablev1.ManualAssignRequest{TeacherId: bob.String(), TimeSlotId: monday.String()},
+			want: valueobject.ConstraintRoomConflict,
+		},
+		"same teacher": {
+			req:  &timetablev1.ManualAssignRequest{TeacherId: alice.String(), RoomId: room2.String(), TimeSlotId: monday.String()},
+			want: valueobject.ConstraintTeacherConflict,
+		},
+	} {
+		tc.req.ScheduleId, tc.req.EntryId = scheduleID.String(), physicsEntry.ID.String()
+		_, err = client.ManualAssign(context.Background(), tc.req)
+		st := status.Convert(err)
+		if st.Code() != codes.FailedPrecondition {
+			t.Fatalf("%s: expected FailedPrecondition, got %v", name, err)
+		}
+		details := st.Details()
+		if len(details) != 1 {
+			t.Fatalf("%s: expected one violation in the status details, got %v", name, details)
+		}
+		v, ok := details[0].(*timetablev1.ScheduleViolation)
+		if !ok || v.Constraint != string(tc.want) || v.OtherEntryId != algebraEntry.ID.String() {
+			t.Fatalf("%s: expected %s with the algebra entry, got %v", name, tc.want, details[0])
+		}
+		if len(scheduleRepo.updatedEntries) != 0 {
+			t.Fatalf("%s: a refused edit must not be saved", name)
+		}
+	}
+
+	// An overlapping slot is stored: the edit is saved and leaves the
+	// schedule unpublishable
+	_, err = client.ManualAssign(context.Background(), &timetablev1.ManualAssignRequest{
+		ScheduleId: scheduleID.String(),
+		EntryId:    physicsEntry.ID.String(),
+		TeacherId:  bob.String(),
+		TimeSlotId: mondayLate.String(),
+	})
+	if err != nil {
+		t.Fatalf("ManualAssign error: %v", err)
+	}
+	if len(scheduleRepo.updatedEntries) != 1 || scheduleRepo.updatedEntries[0].TimeSlotID != mondayLate {
+		t.Fatalf("expected the moved entry to be saved, got %+v", scheduleRepo.updatedEntries)
+	}
+	if schedule.HardViolations != 1 {
+		t.Fatalf("expected the stored violation count to be recomputed, got %d", schedule.HardViolations)
+	}
+	_, err = command.NewPublishScheduleHandler(scheduleRepo, nil, &mockSemesterRepository{}, &mockEventPublisher{}).Handle(context.Background(), command.PublishScheduleCommand{ScheduleID: scheduleID})
+	if !errors.Is(err, entity.ErrHardViolations) {
+		t.Fatalf("expected publish to be blocked, got %v", err)
+	}
+
+	_, err = client.ManualAssign(context.Background(), &timetablev1.ManualAssignRequest{
+		ScheduleId: scheduleID.String(),
+		EntryId:    physicsEntry.ID.String(),
+		TeacherId:  uuid.NewString(),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an unknown teacher, got %v", err)
+	}
+}
+
+func TestTimetableServerPublishLifecycle(t *testing.T) {
+	semesterID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	scheduleRepo := &mockScheduleRepository{
+		byID: map[uuid.UUID]*entity.Schedule{
+			first:  {ID: first, SemesterID: semesterID, Name: "A", Status: valueobject.ScheduleStatusCompleted},
+			second: {ID: second, SemesterID: semesterID, Name: "B", Status: valueobject.ScheduleStatusCompleted},
+		},
+		entries: map[uuid.UUID][]*entity.ScheduleEntry{
+			first: {{ID: uuid.New(), ScheduleID: first, SubjectID: uuid.New(), TeacherID: uuid.New(), RoomID: uuid.New(), TimeSlotID: uuid.New()}},
+		},
+	}
+	versionRepo := &mockScheduleVersionRepository{schedules: scheduleRepo}
+	publisher := &mockEventPublisher{}
+	publish := command.NewPublishScheduleHandler(scheduleRepo, versionRepo, &mockSemesterRepository{}, publisher)
+	lifecycle := ScheduleLifecycle{
+		Publish:   publish,
+		Unpublish: command.NewUnpublishScheduleHandler(scheduleRepo, publisher),
+		Archive:   command.NewArchiveScheduleHandler(scheduleRepo, publisher),
+		Rollback:  command.NewRollbackScheduleHandler(versionRepo, publish),
+		Versions:  query.NewListScheduleVersionsHandler(versionRepo),
+		Version:   query.NewGetScheduleVersionHandler(versionRepo),
+		Diff:      query.NewDiffSchedulesHandler(scheduleRepo, versionRepo),
+	}
+	conn := startTimetableTestServer(t, func(server *grpc.Server) {
+		timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(nil, nil, nil, nil, nil, nil, nil, nil, lifecycle, nil, ExamScheduling{}, RoomManagement{}))
+	})
+	client := timetablev1.NewTimetableServiceClient(conn)
+	ctx := context.Background()
+
+	if _, err := client.PublishSchedule(ctx, &timetablev1.PublishScheduleRequest{ScheduleId: first.String()}); err != nil {
+		t.Fatalf("PublishSchedule error: %v", err)
+	}
+	resp, err := client.PublishSchedule(ctx, &timetablev1.PublishScheduleRequest{ScheduleId: second.String()})
+	if err != nil {
+		t.Fatalf("PublishSchedule error: %v", err)
+	}
+	if resp.Version.Version != 2 || len(resp.ArchivedScheduleIds) != 1 || resp.ArchivedScheduleIds[0] != first.String() {
+		t.Fatalf("publishing B should archive A as version 2, got %+v", resp)
+	}
+
+	if _, err := client.UnpublishSchedule(ctx, &timetablev1.UnpublishScheduleRequest{ScheduleId: second.String()}); err != nil {
+		t.Fatalf("UnpublishSchedule error: %v", err)
+	}
+	_, err = client.UnpublishSchedule(ctx, &timetablev1.UnpublishScheduleRequest{ScheduleId: second.String()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition unpublishing a draft, got %v", err)
+	}
+
+	rollback, err := client.RollbackSchedule(ctx, &timetablev1.RollbackScheduleRequest{SemesterId: semesterID.String(), Version: 1})
+	if err != nil {
+		t.Fatalf("RollbackSchedule error: %v", err)
+	}
+	if rollback.Version.Version != 3 || len(rollback.Version.Entries) != 1 || rollback.Schedule.Status != string(valueobject.ScheduleStatusPublished) {
+		t.Fatalf("rollback should republish version 1 as version 3, got %+v", rollback)
+	}
+
+	_, err = client.GetScheduleVersion(ctx, &timetablev1.GetScheduleVersionRequest{SemesterId: semesterID.String(), Version: 9})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for a missing version, got %v", err)
+	}
+
+	// B had no entries, so going back to A's version adds its one session
+	diff, err := client.DiffSchedules(ctx, &timetablev1.DiffSchedulesRequest{
+		SemesterId: semesterID.String(), BaseVersion: 2, TargetVersion: 3,
+	})
+	if err != nil {
+		t.Fatalf("DiffSchedules error: %v", err)
+	}
+	if len(diff.Changes) != 1 || diff.Changes[0].Kind != "added" || diff.Changes[0].After.Id != "" || len(diff.Teachers) != 1 {
+		t.Fatalf("expected one added session, got %+v", diff)
+	}
+	_, err = client.DiffSchedules(ctx, &timetablev1.DiffSchedulesRequest{BaseScheduleId: first.String(), TargetVersion: 1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a version without semester, got %v", err)
+	}
+}
+
+func TestTimetableServerExportICalendar(t *testing.T) {
+	semesterID, scheduleID := uuid.New(), uuid.New()
+	teacherA, teacherB := uuid.New(), uuid.New()
+	semesterRepo := &mockSemesterRepository{getByID: map[uuid.UUID]*entity.Semester{
+		semesterID: {
+			ID: semesterID, Name: "Fall 2025",
+			StartDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2025, 12, 19, 0, 0, 0, 0, time.UTC),
+		},
+	}}
+	scheduleRepo := &mockScheduleRepository{
+		byID: map[uuid.UUID]*entity.Schedule{
+			scheduleID: {ID: scheduleID, SemesterID: semesterID, Status: valueobject.ScheduleStatusCompleted},
+		},
+		entries: map[uuid.UUID][]*entity.ScheduleEntry{
+			scheduleID: {
+				{ID: uuid.New(), SubjectID: uuid.New(), SubjectCode: "CS101", TeacherID: teacherA, TeacherName: "Alice", DayOfWeek: 0, StartPeriod: 1, EndPeriod: 2},
+				{ID: uuid.New(), SubjectID: uuid.New(), SubjectCode: "CS102", TeacherID: teacherB, TeacherName: "Bob", DayOfWeek: 1, StartPeriod: 3, EndPeriod: 4},
+			},
+		},
+	}
+	export := query.NewExportICalendarHandler(semesterRepo, scheduleRepo, nil)
+	conn := startTimetableTestServer(t, func(server *grpc.Server) {
+		timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(nil, nil, nil, nil, nil, nil, nil, nil, ScheduleLifecycle{}, export, ExamScheduling{}, RoomManagement{}))
+	})
+	client := timetablev1.NewTimetableServiceClient(conn)
+	ctx := context.Background()
+
+	resp, err := client.ExportICalendar(ctx, &timetablev1.ExportICalendarRequest{ScheduleId: scheduleID.String(), TeacherId: teacherA.String()})
+	if err != nil {
+		t.Fatalf("ExportICalendar error: %v", err)
+	}
+	cal := string(resp.Calendar)
+	if strings.Count(cal, "BEGIN:VEVENT") != 1 || !strings.Contains(cal, "SUMMARY:CS101") || resp.Filename != "fall-2025-alice.ics" {
+		t.Fatalf("expected only Alice's class in fall-2025-alice.ics, got %s:\n%s", resp.Filename, cal)
+	}
+
+	// Feeds follow the published schedule; there is none yet
+	_, err = client.ExportICalendar(ctx, &timetablev1.ExportICalendarRequest{SemesterId: semesterID.String()})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound without a published schedule, got %v", err)
+	}
+	_, err = client.ExportICalendar(ctx, &timetablev1.ExportICalendarRequest{ScheduleId: scheduleID.String(), TeacherId: teacherA.String(), RoomId: uuid.New().String()})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for two filters, got %v", err)
+	}
+}
+
+func TestTimetableServerExamSchedules(t *testing.T) {
+	semesterID := uuid.New()
+	algebra, physics := uuid.New(), uuid.New()
+	teacherA, teacherB := uuid.New(), uuid.New()
+	roomID := uuid.New()
+
+	semesterRepo := &mockSemesterRepository{
+		getByID: map[uuid.UUID]*entity.Semester{
+			semesterID: {ID: semesterID, Name: "Fall 2026", OfferedSubjectIDs: []uuid.UUID{algebra, physics}},
+		},
+	}
+	roomRepo := &mockRoomRepository{rooms: []*entity.Room{{ID: roomID, Name: "Hall", Capacity: 60, IsActive: true}}}
+	teachers := &mockTeacherServiceClient{teachers: []service.TeacherInfo{
+		{ID: teacherA, FullName: "Teacher A"},
+		{ID: teacherB, FullName: "Teacher B"},
+	}}
+	subjects := &mockSubjectServiceClient{subjects: []infragrpc.SubjectInfo{{ID: algebra, Code: "MATH1"}, {ID: physics, Code: "PHYS1"}}}
+	examRepo := &mockExamScheduleRepository{}
+	exams := ExamScheduling{
+		Generate: command.NewGenerateExamScheduleHandler(
+			semesterRepo, examRepo, roomRepo, nil,
+			infragrpc.NewHRClientWithTeacherClient(teachers),
+			infragrpc.NewSubjectClientWithServices(subjects, &mockPrerequisiteServiceClient{}),
+			nil,
+		),
+		Get:  query.NewGetExamScheduleHandler(examRepo),
+		List: query.NewListExamSchedulesHandler(examRepo),
+	}
+	conn := startTimetableTestServer(t, func(server *grpc.Server) {
+		timetablev1.RegisterTimetableServiceServer(server, NewTimetableServer(nil, nil, nil, nil, nil, nil, nil, nil, ScheduleLifecycle{}, nil, exams, RoomManagement{}))
+	})
+	client := timetablev1.NewTimetableServiceClient(conn)
+	ctx := context.Background()
+
+	// A single Monday with one sitting: both exams share the slot, so they
+	// need different invigilators, and one room can hold only one of them
+	monday := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	_, err := client.GenerateExamSchedule(ctx, &timetablev1.GenerateExamScheduleRequest{
+		SemesterId: semesterID.String(),
+		StartDate:  timestamppbFromTime(monday),
+		EndDate:    timestamppbFromTime(monday),
+		Settings:   &timetablev1.ExamSettings{Sessions: []*timetablev1.ExamSession{{StartPeriod: 1, EndPeriod: 3}}},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition when the exams cannot fit, got %v", err)
+	}
+
+	resp, err := client.GenerateExamSchedule(ctx, &timetablev1.GenerateExamScheduleRequest{
+		SemesterId: semesterID.String(),
+		StartDate:  timestamppbFromTime(monday),
+		EndDate:    timestamppbFromTime(monday.AddDate(0, 0, 1)),
+	})
+	if err != nil {
+		t.Fatalf("GenerateExamSchedule error: %v", err)
+	}
+	exam := resp.ExamSchedule
+	if exam.Name != "Exams-Fall 2026" || len(exam.Entries) != 2 || len(exam.UnscheduledSubjectIds) != 0 {
+		t.Fatalf("unexpected exam schedule: %+v", exam)
+	}
+	if len(exam.Settings.Sessions) != 2 || exam.Settings.MaxPerDay != 2 {
+		t.Fatalf("expected default settings to be stored, got %+v", exam.Settings)
+	}
+	first, second := exam.Entries[0], exam.Entries[1]
+	if first.RoomName != "Hall" || first.InvigilatorName == "" {
+		t.Fatalf("expected room and invigilator names, got %+v", first)
+	}
+	if first.Date.AsTime().Equal(second.Date.AsTime()) && first.StartPeriod == second.StartPeriod {
+		t.Fatal("two exams may not share the only room at the same time")
+	}
+
+	got, err := client.GetExamSchedule(ctx, &timetablev1.GetExamScheduleRequest{Id: exam.Id})
+	if err != nil || len(got.ExamSchedule.Entries) != 2 {
+		t.Fatalf("GetExamSchedule: %v %+v", err, got)
+	}
+	list, err := client.ListExamSchedules(ctx, &timetablev1.ListExamSchedulesRequest{SemesterId: semesterID.String()})
+	if err != nil || len(list.ExamSchedules) != 1 {
+		t.Fatalf("ListExamSchedules: %v %+v", err, list)
+	}
+	if _, err := client.GetExamSchedule(ctx, &timetablev1.GetExamScheduleRequest{Id: uuid.NewString()}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for an unknown exam schedule, got %v", err)
+	}
+
+	_, err = client.GenerateExamSchedule(ctx, &timetablev1.GenerateExamScheduleRequest{
+		SemesterId: semesterID.String(),
+		StartDate:  timestamppbFromTime(monday),
+		EndDate:    timestamppbFromTime(monday.AddDate(0, 0, -1)),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an end before the start, got %v", err)
+	}
+}
+
+// mockExamScheduleRepository keeps exam schedules in memory.
+type mockExamScheduleRepository struct {
+	exams []*entity.ExamSchedule
+}
+
+var _ repository.ExamScheduleRepository = (*mockExamScheduleRepository)(nil)
+
+func (m *mockExamScheduleRepository) Create(_ context.Context, e *entity.ExamSchedule) (*entity.ExamSchedule, error) {
+	e.ID = uuid.New()
+	e.CreatedAt = time.Now()
+	m.exams = append(m.exams, e)
+	return e, nil
+}
+
+func (m *mockExamScheduleRepository) GetByID(_ context.Context, id uuid.UUID) (*entity.ExamSchedule, error) {
+	for _, e := range m.exams {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, repository.ErrExamScheduleNotFound
+}
+
+func (m *mockExamScheduleRepository) ListBySemester(_ context.Context, semesterID uuid.UUID) ([]*entity.ExamSchedule, error) {
+	var out []*entity.ExamSchedule
+	for _, e := range m.exams {
+		if e.SemesterID == semesterID {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE timetable.schedules
+  ADD COLUMN failure_reason TEXT NOT NULL DEFAULT '',
+  ADD COLUMN diagnosis      JSONB;
+
+-- +goose Down
+ALTER TABLE timetable.schedules
+  DROP COLUMN failure_reason,
+  DROP COLUMN diagnosis;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE timetable.semesters
+  ADD COLUMN soft_constraints JSONB NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE timetable.semesters
+  DROP COLUMN soft_constraints;
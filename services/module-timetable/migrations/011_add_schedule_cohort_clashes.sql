@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE timetable.schedules
+  ADD COLUMN cohort_clashes INT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE timetable.schedules
+  DROP COLUMN cohort_clashes;
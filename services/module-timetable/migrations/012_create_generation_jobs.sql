@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE timetable.generation_jobs (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    schedule_id      UUID NOT NULL UNIQUE REFERENCES timetable.schedules(id) ON DELETE CASCADE,
+    semester_id      UUID NOT NULL REFERENCES timetable.semesters(id),
+    status           VARCHAR(20) NOT NULL DEFAULT 'queued', -- queued|running|succeeded|failed|cancelled
+    params           JSONB NOT NULL DEFAULT '{}',
+    attempts         INT NOT NULL DEFAULT 0,
+    max_attempts     INT NOT NULL DEFAULT 3,
+    lease_owner      TEXT NOT NULL DEFAULT '',
+    lease_expires_at TIMESTAMPTZ,
+    cancel_requested BOOLEAN NOT NULL DEFAULT false,
+    error_message    TEXT NOT NULL DEFAULT '',
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at       TIMESTAMPTZ,
+    finished_at      TIMESTAMPTZ
+);
+
+CREATE INDEX idx_generation_jobs_active ON timetable.generation_jobs (created_at)
+    WHERE status IN ('queued', 'running');
+
+-- +goose Down
+DROP TABLE IF EXISTS timetable.generation_jobs;
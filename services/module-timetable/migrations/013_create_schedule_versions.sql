@@ -0,0 +1,52 @@
+-- +goose Up
+-- Semesters published more than once keep only their latest published
+-- schedule; the older ones are archived so the constraint below can hold.
+UPDATE timetable.schedules s SET status = 'archived'
+WHERE s.status = 'published'
+  AND EXISTS (
+    SELECT 1 FROM timetable.schedules newer
+    WHERE newer.semester_id = s.semester_id
+      AND newer.status = 'published'
+      AND (COALESCE(newer.generated_at, newer.created_at), newer.created_at, newer.id)
+        > (COALESCE(s.generated_at, s.created_at), s.created_at, s.id)
+  );
+
+-- At most one published schedule per semester. Deferred so that publishing a
+-- schedule can archive the previous one within the same transaction.
+ALTER TABLE timetable.schedules
+  ADD CONSTRAINT schedules_one_published_per_semester
+  EXCLUDE USING btree (semester_id WITH =) WHERE (status = 'published')
+  DEFERRABLE INITIALLY DEFERRED;
+
+-- Append-only history of published timetables, numbered per semester. A
+-- schedule that was ever published cannot be deleted (RESTRICT): its versions
+-- are immutable, so it is archived instead.
+CREATE TABLE timetable.schedule_versions (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    semester_id     UUID NOT NULL REFERENCES timetable.semesters(id),
+    schedule_id     UUID NOT NULL REFERENCES timetable.schedules(id) ON DELETE RESTRICT,
+    version         INT NOT NULL,
+    score           FLOAT NOT NULL DEFAULT 0,
+    hard_violations INT NOT NULL DEFAULT 0,
+    soft_penalty    FLOAT NOT NULL DEFAULT 0,
+    entries         JSONB NOT NULL DEFAULT '[]',
+    published_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (semester_id, version)
+);
+
+-- +goose StatementBegin
+CREATE FUNCTION timetable.reject_schedule_version_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'schedule versions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER schedule_versions_immutable
+    BEFORE UPDATE OR DELETE ON timetable.schedule_versions
+    FOR EACH ROW EXECUTE FUNCTION timetable.reject_schedule_version_change();
+
+-- +goose Down
+DROP TABLE IF EXISTS timetable.schedule_versions;
+DROP FUNCTION IF EXISTS timetable.reject_schedule_version_change();
+ALTER TABLE timetable.schedules DROP CONSTRAINT IF EXISTS schedules_one_published_per_semester;
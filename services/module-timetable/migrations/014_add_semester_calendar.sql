@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE timetable.semesters
+  ADD COLUMN periods       JSONB NOT NULL DEFAULT '[]',
+  ADD COLUMN holidays      JSONB NOT NULL DEFAULT '[]',
+  ADD COLUMN week_patterns JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE timetable.semesters
+  DROP COLUMN week_patterns,
+  DROP COLUMN holidays,
+  DROP COLUMN periods;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Generated final-exam timetables. Entries are stored as a JSON snapshot,
+-- like schedule versions, since an exam schedule is never edited in place.
+CREATE TABLE timetable.exam_schedules (
+    id                      UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    semester_id             UUID NOT NULL REFERENCES timetable.semesters(id),
+    name                    VARCHAR(255) NOT NULL,
+    start_date              DATE NOT NULL,
+    end_date                DATE NOT NULL,
+    settings                JSONB NOT NULL DEFAULT '{}',
+    score                   FLOAT NOT NULL DEFAULT 0,
+    soft_penalty            FLOAT NOT NULL DEFAULT 0,
+    entries                 JSONB NOT NULL DEFAULT '[]',
+    unscheduled_subject_ids UUID[] NOT NULL DEFAULT '{}',
+    created_at              TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_exam_schedules_semester ON timetable.exam_schedules(semester_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS timetable.exam_schedules;
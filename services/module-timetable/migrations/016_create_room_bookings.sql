@@ -0,0 +1,40 @@
+-- +goose Up
+-- Restricted rooms accept ad-hoc bookings only after approval.
+ALTER TABLE timetable.rooms ADD COLUMN requires_approval BOOLEAN NOT NULL DEFAULT false;
+
+-- btree_gist lets the exclusion constraint below compare room ids.
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Ad-hoc room bookings (defences, meetings) outside the generated timetable.
+-- A booking holds periods start_period..end_period on every day of its range.
+CREATE TABLE timetable.room_bookings (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    room_id      UUID NOT NULL REFERENCES timetable.rooms(id),
+    title        VARCHAR(200) NOT NULL,
+    purpose      TEXT NOT NULL DEFAULT '',
+    requested_by UUID NOT NULL,
+    start_date   DATE NOT NULL,
+    end_date     DATE NOT NULL,
+    start_period INT NOT NULL,
+    end_period   INT NOT NULL,
+    status       VARCHAR(20) NOT NULL DEFAULT 'pending',
+    reviewed_by  UUID,
+    review_note  TEXT NOT NULL DEFAULT '',
+    reviewed_at  TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (end_date >= start_date),
+    CHECK (start_period >= 1 AND end_period >= start_period),
+    -- Backs up the service's conflict check against concurrent requests:
+    -- pending and approved bookings of a room never overlap.
+    CONSTRAINT room_bookings_no_overlap EXCLUDE USING gist (
+        room_id WITH =,
+        daterange(start_date, end_date, '[]') WITH &&,
+        int4range(start_period, end_period, '[]') WITH &&
+    ) WHERE (status IN ('pending', 'approved'))
+);
+
+CREATE INDEX idx_room_bookings_requested_by ON timetable.room_bookings(requested_by);
+
+-- +goose Down
+DROP TABLE IF EXISTS timetable.room_bookings;
+ALTER TABLE timetable.rooms DROP COLUMN IF EXISTS requires_approval;
@@ -0,0 +1,38 @@
+-- +goose Up
+-- Campuses group buildings; travel between campuses takes long enough that
+-- back-to-back classes on different campuses are penalised (campus_travel).
+CREATE TABLE timetable.campuses (
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code       VARCHAR(20) NOT NULL UNIQUE,
+    name       VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE timetable.buildings (
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    campus_id  UUID NOT NULL REFERENCES timetable.campuses(id),
+    code       VARCHAR(20) NOT NULL UNIQUE,
+    name       VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_buildings_campus_id ON timetable.buildings(campus_id);
+
+-- Travel minutes between two campuses. The matrix is symmetric, so each pair
+-- is stored once with the smaller id first.
+CREATE TABLE timetable.campus_travel_times (
+    from_campus_id UUID NOT NULL REFERENCES timetable.campuses(id) ON DELETE CASCADE,
+    to_campus_id   UUID NOT NULL REFERENCES timetable.campuses(id) ON DELETE CASCADE,
+    minutes        INT NOT NULL CHECK (minutes >= 0),
+    PRIMARY KEY (from_campus_id, to_campus_id),
+    CHECK (from_campus_id < to_campus_id)
+);
+
+ALTER TABLE timetable.rooms ADD COLUMN building_id UUID REFERENCES timetable.buildings(id);
+CREATE INDEX idx_rooms_building_id ON timetable.rooms(building_id);
+
+-- +goose Down
+ALTER TABLE timetable.rooms DROP COLUMN IF EXISTS building_id;
+DROP TABLE IF EXISTS timetable.campus_travel_times;
+DROP TABLE IF EXISTS timetable.buildings;
+DROP TABLE IF EXISTS timetable.campuses;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Offered subjects split into parallel sections: subject id -> sections
+-- (number, capacity, student_group, assistants). Absent = one section.
+ALTER TABLE timetable.semesters ADD COLUMN sections JSONB NOT NULL DEFAULT '{}';
+
+-- Entries carry their section and the assistants teaching with the lead
+-- (teacher_id); assistants is a list of {teacher_id, teacher_name, role}.
+ALTER TABLE timetable.schedule_entries
+  ADD COLUMN section    INT NOT NULL DEFAULT 1 CHECK (section >= 1),
+  ADD COLUMN assistants JSONB NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE timetable.schedule_entries
+  DROP COLUMN assistants,
+  DROP COLUMN section;
+ALTER TABLE timetable.semesters DROP COLUMN sections;
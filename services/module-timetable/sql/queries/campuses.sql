@@ -0,0 +1,27 @@
+-- name: CreateCampus :one
+INSERT INTO timetable.campuses (code, name) VALUES ($1, $2) RETURNING *;
+
+-- name: GetCampusByID :one
+SELECT * FROM timetable.campuses WHERE id = $1;
+
+-- name: ListCampuses :many
+SELECT * FROM timetable.campuses ORDER BY code;
+
+-- name: CreateBuilding :one
+INSERT INTO timetable.buildings (campus_id, code, name) VALUES ($1, $2, $3) RETURNING *;
+
+-- name: GetBuildingByID :one
+SELECT * FROM timetable.buildings WHERE id = $1;
+
+-- name: ListBuildings :many
+SELECT * FROM timetable.buildings
+WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR campus_id = $1)
+ORDER BY code;
+
+-- name: UpsertCampusTravelTime :exec
+INSERT INTO timetable.campus_travel_times (from_campus_id, to_campus_id, minutes)
+VALUES ($1, $2, $3)
+ON CONFLICT (from_campus_id, to_campus_id) DO UPDATE SET minutes = EXCLUDED.minutes;
+
+-- name: ListCampusTravelTimes :many
+SELECT * FROM timetable.campus_travel_times;
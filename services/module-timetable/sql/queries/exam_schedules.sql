@@ -0,0 +1,15 @@
+-- name: CreateExamSchedule :one
+INSERT INTO timetable.exam_schedules
+    (semester_id, name, start_date, end_date, settings, score, soft_penalty, entries, unscheduled_subject_ids)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetExamScheduleByID :one
+SELECT * FROM timetable.exam_schedules WHERE id = $1;
+
+-- name: ListExamSchedules :many
+SELECT id, semester_id, name, start_date, end_date, settings, score, soft_penalty,
+       '[]'::jsonb AS entries, unscheduled_subject_ids, created_at
+FROM timetable.exam_schedules
+WHERE semester_id = $1
+ORDER BY created_at DESC;
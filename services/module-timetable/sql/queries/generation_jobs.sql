@@ -0,0 +1,63 @@
+-- name: CreateGenerationJob :one
+INSERT INTO timetable.generation_jobs (schedule_id, semester_id, params, max_attempts)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetGenerationJobBySchedule :one
+SELECT * FROM timetable.generation_jobs WHERE schedule_id = $1;
+
+-- name: ClaimGenerationJob :one
+-- Claims the oldest queued job, or a running one whose lease expired, as long
+-- as it has attempts left and was not cancelled.
+UPDATE timetable.generation_jobs
+SET status = 'running', lease_owner = $1,
+    lease_expires_at = NOW() + make_interval(secs => $2),
+    attempts = attempts + 1, started_at = COALESCE(started_at, NOW())
+WHERE id = (
+    SELECT id FROM timetable.generation_jobs
+    WHERE NOT cancel_requested AND attempts < max_attempts
+      AND (status = 'queued' OR (status = 'running' AND lease_expires_at < NOW()))
+    ORDER BY created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: RenewGenerationJobLease :one
+UPDATE timetable.generation_jobs
+SET lease_expires_at = NOW() + make_interval(secs => $3)
+WHERE id = $1 AND lease_owner = $2 AND status = 'running'
+RETURNING cancel_requested;
+
+-- name: FinishGenerationJob :exec
+UPDATE timetable.generation_jobs
+SET status = $3, error_message = $4, finished_at = NOW(), lease_expires_at = NULL
+WHERE id = $1 AND lease_owner = $2 AND status = 'running';
+
+-- name: RequestGenerationJobCancel :one
+-- Queued jobs are cancelled at once; running ones are stopped by their worker.
+UPDATE timetable.generation_jobs
+SET cancel_requested = true,
+    status = CASE WHEN status = 'queued' THEN 'cancelled' ELSE status END,
+    finished_at = CASE WHEN status = 'queued' THEN NOW() ELSE finished_at END
+WHERE schedule_id = $1 AND status IN ('queued', 'running')
+RETURNING *;
+
+-- name: ReleaseGenerationJobs :execrows
+-- Puts an owner's running jobs back in the queue; $2 refunds the attempt.
+UPDATE timetable.generation_jobs
+SET status = 'queued', lease_owner = '', lease_expires_at = NULL,
+    attempts = CASE WHEN $2 THEN GREATEST(attempts - 1, 0) ELSE attempts END
+WHERE status = 'running' AND lease_owner = $1;
+
+-- name: ReapGenerationJobs :many
+-- Ends jobs that cannot be claimed again.
+UPDATE timetable.generation_jobs
+SET status = CASE WHEN cancel_requested THEN 'cancelled' ELSE 'failed' END,
+    error_message = CASE WHEN cancel_requested THEN 'generation cancelled'
+                         ELSE 'generation interrupted ' || attempts || ' times' END,
+    finished_at = NOW(), lease_expires_at = NULL
+WHERE (status = 'running' AND lease_expires_at < NOW()
+       AND (cancel_requested OR attempts >= max_attempts))
+   OR (status = 'queued' AND attempts >= max_attempts)
+RETURNING *;
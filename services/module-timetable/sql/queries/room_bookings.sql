@@ -0,0 +1,32 @@
+-- name: CreateRoomBooking :one
+WITH b AS (
+    INSERT INTO timetable.room_bookings
+        (room_id, title, purpose, requested_by, start_date, end_date, start_period, end_period, status)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    RETURNING *
+)
+SELECT b.*, r.name AS room_name FROM b JOIN timetable.rooms r ON r.id = b.room_id;
+
+-- name: GetRoomBookingByID :one
+SELECT b.*, r.name AS room_name
+FROM timetable.room_bookings b JOIN timetable.rooms r ON r.id = b.room_id
+WHERE b.id = $1;
+
+-- name: ListRoomBookings :many
+SELECT b.*, r.name AS room_name
+FROM timetable.room_bookings b JOIN timetable.rooms r ON r.id = b.room_id
+WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR b.room_id = $1)
+  AND ($2 = '00000000-0000-0000-0000-000000000000'::uuid OR b.requested_by = $2)
+  AND (cardinality($3::text[]) = 0 OR b.status = ANY($3))
+  AND ($4::date IS NULL OR b.end_date >= $4)
+  AND ($5::date IS NULL OR b.start_date <= $5)
+ORDER BY b.start_date, b.start_period, b.created_at;
+
+-- name: UpdateRoomBookingStatus :one
+WITH b AS (
+    UPDATE timetable.room_bookings
+    SET status = $2, reviewed_by = $3, review_note = $4, reviewed_at = $5
+    WHERE id = $1 AND status = $6
+    RETURNING *
+)
+SELECT b.*, r.name AS room_name FROM b JOIN timetable.rooms r ON r.id = b.room_id;
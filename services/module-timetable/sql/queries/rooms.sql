@@ -1,13 +1,42 @@
 -- name: CreateRoom :one
-INSERT INTO timetable.rooms (name, capacity, type, features)
-VALUES ($1, $2, $3, $4)
-RETURNING *;
+WITH r AS (
+  INSERT INTO timetable.rooms (name, capacity, type, features, requires_approval, building_id)
+  VALUES ($1, $2, $3, $4, $5, $6)
+  RETURNING *
+)
+SELECT r.*, bl.code AS building_code, bl.campus_id
+FROM r LEFT JOIN timetable.buildings bl ON bl.id = r.building_id;
 
 -- name: GetRoomByID :one
-SELECT * FROM timetable.rooms WHERE id = $1;
+SELECT r.*, bl.code AS building_code, bl.campus_id
+FROM timetable.rooms r LEFT JOIN timetable.buildings bl ON bl.id = r.building_id
+WHERE r.id = $1;
 
 -- name: ListRooms :many
-SELECT * FROM timetable.rooms WHERE is_active = true ORDER BY name LIMIT $1 OFFSET $2;
+SELECT r.*, bl.code AS building_code, bl.campus_id
+FROM timetable.rooms r LEFT JOIN timetable.buildings bl ON bl.id = r.building_id
+WHERE $1::boolean OR r.is_active = true
+ORDER BY r.name LIMIT $2 OFFSET $3;
 
 -- name: CountRooms :one
 SELECT COUNT(*) FROM timetable.rooms WHERE is_active = true;
+
+-- name: UpdateRoom :one
+WITH r AS (
+  UPDATE timetable.rooms
+  SET name = $2, capacity = $3, type = $4, features = $5,
+      requires_approval = $6, building_id = $7, is_active = $8
+  WHERE id = $1
+  RETURNING *
+)
+SELECT r.*, bl.code AS building_code, bl.campus_id
+FROM r LEFT JOIN timetable.buildings bl ON bl.id = r.building_id;
+
+-- name: DeleteRoom :execrows
+DELETE FROM timetable.rooms WHERE id = $1;
+
+-- name: CountPublishedRoomEntries :one
+SELECT COUNT(*)
+FROM timetable.schedule_entries e
+JOIN timetable.schedules s ON s.id = e.schedule_id
+WHERE e.room_id = $1 AND s.status = 'published';
@@ -0,0 +1,27 @@
+-- name: LockScheduleByID :one
+SELECT * FROM timetable.schedules WHERE id = $1 FOR UPDATE;
+
+-- name: ArchivePublishedSchedules :many
+-- Archives the semester's published schedule other than the one being published.
+UPDATE timetable.schedules SET status = 'archived'
+WHERE semester_id = $1 AND status = 'published' AND id <> $2
+RETURNING id;
+
+-- name: CreateScheduleVersion :one
+-- Numbers the version after the semester's latest; the unique key rejects a
+-- concurrent publish that picked the same number.
+INSERT INTO timetable.schedule_versions
+    (semester_id, schedule_id, version, score, hard_violations, soft_penalty, entries)
+SELECT $1, $2, COALESCE(MAX(version), 0) + 1, $3, $4, $5, $6
+FROM timetable.schedule_versions WHERE semester_id = $1
+RETURNING *;
+
+-- name: ListScheduleVersions :many
+SELECT id, semester_id, schedule_id, version, score, hard_violations, soft_penalty,
+       '[]'::jsonb AS entries, published_at
+FROM timetable.schedule_versions
+WHERE semester_id = $1
+ORDER BY version DESC;
+
+-- name: GetScheduleVersion :one
+SELECT * FROM timetable.schedule_versions WHERE semester_id = $1 AND version = $2;
@@ -18,11 +18,17 @@ RETURNING *;
 -- name: UpdateScheduleStatus :one
 UPDATE timetable.schedules SET status = $2 WHERE id = $1 RETURNING *;
 
+-- name: UpdateScheduleFailure :one
+UPDATE timetable.schedules SET failure_reason = $2, diagnosis = $3 WHERE id = $1 RETURNING *;
+
+-- name: UpdateScheduleCohortClashes :one
+UPDATE timetable.schedules SET cohort_clashes = $2 WHERE id = $1 RETURNING *;
+
 -- name: CreateScheduleEntry :one
 INSERT INTO timetable.schedule_entries
     (schedule_id, subject_id, teacher_id, room_id, time_slot_id, is_manual_override,
-     subject_name, subject_code, teacher_name, department_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+     subject_name, subject_code, teacher_name, department_id, section, assistants)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING *;
 
 -- name: GetScheduleEntry :one
@@ -37,7 +43,7 @@ FROM timetable.schedule_entries e
 JOIN timetable.time_slots ts ON e.time_slot_id = ts.id
 JOIN timetable.rooms r       ON e.room_id      = r.id
 WHERE e.schedule_id = $1
-ORDER BY ts.day_of_week, ts.start_period;
+ORDER BY ts.day_of_week, ts.start_period, e.section;
 
 -- name: ListSchedulesPaged :many
 SELECT * FROM timetable.schedules
@@ -51,7 +57,8 @@ WHERE ($1::uuid IS NULL OR NOT $1::uuid = '00000000-0000-0000-0000-000000000000'
 
 -- name: UpdateScheduleEntry :one
 UPDATE timetable.schedule_entries
-SET teacher_id = $2, room_id = $3, time_slot_id = $4, is_manual_override = $5
+SET teacher_id = $2, room_id = $3, time_slot_id = $4, is_manual_override = $5, teacher_name = $6,
+    assistants = $7
 WHERE id = $1
 RETURNING *;
 
@@ -31,3 +31,29 @@ RETURNING *;
 
 -- name: ListTimeSlotsBySemester :many
 SELECT * FROM timetable.time_slots WHERE semester_id = $1 ORDER BY day_of_week, start_period;
+
+-- name: SetSemesterSoftConstraints :one
+UPDATE timetable.semesters SET soft_constraints = $2 WHERE id = $1
+RETURNING *;
+
+-- name: SetSemesterPeriods :one
+UPDATE timetable.semesters SET periods = $2 WHERE id = $1
+RETURNING *;
+
+-- name: SetSemesterHolidays :one
+UPDATE timetable.semesters SET holidays = $2 WHERE id = $1
+RETURNING *;
+
+-- name: SetSemesterWeekPattern :one
+UPDATE timetable.semesters
+SET week_patterns = CASE WHEN $3::text = '' THEN week_patterns - $2::text
+    ELSE week_patterns || jsonb_build_object($2::text, $3::text) END
+WHERE id = $1
+RETURNING *;
+
+-- name: SetSemesterSections :one
+UPDATE timetable.semesters
+SET sections = CASE WHEN $3::jsonb IS NULL THEN sections - $2::text
+    ELSE sections || jsonb_build_object($2::text, $3::jsonb) END
+WHERE id = $1
+RETURNING *;